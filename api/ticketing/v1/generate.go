@@ -0,0 +1,4 @@
+package ticketingv1
+
+// 修改 ticketing.proto 後重新產生 ticketing.pb.go 及 ticketing_grpc.pb.go（需安裝 protoc、protoc-gen-go 及 protoc-gen-go-grpc）
+//go:generate protoc -I ../../.. --go_out=../../.. --go_opt=paths=source_relative --go-grpc_out=../../.. --go-grpc_opt=paths=source_relative api/ticketing/v1/ticketing.proto
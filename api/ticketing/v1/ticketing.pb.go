@@ -0,0 +1,1609 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: api/ticketing/v1/ticketing.proto
+
+// 票務 gRPC API，供合作夥伴的售票系統查詢活動 / 票種及下單。
+// 與 HTTP API 共用同一層 Service，錯誤依 app_errors 的對應轉為 gRPC status code，
+// 錯誤碼（例如 INSUFFICIENT_STOCK）放在 status details 的 ErrorInfo.reason。
+
+package ticketingv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type OrderStatus int32
+
+const (
+	OrderStatus_ORDER_STATUS_UNSPECIFIED OrderStatus = 0
+	OrderStatus_ORDER_STATUS_PENDING     OrderStatus = 1
+	OrderStatus_ORDER_STATUS_CONFIRMED   OrderStatus = 2
+	OrderStatus_ORDER_STATUS_CANCELLED   OrderStatus = 3
+	OrderStatus_ORDER_STATUS_EXPIRED     OrderStatus = 4
+	OrderStatus_ORDER_STATUS_REFUNDED    OrderStatus = 5
+)
+
+// Enum value maps for OrderStatus.
+var (
+	OrderStatus_name = map[int32]string{
+		0: "ORDER_STATUS_UNSPECIFIED",
+		1: "ORDER_STATUS_PENDING",
+		2: "ORDER_STATUS_CONFIRMED",
+		3: "ORDER_STATUS_CANCELLED",
+		4: "ORDER_STATUS_EXPIRED",
+		5: "ORDER_STATUS_REFUNDED",
+	}
+	OrderStatus_value = map[string]int32{
+		"ORDER_STATUS_UNSPECIFIED": 0,
+		"ORDER_STATUS_PENDING":     1,
+		"ORDER_STATUS_CONFIRMED":   2,
+		"ORDER_STATUS_CANCELLED":   3,
+		"ORDER_STATUS_EXPIRED":     4,
+		"ORDER_STATUS_REFUNDED":    5,
+	}
+)
+
+func (x OrderStatus) Enum() *OrderStatus {
+	p := new(OrderStatus)
+	*p = x
+	return p
+}
+
+func (x OrderStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OrderStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_ticketing_v1_ticketing_proto_enumTypes[0].Descriptor()
+}
+
+func (OrderStatus) Type() protoreflect.EnumType {
+	return &file_api_ticketing_v1_ticketing_proto_enumTypes[0]
+}
+
+func (x OrderStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OrderStatus.Descriptor instead.
+func (OrderStatus) EnumDescriptor() ([]byte, []int) {
+	return file_api_ticketing_v1_ticketing_proto_rawDescGZIP(), []int{0}
+}
+
+type StockStatus int32
+
+const (
+	StockStatus_STOCK_STATUS_UNSPECIFIED StockStatus = 0
+	StockStatus_STOCK_STATUS_AVAILABLE   StockStatus = 1
+	StockStatus_STOCK_STATUS_LOW_STOCK   StockStatus = 2
+	StockStatus_STOCK_STATUS_SOLD_OUT    StockStatus = 3
+)
+
+// Enum value maps for StockStatus.
+var (
+	StockStatus_name = map[int32]string{
+		0: "STOCK_STATUS_UNSPECIFIED",
+		1: "STOCK_STATUS_AVAILABLE",
+		2: "STOCK_STATUS_LOW_STOCK",
+		3: "STOCK_STATUS_SOLD_OUT",
+	}
+	StockStatus_value = map[string]int32{
+		"STOCK_STATUS_UNSPECIFIED": 0,
+		"STOCK_STATUS_AVAILABLE":   1,
+		"STOCK_STATUS_LOW_STOCK":   2,
+		"STOCK_STATUS_SOLD_OUT":    3,
+	}
+)
+
+func (x StockStatus) Enum() *StockStatus {
+	p := new(StockStatus)
+	*p = x
+	return p
+}
+
+func (x StockStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StockStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_ticketing_v1_ticketing_proto_enumTypes[1].Descriptor()
+}
+
+func (StockStatus) Type() protoreflect.EnumType {
+	return &file_api_ticketing_v1_ticketing_proto_enumTypes[1]
+}
+
+func (x StockStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StockStatus.Descriptor instead.
+func (StockStatus) EnumDescriptor() ([]byte, []int) {
+	return file_api_ticketing_v1_ticketing_proto_rawDescGZIP(), []int{1}
+}
+
+// id 為內部 id（下單時使用），event_id / ticket_id / order_id 為對外的 UUID，與 HTTP API 相同
+type Event struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	EventId     string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Name        string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	// 每人跨票種的購買上限，未設定為不限
+	MaxPerUser    *int32                 `protobuf:"varint,5,opt,name=max_per_user,json=maxPerUser,proto3,oneof" json:"max_per_user,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	mi := &file_api_ticketing_v1_ticketing_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ticketing_v1_ticketing_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_api_ticketing_v1_ticketing_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Event) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Event) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *Event) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Event) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *Event) GetMaxPerUser() int32 {
+	if x != nil && x.MaxPerUser != nil {
+		return *x.MaxPerUser
+	}
+	return 0
+}
+
+func (x *Event) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Event) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type Ticket struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TicketId       string                 `protobuf:"bytes,2,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	EventId        int32                  `protobuf:"varint,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Name           string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Price          float64                `protobuf:"fixed64,5,opt,name=price,proto3" json:"price,omitempty"`
+	TotalStock     int32                  `protobuf:"varint,6,opt,name=total_stock,json=totalStock,proto3" json:"total_stock,omitempty"`
+	RemainingStock int32                  `protobuf:"varint,7,opt,name=remaining_stock,json=remainingStock,proto3" json:"remaining_stock,omitempty"`
+	MaxPerUser     int32                  `protobuf:"varint,8,opt,name=max_per_user,json=maxPerUser,proto3" json:"max_per_user,omitempty"`
+	// 對號座票種，下單時須帶入已保留的座位
+	Seated        bool                   `protobuf:"varint,9,opt,name=seated,proto3" json:"seated,omitempty"`
+	Presale       bool                   `protobuf:"varint,10,opt,name=presale,proto3" json:"presale,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Ticket) Reset() {
+	*x = Ticket{}
+	mi := &file_api_ticketing_v1_ticketing_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Ticket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ticket) ProtoMessage() {}
+
+func (x *Ticket) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ticketing_v1_ticketing_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ticket.ProtoReflect.Descriptor instead.
+func (*Ticket) Descriptor() ([]byte, []int) {
+	return file_api_ticketing_v1_ticketing_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Ticket) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Ticket) GetTicketId() string {
+	if x != nil {
+		return x.TicketId
+	}
+	return ""
+}
+
+func (x *Ticket) GetEventId() int32 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *Ticket) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Ticket) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Ticket) GetTotalStock() int32 {
+	if x != nil {
+		return x.TotalStock
+	}
+	return 0
+}
+
+func (x *Ticket) GetRemainingStock() int32 {
+	if x != nil {
+		return x.RemainingStock
+	}
+	return 0
+}
+
+func (x *Ticket) GetMaxPerUser() int32 {
+	if x != nil {
+		return x.MaxPerUser
+	}
+	return 0
+}
+
+func (x *Ticket) GetSeated() bool {
+	if x != nil {
+		return x.Seated
+	}
+	return false
+}
+
+func (x *Ticket) GetPresale() bool {
+	if x != nil {
+		return x.Presale
+	}
+	return false
+}
+
+func (x *Ticket) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Ticket) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type TicketAvailability struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TicketId string                 `protobuf:"bytes,2,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	EventId  int32                  `protobuf:"varint,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Name     string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	// 目前適用的單價及價格階段，未套用價格階段時 price_phase 為空
+	Price          float64     `protobuf:"fixed64,5,opt,name=price,proto3" json:"price,omitempty"`
+	PricePhase     string      `protobuf:"bytes,6,opt,name=price_phase,json=pricePhase,proto3" json:"price_phase,omitempty"`
+	Presale        bool        `protobuf:"varint,7,opt,name=presale,proto3" json:"presale,omitempty"`
+	TotalStock     int32       `protobuf:"varint,8,opt,name=total_stock,json=totalStock,proto3" json:"total_stock,omitempty"`
+	RemainingStock int32       `protobuf:"varint,9,opt,name=remaining_stock,json=remainingStock,proto3" json:"remaining_stock,omitempty"`
+	Available      bool        `protobuf:"varint,10,opt,name=available,proto3" json:"available,omitempty"`
+	Status         StockStatus `protobuf:"varint,11,opt,name=status,proto3,enum=ticketing.v1.StockStatus" json:"status,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TicketAvailability) Reset() {
+	*x = TicketAvailability{}
+	mi := &file_api_ticketing_v1_ticketing_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TicketAvailability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TicketAvailability) ProtoMessage() {}
+
+func (x *TicketAvailability) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ticketing_v1_ticketing_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TicketAvailability.ProtoReflect.Descriptor instead.
+func (*TicketAvailability) Descriptor() ([]byte, []int) {
+	return file_api_ticketing_v1_ticketing_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TicketAvailability) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TicketAvailability) GetTicketId() string {
+	if x != nil {
+		return x.TicketId
+	}
+	return ""
+}
+
+func (x *TicketAvailability) GetEventId() int32 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *TicketAvailability) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TicketAvailability) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *TicketAvailability) GetPricePhase() string {
+	if x != nil {
+		return x.PricePhase
+	}
+	return ""
+}
+
+func (x *TicketAvailability) GetPresale() bool {
+	if x != nil {
+		return x.Presale
+	}
+	return false
+}
+
+func (x *TicketAvailability) GetTotalStock() int32 {
+	if x != nil {
+		return x.TotalStock
+	}
+	return 0
+}
+
+func (x *TicketAvailability) GetRemainingStock() int32 {
+	if x != nil {
+		return x.RemainingStock
+	}
+	return 0
+}
+
+func (x *TicketAvailability) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+func (x *TicketAvailability) GetStatus() StockStatus {
+	if x != nil {
+		return x.Status
+	}
+	return StockStatus_STOCK_STATUS_UNSPECIFIED
+}
+
+type Order struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 寫入資料庫前為空
+	OrderId        string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	RequestId      string                 `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	UserId         int32                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TicketId       int32                  `protobuf:"varint,4,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	Quantity       int32                  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	TotalPrice     float64                `protobuf:"fixed64,6,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
+	DiscountAmount float64                `protobuf:"fixed64,7,opt,name=discount_amount,json=discountAmount,proto3" json:"discount_amount,omitempty"`
+	PricePhase     *string                `protobuf:"bytes,8,opt,name=price_phase,json=pricePhase,proto3,oneof" json:"price_phase,omitempty"`
+	PromoCode      *string                `protobuf:"bytes,9,opt,name=promo_code,json=promoCode,proto3,oneof" json:"promo_code,omitempty"`
+	SeatIds        []int32                `protobuf:"varint,10,rep,packed,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
+	Status         OrderStatus            `protobuf:"varint,11,opt,name=status,proto3,enum=ticketing.v1.OrderStatus" json:"status,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Order) Reset() {
+	*x = Order{}
+	mi := &file_api_ticketing_v1_ticketing_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Order) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Order) ProtoMessage() {}
+
+func (x *Order) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ticketing_v1_ticketing_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Order.ProtoReflect.Descriptor instead.
+func (*Order) Descriptor() ([]byte, []int) {
+	return file_api_ticketing_v1_ticketing_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Order) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *Order) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *Order) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Order) GetTicketId() int32 {
+	if x != nil {
+		return x.TicketId
+	}
+	return 0
+}
+
+func (x *Order) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *Order) GetTotalPrice() float64 {
+	if x != nil {
+		return x.TotalPrice
+	}
+	return 0
+}
+
+func (x *Order) GetDiscountAmount() float64 {
+	if x != nil {
+		return x.DiscountAmount
+	}
+	return 0
+}
+
+func (x *Order) GetPricePhase() string {
+	if x != nil && x.PricePhase != nil {
+		return *x.PricePhase
+	}
+	return ""
+}
+
+func (x *Order) GetPromoCode() string {
+	if x != nil && x.PromoCode != nil {
+		return *x.PromoCode
+	}
+	return ""
+}
+
+func (x *Order) GetSeatIds() []int32 {
+	if x != nil {
+		return x.SeatIds
+	}
+	return nil
+}
+
+func (x *Order) GetStatus() OrderStatus {
+	if x != nil {
+		return x.Status
+	}
+	return OrderStatus_ORDER_STATUS_UNSPECIFIED
+}
+
+func (x *Order) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Order) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ListEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEventsRequest) Reset() {
+	*x = ListEventsRequest{}
+	mi := &file_api_ticketing_v1_ticketing_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventsRequest) ProtoMessage() {}
+
+func (x *ListEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ticketing_v1_ticketing_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListEventsRequest) Descriptor() ([]byte, []int) {
+	return file_api_ticketing_v1_ticketing_proto_rawDescGZIP(), []int{4}
+}
+
+type ListEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*Event               `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEventsResponse) Reset() {
+	*x = ListEventsResponse{}
+	mi := &file_api_ticketing_v1_ticketing_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventsResponse) ProtoMessage() {}
+
+func (x *ListEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ticketing_v1_ticketing_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListEventsResponse) Descriptor() ([]byte, []int) {
+	return file_api_ticketing_v1_ticketing_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListEventsResponse) GetEvents() []*Event {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type GetEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEventRequest) Reset() {
+	*x = GetEventRequest{}
+	mi := &file_api_ticketing_v1_ticketing_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventRequest) ProtoMessage() {}
+
+func (x *GetEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ticketing_v1_ticketing_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventRequest.ProtoReflect.Descriptor instead.
+func (*GetEventRequest) Descriptor() ([]byte, []int) {
+	return file_api_ticketing_v1_ticketing_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetEventRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+type ListTicketsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTicketsRequest) Reset() {
+	*x = ListTicketsRequest{}
+	mi := &file_api_ticketing_v1_ticketing_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTicketsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTicketsRequest) ProtoMessage() {}
+
+func (x *ListTicketsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ticketing_v1_ticketing_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTicketsRequest.ProtoReflect.Descriptor instead.
+func (*ListTicketsRequest) Descriptor() ([]byte, []int) {
+	return file_api_ticketing_v1_ticketing_proto_rawDescGZIP(), []int{7}
+}
+
+type ListTicketsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tickets       []*Ticket              `protobuf:"bytes,1,rep,name=tickets,proto3" json:"tickets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTicketsResponse) Reset() {
+	*x = ListTicketsResponse{}
+	mi := &file_api_ticketing_v1_ticketing_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTicketsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTicketsResponse) ProtoMessage() {}
+
+func (x *ListTicketsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ticketing_v1_ticketing_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTicketsResponse.ProtoReflect.Descriptor instead.
+func (*ListTicketsResponse) Descriptor() ([]byte, []int) {
+	return file_api_ticketing_v1_ticketing_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListTicketsResponse) GetTickets() []*Ticket {
+	if x != nil {
+		return x.Tickets
+	}
+	return nil
+}
+
+type GetTicketRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TicketId      string                 `protobuf:"bytes,1,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTicketRequest) Reset() {
+	*x = GetTicketRequest{}
+	mi := &file_api_ticketing_v1_ticketing_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTicketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTicketRequest) ProtoMessage() {}
+
+func (x *GetTicketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ticketing_v1_ticketing_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTicketRequest.ProtoReflect.Descriptor instead.
+func (*GetTicketRequest) Descriptor() ([]byte, []int) {
+	return file_api_ticketing_v1_ticketing_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetTicketRequest) GetTicketId() string {
+	if x != nil {
+		return x.TicketId
+	}
+	return ""
+}
+
+type GetTicketAvailabilityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TicketId      string                 `protobuf:"bytes,1,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTicketAvailabilityRequest) Reset() {
+	*x = GetTicketAvailabilityRequest{}
+	mi := &file_api_ticketing_v1_ticketing_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTicketAvailabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTicketAvailabilityRequest) ProtoMessage() {}
+
+func (x *GetTicketAvailabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ticketing_v1_ticketing_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTicketAvailabilityRequest.ProtoReflect.Descriptor instead.
+func (*GetTicketAvailabilityRequest) Descriptor() ([]byte, []int) {
+	return file_api_ticketing_v1_ticketing_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetTicketAvailabilityRequest) GetTicketId() string {
+	if x != nil {
+		return x.TicketId
+	}
+	return ""
+}
+
+type ListEventAvailabilityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEventAvailabilityRequest) Reset() {
+	*x = ListEventAvailabilityRequest{}
+	mi := &file_api_ticketing_v1_ticketing_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEventAvailabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventAvailabilityRequest) ProtoMessage() {}
+
+func (x *ListEventAvailabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ticketing_v1_ticketing_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventAvailabilityRequest.ProtoReflect.Descriptor instead.
+func (*ListEventAvailabilityRequest) Descriptor() ([]byte, []int) {
+	return file_api_ticketing_v1_ticketing_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListEventAvailabilityRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+type ListEventAvailabilityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tickets       []*TicketAvailability  `protobuf:"bytes,1,rep,name=tickets,proto3" json:"tickets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEventAvailabilityResponse) Reset() {
+	*x = ListEventAvailabilityResponse{}
+	mi := &file_api_ticketing_v1_ticketing_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEventAvailabilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventAvailabilityResponse) ProtoMessage() {}
+
+func (x *ListEventAvailabilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ticketing_v1_ticketing_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventAvailabilityResponse.ProtoReflect.Descriptor instead.
+func (*ListEventAvailabilityResponse) Descriptor() ([]byte, []int) {
+	return file_api_ticketing_v1_ticketing_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListEventAvailabilityResponse) GetTickets() []*TicketAvailability {
+	if x != nil {
+		return x.Tickets
+	}
+	return nil
+}
+
+type CreateOrderRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// 票種的內部 id，與 HTTP API 相同
+	TicketId int32 `protobuf:"varint,2,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	Quantity int32 `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// 對號座票種必填，數量需與 quantity 相同，且須先保留座位
+	SeatIds []int32 `protobuf:"varint,4,rep,packed,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
+	// 由保留轉為訂單時帶入
+	HoldId *string `protobuf:"bytes,5,opt,name=hold_id,json=holdId,proto3,oneof" json:"hold_id,omitempty"`
+	// 價格鎖定：使用者看到的單價，成立訂單時的售價不同則回傳 FAILED_PRECONDITION
+	ExpectedPrice      *float64 `protobuf:"fixed64,6,opt,name=expected_price,json=expectedPrice,proto3,oneof" json:"expected_price,omitempty"`
+	PromoCode          *string  `protobuf:"bytes,7,opt,name=promo_code,json=promoCode,proto3,oneof" json:"promo_code,omitempty"`
+	AccessCode         *string  `protobuf:"bytes,8,opt,name=access_code,json=accessCode,proto3,oneof" json:"access_code,omitempty"`
+	PaymentFingerprint *string  `protobuf:"bytes,9,opt,name=payment_fingerprint,json=paymentFingerprint,proto3,oneof" json:"payment_fingerprint,omitempty"`
+	DeviceFingerprint  *string  `protobuf:"bytes,10,opt,name=device_fingerprint,json=deviceFingerprint,proto3,oneof" json:"device_fingerprint,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CreateOrderRequest) Reset() {
+	*x = CreateOrderRequest{}
+	mi := &file_api_ticketing_v1_ticketing_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrderRequest) ProtoMessage() {}
+
+func (x *CreateOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ticketing_v1_ticketing_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrderRequest.ProtoReflect.Descriptor instead.
+func (*CreateOrderRequest) Descriptor() ([]byte, []int) {
+	return file_api_ticketing_v1_ticketing_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CreateOrderRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateOrderRequest) GetTicketId() int32 {
+	if x != nil {
+		return x.TicketId
+	}
+	return 0
+}
+
+func (x *CreateOrderRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *CreateOrderRequest) GetSeatIds() []int32 {
+	if x != nil {
+		return x.SeatIds
+	}
+	return nil
+}
+
+func (x *CreateOrderRequest) GetHoldId() string {
+	if x != nil && x.HoldId != nil {
+		return *x.HoldId
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetExpectedPrice() float64 {
+	if x != nil && x.ExpectedPrice != nil {
+		return *x.ExpectedPrice
+	}
+	return 0
+}
+
+func (x *CreateOrderRequest) GetPromoCode() string {
+	if x != nil && x.PromoCode != nil {
+		return *x.PromoCode
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetAccessCode() string {
+	if x != nil && x.AccessCode != nil {
+		return *x.AccessCode
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetPaymentFingerprint() string {
+	if x != nil && x.PaymentFingerprint != nil {
+		return *x.PaymentFingerprint
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetDeviceFingerprint() string {
+	if x != nil && x.DeviceFingerprint != nil {
+		return *x.DeviceFingerprint
+	}
+	return ""
+}
+
+type GetOrderRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Lookup:
+	//
+	//	*GetOrderRequest_OrderId
+	//	*GetOrderRequest_RequestId
+	Lookup        isGetOrderRequest_Lookup `protobuf_oneof:"lookup"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderRequest) Reset() {
+	*x = GetOrderRequest{}
+	mi := &file_api_ticketing_v1_ticketing_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderRequest) ProtoMessage() {}
+
+func (x *GetOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ticketing_v1_ticketing_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderRequest) Descriptor() ([]byte, []int) {
+	return file_api_ticketing_v1_ticketing_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetOrderRequest) GetLookup() isGetOrderRequest_Lookup {
+	if x != nil {
+		return x.Lookup
+	}
+	return nil
+}
+
+func (x *GetOrderRequest) GetOrderId() string {
+	if x != nil {
+		if x, ok := x.Lookup.(*GetOrderRequest_OrderId); ok {
+			return x.OrderId
+		}
+	}
+	return ""
+}
+
+func (x *GetOrderRequest) GetRequestId() string {
+	if x != nil {
+		if x, ok := x.Lookup.(*GetOrderRequest_RequestId); ok {
+			return x.RequestId
+		}
+	}
+	return ""
+}
+
+type isGetOrderRequest_Lookup interface {
+	isGetOrderRequest_Lookup()
+}
+
+type GetOrderRequest_OrderId struct {
+	OrderId string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3,oneof"`
+}
+
+type GetOrderRequest_RequestId struct {
+	// CreateOrder 回傳的 request_id；訂單寫入資料庫前回傳 NOT_FOUND
+	RequestId string `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3,oneof"`
+}
+
+func (*GetOrderRequest_OrderId) isGetOrderRequest_Lookup() {}
+
+func (*GetOrderRequest_RequestId) isGetOrderRequest_Lookup() {}
+
+type CancelOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelOrderRequest) Reset() {
+	*x = CancelOrderRequest{}
+	mi := &file_api_ticketing_v1_ticketing_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOrderRequest) ProtoMessage() {}
+
+func (x *CancelOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ticketing_v1_ticketing_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOrderRequest.ProtoReflect.Descriptor instead.
+func (*CancelOrderRequest) Descriptor() ([]byte, []int) {
+	return file_api_ticketing_v1_ticketing_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CancelOrderRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *CancelOrderRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type WatchOrderRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Lookup:
+	//
+	//	*WatchOrderRequest_OrderId
+	//	*WatchOrderRequest_RequestId
+	Lookup        isWatchOrderRequest_Lookup `protobuf_oneof:"lookup"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchOrderRequest) Reset() {
+	*x = WatchOrderRequest{}
+	mi := &file_api_ticketing_v1_ticketing_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchOrderRequest) ProtoMessage() {}
+
+func (x *WatchOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ticketing_v1_ticketing_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchOrderRequest.ProtoReflect.Descriptor instead.
+func (*WatchOrderRequest) Descriptor() ([]byte, []int) {
+	return file_api_ticketing_v1_ticketing_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *WatchOrderRequest) GetLookup() isWatchOrderRequest_Lookup {
+	if x != nil {
+		return x.Lookup
+	}
+	return nil
+}
+
+func (x *WatchOrderRequest) GetOrderId() string {
+	if x != nil {
+		if x, ok := x.Lookup.(*WatchOrderRequest_OrderId); ok {
+			return x.OrderId
+		}
+	}
+	return ""
+}
+
+func (x *WatchOrderRequest) GetRequestId() string {
+	if x != nil {
+		if x, ok := x.Lookup.(*WatchOrderRequest_RequestId); ok {
+			return x.RequestId
+		}
+	}
+	return ""
+}
+
+type isWatchOrderRequest_Lookup interface {
+	isWatchOrderRequest_Lookup()
+}
+
+type WatchOrderRequest_OrderId struct {
+	OrderId string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3,oneof"`
+}
+
+type WatchOrderRequest_RequestId struct {
+	// CreateOrder 回傳的 request_id；訂單尚未寫入資料庫時等待寫入後才送出第一筆
+	RequestId string `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3,oneof"`
+}
+
+func (*WatchOrderRequest_OrderId) isWatchOrderRequest_Lookup() {}
+
+func (*WatchOrderRequest_RequestId) isWatchOrderRequest_Lookup() {}
+
+var File_api_ticketing_v1_ticketing_proto protoreflect.FileDescriptor
+
+const file_api_ticketing_v1_ticketing_proto_rawDesc = "" +
+	"\n" +
+	" api/ticketing/v1/ticketing.proto\x12\fticketing.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xab\x02\n" +
+	"\x05Event\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12%\n" +
+	"\vdescription\x18\x04 \x01(\tH\x00R\vdescription\x88\x01\x01\x12%\n" +
+	"\fmax_per_user\x18\x05 \x01(\x05H\x01R\n" +
+	"maxPerUser\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x0e\n" +
+	"\f_descriptionB\x0f\n" +
+	"\r_max_per_user\"\x8e\x03\n" +
+	"\x06Ticket\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1b\n" +
+	"\tticket_id\x18\x02 \x01(\tR\bticketId\x12\x19\n" +
+	"\bevent_id\x18\x03 \x01(\x05R\aeventId\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x14\n" +
+	"\x05price\x18\x05 \x01(\x01R\x05price\x12\x1f\n" +
+	"\vtotal_stock\x18\x06 \x01(\x05R\n" +
+	"totalStock\x12'\n" +
+	"\x0fremaining_stock\x18\a \x01(\x05R\x0eremainingStock\x12 \n" +
+	"\fmax_per_user\x18\b \x01(\x05R\n" +
+	"maxPerUser\x12\x16\n" +
+	"\x06seated\x18\t \x01(\bR\x06seated\x12\x18\n" +
+	"\apresale\x18\n" +
+	" \x01(\bR\apresale\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xdc\x02\n" +
+	"\x12TicketAvailability\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1b\n" +
+	"\tticket_id\x18\x02 \x01(\tR\bticketId\x12\x19\n" +
+	"\bevent_id\x18\x03 \x01(\x05R\aeventId\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x14\n" +
+	"\x05price\x18\x05 \x01(\x01R\x05price\x12\x1f\n" +
+	"\vprice_phase\x18\x06 \x01(\tR\n" +
+	"pricePhase\x12\x18\n" +
+	"\apresale\x18\a \x01(\bR\apresale\x12\x1f\n" +
+	"\vtotal_stock\x18\b \x01(\x05R\n" +
+	"totalStock\x12'\n" +
+	"\x0fremaining_stock\x18\t \x01(\x05R\x0eremainingStock\x12\x1c\n" +
+	"\tavailable\x18\n" +
+	" \x01(\bR\tavailable\x121\n" +
+	"\x06status\x18\v \x01(\x0e2\x19.ticketing.v1.StockStatusR\x06status\"\x8a\x04\n" +
+	"\x05Order\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x02 \x01(\tR\trequestId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x05R\x06userId\x12\x1b\n" +
+	"\tticket_id\x18\x04 \x01(\x05R\bticketId\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x05R\bquantity\x12\x1f\n" +
+	"\vtotal_price\x18\x06 \x01(\x01R\n" +
+	"totalPrice\x12'\n" +
+	"\x0fdiscount_amount\x18\a \x01(\x01R\x0ediscountAmount\x12$\n" +
+	"\vprice_phase\x18\b \x01(\tH\x00R\n" +
+	"pricePhase\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"promo_code\x18\t \x01(\tH\x01R\tpromoCode\x88\x01\x01\x12\x19\n" +
+	"\bseat_ids\x18\n" +
+	" \x03(\x05R\aseatIds\x121\n" +
+	"\x06status\x18\v \x01(\x0e2\x19.ticketing.v1.OrderStatusR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x0e\n" +
+	"\f_price_phaseB\r\n" +
+	"\v_promo_code\"\x13\n" +
+	"\x11ListEventsRequest\"A\n" +
+	"\x12ListEventsResponse\x12+\n" +
+	"\x06events\x18\x01 \x03(\v2\x13.ticketing.v1.EventR\x06events\",\n" +
+	"\x0fGetEventRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\"\x14\n" +
+	"\x12ListTicketsRequest\"E\n" +
+	"\x13ListTicketsResponse\x12.\n" +
+	"\atickets\x18\x01 \x03(\v2\x14.ticketing.v1.TicketR\atickets\"/\n" +
+	"\x10GetTicketRequest\x12\x1b\n" +
+	"\tticket_id\x18\x01 \x01(\tR\bticketId\";\n" +
+	"\x1cGetTicketAvailabilityRequest\x12\x1b\n" +
+	"\tticket_id\x18\x01 \x01(\tR\bticketId\"9\n" +
+	"\x1cListEventAvailabilityRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\"[\n" +
+	"\x1dListEventAvailabilityResponse\x12:\n" +
+	"\atickets\x18\x01 \x03(\v2 .ticketing.v1.TicketAvailabilityR\atickets\"\xec\x03\n" +
+	"\x12CreateOrderRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x1b\n" +
+	"\tticket_id\x18\x02 \x01(\x05R\bticketId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12\x19\n" +
+	"\bseat_ids\x18\x04 \x03(\x05R\aseatIds\x12\x1c\n" +
+	"\ahold_id\x18\x05 \x01(\tH\x00R\x06holdId\x88\x01\x01\x12*\n" +
+	"\x0eexpected_price\x18\x06 \x01(\x01H\x01R\rexpectedPrice\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"promo_code\x18\a \x01(\tH\x02R\tpromoCode\x88\x01\x01\x12$\n" +
+	"\vaccess_code\x18\b \x01(\tH\x03R\n" +
+	"accessCode\x88\x01\x01\x124\n" +
+	"\x13payment_fingerprint\x18\t \x01(\tH\x04R\x12paymentFingerprint\x88\x01\x01\x122\n" +
+	"\x12device_fingerprint\x18\n" +
+	" \x01(\tH\x05R\x11deviceFingerprint\x88\x01\x01B\n" +
+	"\n" +
+	"\b_hold_idB\x11\n" +
+	"\x0f_expected_priceB\r\n" +
+	"\v_promo_codeB\x0e\n" +
+	"\f_access_codeB\x16\n" +
+	"\x14_payment_fingerprintB\x15\n" +
+	"\x13_device_fingerprint\"Y\n" +
+	"\x0fGetOrderRequest\x12\x1b\n" +
+	"\border_id\x18\x01 \x01(\tH\x00R\aorderId\x12\x1f\n" +
+	"\n" +
+	"request_id\x18\x02 \x01(\tH\x00R\trequestIdB\b\n" +
+	"\x06lookup\"T\n" +
+	"\x12CancelOrderRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reasonJ\x04\b\x02\x10\x03R\x05actor\"[\n" +
+	"\x11WatchOrderRequest\x12\x1b\n" +
+	"\border_id\x18\x01 \x01(\tH\x00R\aorderId\x12\x1f\n" +
+	"\n" +
+	"request_id\x18\x02 \x01(\tH\x00R\trequestIdB\b\n" +
+	"\x06lookup*\xb2\x01\n" +
+	"\vOrderStatus\x12\x1c\n" +
+	"\x18ORDER_STATUS_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14ORDER_STATUS_PENDING\x10\x01\x12\x1a\n" +
+	"\x16ORDER_STATUS_CONFIRMED\x10\x02\x12\x1a\n" +
+	"\x16ORDER_STATUS_CANCELLED\x10\x03\x12\x18\n" +
+	"\x14ORDER_STATUS_EXPIRED\x10\x04\x12\x19\n" +
+	"\x15ORDER_STATUS_REFUNDED\x10\x05*~\n" +
+	"\vStockStatus\x12\x1c\n" +
+	"\x18STOCK_STATUS_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16STOCK_STATUS_AVAILABLE\x10\x01\x12\x1a\n" +
+	"\x16STOCK_STATUS_LOW_STOCK\x10\x02\x12\x19\n" +
+	"\x15STOCK_STATUS_SOLD_OUT\x10\x032\xa5\x06\n" +
+	"\x10TicketingService\x12O\n" +
+	"\n" +
+	"ListEvents\x12\x1f.ticketing.v1.ListEventsRequest\x1a .ticketing.v1.ListEventsResponse\x12>\n" +
+	"\bGetEvent\x12\x1d.ticketing.v1.GetEventRequest\x1a\x13.ticketing.v1.Event\x12R\n" +
+	"\vListTickets\x12 .ticketing.v1.ListTicketsRequest\x1a!.ticketing.v1.ListTicketsResponse\x12A\n" +
+	"\tGetTicket\x12\x1e.ticketing.v1.GetTicketRequest\x1a\x14.ticketing.v1.Ticket\x12e\n" +
+	"\x15GetTicketAvailability\x12*.ticketing.v1.GetTicketAvailabilityRequest\x1a .ticketing.v1.TicketAvailability\x12p\n" +
+	"\x15ListEventAvailability\x12*.ticketing.v1.ListEventAvailabilityRequest\x1a+.ticketing.v1.ListEventAvailabilityResponse\x12D\n" +
+	"\vCreateOrder\x12 .ticketing.v1.CreateOrderRequest\x1a\x13.ticketing.v1.Order\x12>\n" +
+	"\bGetOrder\x12\x1d.ticketing.v1.GetOrderRequest\x1a\x13.ticketing.v1.Order\x12D\n" +
+	"\vCancelOrder\x12 .ticketing.v1.CancelOrderRequest\x1a\x13.ticketing.v1.Order\x12D\n" +
+	"\n" +
+	"WatchOrder\x12\x1f.ticketing.v1.WatchOrderRequest\x1a\x13.ticketing.v1.Order0\x01B6Z4go-gin-high-concurrency/api/ticketing/v1;ticketingv1b\x06proto3"
+
+var (
+	file_api_ticketing_v1_ticketing_proto_rawDescOnce sync.Once
+	file_api_ticketing_v1_ticketing_proto_rawDescData []byte
+)
+
+func file_api_ticketing_v1_ticketing_proto_rawDescGZIP() []byte {
+	file_api_ticketing_v1_ticketing_proto_rawDescOnce.Do(func() {
+		file_api_ticketing_v1_ticketing_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_ticketing_v1_ticketing_proto_rawDesc), len(file_api_ticketing_v1_ticketing_proto_rawDesc)))
+	})
+	return file_api_ticketing_v1_ticketing_proto_rawDescData
+}
+
+var file_api_ticketing_v1_ticketing_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_api_ticketing_v1_ticketing_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_api_ticketing_v1_ticketing_proto_goTypes = []any{
+	(OrderStatus)(0),                      // 0: ticketing.v1.OrderStatus
+	(StockStatus)(0),                      // 1: ticketing.v1.StockStatus
+	(*Event)(nil),                         // 2: ticketing.v1.Event
+	(*Ticket)(nil),                        // 3: ticketing.v1.Ticket
+	(*TicketAvailability)(nil),            // 4: ticketing.v1.TicketAvailability
+	(*Order)(nil),                         // 5: ticketing.v1.Order
+	(*ListEventsRequest)(nil),             // 6: ticketing.v1.ListEventsRequest
+	(*ListEventsResponse)(nil),            // 7: ticketing.v1.ListEventsResponse
+	(*GetEventRequest)(nil),               // 8: ticketing.v1.GetEventRequest
+	(*ListTicketsRequest)(nil),            // 9: ticketing.v1.ListTicketsRequest
+	(*ListTicketsResponse)(nil),           // 10: ticketing.v1.ListTicketsResponse
+	(*GetTicketRequest)(nil),              // 11: ticketing.v1.GetTicketRequest
+	(*GetTicketAvailabilityRequest)(nil),  // 12: ticketing.v1.GetTicketAvailabilityRequest
+	(*ListEventAvailabilityRequest)(nil),  // 13: ticketing.v1.ListEventAvailabilityRequest
+	(*ListEventAvailabilityResponse)(nil), // 14: ticketing.v1.ListEventAvailabilityResponse
+	(*CreateOrderRequest)(nil),            // 15: ticketing.v1.CreateOrderRequest
+	(*GetOrderRequest)(nil),               // 16: ticketing.v1.GetOrderRequest
+	(*CancelOrderRequest)(nil),            // 17: ticketing.v1.CancelOrderRequest
+	(*WatchOrderRequest)(nil),             // 18: ticketing.v1.WatchOrderRequest
+	(*timestamppb.Timestamp)(nil),         // 19: google.protobuf.Timestamp
+}
+var file_api_ticketing_v1_ticketing_proto_depIdxs = []int32{
+	19, // 0: ticketing.v1.Event.created_at:type_name -> google.protobuf.Timestamp
+	19, // 1: ticketing.v1.Event.updated_at:type_name -> google.protobuf.Timestamp
+	19, // 2: ticketing.v1.Ticket.created_at:type_name -> google.protobuf.Timestamp
+	19, // 3: ticketing.v1.Ticket.updated_at:type_name -> google.protobuf.Timestamp
+	1,  // 4: ticketing.v1.TicketAvailability.status:type_name -> ticketing.v1.StockStatus
+	0,  // 5: ticketing.v1.Order.status:type_name -> ticketing.v1.OrderStatus
+	19, // 6: ticketing.v1.Order.created_at:type_name -> google.protobuf.Timestamp
+	19, // 7: ticketing.v1.Order.updated_at:type_name -> google.protobuf.Timestamp
+	2,  // 8: ticketing.v1.ListEventsResponse.events:type_name -> ticketing.v1.Event
+	3,  // 9: ticketing.v1.ListTicketsResponse.tickets:type_name -> ticketing.v1.Ticket
+	4,  // 10: ticketing.v1.ListEventAvailabilityResponse.tickets:type_name -> ticketing.v1.TicketAvailability
+	6,  // 11: ticketing.v1.TicketingService.ListEvents:input_type -> ticketing.v1.ListEventsRequest
+	8,  // 12: ticketing.v1.TicketingService.GetEvent:input_type -> ticketing.v1.GetEventRequest
+	9,  // 13: ticketing.v1.TicketingService.ListTickets:input_type -> ticketing.v1.ListTicketsRequest
+	11, // 14: ticketing.v1.TicketingService.GetTicket:input_type -> ticketing.v1.GetTicketRequest
+	12, // 15: ticketing.v1.TicketingService.GetTicketAvailability:input_type -> ticketing.v1.GetTicketAvailabilityRequest
+	13, // 16: ticketing.v1.TicketingService.ListEventAvailability:input_type -> ticketing.v1.ListEventAvailabilityRequest
+	15, // 17: ticketing.v1.TicketingService.CreateOrder:input_type -> ticketing.v1.CreateOrderRequest
+	16, // 18: ticketing.v1.TicketingService.GetOrder:input_type -> ticketing.v1.GetOrderRequest
+	17, // 19: ticketing.v1.TicketingService.CancelOrder:input_type -> ticketing.v1.CancelOrderRequest
+	18, // 20: ticketing.v1.TicketingService.WatchOrder:input_type -> ticketing.v1.WatchOrderRequest
+	7,  // 21: ticketing.v1.TicketingService.ListEvents:output_type -> ticketing.v1.ListEventsResponse
+	2,  // 22: ticketing.v1.TicketingService.GetEvent:output_type -> ticketing.v1.Event
+	10, // 23: ticketing.v1.TicketingService.ListTickets:output_type -> ticketing.v1.ListTicketsResponse
+	3,  // 24: ticketing.v1.TicketingService.GetTicket:output_type -> ticketing.v1.Ticket
+	4,  // 25: ticketing.v1.TicketingService.GetTicketAvailability:output_type -> ticketing.v1.TicketAvailability
+	14, // 26: ticketing.v1.TicketingService.ListEventAvailability:output_type -> ticketing.v1.ListEventAvailabilityResponse
+	5,  // 27: ticketing.v1.TicketingService.CreateOrder:output_type -> ticketing.v1.Order
+	5,  // 28: ticketing.v1.TicketingService.GetOrder:output_type -> ticketing.v1.Order
+	5,  // 29: ticketing.v1.TicketingService.CancelOrder:output_type -> ticketing.v1.Order
+	5,  // 30: ticketing.v1.TicketingService.WatchOrder:output_type -> ticketing.v1.Order
+	21, // [21:31] is the sub-list for method output_type
+	11, // [11:21] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_api_ticketing_v1_ticketing_proto_init() }
+func file_api_ticketing_v1_ticketing_proto_init() {
+	if File_api_ticketing_v1_ticketing_proto != nil {
+		return
+	}
+	file_api_ticketing_v1_ticketing_proto_msgTypes[0].OneofWrappers = []any{}
+	file_api_ticketing_v1_ticketing_proto_msgTypes[3].OneofWrappers = []any{}
+	file_api_ticketing_v1_ticketing_proto_msgTypes[13].OneofWrappers = []any{}
+	file_api_ticketing_v1_ticketing_proto_msgTypes[14].OneofWrappers = []any{
+		(*GetOrderRequest_OrderId)(nil),
+		(*GetOrderRequest_RequestId)(nil),
+	}
+	file_api_ticketing_v1_ticketing_proto_msgTypes[16].OneofWrappers = []any{
+		(*WatchOrderRequest_OrderId)(nil),
+		(*WatchOrderRequest_RequestId)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_ticketing_v1_ticketing_proto_rawDesc), len(file_api_ticketing_v1_ticketing_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   17,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_ticketing_v1_ticketing_proto_goTypes,
+		DependencyIndexes: file_api_ticketing_v1_ticketing_proto_depIdxs,
+		EnumInfos:         file_api_ticketing_v1_ticketing_proto_enumTypes,
+		MessageInfos:      file_api_ticketing_v1_ticketing_proto_msgTypes,
+	}.Build()
+	File_api_ticketing_v1_ticketing_proto = out.File
+	file_api_ticketing_v1_ticketing_proto_goTypes = nil
+	file_api_ticketing_v1_ticketing_proto_depIdxs = nil
+}
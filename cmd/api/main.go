@@ -0,0 +1,8 @@
+// Command api 只提供 HTTP API，訂單寫入佇列後由 cmd/worker 消費
+package main
+
+import "go-gin-high-concurrency/internal/app"
+
+func main() {
+	app.Main(app.ModeAPI)
+}
@@ -0,0 +1,9 @@
+// Command worker 只執行背景 Worker（訂單消費、Outbox Relay、Webhook 投遞、保留回收及候補遞補），
+// 並於 worker.health_addr 提供健康檢查及設定管理
+package main
+
+import "go-gin-high-concurrency/internal/app"
+
+func main() {
+	app.Main(app.ModeWorker)
+}
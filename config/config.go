@@ -1,96 +1,260 @@
 package config
 
 import (
-	"os"
-	"strconv"
+	"time"
 )
 
+// Config 應用程式的完整設定，載入順序為：預設值 -> 設定檔 (YAML / TOML) -> 環境變數 -> 命令列參數
 type Config struct {
-	Database DatabaseConfig
-	Redis    RedisConfig
+	Server   ServerConfig   `yaml:"server" toml:"server"`
+	GRPC     GRPCConfig     `yaml:"grpc" toml:"grpc"`
+	Database DatabaseConfig `yaml:"database" toml:"database"`
+	Redis    RedisConfig    `yaml:"redis" toml:"redis"`
+	Queue    QueueConfig    `yaml:"queue" toml:"queue"`
+	Worker   WorkerConfig   `yaml:"worker" toml:"worker"`
+	Risk     RiskConfig     `yaml:"risk" toml:"risk"`
+	Health   HealthConfig   `yaml:"health" toml:"health"`
+	Log      LogConfig      `yaml:"log" toml:"log"`
+}
+
+// ServerConfig HTTP Server 及優雅關閉的設定
+type ServerConfig struct {
+	Addr                  string   `yaml:"addr" toml:"addr"`
+	ShutdownTimeout       Duration `yaml:"shutdown_timeout" toml:"shutdown_timeout"`               // 等待處理中的請求完成的時間
+	WorkerShutdownTimeout Duration `yaml:"worker_shutdown_timeout" toml:"worker_shutdown_timeout"` // 等待 Worker 完成處理中訂單的時間
+}
+
+// GRPCConfig 供合作夥伴系統呼叫的 gRPC Server，啟用時與 HTTP API 在同一程序、使用獨立的 port
+type GRPCConfig struct {
+	Enabled       bool     `yaml:"enabled" toml:"enabled"`
+	Addr          string   `yaml:"addr" toml:"addr"`
+	WatchInterval Duration `yaml:"watch_interval" toml:"watch_interval"` // WatchOrder 查詢訂單狀態的間隔
 }
 
 type DatabaseConfig struct {
-	Host     string
-	Port     string
-	User     string
-	Password string
-	DBName   string
-	SSLMode  string
+	Host            string   `yaml:"host" toml:"host"`
+	Port            string   `yaml:"port" toml:"port"`
+	User            string   `yaml:"user" toml:"user"`
+	Password        string   `yaml:"password" toml:"password"`
+	DBName          string   `yaml:"dbname" toml:"dbname"`
+	SSLMode         string   `yaml:"sslmode" toml:"sslmode"`
+	MaxConns        int32    `yaml:"max_conns" toml:"max_conns"`                 // 最大連接數
+	MinConns        int32    `yaml:"min_conns" toml:"min_conns"`                 // 最小連接數
+	MaxConnLifetime Duration `yaml:"max_conn_lifetime" toml:"max_conn_lifetime"` // 連接最大生命週期
+	MaxConnIdleTime Duration `yaml:"max_conn_idle_time" toml:"max_conn_idle_time"`
+	AutoMigrate     bool     `yaml:"auto_migrate" toml:"auto_migrate"` // 啟動時套用尚未套用的 migration（內嵌於執行檔）
 }
 
 type RedisConfig struct {
-	Host     string
-	Port     string
-	Password string
-	DB       int
+	Host     string `yaml:"host" toml:"host"`
+	Port     string `yaml:"port" toml:"port"`
+	Password string `yaml:"password" toml:"password"`
+	DB       int    `yaml:"db" toml:"db"`
 }
 
-var AppConfig *Config
+// 訂單佇列的後端
+const (
+	QueueBackendRedis  = "redis"  // Redis Stream（預設）
+	QueueBackendMemory = "memory" // 程序內的記憶體佇列，僅適用 API 與 Worker 在同一程序（cmd/server）
+	QueueBackendNATS   = "nats"   // NATS JetStream
+)
 
-func LoadConfig() *Config {
-	dbConfig := GetDatabaseConfig()
-	redisConfig := GetRedisConfig()
+// QueueConfig 訂單佇列及領域事件的設定；領域事件固定使用 Redis Stream，訂單佇列依 Backend 選擇
+type QueueConfig struct {
+	Backend            string            `yaml:"backend" toml:"backend"` // redis / memory / nats
+	OrderStream        string            `yaml:"order_stream" toml:"order_stream"`
+	ConsumerID         string            `yaml:"consumer_id" toml:"consumer_id"` // 訂單 consumer 名稱，多個實例需各自設定；空字串時使用隨機 UUID
+	EventStream        string            `yaml:"event_stream" toml:"event_stream"`
+	ClaimMinIdleTime   Duration          `yaml:"claim_min_idle_time" toml:"claim_min_idle_time"` // PEL 中超過此時間才被 XAUTOCLAIM 領取
+	MaxRetryCount      int               `yaml:"max_retry_count" toml:"max_retry_count"`         // 超過此次數視為毒藥消息並丟棄，所有後端共用
+	ReadGroupBlockTime Duration          `yaml:"read_group_block_time" toml:"read_group_block_time"`
+	Memory             MemoryQueueConfig `yaml:"memory" toml:"memory"`
+	NATS               NATSQueueConfig   `yaml:"nats" toml:"nats"`
+}
 
-	AppConfig = &Config{
-		Database: dbConfig,
-		Redis:    redisConfig,
-	}
+// MemoryQueueConfig 記憶體佇列的容量及重新投遞延遲
+type MemoryQueueConfig struct {
+	Capacity        int      `yaml:"capacity" toml:"capacity"` // 佇列中的訂單上限（含處理中），超過時下單失敗
+	RedeliveryDelay Duration `yaml:"redelivery_delay" toml:"redelivery_delay"`
+}
 
-	return AppConfig
+// NATSQueueConfig NATS JetStream 的連線、stream 及 consumer 設定；stream 與 consumer 不存在時自動建立
+type NATSQueueConfig struct {
+	URL             string   `yaml:"url" toml:"url"`
+	Stream          string   `yaml:"stream" toml:"stream"`
+	Subject         string   `yaml:"subject" toml:"subject"`
+	Durable         string   `yaml:"durable" toml:"durable"`   // 所有 Worker 共用的 durable consumer 名稱
+	AckWait         Duration `yaml:"ack_wait" toml:"ack_wait"` // 超過此時間未 Ack 由伺服器重新投遞
+	RedeliveryDelay Duration `yaml:"redelivery_delay" toml:"redelivery_delay"`
 }
 
-func LoadTestConfig() *Config {
-	testConfig := &DatabaseConfig{
-		Host:     "localhost",
-		Port:     "5433", // 測試 DB 用 5433 port
-		User:     "postgres",
-		Password: "postgres",
-		DBName:   "test_db",
-		SSLMode:  "disable",
-	}
+// WorkerConfig 背景 Worker 的並行數、批次大小與輪詢間隔
+type WorkerConfig struct {
+	HealthAddr        string                  `yaml:"health_addr" toml:"health_addr"` // 獨立 Worker 程序 (cmd/worker) 健康檢查及設定管理的監聽位址
+	OrderWorker       OrderWorkerConfig       `yaml:"order_worker" toml:"order_worker"`
+	OutboxRelay       PollerConfig            `yaml:"outbox_relay" toml:"outbox_relay"`
+	WebhookDispatcher WebhookDispatcherConfig `yaml:"webhook_dispatcher" toml:"webhook_dispatcher"`
+	HoldSweeper       PollerConfig            `yaml:"hold_sweeper" toml:"hold_sweeper"`
+	WaitlistPromoter  PollerConfig            `yaml:"waitlist_promoter" toml:"waitlist_promoter"`
+}
 
-	testRedisConfig := RedisConfig{
-		Host:     "localhost",
-		Port:     "6379",
-		Password: "",
-		DB:       1,
-	}
+type OrderWorkerConfig struct {
+	Concurrency int `yaml:"concurrency" toml:"concurrency"` // 同時處理的訂單數
+}
 
-	return &Config{
-		Database: *testConfig,
-		Redis:    testRedisConfig,
-	}
+// PollerConfig 輪詢型 Worker 的共用設定
+type PollerConfig struct {
+	BatchSize    int      `yaml:"batch_size" toml:"batch_size"`
+	PollInterval Duration `yaml:"poll_interval" toml:"poll_interval"`
 }
 
-func GetDatabaseConfig() DatabaseConfig {
-	return DatabaseConfig{
-		Host:     getEnv("DB_HOST", "localhost"),
-		Port:     getEnv("DB_PORT", "5432"),
-		User:     getEnv("DB_USER", "postgres"),
-		Password: getEnv("DB_PASSWORD", "postgres"),
-		DBName:   getEnv("DB_NAME", "postgres"),
-		SSLMode:  getEnv("DB_SSL_MODE", "disable"),
-	}
+type WebhookDispatcherConfig struct {
+	BatchSize      int      `yaml:"batch_size" toml:"batch_size"`
+	PollInterval   Duration `yaml:"poll_interval" toml:"poll_interval"`
+	RequestTimeout Duration `yaml:"request_timeout" toml:"request_timeout"` // 單次 HTTP 請求逾時
+	MaxAttempts    int      `yaml:"max_attempts" toml:"max_attempts"`       // 超過此次數標記為 failed，不再自動重試
+	InitialBackoff Duration `yaml:"initial_backoff" toml:"initial_backoff"`
+	MaxBackoff     Duration `yaml:"max_backoff" toml:"max_backoff"`
 }
 
-func GetRedisConfig() RedisConfig {
-	db, err := strconv.Atoi(getEnv("REDIS_DB", "0"))
-	if err != nil {
-		panic(err)
-	}
+// RiskConfig 下單風險評分的計數視窗與頻率上限；分數權重及標記 / 拒絕門檻使用預設值
+type RiskConfig struct {
+	Window            Duration `yaml:"window" toml:"window"`                 // 下單次數及庫存不足次數的計數視窗
+	PaymentWindow     Duration `yaml:"payment_window" toml:"payment_window"` // 付款識別共用的計數視窗
+	MaxUserAttempts   int      `yaml:"max_user_attempts" toml:"max_user_attempts"`
+	MaxIPAttempts     int      `yaml:"max_ip_attempts" toml:"max_ip_attempts"`
+	MaxDeviceAttempts int      `yaml:"max_device_attempts" toml:"max_device_attempts"`
+	MaxStockMisses    int      `yaml:"max_stock_misses" toml:"max_stock_misses"`
+	MaxPaymentUsers   int      `yaml:"max_payment_users" toml:"max_payment_users"`
+}
+
+// HealthConfig /healthz 及 /readyz 的檢查門檻
+type HealthConfig struct {
+	CheckTimeout           Duration `yaml:"check_timeout" toml:"check_timeout"`                       // 單項檢查的逾時
+	WorkerHeartbeatTimeout Duration `yaml:"worker_heartbeat_timeout" toml:"worker_heartbeat_timeout"` // 訂單 Worker 超過此時間沒有心跳視為停擺
+	MaxStreamLag           int      `yaml:"max_stream_lag" toml:"max_stream_lag"`                     // 尚未投遞的訂單超過此數量時未就緒，0 表示不檢查
+	MaxPendingMessages     int      `yaml:"max_pending_messages" toml:"max_pending_messages"`         // 尚未 Ack 的訂單超過此數量時未就緒，0 表示不檢查
+	DrainDelay             Duration `yaml:"drain_delay" toml:"drain_delay"`                           // 收到終止信號後先回報未就緒，等待此時間才關閉 HTTP Server
+}
+
+// LogConfig 日誌等級 (debug / info / warn / error) 及格式 (json / console)
+type LogConfig struct {
+	Level  string `yaml:"level" toml:"level"`
+	Format string `yaml:"format" toml:"format"`
+}
+
+var AppConfig *Config
 
-	return RedisConfig{
-		Host:     getEnv("REDIS_HOST", "localhost"),
-		Port:     getEnv("REDIS_PORT", "6379"),
-		Password: getEnv("REDIS_PASSWORD", ""),
-		DB:       db,
+// Default 回傳預設設定，與各元件未注入設定時的預設值一致
+func Default() *Config {
+	return &Config{
+		Server: ServerConfig{
+			Addr:                  ":8080",
+			ShutdownTimeout:       Duration(10 * time.Second),
+			WorkerShutdownTimeout: Duration(5 * time.Second),
+		},
+		GRPC: GRPCConfig{
+			Addr:          ":9090",
+			WatchInterval: Duration(time.Second),
+		},
+		Database: DatabaseConfig{
+			Host:            "localhost",
+			Port:            "5432",
+			User:            "postgres",
+			Password:        "postgres",
+			DBName:          "postgres",
+			SSLMode:         "disable",
+			MaxConns:        25,
+			MinConns:        5,
+			MaxConnLifetime: Duration(time.Hour),
+			MaxConnIdleTime: Duration(30 * time.Minute),
+		},
+		Redis: RedisConfig{
+			Host: "localhost",
+			Port: "6379",
+		},
+		Queue: QueueConfig{
+			Backend:            QueueBackendRedis,
+			OrderStream:        "orders:stream",
+			ConsumerID:         "",
+			EventStream:        "events:stream",
+			ClaimMinIdleTime:   Duration(5 * time.Second),
+			MaxRetryCount:      5,
+			ReadGroupBlockTime: Duration(2 * time.Second),
+			Memory: MemoryQueueConfig{
+				Capacity:        1000,
+				RedeliveryDelay: Duration(time.Second),
+			},
+			NATS: NATSQueueConfig{
+				URL:             "nats://localhost:4222",
+				Stream:          "ORDERS",
+				Subject:         "orders.created",
+				Durable:         "order-workers",
+				AckWait:         Duration(30 * time.Second),
+				RedeliveryDelay: Duration(5 * time.Second),
+			},
+		},
+		Worker: WorkerConfig{
+			HealthAddr:  ":8081",
+			OrderWorker: OrderWorkerConfig{Concurrency: 1},
+			OutboxRelay: PollerConfig{BatchSize: 100, PollInterval: Duration(500 * time.Millisecond)},
+			WebhookDispatcher: WebhookDispatcherConfig{
+				BatchSize:      50,
+				PollInterval:   Duration(time.Second),
+				RequestTimeout: Duration(10 * time.Second),
+				MaxAttempts:    8,
+				InitialBackoff: Duration(10 * time.Second),
+				MaxBackoff:     Duration(time.Hour),
+			},
+			HoldSweeper:      PollerConfig{BatchSize: 200, PollInterval: Duration(time.Second)},
+			WaitlistPromoter: PollerConfig{BatchSize: 50, PollInterval: Duration(time.Second)},
+		},
+		Risk: RiskConfig{
+			Window:            Duration(time.Minute),
+			PaymentWindow:     Duration(24 * time.Hour),
+			MaxUserAttempts:   10,
+			MaxIPAttempts:     30,
+			MaxDeviceAttempts: 10,
+			MaxStockMisses:    5,
+			MaxPaymentUsers:   3,
+		},
+		Health: HealthConfig{
+			CheckTimeout:           Duration(2 * time.Second),
+			WorkerHeartbeatTimeout: Duration(30 * time.Second),
+			MaxStreamLag:           10000,
+			MaxPendingMessages:     1000,
+		},
+		Log: LogConfig{
+			Level:  "info",
+			Format: "json",
+		},
 	}
 }
 
-func getEnv(key, fallback string) string {
-	if value := os.Getenv(key); value != "" {
-		return value
+// LoadConfig 以預設方式載入設定（不含命令列參數），失敗時回傳錯誤
+func LoadConfig() (*Config, error) {
+	return Load(nil)
+}
+
+func LoadTestConfig() *Config {
+	cfg := Default()
+	cfg.Database = DatabaseConfig{
+		Host:            "localhost",
+		Port:            "5433", // 測試 DB 用 5433 port
+		User:            "postgres",
+		Password:        "postgres",
+		DBName:          "test_db",
+		SSLMode:         "disable",
+		MaxConns:        cfg.Database.MaxConns,
+		MinConns:        cfg.Database.MinConns,
+		MaxConnLifetime: cfg.Database.MaxConnLifetime,
+		MaxConnIdleTime: cfg.Database.MaxConnIdleTime,
+	}
+	cfg.Redis = RedisConfig{
+		Host:     "localhost",
+		Port:     "6379",
+		Password: "",
+		DB:       1,
 	}
-	return fallback
+	return cfg
 }
@@ -0,0 +1,269 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	toml "github.com/pelletier/go-toml/v2"
+	"gopkg.in/yaml.v3"
+)
+
+// ConfigFileEnv 未帶 -config 參數時讀取的設定檔路徑環境變數
+const ConfigFileEnv = "CONFIG_FILE"
+
+// binding 將一個環境變數或命令列參數的字串值寫入設定欄位
+type binding struct {
+	key    string
+	usage  string
+	set    func(value string) error
+	isBool bool // 命令列參數可不帶值（-flag 等同 -flag=true）
+}
+
+// Load 依序套用預設值、設定檔、環境變數及命令列參數 args（不含程式名稱），驗證後回傳設定。
+// 設定檔路徑由 -config 參數或 CONFIG_FILE 環境變數指定，未指定時略過。
+func Load(args []string) (*Config, error) {
+	cfg := Default()
+
+	fs := flag.NewFlagSet("config", flag.ContinueOnError)
+	path := fs.String("config", os.Getenv(ConfigFileEnv), "設定檔路徑 (.yaml / .yml / .toml)")
+	// 命令列參數在設定檔及環境變數之後才套用，這裡先記錄下來
+	var pending []func() error
+	for _, b := range flagBindings(cfg) {
+		b := b
+		record := func(value string) error {
+			pending = append(pending, func() error {
+				if err := b.set(value); err != nil {
+					return fmt.Errorf("flag -%s: %w", b.key, err)
+				}
+				return nil
+			})
+			return nil
+		}
+		if b.isBool {
+			fs.BoolFunc(b.key, b.usage, record)
+		} else {
+			fs.Func(b.key, b.usage, record)
+		}
+	}
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+
+	if *path != "" {
+		if err := loadFile(cfg, *path); err != nil {
+			return nil, err
+		}
+	}
+	if err := applyEnv(cfg); err != nil {
+		return nil, err
+	}
+	for _, set := range pending {
+		if err := set(); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	AppConfig = cfg
+	return cfg, nil
+}
+
+// loadFile 依副檔名以 YAML 或 TOML 解析設定檔，設定檔未列出的欄位保留原值；不認得的欄位視為錯誤，避免拼錯的設定被默默忽略
+func loadFile(cfg *Config, path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("read config file: %w", err)
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		decoder := yaml.NewDecoder(bytes.NewReader(data))
+		decoder.KnownFields(true)
+		if err := decoder.Decode(cfg); err != nil && !errors.Is(err, io.EOF) {
+			return fmt.Errorf("parse config file %s: %w", path, err)
+		}
+	case ".toml":
+		decoder := toml.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(cfg); err != nil {
+			return fmt.Errorf("parse config file %s: %w", path, err)
+		}
+	default:
+		return fmt.Errorf("unsupported config file extension %q (want .yaml, .yml or .toml)", filepath.Ext(path))
+	}
+	return nil
+}
+
+func applyEnv(cfg *Config) error {
+	for _, b := range envBindings(cfg) {
+		value := os.Getenv(b.key)
+		if value == "" {
+			continue
+		}
+		if err := b.set(value); err != nil {
+			return fmt.Errorf("env %s: %w", b.key, err)
+		}
+	}
+	return nil
+}
+
+// envBindings 環境變數與設定欄位的對應；DB_* 及 REDIS_* 沿用既有的名稱
+func envBindings(cfg *Config) []binding {
+	return []binding{
+		{key: "SERVER_ADDR", set: stringVar(&cfg.Server.Addr)},
+		{key: "SERVER_SHUTDOWN_TIMEOUT", set: durationVar(&cfg.Server.ShutdownTimeout)},
+		{key: "SERVER_WORKER_SHUTDOWN_TIMEOUT", set: durationVar(&cfg.Server.WorkerShutdownTimeout)},
+
+		{key: "GRPC_ENABLED", set: boolVar(&cfg.GRPC.Enabled)},
+		{key: "GRPC_ADDR", set: stringVar(&cfg.GRPC.Addr)},
+		{key: "GRPC_WATCH_INTERVAL", set: durationVar(&cfg.GRPC.WatchInterval)},
+
+		{key: "DB_HOST", set: stringVar(&cfg.Database.Host)},
+		{key: "DB_PORT", set: stringVar(&cfg.Database.Port)},
+		{key: "DB_USER", set: stringVar(&cfg.Database.User)},
+		{key: "DB_PASSWORD", set: stringVar(&cfg.Database.Password)},
+		{key: "DB_NAME", set: stringVar(&cfg.Database.DBName)},
+		{key: "DB_SSL_MODE", set: stringVar(&cfg.Database.SSLMode)},
+		{key: "DB_MAX_CONNS", set: int32Var(&cfg.Database.MaxConns)},
+		{key: "DB_MIN_CONNS", set: int32Var(&cfg.Database.MinConns)},
+		{key: "DB_MAX_CONN_LIFETIME", set: durationVar(&cfg.Database.MaxConnLifetime)},
+		{key: "DB_MAX_CONN_IDLE_TIME", set: durationVar(&cfg.Database.MaxConnIdleTime)},
+		{key: "DB_AUTO_MIGRATE", set: boolVar(&cfg.Database.AutoMigrate)},
+
+		{key: "REDIS_HOST", set: stringVar(&cfg.Redis.Host)},
+		{key: "REDIS_PORT", set: stringVar(&cfg.Redis.Port)},
+		{key: "REDIS_PASSWORD", set: stringVar(&cfg.Redis.Password)},
+		{key: "REDIS_DB", set: intVar(&cfg.Redis.DB)},
+
+		{key: "QUEUE_BACKEND", set: stringVar(&cfg.Queue.Backend)},
+		{key: "QUEUE_ORDER_STREAM", set: stringVar(&cfg.Queue.OrderStream)},
+		{key: "QUEUE_CONSUMER_ID", set: stringVar(&cfg.Queue.ConsumerID)},
+		{key: "QUEUE_EVENT_STREAM", set: stringVar(&cfg.Queue.EventStream)},
+		{key: "QUEUE_CLAIM_MIN_IDLE_TIME", set: durationVar(&cfg.Queue.ClaimMinIdleTime)},
+		{key: "QUEUE_MAX_RETRY_COUNT", set: intVar(&cfg.Queue.MaxRetryCount)},
+		{key: "QUEUE_READ_GROUP_BLOCK_TIME", set: durationVar(&cfg.Queue.ReadGroupBlockTime)},
+		{key: "QUEUE_MEMORY_CAPACITY", set: intVar(&cfg.Queue.Memory.Capacity)},
+		{key: "QUEUE_MEMORY_REDELIVERY_DELAY", set: durationVar(&cfg.Queue.Memory.RedeliveryDelay)},
+		{key: "NATS_URL", set: stringVar(&cfg.Queue.NATS.URL)},
+		{key: "QUEUE_NATS_STREAM", set: stringVar(&cfg.Queue.NATS.Stream)},
+		{key: "QUEUE_NATS_SUBJECT", set: stringVar(&cfg.Queue.NATS.Subject)},
+		{key: "QUEUE_NATS_DURABLE", set: stringVar(&cfg.Queue.NATS.Durable)},
+		{key: "QUEUE_NATS_ACK_WAIT", set: durationVar(&cfg.Queue.NATS.AckWait)},
+		{key: "QUEUE_NATS_REDELIVERY_DELAY", set: durationVar(&cfg.Queue.NATS.RedeliveryDelay)},
+
+		{key: "WORKER_HEALTH_ADDR", set: stringVar(&cfg.Worker.HealthAddr)},
+		{key: "WORKER_ORDER_CONCURRENCY", set: intVar(&cfg.Worker.OrderWorker.Concurrency)},
+		{key: "WORKER_OUTBOX_RELAY_BATCH_SIZE", set: intVar(&cfg.Worker.OutboxRelay.BatchSize)},
+		{key: "WORKER_OUTBOX_RELAY_POLL_INTERVAL", set: durationVar(&cfg.Worker.OutboxRelay.PollInterval)},
+		{key: "WORKER_WEBHOOK_DISPATCHER_BATCH_SIZE", set: intVar(&cfg.Worker.WebhookDispatcher.BatchSize)},
+		{key: "WORKER_WEBHOOK_DISPATCHER_POLL_INTERVAL", set: durationVar(&cfg.Worker.WebhookDispatcher.PollInterval)},
+		{key: "WORKER_WEBHOOK_DISPATCHER_REQUEST_TIMEOUT", set: durationVar(&cfg.Worker.WebhookDispatcher.RequestTimeout)},
+		{key: "WORKER_WEBHOOK_DISPATCHER_MAX_ATTEMPTS", set: intVar(&cfg.Worker.WebhookDispatcher.MaxAttempts)},
+		{key: "WORKER_HOLD_SWEEPER_BATCH_SIZE", set: intVar(&cfg.Worker.HoldSweeper.BatchSize)},
+		{key: "WORKER_HOLD_SWEEPER_POLL_INTERVAL", set: durationVar(&cfg.Worker.HoldSweeper.PollInterval)},
+		{key: "WORKER_WAITLIST_PROMOTER_BATCH_SIZE", set: intVar(&cfg.Worker.WaitlistPromoter.BatchSize)},
+		{key: "WORKER_WAITLIST_PROMOTER_POLL_INTERVAL", set: durationVar(&cfg.Worker.WaitlistPromoter.PollInterval)},
+
+		{key: "RISK_WINDOW", set: durationVar(&cfg.Risk.Window)},
+		{key: "RISK_PAYMENT_WINDOW", set: durationVar(&cfg.Risk.PaymentWindow)},
+		{key: "RISK_MAX_USER_ATTEMPTS", set: intVar(&cfg.Risk.MaxUserAttempts)},
+		{key: "RISK_MAX_IP_ATTEMPTS", set: intVar(&cfg.Risk.MaxIPAttempts)},
+		{key: "RISK_MAX_DEVICE_ATTEMPTS", set: intVar(&cfg.Risk.MaxDeviceAttempts)},
+		{key: "RISK_MAX_STOCK_MISSES", set: intVar(&cfg.Risk.MaxStockMisses)},
+		{key: "RISK_MAX_PAYMENT_USERS", set: intVar(&cfg.Risk.MaxPaymentUsers)},
+
+		{key: "HEALTH_CHECK_TIMEOUT", set: durationVar(&cfg.Health.CheckTimeout)},
+		{key: "HEALTH_WORKER_HEARTBEAT_TIMEOUT", set: durationVar(&cfg.Health.WorkerHeartbeatTimeout)},
+		{key: "HEALTH_MAX_STREAM_LAG", set: intVar(&cfg.Health.MaxStreamLag)},
+		{key: "HEALTH_MAX_PENDING_MESSAGES", set: intVar(&cfg.Health.MaxPendingMessages)},
+		{key: "HEALTH_DRAIN_DELAY", set: durationVar(&cfg.Health.DrainDelay)},
+
+		{key: "LOG_LEVEL", set: stringVar(&cfg.Log.Level)},
+		{key: "LOG_FORMAT", set: stringVar(&cfg.Log.Format)},
+	}
+}
+
+// flagBindings 命令列參數與設定欄位的對應；密碼不提供命令列參數，避免出現在 process list
+func flagBindings(cfg *Config) []binding {
+	return []binding{
+		{key: "addr", usage: "HTTP 監聽位址", set: stringVar(&cfg.Server.Addr)},
+		{key: "shutdown-timeout", usage: "等待處理中請求完成的時間", set: durationVar(&cfg.Server.ShutdownTimeout)},
+		{key: "grpc", usage: "啟動 gRPC Server", set: boolVar(&cfg.GRPC.Enabled), isBool: true},
+		{key: "grpc-addr", usage: "gRPC 監聽位址", set: stringVar(&cfg.GRPC.Addr)},
+		{key: "db-host", usage: "PostgreSQL 主機", set: stringVar(&cfg.Database.Host)},
+		{key: "db-port", usage: "PostgreSQL port", set: stringVar(&cfg.Database.Port)},
+		{key: "db-user", usage: "PostgreSQL 使用者", set: stringVar(&cfg.Database.User)},
+		{key: "db-name", usage: "PostgreSQL 資料庫名稱", set: stringVar(&cfg.Database.DBName)},
+		{key: "db-sslmode", usage: "PostgreSQL sslmode", set: stringVar(&cfg.Database.SSLMode)},
+		{key: "db-max-conns", usage: "連接池最大連接數", set: int32Var(&cfg.Database.MaxConns)},
+		{key: "db-min-conns", usage: "連接池最小連接數", set: int32Var(&cfg.Database.MinConns)},
+		{key: "auto-migrate", usage: "啟動時套用尚未套用的資料庫 migration", set: boolVar(&cfg.Database.AutoMigrate), isBool: true},
+		{key: "redis-host", usage: "Redis 主機", set: stringVar(&cfg.Redis.Host)},
+		{key: "redis-port", usage: "Redis port", set: stringVar(&cfg.Redis.Port)},
+		{key: "redis-db", usage: "Redis DB 編號", set: intVar(&cfg.Redis.DB)},
+		{key: "queue-backend", usage: "訂單佇列的後端 (redis / memory / nats)", set: stringVar(&cfg.Queue.Backend)},
+		{key: "order-stream", usage: "訂單佇列的 Redis Stream key", set: stringVar(&cfg.Queue.OrderStream)},
+		{key: "nats-url", usage: "NATS 伺服器位址（queue-backend 為 nats 時使用）", set: stringVar(&cfg.Queue.NATS.URL)},
+		{key: "consumer-id", usage: "訂單佇列的 consumer 名稱", set: stringVar(&cfg.Queue.ConsumerID)},
+		{key: "event-stream", usage: "領域事件的 Redis Stream key", set: stringVar(&cfg.Queue.EventStream)},
+		{key: "worker-health-addr", usage: "獨立 Worker 程序健康檢查的監聽位址", set: stringVar(&cfg.Worker.HealthAddr)},
+		{key: "order-concurrency", usage: "同時處理的訂單數", set: intVar(&cfg.Worker.OrderWorker.Concurrency)},
+		{key: "log-level", usage: "日誌等級 (debug / info / warn / error)", set: stringVar(&cfg.Log.Level)},
+		{key: "log-format", usage: "日誌格式 (json / console)", set: stringVar(&cfg.Log.Format)},
+	}
+}
+
+func stringVar(p *string) func(string) error {
+	return func(value string) error {
+		*p = value
+		return nil
+	}
+}
+
+func intVar(p *int) func(string) error {
+	return func(value string) error {
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("invalid integer %q", value)
+		}
+		*p = n
+		return nil
+	}
+}
+
+func boolVar(p *bool) func(string) error {
+	return func(value string) error {
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("invalid boolean %q", value)
+		}
+		*p = b
+		return nil
+	}
+}
+
+func int32Var(p *int32) func(string) error {
+	return func(value string) error {
+		n, err := strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			return fmt.Errorf("invalid integer %q", value)
+		}
+		*p = int32(n)
+		return nil
+	}
+}
+
+func durationVar(p *Duration) func(string) error {
+	return func(value string) error {
+		return p.UnmarshalText([]byte(value))
+	}
+}
@@ -0,0 +1,50 @@
+package config
+
+import (
+	"io"
+	"net/url"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const redacted = "******"
+
+// Redacted 回傳隱藏密碼的副本，供輸出或記錄生效中的設定
+func (c *Config) Redacted() *Config {
+	copied := *c
+	if copied.Database.Password != "" {
+		copied.Database.Password = redacted
+	}
+	if copied.Redis.Password != "" {
+		copied.Redis.Password = redacted
+	}
+	copied.Queue.NATS.URL = redactURL(copied.Queue.NATS.URL)
+	return &copied
+}
+
+// Print 以 YAML 輸出生效中的設定，密碼以 ****** 隱藏，其餘內容可直接作為設定檔使用
+func (c *Config) Print(w io.Writer) error {
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(c.Redacted()); err != nil {
+		return err
+	}
+	return encoder.Close()
+}
+
+// redactURL 隱藏 URL 中的密碼；只有 token（nats://token@host）時隱藏 token
+func redactURL(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil || u.User == nil {
+		return raw
+	}
+	// url 會跳脫 userinfo 中的 *，先以不需跳脫的佔位字串組裝再替換
+	const placeholder = "REDACTED"
+	if _, ok := u.User.Password(); ok {
+		u.User = url.UserPassword(u.User.Username(), placeholder)
+	} else {
+		u.User = url.User(placeholder)
+	}
+	return strings.Replace(u.String(), placeholder, redacted, 1)
+}
@@ -0,0 +1,106 @@
+package config
+
+import (
+	"sync"
+
+	"go-gin-high-concurrency/pkg/logger"
+
+	"go.uber.org/zap"
+)
+
+// RuntimeSettings 可於執行中重新載入的設定；其餘設定（監聽位址、連線資訊、stream key 等）需重新啟動才會生效
+type RuntimeSettings struct {
+	LogLevel                   string   `json:"log_level"`
+	OrderWorkerConcurrency     int      `json:"order_worker_concurrency"`
+	QueueMaxRetryCount         int      `json:"queue_max_retry_count"`
+	QueueClaimMinIdleTime      Duration `json:"queue_claim_min_idle_time"`
+	QueueMemoryRedeliveryDelay Duration `json:"queue_memory_redelivery_delay"`
+	QueueNATSRedeliveryDelay   Duration `json:"queue_nats_redelivery_delay"`
+	WebhookMaxAttempts         int      `json:"webhook_max_attempts"`
+	WebhookInitialBackoff      Duration `json:"webhook_initial_backoff"`
+	WebhookMaxBackoff          Duration `json:"webhook_max_backoff"`
+	RiskWindow                 Duration `json:"risk_window"`
+	RiskPaymentWindow          Duration `json:"risk_payment_window"`
+	RiskMaxUserAttempts        int      `json:"risk_max_user_attempts"`
+	RiskMaxIPAttempts          int      `json:"risk_max_ip_attempts"`
+	RiskMaxDeviceAttempts      int      `json:"risk_max_device_attempts"`
+	RiskMaxStockMisses         int      `json:"risk_max_stock_misses"`
+	RiskMaxPaymentUsers        int      `json:"risk_max_payment_users"`
+}
+
+// Runtime 取出設定中可於執行中重新載入的部分
+func (c *Config) Runtime() RuntimeSettings {
+	return RuntimeSettings{
+		LogLevel:                   c.Log.Level,
+		OrderWorkerConcurrency:     c.Worker.OrderWorker.Concurrency,
+		QueueMaxRetryCount:         c.Queue.MaxRetryCount,
+		QueueClaimMinIdleTime:      c.Queue.ClaimMinIdleTime,
+		QueueMemoryRedeliveryDelay: c.Queue.Memory.RedeliveryDelay,
+		QueueNATSRedeliveryDelay:   c.Queue.NATS.RedeliveryDelay,
+		WebhookMaxAttempts:         c.Worker.WebhookDispatcher.MaxAttempts,
+		WebhookInitialBackoff:      c.Worker.WebhookDispatcher.InitialBackoff,
+		WebhookMaxBackoff:          c.Worker.WebhookDispatcher.MaxBackoff,
+		RiskWindow:                 c.Risk.Window,
+		RiskPaymentWindow:          c.Risk.PaymentWindow,
+		RiskMaxUserAttempts:        c.Risk.MaxUserAttempts,
+		RiskMaxIPAttempts:          c.Risk.MaxIPAttempts,
+		RiskMaxDeviceAttempts:      c.Risk.MaxDeviceAttempts,
+		RiskMaxStockMisses:         c.Risk.MaxStockMisses,
+		RiskMaxPaymentUsers:        c.Risk.MaxPaymentUsers,
+	}
+}
+
+// Reloader 重新載入設定並套用到執行中的元件（SIGHUP 或管理端 API 觸發）
+type Reloader interface {
+	// 重新載入：依原本的來源（設定檔、環境變數、命令列參數）重新載入並驗證，成功後依序套用；失敗時保留目前的設定
+	Reload() (RuntimeSettings, error)
+	// 目前生效的設定
+	Current() RuntimeSettings
+	// 註冊重新載入成功後的套用函式，應於啟動時註冊完畢
+	OnReload(apply func(settings RuntimeSettings))
+}
+
+type ReloaderImpl struct {
+	mu       sync.Mutex
+	load     func() (*Config, error)
+	current  RuntimeSettings
+	appliers []func(settings RuntimeSettings)
+}
+
+// NewReloader 建立 Reloader。load 為重新載入設定的方式，initial 為啟動時載入的設定
+func NewReloader(load func() (*Config, error), initial *Config) Reloader {
+	return &ReloaderImpl{
+		load:    load,
+		current: initial.Runtime(),
+	}
+}
+
+func (r *ReloaderImpl) Reload() (RuntimeSettings, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	cfg, err := r.load()
+	if err != nil {
+		logger.L.Error("reload config failed, keeping current settings", zap.Error(err))
+		return r.current, err
+	}
+	settings := cfg.Runtime()
+	for _, apply := range r.appliers {
+		apply(settings)
+	}
+	r.current = settings
+	logger.L.Info("runtime settings reloaded", zap.Any("settings", settings))
+	return settings, nil
+}
+
+func (r *ReloaderImpl) Current() RuntimeSettings {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.current
+}
+
+func (r *ReloaderImpl) OnReload(apply func(settings RuntimeSettings)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.appliers = append(r.appliers, apply)
+}
@@ -0,0 +1,165 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+
+	"go.uber.org/zap/zapcore"
+)
+
+var sslModes = map[string]bool{
+	"disable": true, "allow": true, "prefer": true, "require": true, "verify-ca": true, "verify-full": true,
+}
+
+// Validate 檢查所有欄位，一次回傳全部的錯誤（以 errors.Join 合併，每行一個欄位）
+func (c *Config) Validate() error {
+	v := &validator{}
+
+	if _, _, err := net.SplitHostPort(c.Server.Addr); err != nil {
+		v.addf("server.addr", "invalid listen address %q", c.Server.Addr)
+	}
+	v.positiveDuration("server.shutdown_timeout", c.Server.ShutdownTimeout)
+	v.positiveDuration("server.worker_shutdown_timeout", c.Server.WorkerShutdownTimeout)
+
+	if c.GRPC.Enabled {
+		if _, _, err := net.SplitHostPort(c.GRPC.Addr); err != nil {
+			v.addf("grpc.addr", "invalid listen address %q", c.GRPC.Addr)
+		} else if c.GRPC.Addr == c.Server.Addr {
+			v.addf("grpc.addr", "must differ from server.addr (%s)", c.Server.Addr)
+		}
+	}
+	v.positiveDuration("grpc.watch_interval", c.GRPC.WatchInterval)
+
+	v.required("database.host", c.Database.Host)
+	v.port("database.port", c.Database.Port)
+	v.required("database.user", c.Database.User)
+	v.required("database.dbname", c.Database.DBName)
+	if !sslModes[c.Database.SSLMode] {
+		v.addf("database.sslmode", "unsupported sslmode %q", c.Database.SSLMode)
+	}
+	if c.Database.MaxConns <= 0 {
+		v.addf("database.max_conns", "must be greater than 0")
+	}
+	if c.Database.MinConns < 0 || c.Database.MinConns > c.Database.MaxConns {
+		v.addf("database.min_conns", "must be between 0 and max_conns (%d)", c.Database.MaxConns)
+	}
+	v.positiveDuration("database.max_conn_lifetime", c.Database.MaxConnLifetime)
+	v.positiveDuration("database.max_conn_idle_time", c.Database.MaxConnIdleTime)
+
+	v.required("redis.host", c.Redis.Host)
+	v.port("redis.port", c.Redis.Port)
+	if c.Redis.DB < 0 {
+		v.addf("redis.db", "must not be negative")
+	}
+
+	switch c.Queue.Backend {
+	case QueueBackendRedis:
+	case QueueBackendMemory:
+		v.positiveInt("queue.memory.capacity", c.Queue.Memory.Capacity)
+		v.positiveDuration("queue.memory.redelivery_delay", c.Queue.Memory.RedeliveryDelay)
+	case QueueBackendNATS:
+		v.required("queue.nats.url", c.Queue.NATS.URL)
+		v.required("queue.nats.stream", c.Queue.NATS.Stream)
+		v.required("queue.nats.subject", c.Queue.NATS.Subject)
+		v.required("queue.nats.durable", c.Queue.NATS.Durable)
+		v.positiveDuration("queue.nats.ack_wait", c.Queue.NATS.AckWait)
+		v.positiveDuration("queue.nats.redelivery_delay", c.Queue.NATS.RedeliveryDelay)
+	default:
+		v.addf("queue.backend", "unsupported backend %q (want redis, memory or nats)", c.Queue.Backend)
+	}
+	v.required("queue.order_stream", c.Queue.OrderStream)
+	v.required("queue.event_stream", c.Queue.EventStream)
+	v.positiveDuration("queue.claim_min_idle_time", c.Queue.ClaimMinIdleTime)
+	v.positiveInt("queue.max_retry_count", c.Queue.MaxRetryCount)
+	v.positiveDuration("queue.read_group_block_time", c.Queue.ReadGroupBlockTime)
+
+	if _, _, err := net.SplitHostPort(c.Worker.HealthAddr); err != nil {
+		v.addf("worker.health_addr", "invalid listen address %q", c.Worker.HealthAddr)
+	}
+	v.positiveInt("worker.order_worker.concurrency", c.Worker.OrderWorker.Concurrency)
+	v.poller("worker.outbox_relay", c.Worker.OutboxRelay)
+	v.poller("worker.hold_sweeper", c.Worker.HoldSweeper)
+	v.poller("worker.waitlist_promoter", c.Worker.WaitlistPromoter)
+	webhook := c.Worker.WebhookDispatcher
+	v.poller("worker.webhook_dispatcher", PollerConfig{BatchSize: webhook.BatchSize, PollInterval: webhook.PollInterval})
+	v.positiveDuration("worker.webhook_dispatcher.request_timeout", webhook.RequestTimeout)
+	v.positiveInt("worker.webhook_dispatcher.max_attempts", webhook.MaxAttempts)
+	v.positiveDuration("worker.webhook_dispatcher.initial_backoff", webhook.InitialBackoff)
+	if webhook.MaxBackoff < webhook.InitialBackoff {
+		v.addf("worker.webhook_dispatcher.max_backoff", "must not be less than initial_backoff (%s)", webhook.InitialBackoff)
+	}
+
+	v.positiveDuration("risk.window", c.Risk.Window)
+	v.positiveDuration("risk.payment_window", c.Risk.PaymentWindow)
+	v.positiveInt("risk.max_user_attempts", c.Risk.MaxUserAttempts)
+	v.positiveInt("risk.max_ip_attempts", c.Risk.MaxIPAttempts)
+	v.positiveInt("risk.max_device_attempts", c.Risk.MaxDeviceAttempts)
+	v.positiveInt("risk.max_stock_misses", c.Risk.MaxStockMisses)
+	v.positiveInt("risk.max_payment_users", c.Risk.MaxPaymentUsers)
+
+	v.positiveDuration("health.check_timeout", c.Health.CheckTimeout)
+	v.positiveDuration("health.worker_heartbeat_timeout", c.Health.WorkerHeartbeatTimeout)
+	v.nonNegativeInt("health.max_stream_lag", c.Health.MaxStreamLag)
+	v.nonNegativeInt("health.max_pending_messages", c.Health.MaxPendingMessages)
+	if c.Health.DrainDelay < 0 {
+		v.addf("health.drain_delay", "must not be negative")
+	}
+
+	if _, err := zapcore.ParseLevel(c.Log.Level); err != nil {
+		v.addf("log.level", "unsupported level %q (want debug, info, warn or error)", c.Log.Level)
+	}
+	if c.Log.Format != "json" && c.Log.Format != "console" {
+		v.addf("log.format", "unsupported format %q (want json or console)", c.Log.Format)
+	}
+
+	if len(v.errs) == 0 {
+		return nil
+	}
+	return fmt.Errorf("invalid config:\n%w", errors.Join(v.errs...))
+}
+
+type validator struct {
+	errs []error
+}
+
+func (v *validator) addf(field string, format string, args ...interface{}) {
+	v.errs = append(v.errs, fmt.Errorf("%s: "+format, append([]interface{}{field}, args...)...))
+}
+
+func (v *validator) required(field string, value string) {
+	if value == "" {
+		v.addf(field, "must not be empty")
+	}
+}
+
+func (v *validator) port(field string, value string) {
+	port, err := strconv.Atoi(value)
+	if err != nil || port <= 0 || port > 65535 {
+		v.addf(field, "invalid port %q", value)
+	}
+}
+
+func (v *validator) positiveInt(field string, value int) {
+	if value <= 0 {
+		v.addf(field, "must be greater than 0")
+	}
+}
+
+func (v *validator) nonNegativeInt(field string, value int) {
+	if value < 0 {
+		v.addf(field, "must not be negative")
+	}
+}
+
+func (v *validator) positiveDuration(field string, value Duration) {
+	if value <= 0 {
+		v.addf(field, "must be a positive duration")
+	}
+}
+
+func (v *validator) poller(field string, value PollerConfig) {
+	v.positiveInt(field+".batch_size", value.BatchSize)
+	v.positiveDuration(field+".poll_interval", value.PollInterval)
+}
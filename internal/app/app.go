@@ -0,0 +1,145 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"go-gin-high-concurrency/config"
+	"go-gin-high-concurrency/internal/cache"
+	"go-gin-high-concurrency/internal/database"
+	"go-gin-high-concurrency/internal/queue"
+	"go-gin-high-concurrency/internal/repository"
+	"go-gin-high-concurrency/internal/service"
+	"go-gin-high-concurrency/internal/worker"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/nats-io/nats.go"
+	"github.com/redis/go-redis/v9"
+)
+
+// App API 與 Worker 共用的元件：連線、Repository、Cache、訂單佇列及 Service
+type App struct {
+	cfg  *config.Config
+	args []string // 啟動時的命令列參數，重新載入設定時沿用
+
+	pool *pgxpool.Pool
+	rdb  *redis.Client
+	nc   *nats.Conn // 僅 queue.backend 為 nats 時建立
+
+	orderRepository   repository.OrderRepository
+	outboxRepository  repository.OutboxRepository
+	webhookRepository repository.WebhookRepository
+
+	orderQueue queue.OrderQueue
+	riskScorer service.RiskScorer
+
+	orderService     service.OrderService
+	eventService     service.EventService
+	ticketService    service.TicketService
+	seatService      service.SeatService
+	webhookService   service.WebhookService
+	holdService      service.HoldService
+	waitlistService  service.WaitlistService
+	promoCodeService service.PromoCodeService
+	presaleService   service.PresaleService
+	riskService      service.RiskService
+
+	reloader config.Reloader
+
+	orderWorker worker.OrderWorker    // StartWorkers 後才有值
+	health      service.HealthService // Run 時依 mode 建立
+}
+
+// New 建立資料庫及 Redis 連線並組裝所有元件；args 為載入 cfg 時的命令列參數，重新載入設定時沿用
+func New(cfg *config.Config, args []string) (*App, error) {
+	pool, err := database.InitDatabase(&cfg.Database)
+	if err != nil {
+		return nil, fmt.Errorf("initialize database: %w", err)
+	}
+	if cfg.Database.AutoMigrate {
+		if err := autoMigrate(context.Background(), pool); err != nil {
+			pool.Close()
+			return nil, fmt.Errorf("auto migrate: %w", err)
+		}
+	}
+	rdb, err := database.InitRedis(&cfg.Redis)
+	if err != nil {
+		pool.Close()
+		return nil, fmt.Errorf("initialize redis: %w", err)
+	}
+
+	a := &App{cfg: cfg, args: args, pool: pool, rdb: rdb}
+	if err := a.wire(); err != nil {
+		a.Close()
+		return nil, err
+	}
+	return a, nil
+}
+
+func (a *App) wire() error {
+	cfg, pool, rdb := a.cfg, a.pool, a.rdb
+
+	// 初始化 Repository
+	a.orderRepository = repository.NewOrderRepository(pool)
+	ticketRepository := repository.NewTicketRepository(pool)
+	eventRepository := repository.NewEventRepository(pool)
+	a.outboxRepository = repository.NewOutboxRepository(pool)
+	a.webhookRepository = repository.NewWebhookRepository(pool)
+	seatRepository := repository.NewSeatRepository(pool)
+	promoCodeRepository := repository.NewPromoCodeRepository(pool)
+	presaleRepository := repository.NewPresaleRepository(pool)
+
+	// 初始化 Cache
+	inventoryManager := cache.NewRedisTicketInventoryManager(rdb)
+	seatHoldManager := cache.NewRedisSeatHoldManager(rdb)
+	holdManager := cache.NewRedisTicketHoldManager(rdb)
+	waitlistManager := cache.NewRedisWaitlistManager(rdb)
+	promoCodeManager := cache.NewRedisPromoCodeManager(rdb)
+	presaleManager := cache.NewRedisPresaleManager(rdb)
+	riskSignalManager := cache.NewRedisRiskSignalManager(rdb)
+
+	// 初始化訂單佇列
+	orderQueue, err := a.newOrderQueue()
+	if err != nil {
+		return err
+	}
+	a.orderQueue = orderQueue
+
+	// 初始化 Service
+	a.riskScorer = service.NewRiskScorer(riskSignalManager, &service.RiskScorerConfig{
+		Window:            cfg.Risk.Window.Std(),
+		PaymentWindow:     cfg.Risk.PaymentWindow.Std(),
+		MaxUserAttempts:   cfg.Risk.MaxUserAttempts,
+		MaxIPAttempts:     cfg.Risk.MaxIPAttempts,
+		MaxDeviceAttempts: cfg.Risk.MaxDeviceAttempts,
+		MaxStockMisses:    cfg.Risk.MaxStockMisses,
+		MaxPaymentUsers:   cfg.Risk.MaxPaymentUsers,
+	})
+	a.orderService = service.NewOrderService(pool, a.orderRepository, ticketRepository, seatRepository, a.outboxRepository, promoCodeRepository, inventoryManager, seatHoldManager, holdManager, promoCodeManager, presaleManager, a.riskScorer, orderQueue)
+	a.eventService = service.NewEventService(eventRepository, ticketRepository, seatRepository, presaleRepository, inventoryManager, seatHoldManager, presaleManager)
+	a.ticketService = service.NewTicketService(pool, ticketRepository, seatRepository, inventoryManager)
+	a.seatService = service.NewSeatService(pool, seatRepository, ticketRepository, seatHoldManager)
+	a.webhookService = service.NewWebhookService(a.webhookRepository, eventRepository, ticketRepository)
+	a.holdService = service.NewHoldService(holdManager)
+	a.waitlistService = service.NewWaitlistService(pool, ticketRepository, a.outboxRepository, waitlistManager)
+	a.promoCodeService = service.NewPromoCodeService(promoCodeRepository, eventRepository, ticketRepository, promoCodeManager)
+	a.presaleService = service.NewPresaleService(presaleRepository, ticketRepository, presaleManager)
+	a.riskService = service.NewRiskService(a.orderRepository, eventRepository)
+
+	// 重新載入設定：SIGHUP 或 POST /api/v1/admin/settings/reload，依啟動時的來源重新載入後套用到執行中的元件
+	a.reloader = config.NewReloader(func() (*config.Config, error) { return config.Load(a.args) }, cfg)
+	a.registerReloadAppliers()
+	return nil
+}
+
+// Close 關閉資料庫、Redis 及 NATS 連接
+func (a *App) Close() {
+	if a.nc != nil {
+		a.nc.Close()
+	}
+	if a.rdb != nil {
+		_ = a.rdb.Close()
+	}
+	if a.pool != nil {
+		a.pool.Close()
+	}
+}
@@ -0,0 +1,49 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"go-gin-high-concurrency/internal/grpcserver"
+	"go-gin-high-concurrency/pkg/logger"
+	"net"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+// startGRPCServer 在 cfg.GRPC.Addr 啟動 gRPC Server；監聽失敗時回傳錯誤，執行中發生的錯誤送到 serveErr
+func (a *App) startGRPCServer(serveErr chan<- error) (*grpc.Server, error) {
+	lis, err := net.Listen("tcp", a.cfg.GRPC.Addr)
+	if err != nil {
+		return nil, fmt.Errorf("listen gRPC: %w", err)
+	}
+	ticketing := grpcserver.NewTicketingServer(a.eventService, a.ticketService, a.orderService, &grpcserver.Config{
+		WatchInterval: a.cfg.GRPC.WatchInterval.Std(),
+	})
+	srv := grpcserver.NewServer(ticketing)
+
+	go func() {
+		logger.L.Info("gRPC server starting", zap.String("addr", a.cfg.GRPC.Addr))
+		if err := srv.Serve(lis); err != nil {
+			serveErr <- fmt.Errorf("serve gRPC: %w", err)
+		}
+	}()
+	return srv, nil
+}
+
+// stopGRPCServer 等待處理中的呼叫完成；WatchOrder 等長連線在 ctx 結束後強制關閉
+func stopGRPCServer(ctx context.Context, srv *grpc.Server) {
+	stopped := make(chan struct{})
+	go func() {
+		srv.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+		logger.L.Info("gRPC server gracefully stopped")
+	case <-ctx.Done():
+		srv.Stop()
+		logger.L.Warn("gRPC server forced to stop")
+	}
+}
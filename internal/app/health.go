@@ -0,0 +1,32 @@
+package app
+
+import (
+	"go-gin-high-concurrency/internal/queue"
+	"go-gin-high-concurrency/internal/service"
+)
+
+// newHealthService 建立健康檢查：liveness 只檢查訂單 Worker 心跳；readiness 檢查 PostgreSQL、Redis 及 consumer group，
+// 執行 Worker 時另外檢查心跳及訂單佇列積壓
+func (a *App) newHealthService(runWorkers bool) service.HealthService {
+	cfg := a.cfg.Health
+	var liveness []service.HealthCheck
+	readiness := []service.HealthCheck{
+		service.PostgresHealthCheck(a.pool),
+		service.RedisHealthCheck(a.rdb),
+	}
+	inspectable, inspectableOK := a.orderQueue.(queue.InspectableOrderQueue)
+	if inspectableOK {
+		readiness = append(readiness, service.ConsumerGroupHealthCheck(inspectable))
+	}
+	if runWorkers {
+		heartbeat := service.HeartbeatHealthCheck("order_worker", a.orderWorker.LastHeartbeat, cfg.WorkerHeartbeatTimeout.Std())
+		liveness = append(liveness, heartbeat)
+		readiness = append(readiness, heartbeat)
+		if inspectableOK {
+			readiness = append(readiness, service.StreamLagHealthCheck(inspectable, int64(cfg.MaxStreamLag), int64(cfg.MaxPendingMessages)))
+		}
+	}
+	return service.NewHealthService(liveness, readiness, &service.HealthServiceConfig{
+		CheckTimeout: cfg.CheckTimeout.Std(),
+	})
+}
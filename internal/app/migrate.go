@@ -0,0 +1,146 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"go-gin-high-concurrency/internal/database"
+	"go-gin-high-concurrency/migrations"
+	"go-gin-high-concurrency/pkg/logger"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+const migrateUsage = `usage: migrate <command> [flags]
+
+commands:
+  up               套用所有尚未套用的 migration
+  down [N]         回滾最近的 N 個 migration（預設 1）
+  status           列出目前版本及各 migration 是否已套用
+  force VERSION    設定版本並清除 dirty，不執行 SQL（VERSION 為 -1 時清除版本）
+
+flags 與啟動 server 相同（-config、-db-host 等）`
+
+// runMigrate 執行 migrate 子命令，args 不含 "migrate"
+func runMigrate(args []string) int {
+	if len(args) == 0 {
+		fmt.Fprintln(os.Stderr, migrateUsage)
+		return 2
+	}
+	command, rest := args[0], args[1:]
+	var operand string
+	switch command {
+	case "up", "status":
+	case "down":
+		if len(rest) > 0 && !strings.HasPrefix(rest[0], "-") {
+			operand, rest = rest[0], rest[1:]
+		}
+	case "force":
+		if len(rest) == 0 {
+			fmt.Fprintln(os.Stderr, "migrate force: missing VERSION")
+			return 2
+		}
+		operand, rest = rest[0], rest[1:]
+	default:
+		fmt.Fprintf(os.Stderr, "migrate: unknown command %q\n\n%s\n", command, migrateUsage)
+		return 2
+	}
+
+	cfg, err := loadConfig(rest)
+	if err != nil {
+		return 2
+	}
+	if err := logger.Configure(cfg.Log.Level, cfg.Log.Format); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 2
+	}
+	pool, err := database.InitDatabase(&cfg.Database)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "initialize database:", err)
+		return 1
+	}
+	defer pool.Close()
+
+	if err := migrate(context.Background(), pool, command, operand, os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	return 0
+}
+
+func migrate(ctx context.Context, pool *pgxpool.Pool, command string, operand string, w io.Writer) error {
+	migrator, err := database.NewMigrator(pool, migrations.FS, nil)
+	if err != nil {
+		return err
+	}
+
+	switch command {
+	case "up":
+		applied, err := migrator.Up(ctx)
+		fmt.Fprintf(w, "applied %d migration(s)\n", applied)
+		return err
+	case "down":
+		steps := 1
+		if operand != "" {
+			if steps, err = strconv.Atoi(operand); err != nil || steps <= 0 {
+				return fmt.Errorf("migrate down: invalid step count %q", operand)
+			}
+		}
+		reverted, err := migrator.Down(ctx, steps)
+		fmt.Fprintf(w, "reverted %d migration(s)\n", reverted)
+		return err
+	case "force":
+		version, err := strconv.Atoi(operand)
+		if err != nil {
+			return fmt.Errorf("migrate force: invalid version %q", operand)
+		}
+		if err := migrator.Force(ctx, version); err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "forced version %d\n", version)
+		return nil
+	default:
+		status, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+		printMigrationStatus(w, status)
+		return nil
+	}
+}
+
+func printMigrationStatus(w io.Writer, status *database.MigrationStatus) {
+	switch {
+	case status.Version == database.NilVersion:
+		fmt.Fprintln(w, "version: none")
+	case status.Dirty:
+		fmt.Fprintf(w, "version: %d (dirty)\n", status.Version)
+	default:
+		fmt.Fprintf(w, "version: %d\n", status.Version)
+	}
+	for _, m := range status.Migrations {
+		mark := " "
+		if m.Applied {
+			mark = "x"
+		}
+		fmt.Fprintf(w, "[%s] %03d %s\n", mark, m.Version, m.Name)
+	}
+}
+
+// autoMigrate 啟動時套用尚未套用的 migration；多個實例同時啟動時由 advisory lock 排序，只有一個實例實際執行
+func autoMigrate(ctx context.Context, pool *pgxpool.Pool) error {
+	migrator, err := database.NewMigrator(pool, migrations.FS, nil)
+	if err != nil {
+		return err
+	}
+	applied, err := migrator.Up(ctx)
+	if err != nil {
+		return err
+	}
+	logger.L.Info("Database migrations applied", zap.Int("applied", applied))
+	return nil
+}
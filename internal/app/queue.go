@@ -0,0 +1,51 @@
+package app
+
+import (
+	"fmt"
+	"go-gin-high-concurrency/config"
+	"go-gin-high-concurrency/internal/queue"
+
+	"github.com/nats-io/nats.go"
+)
+
+// newOrderQueue 依 cfg.Queue.Backend 建立訂單佇列；NATS 的連線保留在 App 上，Close 時關閉
+func (a *App) newOrderQueue() (queue.OrderQueue, error) {
+	cfg := a.cfg.Queue
+	switch cfg.Backend {
+	case config.QueueBackendMemory:
+		return queue.NewMemoryOrderQueue(&queue.MemoryOrderQueueConfig{
+			Capacity:        cfg.Memory.Capacity,
+			RedeliveryDelay: cfg.Memory.RedeliveryDelay.Std(),
+			MaxRetryCount:   cfg.MaxRetryCount,
+		}), nil
+	case config.QueueBackendNATS:
+		nc, err := nats.Connect(cfg.NATS.URL, nats.Name("go-gin-high-concurrency"))
+		if err != nil {
+			return nil, fmt.Errorf("connect NATS: %w", err)
+		}
+		a.nc = nc
+		orderQueue, err := queue.NewNATSOrderQueue(nc, &queue.NATSOrderQueueConfig{
+			Stream:          cfg.NATS.Stream,
+			Subject:         cfg.NATS.Subject,
+			Durable:         cfg.NATS.Durable,
+			AckWait:         cfg.NATS.AckWait.Std(),
+			RedeliveryDelay: cfg.NATS.RedeliveryDelay.Std(),
+			MaxRetryCount:   cfg.MaxRetryCount,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("create NATS JetStream order queue: %w", err)
+		}
+		return orderQueue, nil
+	default:
+		orderQueue, err := queue.NewRedisStreamOrderQueue(a.rdb, cfg.ConsumerID, &queue.RedisStreamOrderQueueConfig{
+			StreamKey:          cfg.OrderStream,
+			ClaimMinIdleTime:   cfg.ClaimMinIdleTime.Std(),
+			MaxRetryCount:      cfg.MaxRetryCount,
+			ReadGroupBlockTime: cfg.ReadGroupBlockTime.Std(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("create Redis stream order queue: %w", err)
+		}
+		return orderQueue, nil
+	}
+}
@@ -0,0 +1,76 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"go-gin-high-concurrency/config"
+	"go-gin-high-concurrency/internal/queue"
+	"go-gin-high-concurrency/internal/service"
+	"go-gin-high-concurrency/pkg/logger"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"go.uber.org/zap"
+)
+
+// registerReloadAppliers 註冊重新載入設定後，套用到日誌等級、佇列及風險評分的函式；Worker 的設定於 StartWorkers 註冊
+func (a *App) registerReloadAppliers() {
+	a.reloader.OnReload(func(s config.RuntimeSettings) {
+		if err := logger.SetLevel(s.LogLevel); err != nil {
+			logger.L.Error("Failed to apply log level", zap.String("level", s.LogLevel), zap.Error(err))
+		}
+	})
+	switch configurable := a.orderQueue.(type) {
+	case queue.ConfigurableOrderQueue:
+		a.reloader.OnReload(func(s config.RuntimeSettings) {
+			configurable.UpdateConfig(queue.RedisStreamOrderQueueConfig{
+				ClaimMinIdleTime: s.QueueClaimMinIdleTime.Std(),
+				MaxRetryCount:    s.QueueMaxRetryCount,
+			})
+		})
+	case queue.ConfigurableMemoryOrderQueue:
+		a.reloader.OnReload(func(s config.RuntimeSettings) {
+			configurable.UpdateConfig(queue.MemoryOrderQueueConfig{
+				RedeliveryDelay: s.QueueMemoryRedeliveryDelay.Std(),
+				MaxRetryCount:   s.QueueMaxRetryCount,
+			})
+		})
+	case queue.ConfigurableNATSOrderQueue:
+		a.reloader.OnReload(func(s config.RuntimeSettings) {
+			configurable.UpdateConfig(queue.NATSOrderQueueConfig{
+				RedeliveryDelay: s.QueueNATSRedeliveryDelay.Std(),
+				MaxRetryCount:   s.QueueMaxRetryCount,
+			})
+		})
+	default:
+		logger.L.Warn("Order queue settings are not reloadable", zap.String("queue", fmt.Sprintf("%T", a.orderQueue)))
+	}
+	a.reloader.OnReload(func(s config.RuntimeSettings) {
+		a.riskScorer.UpdateConfig(service.RiskScorerConfig{
+			Window:            s.RiskWindow.Std(),
+			PaymentWindow:     s.RiskPaymentWindow.Std(),
+			MaxUserAttempts:   s.RiskMaxUserAttempts,
+			MaxIPAttempts:     s.RiskMaxIPAttempts,
+			MaxDeviceAttempts: s.RiskMaxDeviceAttempts,
+			MaxStockMisses:    s.RiskMaxStockMisses,
+			MaxPaymentUsers:   s.RiskMaxPaymentUsers,
+		})
+	})
+}
+
+// watchReloadSignal 收到 SIGHUP 時重新載入設定，直到 ctx 結束
+func (a *App) watchReloadSignal(ctx context.Context) {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	defer signal.Stop(hup)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-hup:
+			logger.L.Info("SIGHUP received, reloading config")
+			_, _ = a.reloader.Reload()
+		}
+	}
+}
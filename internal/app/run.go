@@ -0,0 +1,211 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"go-gin-high-concurrency/config"
+	"go-gin-high-concurrency/pkg/logger"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+// Mode 程序要啟動的部分
+type Mode string
+
+const (
+	ModeAll    Mode = "all"    // HTTP API 與背景 Worker 在同一個程序，供本機開發使用
+	ModeAPI    Mode = "api"    // 只提供 HTTP API，訂單由 Worker 程序消費
+	ModeWorker Mode = "worker" // 只執行背景 Worker，另開 HTTP Server 提供健康檢查及設定管理
+)
+
+// Main 各程序進入點共用的流程：載入設定、組裝元件、依 mode 執行直到收到終止信號。
+// 支援 `config print` 子命令，輸出生效中的設定（密碼已隱藏）後結束；`migrate` 子命令執行內嵌的資料庫 migration。
+func Main(mode Mode) {
+	os.Exit(run(mode, os.Args[1:]))
+}
+
+func run(mode Mode, args []string) int {
+	if len(args) > 0 && args[0] == "migrate" {
+		return runMigrate(args[1:])
+	}
+	if len(args) > 1 && args[0] == "config" && args[1] == "print" {
+		cfg, err := loadConfig(args[2:])
+		if err != nil {
+			return 2
+		}
+		if err := cfg.Print(os.Stdout); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+		return 0
+	}
+
+	cfg, err := loadConfig(args)
+	if err != nil {
+		return 2
+	}
+	if err := logger.Configure(cfg.Log.Level, cfg.Log.Format); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 2
+	}
+	defer func() { _ = logger.L.Sync() }()
+
+	a, err := New(cfg, args)
+	if err != nil {
+		logger.L.Error("Failed to initialize app", zap.Error(err))
+		return 1
+	}
+	// 關閉資料庫和 Redis 連接
+	defer a.Close()
+
+	// 使用 signal.NotifyContext 來監聽終止信號
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if err := a.Run(ctx, mode); err != nil {
+		logger.L.Error("App stopped with error", zap.String("mode", string(mode)), zap.Error(err))
+		return 1
+	}
+	return 0
+}
+
+// Run 依 mode 啟動 HTTP Server 及背景 Worker，ctx 結束後依序優雅關閉：先停止接收新請求，再停止 Worker
+func (a *App) Run(ctx context.Context, mode Mode) error {
+	cfg := a.cfg
+
+	// Worker 使用 Background context（長期運行的後台任務，獨立於 HTTP Server）
+	workerCtx, workerCancel := context.WithCancel(context.Background())
+	defer workerCancel()
+
+	// 記憶體佇列只存在於本程序，API 與 Worker 分開部署時訂單無法送達 Worker
+	if cfg.Queue.Backend == config.QueueBackendMemory && mode != ModeAll {
+		return fmt.Errorf("queue backend %q requires mode %q, got %q", cfg.Queue.Backend, ModeAll, mode)
+	}
+
+	runWorkers := mode == ModeAll || mode == ModeWorker
+	if runWorkers {
+		if err := a.StartWorkers(workerCtx); err != nil {
+			return err
+		}
+	}
+	go a.watchReloadSignal(workerCtx)
+	a.health = a.newHealthService(runWorkers)
+
+	addr, router := cfg.Worker.HealthAddr, a.workerRouter()
+	if mode != ModeWorker {
+		apiRouter, err := a.apiRouter()
+		if err != nil {
+			return err
+		}
+		addr, router = cfg.Server.Addr, apiRouter
+	}
+
+	// 創建 HTTP Server（使用 http.Server 以支持優雅關閉）
+	// 長連線（SSE）使用 serverCtx 作為 base context，Shutdown 時一併結束
+	serverCtx, serverCancel := context.WithCancel(context.Background())
+	defer serverCancel()
+	srv := &http.Server{
+		Addr:        addr,
+		Handler:     router,
+		BaseContext: func(net.Listener) context.Context { return serverCtx },
+	}
+	srv.RegisterOnShutdown(serverCancel)
+
+	// 在 goroutine 中啟動服務器
+	serveErr := make(chan error, 2)
+	var grpcServer *grpc.Server
+	if mode != ModeWorker && cfg.GRPC.Enabled {
+		var err error
+		if grpcServer, err = a.startGRPCServer(serveErr); err != nil {
+			return err
+		}
+	}
+	go func() {
+		logger.L.Info("Server starting", zap.String("addr", addr), zap.String("mode", string(mode)))
+		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			serveErr <- err
+		}
+	}()
+
+	// 等待終止信號
+	select {
+	case <-ctx.Done():
+	case err := <-serveErr:
+		return fmt.Errorf("start server: %w", err)
+	}
+
+	logger.L.Info("Shutting down server...")
+
+	// 0. 先回報未就緒，等待負載平衡器移除此實例後才停止接收新請求
+	a.health.StartDraining()
+	if drainDelay := cfg.Health.DrainDelay.Std(); drainDelay > 0 {
+		logger.L.Info("Draining before shutdown", zap.Duration("drain_delay", drainDelay))
+		time.Sleep(drainDelay)
+	}
+
+	// 設置 shutdown timeout（給正在處理的請求時間完成）
+	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), cfg.Server.ShutdownTimeout.Std())
+	defer shutdownCancel()
+
+	// 1. 先停止接收新請求（關閉 HTTP Server 及 gRPC Server）
+	// 注意：Gin 會自動等待正在處理的 HTTP 請求完成
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		logger.L.Warn("Server forced to shutdown", zap.Error(err))
+	} else {
+		logger.L.Info("Server gracefully stopped")
+	}
+	if grpcServer != nil {
+		stopGRPCServer(shutdownCtx, grpcServer)
+	}
+
+	// 2. 停止 Worker（讓它完成正在處理的訂單）
+	workerCancel()
+	if runWorkers {
+		a.waitWorkers()
+	}
+
+	logger.L.Info("Server shutdown complete")
+	return nil
+}
+
+// waitWorkers 等待 Worker 完成（給一點時間讓正在處理的訂單完成）
+// 注意：在實際生產環境中，你可能需要更精確的等待機制（例如使用 sync.WaitGroup）
+func (a *App) waitWorkers() {
+	logger.L.Info("Stopping worker...")
+	workerShutdownCtx, workerShutdownCancel := context.WithTimeout(context.Background(), a.cfg.Server.WorkerShutdownTimeout.Std())
+	defer workerShutdownCancel()
+
+	// 等待 Worker 完成或超時
+	select {
+	case <-workerShutdownCtx.Done():
+		if workerShutdownCtx.Err() == context.DeadlineExceeded {
+			logger.L.Warn("Worker shutdown timeout exceeded")
+		} else {
+			logger.L.Info("Worker stopped successfully")
+		}
+	case <-time.After(2 * time.Second):
+		logger.L.Info("Waiting for worker to finish processing...")
+	}
+}
+
+// loadConfig 載入並驗證設定；錯誤輸出到 stderr（logger 尚未依設定初始化），-h 時輸出參數說明後結束
+func loadConfig(args []string) (*config.Config, error) {
+	cfg, err := config.Load(args)
+	if errors.Is(err, flag.ErrHelp) {
+		os.Exit(0)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
+	}
+	return cfg, nil
+}
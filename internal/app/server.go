@@ -0,0 +1,73 @@
+package app
+
+import (
+	"fmt"
+	"go-gin-high-concurrency/internal/handler"
+	"go-gin-high-concurrency/internal/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// apiRouter API 程序的路由：所有業務 API、API 文件、設定管理及健康檢查
+func (a *App) apiRouter() (*gin.Engine, error) {
+	orderHandler := handler.NewOrderHandler(a.orderService)
+	eventHandler := handler.NewEventHandler(a.eventService)
+	ticketHandler := handler.NewTicketHandler(a.ticketService)
+	webhookHandler := handler.NewWebhookHandler(a.webhookService)
+	seatHandler := handler.NewSeatHandler(a.seatService)
+	holdHandler := handler.NewHoldHandler(a.holdService)
+	waitlistHandler := handler.NewWaitlistHandler(a.waitlistService)
+	promoCodeHandler := handler.NewPromoCodeHandler(a.promoCodeService)
+	presaleHandler := handler.NewPresaleHandler(a.presaleService)
+	riskHandler := handler.NewRiskHandler(a.riskService)
+	doc, err := handler.OpenAPIDocument()
+	if err != nil {
+		return nil, fmt.Errorf("build OpenAPI document: %w", err)
+	}
+	openAPIHandler, err := handler.NewOpenAPIHandler(doc)
+	if err != nil {
+		return nil, fmt.Errorf("build OpenAPI document: %w", err)
+	}
+	router := a.baseRouter()
+
+	// 註冊路由
+	orderHandler.RegisterRoutes(router)
+	eventHandler.RegisterRoutes(router)
+	ticketHandler.RegisterRoutes(router)
+	webhookHandler.RegisterRoutes(router)
+	seatHandler.RegisterRoutes(router)
+	holdHandler.RegisterRoutes(router)
+	waitlistHandler.RegisterRoutes(router)
+	promoCodeHandler.RegisterRoutes(router)
+	presaleHandler.RegisterRoutes(router)
+	riskHandler.RegisterRoutes(router)
+	openAPIHandler.RegisterRoutes(router)
+	return router, nil
+}
+
+// workerRouter Worker 程序的路由：僅提供健康檢查及設定管理
+func (a *App) workerRouter() *gin.Engine {
+	return a.baseRouter()
+}
+
+// baseRouter API 與 Worker 程序共用的路由：健康檢查（/ping、/healthz、/readyz）及設定管理
+func (a *App) baseRouter() *gin.Engine {
+	router := gin.New()
+	router.Use(
+		middleware.RequestID(),
+		middleware.AccessLog("/ping", "/healthz", "/readyz"),
+		middleware.Recovery(),
+	)
+
+	// Health check
+	router.GET("/ping", func(c *gin.Context) {
+		c.JSON(200, gin.H{
+			"message": "pong",
+		})
+	})
+
+	handler.NewHealthHandler(a.health).RegisterRoutes(router)
+	handler.NewSettingsHandler(a.reloader).RegisterRoutes(router)
+	handler.RegisterFallbackRoutes(router)
+	return router
+}
@@ -0,0 +1,83 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"go-gin-high-concurrency/config"
+	"go-gin-high-concurrency/internal/queue"
+	"go-gin-high-concurrency/internal/worker"
+	"go-gin-high-concurrency/pkg/logger"
+)
+
+// StartWorkers 啟動所有背景 Worker，ctx 結束時停止
+func (a *App) StartWorkers(ctx context.Context) error {
+	cfg := a.cfg
+
+	orderWorker := worker.NewOrderWorker(a.orderService, a.orderQueue, &worker.OrderWorkerConfig{
+		Concurrency: cfg.Worker.OrderWorker.Concurrency,
+	})
+	if err := orderWorker.Start(ctx); err != nil {
+		return fmt.Errorf("start order worker: %w", err)
+	}
+	logger.L.Info("Order worker started successfully")
+	a.orderWorker = orderWorker
+
+	// Outbox Relay：將交易內寫入的領域事件發佈到 Redis Stream，並為訂閱的 webhook 建立投遞
+	eventPublisher := queue.NewFanoutEventPublisher(
+		queue.NewRedisStreamEventPublisher(a.rdb, cfg.Queue.EventStream),
+		a.webhookService,
+	)
+	outboxRelay := worker.NewOutboxRelay(a.pool, a.outboxRepository, eventPublisher, &worker.OutboxRelayConfig{
+		BatchSize:    cfg.Worker.OutboxRelay.BatchSize,
+		PollInterval: cfg.Worker.OutboxRelay.PollInterval.Std(),
+	})
+	if err := outboxRelay.Start(ctx); err != nil {
+		return fmt.Errorf("start outbox relay: %w", err)
+	}
+	logger.L.Info("Outbox relay started successfully")
+
+	webhookDispatcher := worker.NewWebhookDispatcher(a.webhookRepository, &worker.WebhookDispatcherConfig{
+		BatchSize:      cfg.Worker.WebhookDispatcher.BatchSize,
+		PollInterval:   cfg.Worker.WebhookDispatcher.PollInterval.Std(),
+		RequestTimeout: cfg.Worker.WebhookDispatcher.RequestTimeout.Std(),
+		MaxAttempts:    cfg.Worker.WebhookDispatcher.MaxAttempts,
+		InitialBackoff: cfg.Worker.WebhookDispatcher.InitialBackoff.Std(),
+		MaxBackoff:     cfg.Worker.WebhookDispatcher.MaxBackoff.Std(),
+	})
+	if err := webhookDispatcher.Start(ctx); err != nil {
+		return fmt.Errorf("start webhook dispatcher: %w", err)
+	}
+	logger.L.Info("Webhook dispatcher started successfully")
+
+	// Hold Sweeper：歸還逾時未轉為訂單的保留
+	holdSweeper := worker.NewHoldSweeper(a.holdService, &worker.HoldSweeperConfig{
+		BatchSize:    cfg.Worker.HoldSweeper.BatchSize,
+		PollInterval: cfg.Worker.HoldSweeper.PollInterval.Std(),
+	})
+	if err := holdSweeper.Start(ctx); err != nil {
+		return fmt.Errorf("start hold sweeper: %w", err)
+	}
+	logger.L.Info("Hold sweeper started successfully")
+
+	// Waitlist Promoter：將釋出的庫存遞補給候補者
+	waitlistPromoter := worker.NewWaitlistPromoter(a.waitlistService, &worker.WaitlistPromoterConfig{
+		BatchSize:    cfg.Worker.WaitlistPromoter.BatchSize,
+		PollInterval: cfg.Worker.WaitlistPromoter.PollInterval.Std(),
+	})
+	if err := waitlistPromoter.Start(ctx); err != nil {
+		return fmt.Errorf("start waitlist promoter: %w", err)
+	}
+	logger.L.Info("Waitlist promoter started successfully")
+
+	a.reloader.OnReload(func(s config.RuntimeSettings) {
+		orderWorker.UpdateConfig(worker.OrderWorkerConfig{Concurrency: s.OrderWorkerConcurrency})
+	})
+	a.reloader.OnReload(func(s config.RuntimeSettings) {
+		webhookDispatcher.UpdateConfig(worker.WebhookDispatcherConfig{
+			MaxAttempts:    s.WebhookMaxAttempts,
+			InitialBackoff: s.WebhookInitialBackoff.Std(),
+			MaxBackoff:     s.WebhookMaxBackoff.Std(),
+		})
+	})
+	return nil
+}
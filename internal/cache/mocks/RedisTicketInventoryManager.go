@@ -7,6 +7,7 @@ package mocks
 import (
 	"context"
 	"go-gin-high-concurrency/internal/cache"
+	"go-gin-high-concurrency/internal/model"
 
 	mock "github.com/stretchr/testify/mock"
 )
@@ -38,32 +39,95 @@ func (_m *MockRedisTicketInventoryManager) EXPECT() *MockRedisTicketInventoryMan
 	return &MockRedisTicketInventoryManager_Expecter{mock: &_m.Mock}
 }
 
+// AdjustStock provides a mock function for the type MockRedisTicketInventoryManager
+func (_mock *MockRedisTicketInventoryManager) AdjustStock(ctx context.Context, ticketID int, delta int) error {
+	ret := _mock.Called(ctx, ticketID, delta)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AdjustStock")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int) error); ok {
+		r0 = returnFunc(ctx, ticketID, delta)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRedisTicketInventoryManager_AdjustStock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AdjustStock'
+type MockRedisTicketInventoryManager_AdjustStock_Call struct {
+	*mock.Call
+}
+
+// AdjustStock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ticketID int
+//   - delta int
+func (_e *MockRedisTicketInventoryManager_Expecter) AdjustStock(ctx interface{}, ticketID interface{}, delta interface{}) *MockRedisTicketInventoryManager_AdjustStock_Call {
+	return &MockRedisTicketInventoryManager_AdjustStock_Call{Call: _e.mock.On("AdjustStock", ctx, ticketID, delta)}
+}
+
+func (_c *MockRedisTicketInventoryManager_AdjustStock_Call) Run(run func(ctx context.Context, ticketID int, delta int)) *MockRedisTicketInventoryManager_AdjustStock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRedisTicketInventoryManager_AdjustStock_Call) Return(err error) *MockRedisTicketInventoryManager_AdjustStock_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRedisTicketInventoryManager_AdjustStock_Call) RunAndReturn(run func(ctx context.Context, ticketID int, delta int) error) *MockRedisTicketInventoryManager_AdjustStock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DecreStock provides a mock function for the type MockRedisTicketInventoryManager
-func (_mock *MockRedisTicketInventoryManager) DecreStock(ctx context.Context, ticketID int, quantity int, userID int) (bool, float64, error) {
-	ret := _mock.Called(ctx, ticketID, quantity, userID)
+func (_mock *MockRedisTicketInventoryManager) DecreStock(ctx context.Context, ticketID int, quantity int, userID int, accessCode string) (bool, cache.PriceQuote, error) {
+	ret := _mock.Called(ctx, ticketID, quantity, userID, accessCode)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DecreStock")
 	}
 
 	var r0 bool
-	var r1 float64
+	var r1 cache.PriceQuote
 	var r2 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int, int) (bool, float64, error)); ok {
-		return returnFunc(ctx, ticketID, quantity, userID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int, int, string) (bool, cache.PriceQuote, error)); ok {
+		return returnFunc(ctx, ticketID, quantity, userID, accessCode)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int, int) bool); ok {
-		r0 = returnFunc(ctx, ticketID, quantity, userID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int, int, string) bool); ok {
+		r0 = returnFunc(ctx, ticketID, quantity, userID, accessCode)
 	} else {
 		r0 = ret.Get(0).(bool)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int, int, int) float64); ok {
-		r1 = returnFunc(ctx, ticketID, quantity, userID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int, int, int, string) cache.PriceQuote); ok {
+		r1 = returnFunc(ctx, ticketID, quantity, userID, accessCode)
 	} else {
-		r1 = ret.Get(1).(float64)
+		r1 = ret.Get(1).(cache.PriceQuote)
 	}
-	if returnFunc, ok := ret.Get(2).(func(context.Context, int, int, int) error); ok {
-		r2 = returnFunc(ctx, ticketID, quantity, userID)
+	if returnFunc, ok := ret.Get(2).(func(context.Context, int, int, int, string) error); ok {
+		r2 = returnFunc(ctx, ticketID, quantity, userID, accessCode)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -80,11 +144,12 @@ type MockRedisTicketInventoryManager_DecreStock_Call struct {
 //   - ticketID int
 //   - quantity int
 //   - userID int
-func (_e *MockRedisTicketInventoryManager_Expecter) DecreStock(ctx interface{}, ticketID interface{}, quantity interface{}, userID interface{}) *MockRedisTicketInventoryManager_DecreStock_Call {
-	return &MockRedisTicketInventoryManager_DecreStock_Call{Call: _e.mock.On("DecreStock", ctx, ticketID, quantity, userID)}
+//   - accessCode string
+func (_e *MockRedisTicketInventoryManager_Expecter) DecreStock(ctx interface{}, ticketID interface{}, quantity interface{}, userID interface{}, accessCode interface{}) *MockRedisTicketInventoryManager_DecreStock_Call {
+	return &MockRedisTicketInventoryManager_DecreStock_Call{Call: _e.mock.On("DecreStock", ctx, ticketID, quantity, userID, accessCode)}
 }
 
-func (_c *MockRedisTicketInventoryManager_DecreStock_Call) Run(run func(ctx context.Context, ticketID int, quantity int, userID int)) *MockRedisTicketInventoryManager_DecreStock_Call {
+func (_c *MockRedisTicketInventoryManager_DecreStock_Call) Run(run func(ctx context.Context, ticketID int, quantity int, userID int, accessCode string)) *MockRedisTicketInventoryManager_DecreStock_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -102,22 +167,27 @@ func (_c *MockRedisTicketInventoryManager_DecreStock_Call) Run(run func(ctx cont
 		if args[3] != nil {
 			arg3 = args[3].(int)
 		}
+		var arg4 string
+		if args[4] != nil {
+			arg4 = args[4].(string)
+		}
 		run(
 			arg0,
 			arg1,
 			arg2,
 			arg3,
+			arg4,
 		)
 	})
 	return _c
 }
 
-func (_c *MockRedisTicketInventoryManager_DecreStock_Call) Return(b bool, f float64, err error) *MockRedisTicketInventoryManager_DecreStock_Call {
-	_c.Call.Return(b, f, err)
+func (_c *MockRedisTicketInventoryManager_DecreStock_Call) Return(b bool, priceQuote cache.PriceQuote, err error) *MockRedisTicketInventoryManager_DecreStock_Call {
+	_c.Call.Return(b, priceQuote, err)
 	return _c
 }
 
-func (_c *MockRedisTicketInventoryManager_DecreStock_Call) RunAndReturn(run func(ctx context.Context, ticketID int, quantity int, userID int) (bool, float64, error)) *MockRedisTicketInventoryManager_DecreStock_Call {
+func (_c *MockRedisTicketInventoryManager_DecreStock_Call) RunAndReturn(run func(ctx context.Context, ticketID int, quantity int, userID int, accessCode string) (bool, cache.PriceQuote, error)) *MockRedisTicketInventoryManager_DecreStock_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -323,17 +393,280 @@ func (_c *MockRedisTicketInventoryManager_RollbackStock_Call) RunAndReturn(run f
 	return _c
 }
 
+// SetEventLimit provides a mock function for the type MockRedisTicketInventoryManager
+func (_mock *MockRedisTicketInventoryManager) SetEventLimit(ctx context.Context, eventID int, limit int) error {
+	ret := _mock.Called(ctx, eventID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetEventLimit")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int) error); ok {
+		r0 = returnFunc(ctx, eventID, limit)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRedisTicketInventoryManager_SetEventLimit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetEventLimit'
+type MockRedisTicketInventoryManager_SetEventLimit_Call struct {
+	*mock.Call
+}
+
+// SetEventLimit is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventID int
+//   - limit int
+func (_e *MockRedisTicketInventoryManager_Expecter) SetEventLimit(ctx interface{}, eventID interface{}, limit interface{}) *MockRedisTicketInventoryManager_SetEventLimit_Call {
+	return &MockRedisTicketInventoryManager_SetEventLimit_Call{Call: _e.mock.On("SetEventLimit", ctx, eventID, limit)}
+}
+
+func (_c *MockRedisTicketInventoryManager_SetEventLimit_Call) Run(run func(ctx context.Context, eventID int, limit int)) *MockRedisTicketInventoryManager_SetEventLimit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRedisTicketInventoryManager_SetEventLimit_Call) Return(err error) *MockRedisTicketInventoryManager_SetEventLimit_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRedisTicketInventoryManager_SetEventLimit_Call) RunAndReturn(run func(ctx context.Context, eventID int, limit int) error) *MockRedisTicketInventoryManager_SetEventLimit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetPricePhases provides a mock function for the type MockRedisTicketInventoryManager
+func (_mock *MockRedisTicketInventoryManager) SetPricePhases(ctx context.Context, ticketID int, phases []*model.TicketPricePhase) error {
+	ret := _mock.Called(ctx, ticketID, phases)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetPricePhases")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, []*model.TicketPricePhase) error); ok {
+		r0 = returnFunc(ctx, ticketID, phases)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRedisTicketInventoryManager_SetPricePhases_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetPricePhases'
+type MockRedisTicketInventoryManager_SetPricePhases_Call struct {
+	*mock.Call
+}
+
+// SetPricePhases is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ticketID int
+//   - phases []*model.TicketPricePhase
+func (_e *MockRedisTicketInventoryManager_Expecter) SetPricePhases(ctx interface{}, ticketID interface{}, phases interface{}) *MockRedisTicketInventoryManager_SetPricePhases_Call {
+	return &MockRedisTicketInventoryManager_SetPricePhases_Call{Call: _e.mock.On("SetPricePhases", ctx, ticketID, phases)}
+}
+
+func (_c *MockRedisTicketInventoryManager_SetPricePhases_Call) Run(run func(ctx context.Context, ticketID int, phases []*model.TicketPricePhase)) *MockRedisTicketInventoryManager_SetPricePhases_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 []*model.TicketPricePhase
+		if args[2] != nil {
+			arg2 = args[2].([]*model.TicketPricePhase)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRedisTicketInventoryManager_SetPricePhases_Call) Return(err error) *MockRedisTicketInventoryManager_SetPricePhases_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRedisTicketInventoryManager_SetPricePhases_Call) RunAndReturn(run func(ctx context.Context, ticketID int, phases []*model.TicketPricePhase) error) *MockRedisTicketInventoryManager_SetPricePhases_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SubscribeStock provides a mock function for the type MockRedisTicketInventoryManager
+func (_mock *MockRedisTicketInventoryManager) SubscribeStock(ctx context.Context, ticketIDs []int) (<-chan cache.StockUpdate, error) {
+	ret := _mock.Called(ctx, ticketIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubscribeStock")
+	}
+
+	var r0 <-chan cache.StockUpdate
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []int) (<-chan cache.StockUpdate, error)); ok {
+		return returnFunc(ctx, ticketIDs)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []int) <-chan cache.StockUpdate); ok {
+		r0 = returnFunc(ctx, ticketIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan cache.StockUpdate)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []int) error); ok {
+		r1 = returnFunc(ctx, ticketIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRedisTicketInventoryManager_SubscribeStock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubscribeStock'
+type MockRedisTicketInventoryManager_SubscribeStock_Call struct {
+	*mock.Call
+}
+
+// SubscribeStock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ticketIDs []int
+func (_e *MockRedisTicketInventoryManager_Expecter) SubscribeStock(ctx interface{}, ticketIDs interface{}) *MockRedisTicketInventoryManager_SubscribeStock_Call {
+	return &MockRedisTicketInventoryManager_SubscribeStock_Call{Call: _e.mock.On("SubscribeStock", ctx, ticketIDs)}
+}
+
+func (_c *MockRedisTicketInventoryManager_SubscribeStock_Call) Run(run func(ctx context.Context, ticketIDs []int)) *MockRedisTicketInventoryManager_SubscribeStock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []int
+		if args[1] != nil {
+			arg1 = args[1].([]int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRedisTicketInventoryManager_SubscribeStock_Call) Return(stockUpdateCh <-chan cache.StockUpdate, err error) *MockRedisTicketInventoryManager_SubscribeStock_Call {
+	_c.Call.Return(stockUpdateCh, err)
+	return _c
+}
+
+func (_c *MockRedisTicketInventoryManager_SubscribeStock_Call) RunAndReturn(run func(ctx context.Context, ticketIDs []int) (<-chan cache.StockUpdate, error)) *MockRedisTicketInventoryManager_SubscribeStock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateInfo provides a mock function for the type MockRedisTicketInventoryManager
+func (_mock *MockRedisTicketInventoryManager) UpdateInfo(ctx context.Context, ticketID int, price float64, limit int) error {
+	ret := _mock.Called(ctx, ticketID, price, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateInfo")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, float64, int) error); ok {
+		r0 = returnFunc(ctx, ticketID, price, limit)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRedisTicketInventoryManager_UpdateInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateInfo'
+type MockRedisTicketInventoryManager_UpdateInfo_Call struct {
+	*mock.Call
+}
+
+// UpdateInfo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ticketID int
+//   - price float64
+//   - limit int
+func (_e *MockRedisTicketInventoryManager_Expecter) UpdateInfo(ctx interface{}, ticketID interface{}, price interface{}, limit interface{}) *MockRedisTicketInventoryManager_UpdateInfo_Call {
+	return &MockRedisTicketInventoryManager_UpdateInfo_Call{Call: _e.mock.On("UpdateInfo", ctx, ticketID, price, limit)}
+}
+
+func (_c *MockRedisTicketInventoryManager_UpdateInfo_Call) Run(run func(ctx context.Context, ticketID int, price float64, limit int)) *MockRedisTicketInventoryManager_UpdateInfo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 float64
+		if args[2] != nil {
+			arg2 = args[2].(float64)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRedisTicketInventoryManager_UpdateInfo_Call) Return(err error) *MockRedisTicketInventoryManager_UpdateInfo_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRedisTicketInventoryManager_UpdateInfo_Call) RunAndReturn(run func(ctx context.Context, ticketID int, price float64, limit int) error) *MockRedisTicketInventoryManager_UpdateInfo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // WarmUpInventory provides a mock function for the type MockRedisTicketInventoryManager
-func (_mock *MockRedisTicketInventoryManager) WarmUpInventory(ctx context.Context, tickelID int, stock int, price float64, limit int) error {
-	ret := _mock.Called(ctx, tickelID, stock, price, limit)
+func (_mock *MockRedisTicketInventoryManager) WarmUpInventory(ctx context.Context, tickelID int, eventID int, stock int, price float64, limit int) error {
+	ret := _mock.Called(ctx, tickelID, eventID, stock, price, limit)
 
 	if len(ret) == 0 {
 		panic("no return value specified for WarmUpInventory")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int, float64, int) error); ok {
-		r0 = returnFunc(ctx, tickelID, stock, price, limit)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int, int, float64, int) error); ok {
+		r0 = returnFunc(ctx, tickelID, eventID, stock, price, limit)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -348,14 +681,15 @@ type MockRedisTicketInventoryManager_WarmUpInventory_Call struct {
 // WarmUpInventory is a helper method to define mock.On call
 //   - ctx context.Context
 //   - tickelID int
+//   - eventID int
 //   - stock int
 //   - price float64
 //   - limit int
-func (_e *MockRedisTicketInventoryManager_Expecter) WarmUpInventory(ctx interface{}, tickelID interface{}, stock interface{}, price interface{}, limit interface{}) *MockRedisTicketInventoryManager_WarmUpInventory_Call {
-	return &MockRedisTicketInventoryManager_WarmUpInventory_Call{Call: _e.mock.On("WarmUpInventory", ctx, tickelID, stock, price, limit)}
+func (_e *MockRedisTicketInventoryManager_Expecter) WarmUpInventory(ctx interface{}, tickelID interface{}, eventID interface{}, stock interface{}, price interface{}, limit interface{}) *MockRedisTicketInventoryManager_WarmUpInventory_Call {
+	return &MockRedisTicketInventoryManager_WarmUpInventory_Call{Call: _e.mock.On("WarmUpInventory", ctx, tickelID, eventID, stock, price, limit)}
 }
 
-func (_c *MockRedisTicketInventoryManager_WarmUpInventory_Call) Run(run func(ctx context.Context, tickelID int, stock int, price float64, limit int)) *MockRedisTicketInventoryManager_WarmUpInventory_Call {
+func (_c *MockRedisTicketInventoryManager_WarmUpInventory_Call) Run(run func(ctx context.Context, tickelID int, eventID int, stock int, price float64, limit int)) *MockRedisTicketInventoryManager_WarmUpInventory_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -369,13 +703,17 @@ func (_c *MockRedisTicketInventoryManager_WarmUpInventory_Call) Run(run func(ctx
 		if args[2] != nil {
 			arg2 = args[2].(int)
 		}
-		var arg3 float64
+		var arg3 int
 		if args[3] != nil {
-			arg3 = args[3].(float64)
+			arg3 = args[3].(int)
 		}
-		var arg4 int
+		var arg4 float64
 		if args[4] != nil {
-			arg4 = args[4].(int)
+			arg4 = args[4].(float64)
+		}
+		var arg5 int
+		if args[5] != nil {
+			arg5 = args[5].(int)
 		}
 		run(
 			arg0,
@@ -383,6 +721,7 @@ func (_c *MockRedisTicketInventoryManager_WarmUpInventory_Call) Run(run func(ctx
 			arg2,
 			arg3,
 			arg4,
+			arg5,
 		)
 	})
 	return _c
@@ -393,7 +732,7 @@ func (_c *MockRedisTicketInventoryManager_WarmUpInventory_Call) Return(err error
 	return _c
 }
 
-func (_c *MockRedisTicketInventoryManager_WarmUpInventory_Call) RunAndReturn(run func(ctx context.Context, tickelID int, stock int, price float64, limit int) error) *MockRedisTicketInventoryManager_WarmUpInventory_Call {
+func (_c *MockRedisTicketInventoryManager_WarmUpInventory_Call) RunAndReturn(run func(ctx context.Context, tickelID int, eventID int, stock int, price float64, limit int) error) *MockRedisTicketInventoryManager_WarmUpInventory_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,198 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"go-gin-high-concurrency/internal/model"
+
+	"github.com/redis/go-redis/v9"
+)
+
+type RedisPresaleManager interface {
+	// 預熱：標記票種為預售並載入存取碼、使用次數及名單，取代既有設定；票種未預熱時略過 (使用Lua腳本確保原子性)
+	WarmUp(ctx context.Context, ticketID int, accessCodes []*model.PresaleAccessCode, allowlist []int, used map[string]int) error
+	// 新增：販售中新增存取碼，票種未預熱時略過
+	AddAccessCode(ctx context.Context, ticketID int, accessCode *model.PresaleAccessCode) error
+	// 名單：販售中加入 / 移除名單內的使用者，票種未預熱時略過
+	AddAllowlist(ctx context.Context, ticketID int, userIDs []int) error
+	RemoveAllowlist(ctx context.Context, ticketID int, userID int) error
+	// 歸還：訂單取消或下單失敗時歸還一次存取碼使用次數，未載入時略過 (使用Lua腳本確保原子性)
+	ReturnAccessCode(ctx context.Context, ticketID int, code string) error
+}
+
+// presaleLua 預售票種（ticket info 的 presale 欄位為 '1'）僅接受名單內的使用者或仍有使用次數的存取碼；
+// 名單內的使用者不消耗存取碼。回傳 0 為通過、-5 為無權購買、-6 為存取碼已用完（見 script_result.go），
+// 第二個回傳值為通過後需以 consume_access_code 扣除使用次數的存取碼。
+// presale_keys 依序為存取碼、使用次數、名單的 key（presaleKeys），由呼叫端在 KEYS 宣告。
+const presaleLua = `
+	local function check_presale(presale_keys, presale, user_id, code)
+		if presale ~= '1' then
+			return 0, nil
+		end
+		if redis.call('SISMEMBER', presale_keys[3], user_id) == 1 then
+			return 0, nil
+		end
+		if not code or code == '' then
+			return -5, nil
+		end
+		local max_uses = redis.call('HGET', presale_keys[1], code)
+		if not max_uses then
+			return -5, nil
+		end
+		local used = tonumber(redis.call('HGET', presale_keys[2], code) or '0')
+		if tonumber(max_uses) > 0 and used >= tonumber(max_uses) then
+			return -6, nil
+		end
+		return 0, code
+	end
+
+	local function consume_access_code(presale_keys, code)
+		if code then
+			redis.call('HINCRBY', presale_keys[2], code, 1)
+		end
+	end
+`
+
+var (
+	// 存取碼以 code -> 上限 保存，0 為不限
+	warmUpPresaleScript = redis.NewScript(`
+		local ticket_key = KEYS[1]
+		local codes_key = KEYS[2]
+		local used_key = KEYS[3]
+		local allowlist_key = KEYS[4]
+		if redis.call('EXISTS', ticket_key) == 0 then
+			return 0
+		end
+		redis.call('HSET', ticket_key, 'presale', 1)
+		redis.call('DEL', codes_key, used_key, allowlist_key)
+		local code_count = tonumber(ARGV[1])
+		local used_count = tonumber(ARGV[2])
+		local i = 3
+		for _ = 1, code_count do
+			redis.call('HSET', codes_key, ARGV[i], ARGV[i + 1])
+			i = i + 2
+		end
+		for _ = 1, used_count do
+			redis.call('HSET', used_key, ARGV[i], ARGV[i + 1])
+			i = i + 2
+		end
+		for j = i, #ARGV do
+			redis.call('SADD', allowlist_key, ARGV[j])
+		end
+		return 1
+	`)
+
+	addAccessCodeScript = redis.NewScript(`
+		if redis.call('HGET', KEYS[1], 'presale') ~= '1' then
+			return 0
+		end
+		redis.call('HSET', KEYS[2], ARGV[1], ARGV[2])
+		return 1
+	`)
+
+	addAllowlistScript = redis.NewScript(`
+		if redis.call('HGET', KEYS[1], 'presale') ~= '1' then
+			return 0
+		end
+		redis.call('SADD', KEYS[2], unpack(ARGV))
+		return 1
+	`)
+
+	returnAccessCodeScript = redis.NewScript(`
+		local used = tonumber(redis.call('HGET', KEYS[1], ARGV[1]) or '0')
+		if used <= 0 then
+			return 0
+		end
+		if used == 1 then
+			redis.call('HDEL', KEYS[1], ARGV[1])
+		else
+			redis.call('HINCRBY', KEYS[1], ARGV[1], -1)
+		end
+		return 1
+	`)
+)
+
+type RedisPresaleManagerImpl struct {
+	client *redis.Client
+}
+
+func NewRedisPresaleManager(client *redis.Client) RedisPresaleManager {
+	return &RedisPresaleManagerImpl{
+		client: client,
+	}
+}
+
+// 庫存 key（與 RedisTicketInventoryManager 共用），presale 欄位標記預售票種
+func (m *RedisPresaleManagerImpl) getInfoKey(ticketID int) string {
+	return fmt.Sprintf("ticket:%d:info", ticketID)
+}
+
+// 存取碼及使用上限的 hash
+func presaleCodesKey(ticketID int) string {
+	return fmt.Sprintf("ticket:%d:presale:codes", ticketID)
+}
+
+// 存取碼已使用次數的 hash
+func presaleUsedKey(ticketID int) string {
+	return fmt.Sprintf("ticket:%d:presale:used", ticketID)
+}
+
+// 名單內使用者的 set
+func presaleAllowlistKey(ticketID int) string {
+	return fmt.Sprintf("ticket:%d:presale:allowlist", ticketID)
+}
+
+// presaleKeys 檢查預售資格的腳本需宣告的 key，順序與 presaleLua 的 presale_keys 一致
+func presaleKeys(ticketID int) []string {
+	return []string{presaleCodesKey(ticketID), presaleUsedKey(ticketID), presaleAllowlistKey(ticketID)}
+}
+
+func (m *RedisPresaleManagerImpl) WarmUp(ctx context.Context, ticketID int, accessCodes []*model.PresaleAccessCode, allowlist []int, used map[string]int) error {
+	args := []interface{}{len(accessCodes), len(used)}
+	for _, accessCode := range accessCodes {
+		args = append(args, accessCode.Code, accessCodeLimit(accessCode))
+	}
+	for code, count := range used {
+		args = append(args, code, count)
+	}
+	for _, userID := range allowlist {
+		args = append(args, userID)
+	}
+
+	keys := []string{m.getInfoKey(ticketID), presaleCodesKey(ticketID), presaleUsedKey(ticketID), presaleAllowlistKey(ticketID)}
+	return warmUpPresaleScript.Run(ctx, m.client, keys, args...).Err()
+}
+
+func (m *RedisPresaleManagerImpl) AddAccessCode(ctx context.Context, ticketID int, accessCode *model.PresaleAccessCode) error {
+	keys := []string{m.getInfoKey(ticketID), presaleCodesKey(ticketID)}
+	return addAccessCodeScript.Run(ctx, m.client, keys, accessCode.Code, accessCodeLimit(accessCode)).Err()
+}
+
+func (m *RedisPresaleManagerImpl) AddAllowlist(ctx context.Context, ticketID int, userIDs []int) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	args := make([]interface{}, 0, len(userIDs))
+	for _, userID := range userIDs {
+		args = append(args, userID)
+	}
+	keys := []string{m.getInfoKey(ticketID), presaleAllowlistKey(ticketID)}
+	return addAllowlistScript.Run(ctx, m.client, keys, args...).Err()
+}
+
+// RemoveAllowlist 移除不存在的成員本來就是 no-op，不需檢查票種是否已預熱
+func (m *RedisPresaleManagerImpl) RemoveAllowlist(ctx context.Context, ticketID int, userID int) error {
+	return m.client.SRem(ctx, presaleAllowlistKey(ticketID), userID).Err()
+}
+
+func (m *RedisPresaleManagerImpl) ReturnAccessCode(ctx context.Context, ticketID int, code string) error {
+	return returnAccessCodeScript.Run(ctx, m.client, []string{presaleUsedKey(ticketID)}, code).Err()
+}
+
+// accessCodeLimit 使用上限在 Redis 中以 0 表示不限
+func accessCodeLimit(accessCode *model.PresaleAccessCode) int {
+	if accessCode.MaxUses == nil {
+		return 0
+	}
+	return *accessCode.MaxUses
+}
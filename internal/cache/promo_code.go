@@ -0,0 +1,211 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"go-gin-high-concurrency/internal/model"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+type RedisPromoCodeManager interface {
+	// 預熱：載入優惠碼及各使用者尚未歸還的使用次數，已載入時略過，避免覆蓋併發中的使用次數；同時清除不存在的紀錄 (使用Lua腳本確保原子性)
+	WarmUp(ctx context.Context, promo *model.PromoCode, userRedemptions map[int]int) error
+	// 使用：檢查有效期間、適用範圍、全域及每人使用次數後扣除一次，回傳折扣設定；未載入時回傳 ErrPromoCodeNotFound (使用Lua腳本確保原子性)
+	Redeem(ctx context.Context, code string, ticketID int, userID int) (*model.PromoCode, error)
+	// 歸還：訂單取消或下單失敗時歸還一次使用次數，未載入時略過 (使用Lua腳本確保原子性)
+	Return(ctx context.Context, code string, userID int) error
+	// 記錄不存在：資料庫查無此優惠碼時記錄 ttl，期間內下單不再回資料庫查詢
+	MarkMissing(ctx context.Context, code string, ttl time.Duration) error
+	// 查詢是否已記錄為不存在
+	IsMissing(ctx context.Context, code string) (bool, error)
+}
+
+var (
+	warmUpPromoCodeScript = redis.NewScript(`
+		local info_key = KEYS[1]
+		local users_key = KEYS[2]
+		redis.call('DEL', KEYS[3])
+		if redis.call('EXISTS', info_key) == 1 then
+			return 0
+		end
+		local field_count = tonumber(ARGV[1])
+		for i = 2, field_count + 1, 2 do
+			redis.call('HSET', info_key, ARGV[i], ARGV[i + 1])
+		end
+		redis.call('DEL', users_key)
+		for i = field_count + 2, #ARGV, 2 do
+			redis.call('HSET', users_key, ARGV[i], ARGV[i + 1])
+		end
+		return 1
+	`)
+
+	redeemPromoCodeScript = redis.NewScript(`
+		local info_key = KEYS[1]
+		local users_key = KEYS[2]
+		local ticket_key = KEYS[3]
+		local user_id = ARGV[1]
+		local now = tonumber(ARGV[3])
+		local info = redis.call('HMGET', info_key, 'id', 'type', 'value', 'event_id', 'ticket_id',
+			'max_redemptions', 'max_per_user', 'starts_at', 'ends_at', 'redeemed')
+		if not info[1] then
+			return {-12}
+		end
+		if (info[8] and now < tonumber(info[8])) or (info[9] and now >= tonumber(info[9])) then
+			return {-13}
+		end
+		if info[5] and info[5] ~= ARGV[2] then
+			return {-14}
+		end
+		if info[4] and redis.call('HGET', ticket_key, 'event_id') ~= info[4] then
+			return {-14}
+		end
+		if info[6] and tonumber(info[10]) >= tonumber(info[6]) then
+			return {-15}
+		end
+		local used = tonumber(redis.call('HGET', users_key, user_id) or '0')
+		if info[7] and used >= tonumber(info[7]) then
+			return {-15}
+		end
+		redis.call('HINCRBY', info_key, 'redeemed', 1)
+		redis.call('HINCRBY', users_key, user_id, 1)
+		return {1, info[1], info[2], info[3]}
+	`)
+
+	returnPromoCodeScript = redis.NewScript(`
+		local info_key = KEYS[1]
+		local users_key = KEYS[2]
+		local user_id = ARGV[1]
+		if redis.call('EXISTS', info_key) == 0 then
+			return 0
+		end
+		if tonumber(redis.call('HGET', info_key, 'redeemed')) > 0 then
+			redis.call('HINCRBY', info_key, 'redeemed', -1)
+		end
+		if redis.call('HINCRBY', users_key, user_id, -1) <= 0 then
+			redis.call('HDEL', users_key, user_id)
+		end
+		return 1
+	`)
+)
+
+type RedisPromoCodeManagerImpl struct {
+	client *redis.Client
+}
+
+func NewRedisPromoCodeManager(client *redis.Client) RedisPromoCodeManager {
+	return &RedisPromoCodeManagerImpl{
+		client: client,
+	}
+}
+
+// 優惠碼設定及全域使用次數的 hash
+func (m *RedisPromoCodeManagerImpl) getInfoKey(code string) string {
+	return fmt.Sprintf("promo:%s:info", code)
+}
+
+// 各使用者使用次數的 hash
+func (m *RedisPromoCodeManagerImpl) getUsersKey(code string) string {
+	return fmt.Sprintf("promo:%s:users", code)
+}
+
+// 資料庫查無此優惠碼的紀錄，TTL 到期自動移除
+func (m *RedisPromoCodeManagerImpl) getMissingKey(code string) string {
+	return fmt.Sprintf("promo:%s:missing", code)
+}
+
+// 票種庫存 key（與 RedisTicketInventoryManager 共用），用於比對票種所屬活動
+func (m *RedisPromoCodeManagerImpl) getTicketInfoKey(ticketID int) string {
+	return fmt.Sprintf("ticket:%d:info", ticketID)
+}
+
+func (m *RedisPromoCodeManagerImpl) WarmUp(ctx context.Context, promo *model.PromoCode, userRedemptions map[int]int) error {
+	redeemed := 0
+	for _, count := range userRedemptions {
+		redeemed += count
+	}
+
+	// 未設定的限制不寫入 hash，腳本以欄位不存在表示不限
+	fields := []interface{}{
+		"id", promo.ID,
+		"type", string(promo.DiscountType),
+		"value", promo.DiscountValue,
+		"redeemed", redeemed,
+	}
+	if promo.EventID != nil {
+		fields = append(fields, "event_id", *promo.EventID)
+	}
+	if promo.TicketID != nil {
+		fields = append(fields, "ticket_id", *promo.TicketID)
+	}
+	if promo.MaxRedemptions != nil {
+		fields = append(fields, "max_redemptions", *promo.MaxRedemptions)
+	}
+	if promo.MaxPerUser != nil {
+		fields = append(fields, "max_per_user", *promo.MaxPerUser)
+	}
+	if promo.StartsAt != nil {
+		fields = append(fields, "starts_at", promo.StartsAt.UnixMilli())
+	}
+	if promo.EndsAt != nil {
+		fields = append(fields, "ends_at", promo.EndsAt.UnixMilli())
+	}
+
+	args := append([]interface{}{len(fields)}, fields...)
+	for userID, count := range userRedemptions {
+		args = append(args, userID, count)
+	}
+
+	keys := []string{m.getInfoKey(promo.Code), m.getUsersKey(promo.Code), m.getMissingKey(promo.Code)}
+	return warmUpPromoCodeScript.Run(ctx, m.client, keys, args...).Err()
+}
+
+func (m *RedisPromoCodeManagerImpl) Redeem(ctx context.Context, code string, ticketID int, userID int) (*model.PromoCode, error) {
+	keys := []string{m.getInfoKey(code), m.getUsersKey(code), m.getTicketInfoKey(ticketID)}
+	result, err := redeemPromoCodeScript.Run(ctx, m.client, keys,
+		userID, ticketID, time.Now().UTC().UnixMilli(),
+	).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	resSlice := result.([]interface{})
+	switch status := resSlice[0].(int64); status {
+	case 1:
+		id, err := strconv.Atoi(resSlice[1].(string))
+		if err != nil {
+			return nil, fmt.Errorf("invalid promo code id: %v", err)
+		}
+		value, err := strconv.ParseFloat(resSlice[3].(string), 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid discount value: %v", err)
+		}
+		return &model.PromoCode{
+			ID:            id,
+			Code:          code,
+			DiscountType:  model.DiscountType(resSlice[2].(string)),
+			DiscountValue: value,
+		}, nil
+	default:
+		return nil, scriptError(status)
+	}
+}
+
+func (m *RedisPromoCodeManagerImpl) Return(ctx context.Context, code string, userID int) error {
+	keys := []string{m.getInfoKey(code), m.getUsersKey(code)}
+	return returnPromoCodeScript.Run(ctx, m.client, keys, userID).Err()
+}
+
+func (m *RedisPromoCodeManagerImpl) MarkMissing(ctx context.Context, code string, ttl time.Duration) error {
+	return m.client.Set(ctx, m.getMissingKey(code), 1, ttl).Err()
+}
+
+func (m *RedisPromoCodeManagerImpl) IsMissing(ctx context.Context, code string) (bool, error) {
+	exists, err := m.client.Exists(ctx, m.getMissingKey(code)).Result()
+	if err != nil {
+		return false, err
+	}
+	return exists == 1, nil
+}
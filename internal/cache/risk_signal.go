@@ -0,0 +1,125 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"go-gin-high-concurrency/internal/model"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+type RedisRiskSignalManager interface {
+	// 記錄：累計本次下單請求的使用者 / IP / 裝置次數及付款識別的使用者，回傳視窗內的計數 (使用Lua腳本確保原子性)
+	RecordAttempt(ctx context.Context, signals model.RiskSignals, window time.Duration, paymentWindow time.Duration) (*model.RiskCounters, error)
+	// 庫存不足：累計使用者因庫存不足下單失敗的次數
+	RecordStockMiss(ctx context.Context, userID int, window time.Duration) error
+}
+
+var (
+	// 計數 key 為空字串時代表請求未提供該特徵，略過並回傳 0；
+	// 計數在第一次累計時設定視窗到期時間（固定視窗），付款識別的使用者 set 每次寫入都延長到期時間
+	recordAttemptScript = redis.NewScript(`
+		local window = tonumber(ARGV[1])
+		local payment_window = tonumber(ARGV[2])
+		local user_id = ARGV[3]
+		local function incr(key)
+			if key == '' then
+				return 0
+			end
+			local count = redis.call('INCR', key)
+			if count == 1 then
+				redis.call('PEXPIRE', key, window)
+			end
+			return count
+		end
+		local user_attempts = incr(KEYS[1])
+		local ip_attempts = incr(KEYS[2])
+		local device_attempts = incr(KEYS[3])
+		local stock_misses = tonumber(redis.call('GET', KEYS[4]) or '0')
+		local payment_users = 0
+		if KEYS[5] ~= '' then
+			redis.call('SADD', KEYS[5], user_id)
+			redis.call('PEXPIRE', KEYS[5], payment_window)
+			payment_users = redis.call('SCARD', KEYS[5])
+		end
+		return {user_attempts, ip_attempts, device_attempts, stock_misses, payment_users}
+	`)
+
+	recordStockMissScript = redis.NewScript(`
+		local count = redis.call('INCR', KEYS[1])
+		if count == 1 then
+			redis.call('PEXPIRE', KEYS[1], ARGV[1])
+		end
+		return count
+	`)
+)
+
+type RedisRiskSignalManagerImpl struct {
+	client *redis.Client
+}
+
+func NewRedisRiskSignalManager(client *redis.Client) RedisRiskSignalManager {
+	return &RedisRiskSignalManagerImpl{
+		client: client,
+	}
+}
+
+// 使用者下單次數
+func (m *RedisRiskSignalManagerImpl) getUserKey(userID int) string {
+	return fmt.Sprintf("risk:user:%d:attempts", userID)
+}
+
+// 使用者因庫存不足下單失敗的次數
+func (m *RedisRiskSignalManagerImpl) getStockMissKey(userID int) string {
+	return fmt.Sprintf("risk:user:%d:stock_misses", userID)
+}
+
+// IP 下單次數，未提供時為空字串
+func (m *RedisRiskSignalManagerImpl) getIPKey(ip string) string {
+	if ip == "" {
+		return ""
+	}
+	return fmt.Sprintf("risk:ip:%s:attempts", ip)
+}
+
+// 裝置指紋下單次數，未提供時為空字串
+func (m *RedisRiskSignalManagerImpl) getDeviceKey(fingerprint string) string {
+	if fingerprint == "" {
+		return ""
+	}
+	return fmt.Sprintf("risk:device:%s:attempts", fingerprint)
+}
+
+// 使用同一付款識別的使用者 set，未提供時為空字串
+func (m *RedisRiskSignalManagerImpl) getPaymentKey(fingerprint string) string {
+	if fingerprint == "" {
+		return ""
+	}
+	return fmt.Sprintf("risk:payment:%s:users", fingerprint)
+}
+
+func (m *RedisRiskSignalManagerImpl) RecordAttempt(ctx context.Context, signals model.RiskSignals, window time.Duration, paymentWindow time.Duration) (*model.RiskCounters, error) {
+	keys := []string{
+		m.getUserKey(signals.UserID),
+		m.getIPKey(signals.ClientIP),
+		m.getDeviceKey(signals.DeviceFingerprint),
+		m.getStockMissKey(signals.UserID),
+		m.getPaymentKey(signals.PaymentFingerprint),
+	}
+	counts, err := recordAttemptScript.Run(ctx, m.client, keys, window.Milliseconds(), paymentWindow.Milliseconds(), signals.UserID).Int64Slice()
+	if err != nil {
+		return nil, err
+	}
+	return &model.RiskCounters{
+		UserAttempts:   int(counts[0]),
+		IPAttempts:     int(counts[1]),
+		DeviceAttempts: int(counts[2]),
+		StockMisses:    int(counts[3]),
+		PaymentUsers:   int(counts[4]),
+	}, nil
+}
+
+func (m *RedisRiskSignalManagerImpl) RecordStockMiss(ctx context.Context, userID int, window time.Duration) error {
+	return recordStockMissScript.Run(ctx, m.client, []string{m.getStockMissKey(userID)}, window.Milliseconds()).Err()
+}
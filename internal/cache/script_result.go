@@ -0,0 +1,56 @@
+package cache
+
+import (
+	"errors"
+	"go-gin-high-concurrency/pkg/app_errors"
+)
+
+// Lua 腳本共用的回傳碼：1 為成功，負數為失敗原因。所有腳本使用同一套編號，
+// 同一個錯誤在各腳本的回傳碼相同，不同錯誤不會共用回傳碼，呼叫端一律以 scriptError 轉換。
+const (
+	scriptInsufficientStock   = -1
+	scriptExceedsMaxPerUser   = -2
+	scriptTicketNotFound      = -3
+	scriptSeatSelectionNeeded = -4
+	scriptPresaleAccessDenied = -5
+	scriptAccessCodeExhausted = -6
+	scriptExceedsEventLimit   = -7
+	scriptSeatUnavailable     = -8
+	scriptSeatHoldExpired     = -9
+	scriptAlreadyWaitlisted   = -10
+	scriptTicketNotSoldOut    = -11
+	scriptPromoCodeNotFound   = -12
+	scriptPromoCodeInactive   = -13
+	scriptPromoCodeNotApplies = -14
+	scriptPromoCodeExhausted  = -15
+	scriptHoldExpired         = -16
+	scriptHoldMismatch        = -17
+)
+
+var scriptErrors = map[int64]error{
+	scriptInsufficientStock:   app_errors.ErrInsufficientStock,
+	scriptExceedsMaxPerUser:   app_errors.ErrExceedsMaxPerUser,
+	scriptTicketNotFound:      app_errors.ErrTicketNotFound,
+	scriptSeatSelectionNeeded: app_errors.ErrSeatSelectionRequired,
+	scriptPresaleAccessDenied: app_errors.ErrPresaleAccessDenied,
+	scriptAccessCodeExhausted: app_errors.ErrAccessCodeExhausted,
+	scriptExceedsEventLimit:   app_errors.ErrExceedsEventLimit,
+	scriptSeatUnavailable:     app_errors.ErrSeatUnavailable,
+	scriptSeatHoldExpired:     app_errors.ErrSeatHoldExpired,
+	scriptAlreadyWaitlisted:   app_errors.ErrAlreadyWaitlisted,
+	scriptTicketNotSoldOut:    app_errors.ErrTicketNotSoldOut,
+	scriptPromoCodeNotFound:   app_errors.ErrPromoCodeNotFound,
+	scriptPromoCodeInactive:   app_errors.ErrPromoCodeInactive,
+	scriptPromoCodeNotApplies: app_errors.ErrPromoCodeNotApplicable,
+	scriptPromoCodeExhausted:  app_errors.ErrPromoCodeExhausted,
+	scriptHoldExpired:         app_errors.ErrHoldExpired,
+	scriptHoldMismatch:        app_errors.ErrInvalidInput,
+}
+
+// scriptError 將腳本的失敗回傳碼轉為對應的錯誤
+func scriptError(code int64) error {
+	if err, ok := scriptErrors[code]; ok {
+		return err
+	}
+	return errors.New("unexpected result")
+}
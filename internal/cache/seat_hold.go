@@ -0,0 +1,349 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"go-gin-high-concurrency/internal/model"
+	"go-gin-high-concurrency/pkg/app_errors"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+type RedisSeatHoldManager interface {
+	// 預熱：將票種標記為對號座，並載入已售出的座位
+	WarmUpSeats(ctx context.Context, ticketID int, soldSeatIDs []int) error
+	// 保留：一次保留多個座位並設定 TTL，任一座位不可用則全部失敗；已購買及保留中的座位合計不可超過限購，預售票種的檢查同 DecreStock 但不扣除存取碼 (使用Lua腳本確保原子性)
+	HoldSeats(ctx context.Context, ticketID int, userID int, seatIDs []int, ttl time.Duration, accessCode string) error
+	// 釋放：釋放使用者自己保留的座位
+	ReleaseHolds(ctx context.Context, ticketID int, userID int, seatIDs []int) error
+	// 售出：將使用者保留的座位轉為已售出並扣減庫存，回傳成交單價與價格階段；預售票種的檢查同 DecreStock (使用Lua腳本確保原子性)
+	CommitSeats(ctx context.Context, ticketID int, userID int, seatIDs []int, accessCode string) (PriceQuote, error)
+	// 回滾：將已售出的座位釋出並回補庫存及使用者購買紀錄 (使用Lua腳本確保原子性)
+	RollbackSeats(ctx context.Context, ticketID int, userID int, seatIDs []int) error
+	// 查詢：回傳座位目前的狀態（只包含已保留或已售出的座位）
+	GetSeatStatuses(ctx context.Context, ticketID int, seatIDs []int) (map[int]model.SeatStatus, error)
+}
+
+var (
+	// 使用者保留中的座位記錄在 user held（sorted set，score 為到期的 unix 毫秒），讀取前先移除已到期的座位
+	holdSeatsScript = redis.NewScript(presaleLua + eventLimitLua + `
+		local ticket_key = KEYS[1]
+		local users_key = KEYS[2]
+		local sold_key = KEYS[3]
+		local held_key = KEYS[4]
+		local presale_keys = {KEYS[5], KEYS[6], KEYS[7]}
+		local user_id = ARGV[1]
+		local ttl = tonumber(ARGV[2])
+		local now = tonumber(ARGV[4])
+		local count = #KEYS - 9
+		local info = redis.call('HMGET', ticket_key, 'seated', 'limit', 'event_id', 'presale')
+		if info[1] ~= '1' or not info[2] then
+			return {-3, 0}
+		end
+		local event_keys = resolve_event_keys(info[3], ARGV[3], KEYS[8], KEYS[9])
+		if event_keys == false then
+			return {-3, 0}
+		end
+		local presale_code = check_presale(presale_keys, info[4], user_id, ARGV[5])
+		if presale_code ~= 0 then
+			return {presale_code, 0}
+		end
+		-- 重新保留（延長 TTL）自己已保留的座位不重複計算
+		redis.call('ZREMRANGEBYSCORE', held_key, '-inf', now)
+		local held = redis.call('ZCARD', held_key)
+		for i = 1, count do
+			if not redis.call('ZSCORE', held_key, ARGV[i + 5]) then
+				held = held + 1
+			end
+		end
+		local user_bought = redis.call('HGET', users_key, user_id) or '0'
+		if tonumber(user_bought) + held > tonumber(info[2]) then
+			return {-2, 0}
+		end
+		if exceeds_event_limit(event_keys, user_id, held) then
+			return {-7, 0}
+		end
+		for i = 1, count do
+			local seat_id = ARGV[i + 5]
+			if redis.call('SISMEMBER', sold_key, seat_id) == 1 then
+				return {-8, tonumber(seat_id)}
+			end
+			local holder = redis.call('GET', KEYS[i + 9])
+			if holder and holder ~= user_id then
+				return {-8, tonumber(seat_id)}
+			end
+		end
+		for i = 1, count do
+			redis.call('SET', KEYS[i + 9], user_id, 'PX', ttl)
+			redis.call('ZADD', held_key, now + ttl, ARGV[i + 5])
+		end
+		redis.call('PEXPIRE', held_key, ttl)
+		return {1, 0}
+	`)
+	releaseHoldsScript = redis.NewScript(`
+		local held_key = KEYS[1]
+		local user_id = ARGV[1]
+		for i = 2, #KEYS do
+			if redis.call('GET', KEYS[i]) == user_id then
+				redis.call('DEL', KEYS[i])
+			end
+			redis.call('ZREM', held_key, ARGV[i])
+		end
+		return "OK"
+	`)
+	commitSeatsScript = redis.NewScript(quotePriceLua + presaleLua + eventLimitLua + `
+		local ticket_key = KEYS[1]
+		local users_key = KEYS[2]
+		local sold_key = KEYS[3]
+		local user_id = ARGV[1]
+		local presale_keys = {KEYS[5], KEYS[6], KEYS[7]}
+		local held_key = KEYS[10]
+		local count = #KEYS - 10
+		local info = redis.call('HMGET', ticket_key, 'stock', 'price', 'limit', 'seated', 'total', 'presale', 'event_id')
+		local stock = info[1]
+		local price = info[2]
+		local limit = info[3]
+		if not stock or not price or not limit or info[4] ~= '1' then
+			return {-3, '0.0'}
+		end
+		local event_keys = resolve_event_keys(info[7], ARGV[5], KEYS[8], KEYS[9])
+		if event_keys == false then
+			return {-3, '0.0'}
+		end
+		local presale_code, access_code = check_presale(presale_keys, info[6], user_id, ARGV[4])
+		if presale_code ~= 0 then
+			return {presale_code, '0.0'}
+		end
+		for i = 1, count do
+			if redis.call('GET', KEYS[i + 10]) ~= user_id then
+				return {-9, '0.0'}
+			end
+		end
+		if tonumber(stock) < count then
+			return {-1, '0.0'}
+		end
+		local user_bought = redis.call('HGET', users_key, user_id) or '0'
+		if tonumber(user_bought) + count > tonumber(limit) then
+			return {-2, '0.0'}
+		end
+		if exceeds_event_limit(event_keys, user_id, count) then
+			return {-7, '0.0'}
+		end
+		local unit_price, phase = quote_price(KEYS[4], price, info[5], stock, count, tonumber(ARGV[3]))
+		for i = 1, count do
+			redis.call('SADD', sold_key, ARGV[i + 5])
+			redis.call('DEL', KEYS[i + 10])
+			redis.call('ZREM', held_key, ARGV[i + 5])
+		end
+		local new_stock = redis.call('HINCRBY', ticket_key, 'stock', -count)
+		redis.call('HINCRBY', users_key, user_id, count)
+		add_event_bought(event_keys, user_id, count)
+		consume_access_code(presale_keys, access_code)
+		redis.call('PUBLISH', ARGV[2], new_stock)
+		return {1, unit_price, phase, access_code or ''}
+	`)
+	rollbackSeatsScript = redis.NewScript(eventLimitLua + `
+		local ticket_key = KEYS[1]
+		local users_key = KEYS[2]
+		local sold_key = KEYS[3]
+		local user_id = ARGV[1]
+		local released = 0
+		for i = 4, #ARGV do
+			released = released + redis.call('SREM', sold_key, ARGV[i])
+		end
+		if released == 0 then
+			return "OK"
+		end
+		local new_stock = redis.call('HINCRBY', ticket_key, 'stock', released)
+		redis.call('HINCRBY', users_key, user_id, -released)
+		add_event_bought(resolve_event_keys(redis.call('HGET', ticket_key, 'event_id'), ARGV[3], KEYS[4], KEYS[5]), user_id, -released)
+		redis.call('PUBLISH', ARGV[2], new_stock)
+		return "OK"
+	`)
+)
+
+type RedisSeatHoldManagerImpl struct {
+	client *redis.Client
+}
+
+func NewRedisSeatHoldManager(client *redis.Client) RedisSeatHoldManager {
+	return &RedisSeatHoldManagerImpl{
+		client: client,
+	}
+}
+
+// 庫存 key（與 RedisTicketInventoryManager 共用）
+func (m *RedisSeatHoldManagerImpl) getInfoKey(ticketID int) string {
+	return fmt.Sprintf("ticket:%d:info", ticketID)
+}
+
+// 用戶購買紀錄的 key（與 RedisTicketInventoryManager 共用）
+func (m *RedisSeatHoldManagerImpl) getUsersKey(ticketID int) string {
+	return fmt.Sprintf("ticket:%d:users", ticketID)
+}
+
+// 庫存變動的 pub/sub channel（與 RedisTicketInventoryManager 共用）
+func (m *RedisSeatHoldManagerImpl) getStockChannel(ticketID int) string {
+	return fmt.Sprintf("ticket:%d:stock", ticketID)
+}
+
+// 價格階段的 key（與 RedisTicketInventoryManager 共用）
+func (m *RedisSeatHoldManagerImpl) getPhasesKey(ticketID int) string {
+	return fmt.Sprintf("ticket:%d:phases", ticketID)
+}
+
+// 已售出座位的 set
+func (m *RedisSeatHoldManagerImpl) getSoldKey(ticketID int) string {
+	return fmt.Sprintf("ticket:%d:seats:sold", ticketID)
+}
+
+// 使用者保留中的座位（sorted set，score 為到期的 unix 毫秒），計入限購
+func (m *RedisSeatHoldManagerImpl) getUserHeldKey(ticketID int, userID int) string {
+	return fmt.Sprintf("ticket:%d:user:%d:seats:held", ticketID, userID)
+}
+
+// 單一座位保留的 key，value 為保留者的 user id，TTL 到期自動釋放
+func (m *RedisSeatHoldManagerImpl) getHoldKey(ticketID int, seatID int) string {
+	return fmt.Sprintf("ticket:%d:seat:%d:hold", ticketID, seatID)
+}
+
+func (m *RedisSeatHoldManagerImpl) getHoldKeys(ticketID int, seatIDs []int) []string {
+	keys := make([]string, 0, len(seatIDs))
+	for _, seatID := range seatIDs {
+		keys = append(keys, m.getHoldKey(ticketID, seatID))
+	}
+	return keys
+}
+
+func seatIDArgs(seatIDs []int) []interface{} {
+	args := make([]interface{}, 0, len(seatIDs))
+	for _, seatID := range seatIDs {
+		args = append(args, seatID)
+	}
+	return args
+}
+
+func (m *RedisSeatHoldManagerImpl) WarmUpSeats(ctx context.Context, ticketID int, soldSeatIDs []int) error {
+	_, err := m.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, m.getInfoKey(ticketID), "seated", 1)
+		pipe.Del(ctx, m.getSoldKey(ticketID))
+		if len(soldSeatIDs) > 0 {
+			pipe.SAdd(ctx, m.getSoldKey(ticketID), seatIDArgs(soldSeatIDs)...)
+		}
+		return nil
+	})
+	return err
+}
+
+func (m *RedisSeatHoldManagerImpl) HoldSeats(ctx context.Context, ticketID int, userID int, seatIDs []int, ttl time.Duration, accessCode string) error {
+	if len(seatIDs) == 0 || ttl <= 0 {
+		return app_errors.ErrInvalidInput
+	}
+
+	eventID, eventKeys, err := ticketEventKeys(ctx, m.client, m.getInfoKey(ticketID))
+	if err != nil {
+		return err
+	}
+
+	keys := append([]string{m.getInfoKey(ticketID), m.getUsersKey(ticketID), m.getSoldKey(ticketID), m.getUserHeldKey(ticketID, userID)}, presaleKeys(ticketID)...)
+	keys = append(keys, eventKeys...)
+	keys = append(keys, m.getHoldKeys(ticketID, seatIDs)...)
+	args := append([]interface{}{userID, ttl.Milliseconds(), eventID, time.Now().UTC().UnixMilli(), accessCode}, seatIDArgs(seatIDs)...)
+	result, err := holdSeatsScript.Run(ctx, m.client, keys, args...).Result()
+	if err != nil {
+		return err
+	}
+
+	resSlice := result.([]interface{})
+	switch code := resSlice[0].(int64); code {
+	case 1:
+		return nil
+	case scriptSeatUnavailable:
+		return fmt.Errorf("%w: seat %d", app_errors.ErrSeatUnavailable, resSlice[1].(int64))
+	default:
+		return scriptError(code)
+	}
+}
+
+func (m *RedisSeatHoldManagerImpl) ReleaseHolds(ctx context.Context, ticketID int, userID int, seatIDs []int) error {
+	if len(seatIDs) == 0 {
+		return nil
+	}
+	keys := append([]string{m.getUserHeldKey(ticketID, userID)}, m.getHoldKeys(ticketID, seatIDs)...)
+	args := append([]interface{}{userID}, seatIDArgs(seatIDs)...)
+	return releaseHoldsScript.Run(ctx, m.client, keys, args...).Err()
+}
+
+func (m *RedisSeatHoldManagerImpl) CommitSeats(ctx context.Context, ticketID int, userID int, seatIDs []int, accessCode string) (PriceQuote, error) {
+	if len(seatIDs) == 0 {
+		return PriceQuote{}, app_errors.ErrInvalidInput
+	}
+
+	eventID, eventKeys, err := ticketEventKeys(ctx, m.client, m.getInfoKey(ticketID))
+	if err != nil {
+		return PriceQuote{}, err
+	}
+
+	keys := append([]string{m.getInfoKey(ticketID), m.getUsersKey(ticketID), m.getSoldKey(ticketID), m.getPhasesKey(ticketID)}, presaleKeys(ticketID)...)
+	keys = append(keys, eventKeys...)
+	keys = append(keys, m.getUserHeldKey(ticketID, userID))
+	keys = append(keys, m.getHoldKeys(ticketID, seatIDs)...)
+	args := append([]interface{}{userID, m.getStockChannel(ticketID), time.Now().UTC().UnixMilli(), accessCode, eventID}, seatIDArgs(seatIDs)...)
+	result, err := commitSeatsScript.Run(ctx, m.client, keys, args...).Result()
+	if err != nil {
+		return PriceQuote{}, err
+	}
+
+	resSlice := result.([]interface{})
+	switch code := resSlice[0].(int64); code {
+	case 1:
+		return parsePriceQuote(resSlice[1:]), nil
+	default:
+		return PriceQuote{}, scriptError(code)
+	}
+}
+
+func (m *RedisSeatHoldManagerImpl) RollbackSeats(ctx context.Context, ticketID int, userID int, seatIDs []int) error {
+	if len(seatIDs) == 0 {
+		return nil
+	}
+
+	eventID, eventKeys, err := ticketEventKeys(ctx, m.client, m.getInfoKey(ticketID))
+	if err != nil {
+		return err
+	}
+
+	keys := append([]string{m.getInfoKey(ticketID), m.getUsersKey(ticketID), m.getSoldKey(ticketID)}, eventKeys...)
+	args := append([]interface{}{userID, m.getStockChannel(ticketID), eventID}, seatIDArgs(seatIDs)...)
+	return rollbackSeatsScript.Run(ctx, m.client, keys, args...).Err()
+}
+
+func (m *RedisSeatHoldManagerImpl) GetSeatStatuses(ctx context.Context, ticketID int, seatIDs []int) (map[int]model.SeatStatus, error) {
+	statuses := make(map[int]model.SeatStatus)
+	if len(seatIDs) == 0 {
+		return statuses, nil
+	}
+
+	pipe := m.client.Pipeline()
+	soldCmd := pipe.SMembers(ctx, m.getSoldKey(ticketID))
+	holdsCmd := pipe.MGet(ctx, m.getHoldKeys(ticketID, seatIDs)...)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	for i, holder := range holdsCmd.Val() {
+		if holder != nil {
+			statuses[seatIDs[i]] = model.SeatStatusHeld
+		}
+	}
+	for _, member := range soldCmd.Val() {
+		seatID, err := strconv.Atoi(member)
+		if err != nil {
+			continue
+		}
+		statuses[seatID] = model.SeatStatusSold
+	}
+	return statuses, nil
+}
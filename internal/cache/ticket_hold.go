@@ -0,0 +1,309 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"go-gin-high-concurrency/internal/model"
+	"go-gin-high-concurrency/pkg/app_errors"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// holdExpiryKey 所有保留的到期時間（sorted set，score 為到期的 unix 毫秒，member 為 hold id）
+const holdExpiryKey = "holds:expiry"
+
+type RedisTicketHoldManager interface {
+	// 保留：扣減庫存並建立有到期時間的保留，預售票種僅開放名單內的使用者 (使用Lua腳本確保原子性)
+	CreateHold(ctx context.Context, ticketID int, userID int, quantity int, ttl time.Duration) (*model.TicketHold, error)
+	// 轉換：將未到期的保留轉為訂單，庫存已於保留時扣除，這裡只移除保留
+	ConvertHold(ctx context.Context, holdID uuid.UUID, userID int, ticketID int, quantity int) (*model.TicketHold, error)
+	// 釋放：使用者提前釋放保留，歸還庫存及使用者購買紀錄
+	ReleaseHold(ctx context.Context, holdID uuid.UUID, userID int) error
+	// 回收：歸還 now 之前到期的保留，回傳本次回收的數量，最多處理 limit 筆
+	ReleaseExpired(ctx context.Context, now time.Time, limit int) (int, error)
+}
+
+// restoreHoldLua 歸還保留的庫存及使用者購買紀錄（含活動累計），並刪除保留（含候補遞補的使用者指標）；庫存 key 已不存在（票種下架或 Redis 重建）時不回補，
+// 避免 HINCRBY 建出缺少 price / limit 的殘缺 hash。
+// KEYS 依序為保留、到期 sorted set、遞補指標、庫存、購買紀錄、活動設定、活動購買紀錄，由呼叫端讀取保留的票種後組出；
+// ARGV 依序為 hold id、票種 id、保留者（空字串為不檢查）、庫存變動 channel、event_id。
+const restoreHoldLua = eventLimitLua + `
+	local function restore_hold()
+		local hold_key, expiry_key, promoted_key, ticket_key, users_key = KEYS[1], KEYS[2], KEYS[3], KEYS[4], KEYS[5]
+		local hold_id = ARGV[1]
+		local hold = redis.call('HMGET', hold_key, 'ticket_id', 'user_id', 'quantity')
+		if not hold[1] or hold[1] ~= ARGV[2] then
+			return 0
+		end
+		if ARGV[3] ~= '' and hold[2] ~= ARGV[3] then
+			return 0
+		end
+		redis.call('ZREM', expiry_key, hold_id)
+		redis.call('DEL', hold_key)
+		if redis.call('HGET', promoted_key, hold[2]) == hold_id then
+			redis.call('HDEL', promoted_key, hold[2])
+		end
+		if redis.call('EXISTS', ticket_key) == 0 then
+			return 1
+		end
+		local qty = tonumber(hold[3])
+		local new_stock = redis.call('HINCRBY', ticket_key, 'stock', qty)
+		redis.call('HINCRBY', users_key, hold[2], -qty)
+		add_event_bought(resolve_event_keys(redis.call('HGET', ticket_key, 'event_id'), ARGV[5], KEYS[6], KEYS[7]), hold[2], -qty)
+		redis.call('PUBLISH', ARGV[4], new_stock)
+		return 1
+	end
+`
+
+var (
+	createHoldScript = redis.NewScript(quotePriceLua + presaleLua + eventLimitLua + `
+		local ticket_key = KEYS[1]
+		local users_key = KEYS[2]
+		local hold_key = KEYS[3]
+		local expiry_key = KEYS[4]
+		local user_id = ARGV[1]
+		local request_qty = tonumber(ARGV[2])
+		local ticket_info = redis.call('HMGET', ticket_key, 'stock', 'price', 'limit', 'seated', 'total', 'presale', 'event_id', 'waitlist_demand')
+		local stock = ticket_info[1]
+		local price = ticket_info[2]
+		local limit = ticket_info[3]
+		if not stock or not price or not limit then
+			return {-3, '0.0'}
+		end
+		if ticket_info[4] == '1' then
+			return {-4, '0.0'}
+		end
+		local event_keys = resolve_event_keys(ticket_info[7], ARGV[8], KEYS[9], KEYS[10])
+		if event_keys == false then
+			return {-3, '0.0'}
+		end
+		local presale_code = check_presale({KEYS[6], KEYS[7], KEYS[8]}, ticket_info[6], user_id, '')
+		if presale_code ~= 0 then
+			return {presale_code, '0.0'}
+		end
+		-- 扣除候補者尚未遞補的需求（waitlist_demand）後才開放一般購買
+		if tonumber(stock) - tonumber(ticket_info[8] or '0') < request_qty then
+			return {-1, '0.0'}
+		end
+		local user_bought = redis.call('HGET', users_key, user_id) or '0'
+		if tonumber(user_bought) + request_qty > tonumber(limit) then
+			return {-2, '0.0'}
+		end
+		if exceeds_event_limit(event_keys, user_id, request_qty) then
+			return {-7, '0.0'}
+		end
+		local unit_price, phase = quote_price(KEYS[5], price, ticket_info[5], stock, request_qty, tonumber(ARGV[7]))
+		local new_stock = redis.call('HINCRBY', ticket_key, 'stock', -request_qty)
+		redis.call('HINCRBY', users_key, user_id, request_qty)
+		add_event_bought(event_keys, user_id, request_qty)
+		redis.call('HSET', hold_key, 'ticket_id', ARGV[6], 'user_id', user_id, 'quantity', request_qty, 'price', unit_price, 'phase', phase)
+		redis.call('ZADD', expiry_key, ARGV[5], ARGV[4])
+		redis.call('PUBLISH', ARGV[3], new_stock)
+		return {1, unit_price, phase}
+	`)
+
+	convertHoldScript = redis.NewScript(`
+		local hold_key = KEYS[1]
+		local expiry_key = KEYS[2]
+		local hold_id = ARGV[1]
+		local expires_at = redis.call('ZSCORE', expiry_key, hold_id)
+		if not expires_at or tonumber(expires_at) <= tonumber(ARGV[5]) then
+			return {-16, '0.0'}
+		end
+		local hold = redis.call('HMGET', hold_key, 'ticket_id', 'user_id', 'quantity', 'price', 'phase')
+		if not hold[1] or hold[2] ~= ARGV[2] then
+			return {-16, '0.0'}
+		end
+		if hold[1] ~= ARGV[3] or hold[3] ~= ARGV[4] then
+			return {-17, '0.0'}
+		end
+		redis.call('DEL', hold_key)
+		redis.call('ZREM', expiry_key, hold_id)
+		if redis.call('HGET', KEYS[3], hold[2]) == hold_id then
+			redis.call('HDEL', KEYS[3], hold[2])
+		end
+		return {1, tostring(hold[4]), hold[5] or ''}
+	`)
+
+	restoreHoldScript = redis.NewScript(restoreHoldLua + `
+		return restore_hold()
+	`)
+)
+
+type RedisTicketHoldManagerImpl struct {
+	client *redis.Client
+}
+
+func NewRedisTicketHoldManager(client *redis.Client) RedisTicketHoldManager {
+	return &RedisTicketHoldManagerImpl{
+		client: client,
+	}
+}
+
+// 庫存 key（與 RedisTicketInventoryManager 共用）
+func (m *RedisTicketHoldManagerImpl) getInfoKey(ticketID int) string {
+	return fmt.Sprintf("ticket:%d:info", ticketID)
+}
+
+// 用戶購買紀錄的 key（與 RedisTicketInventoryManager 共用）
+func (m *RedisTicketHoldManagerImpl) getUsersKey(ticketID int) string {
+	return fmt.Sprintf("ticket:%d:users", ticketID)
+}
+
+// 庫存變動的 pub/sub channel（與 RedisTicketInventoryManager 共用）
+func (m *RedisTicketHoldManagerImpl) getStockChannel(ticketID int) string {
+	return fmt.Sprintf("ticket:%d:stock", ticketID)
+}
+
+// 價格階段的 key（與 RedisTicketInventoryManager 共用）
+func (m *RedisTicketHoldManagerImpl) getPhasesKey(ticketID int) string {
+	return fmt.Sprintf("ticket:%d:phases", ticketID)
+}
+
+// 單一保留的 hash：ticket_id、user_id、quantity、price、phase
+func (m *RedisTicketHoldManagerImpl) getHoldKey(holdID string) string {
+	return fmt.Sprintf("hold:%s", holdID)
+}
+
+// 已遞補使用者的專屬保留（與 RedisWaitlistManager 共用）
+func (m *RedisTicketHoldManagerImpl) getPromotedKey(ticketID int) string {
+	return fmt.Sprintf("ticket:%d:waitlist:promoted", ticketID)
+}
+
+func (m *RedisTicketHoldManagerImpl) CreateHold(ctx context.Context, ticketID int, userID int, quantity int, ttl time.Duration) (*model.TicketHold, error) {
+	if quantity <= 0 || ttl <= 0 {
+		return nil, app_errors.ErrInvalidInput
+	}
+
+	holdID := uuid.New()
+	now := time.Now().UTC()
+	expiresAt := now.Add(ttl)
+	eventID, eventKeys, err := ticketEventKeys(ctx, m.client, m.getInfoKey(ticketID))
+	if err != nil {
+		return nil, err
+	}
+
+	keys := []string{m.getInfoKey(ticketID), m.getUsersKey(ticketID), m.getHoldKey(holdID.String()), holdExpiryKey, m.getPhasesKey(ticketID)}
+	keys = append(keys, presaleKeys(ticketID)...)
+	keys = append(keys, eventKeys...)
+	result, err := createHoldScript.Run(ctx, m.client, keys,
+		userID, quantity, m.getStockChannel(ticketID), holdID.String(), expiresAt.UnixMilli(), ticketID, now.UnixMilli(), eventID,
+	).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	resSlice := result.([]interface{})
+	switch code := resSlice[0].(int64); code {
+	case 1:
+		quote := parsePriceQuote(resSlice[1:])
+		return &model.TicketHold{
+			HoldID:     holdID,
+			TicketID:   ticketID,
+			UserID:     userID,
+			Quantity:   quantity,
+			Price:      quote.Price,
+			PricePhase: quote.Phase,
+			ExpiresAt:  expiresAt,
+		}, nil
+	default:
+		return nil, scriptError(code)
+	}
+}
+
+func (m *RedisTicketHoldManagerImpl) ConvertHold(ctx context.Context, holdID uuid.UUID, userID int, ticketID int, quantity int) (*model.TicketHold, error) {
+	keys := []string{m.getHoldKey(holdID.String()), holdExpiryKey, m.getPromotedKey(ticketID)}
+	result, err := convertHoldScript.Run(ctx, m.client, keys,
+		holdID.String(), userID, ticketID, quantity, time.Now().UTC().UnixMilli(),
+	).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	resSlice := result.([]interface{})
+	switch code := resSlice[0].(int64); code {
+	case 1:
+		quote := parsePriceQuote(resSlice[1:])
+		return &model.TicketHold{
+			HoldID:     holdID,
+			TicketID:   ticketID,
+			UserID:     userID,
+			Quantity:   quantity,
+			Price:      quote.Price,
+			PricePhase: quote.Phase,
+		}, nil
+	default:
+		return nil, scriptError(code)
+	}
+}
+
+func (m *RedisTicketHoldManagerImpl) ReleaseHold(ctx context.Context, holdID uuid.UUID, userID int) error {
+	ticketID, err := m.client.HGet(ctx, m.getHoldKey(holdID.String()), "ticket_id").Result()
+	if err != nil && err != redis.Nil {
+		return err
+	}
+	released, err := m.restoreHold(ctx, holdID.String(), ticketID, strconv.Itoa(userID))
+	if err != nil {
+		return err
+	}
+	if released == 0 {
+		return app_errors.ErrHoldExpired
+	}
+	return nil
+}
+
+// ReleaseExpired 先讀出到期保留所屬的票種，再逐筆以腳本歸還；讀取後才被轉換或釋放的保留由腳本略過
+func (m *RedisTicketHoldManagerImpl) ReleaseExpired(ctx context.Context, now time.Time, limit int) (int, error) {
+	if limit <= 0 {
+		return 0, app_errors.ErrInvalidInput
+	}
+	holdIDs, err := m.client.ZRangeByScore(ctx, holdExpiryKey, &redis.ZRangeBy{
+		Min: "-inf", Max: strconv.FormatInt(now.UnixMilli(), 10), Count: int64(limit),
+	}).Result()
+	if err != nil || len(holdIDs) == 0 {
+		return 0, err
+	}
+
+	pipe := m.client.Pipeline()
+	cmds := make([]*redis.StringCmd, len(holdIDs))
+	for i, holdID := range holdIDs {
+		cmds[i] = pipe.HGet(ctx, m.getHoldKey(holdID), "ticket_id")
+	}
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return 0, err
+	}
+
+	released := 0
+	for i, holdID := range holdIDs {
+		n, err := m.restoreHold(ctx, holdID, cmds[i].Val(), "")
+		if err != nil {
+			return released, err
+		}
+		released += n
+	}
+	return released, nil
+}
+
+// restoreHold 依保留的 ticket_id 組出腳本需要的 key 後歸還；保留已不存在時只移除到期紀錄
+func (m *RedisTicketHoldManagerImpl) restoreHold(ctx context.Context, holdID string, ticketField string, userID string) (int, error) {
+	ticketID, err := strconv.Atoi(ticketField)
+	if err != nil {
+		return 0, m.client.ZRem(ctx, holdExpiryKey, holdID).Err()
+	}
+	eventID, eventKeys, err := ticketEventKeys(ctx, m.client, m.getInfoKey(ticketID))
+	if err != nil {
+		return 0, err
+	}
+	keys := []string{
+		m.getHoldKey(holdID),
+		holdExpiryKey,
+		m.getPromotedKey(ticketID),
+		m.getInfoKey(ticketID),
+		m.getUsersKey(ticketID),
+	}
+	keys = append(keys, eventKeys...)
+	return restoreHoldScript.Run(ctx, m.client, keys, holdID, ticketField, userID, m.getStockChannel(ticketID), eventID).Int()
+}
@@ -2,69 +2,234 @@ package cache
 
 import (
 	"context"
-	"errors"
+	"encoding/json"
 	"fmt"
+	"go-gin-high-concurrency/internal/model"
 	"go-gin-high-concurrency/pkg/app_errors"
 	"strconv"
+	"time"
 
 	"github.com/redis/go-redis/v9"
 )
 
 type RedisTicketInfo struct {
 	Stock int
-	Price float64
+	Price float64 // 目前適用的單價（已套用價格階段）
 	Limit int
+	Phase string // 目前適用的價格階段，未設定階段或全部失效時為空字串
+}
+
+// PriceQuote 預約當下成交的單價與套用的價格階段（未套用任何階段時 Phase 為空字串）
+type PriceQuote struct {
+	Price      float64
+	Phase      string
+	AccessCode string // 本次扣除使用次數的預售存取碼，非預售票種或名單內的使用者為空字串
+}
+
+// pricePhaseEntry 價格階段在 Redis 中的表示；price 以字串保存避免 Lua 轉換浮點數時失真
+type pricePhaseEntry struct {
+	Name      string `json:"name"`
+	Price     string `json:"price"`
+	EndsAt    int64  `json:"ends_at,omitempty"`
+	SoldLimit int    `json:"sold_limit,omitempty"`
+}
+
+// StockUpdate 庫存變動通知（由 Lua 腳本在扣減 / 回滾後 PUBLISH）
+type StockUpdate struct {
+	TicketID int
+	Stock    int
 }
 
 type RedisTicketInventoryManager interface {
-	// 預熱：預先加載票的庫存到 Redis
-	WarmUpInventory(ctx context.Context, tickelID int, stock int, price float64, limit int) error
+	// 預熱：預先加載票的庫存到 Redis，並記錄所屬活動供優惠碼檢查適用範圍
+	WarmUpInventory(ctx context.Context, tickelID int, eventID int, stock int, price float64, limit int) error
 	// 獲取：獲取票的庫存
 	GetStock(ctx context.Context, ticketID int) (int, error)
-	// 獲取：獲取票的資訊
+	// 獲取：獲取票的資訊，售價為目前價格階段的單價
 	GetInfo(ctx context.Context, ticketID int) (RedisTicketInfo, error)
-	// 減少：減少票的庫存 (使用Lua腳本確保原子性)
-	DecreStock(ctx context.Context, ticketID int, quantity int, userID int) (bool, float64, error)
-	// 回滾：回滾票的庫存及使用者購買紀錄 (使用Lua腳本確保原子性)
+	// 減少：減少票的庫存，預售票種需為名單內的使用者或帶有效的存取碼 (使用Lua腳本確保原子性)
+	DecreStock(ctx context.Context, ticketID int, quantity int, userID int, accessCode string) (bool, PriceQuote, error)
+	// 回滾：回滾票的庫存及使用者購買紀錄，票種未預熱時略過 (使用Lua腳本確保原子性)
 	RollbackStock(ctx context.Context, ticketID int, quantity int, userID int) error
+	// 更新：同步後台修改的售價及每人限購，票種未預熱時略過 (使用Lua腳本確保原子性)
+	UpdateInfo(ctx context.Context, ticketID int, price float64, limit int) error
+	// 更新：以新的價格階段取代已預熱票種的階段，票種未預熱時略過
+	SetPricePhases(ctx context.Context, ticketID int, phases []*model.TicketPricePhase) error
+	// 調整：後台加開或收回庫存，票種未預熱時略過，收回後庫存不可為負 (使用Lua腳本確保原子性)
+	AdjustStock(ctx context.Context, ticketID int, delta int) error
+	// 訂閱：訂閱多個票種的庫存變動，ctx 結束時關閉 channel
+	SubscribeStock(ctx context.Context, ticketIDs []int) (<-chan StockUpdate, error)
+	// 設定：活動每人跨票種的購買上限，0 為不限；開賣時及後台修改時寫入
+	SetEventLimit(ctx context.Context, eventID int, limit int) error
 }
 
+// quotePriceLua 依序找出第一個仍有效的價格階段作為本次預約的單價，全部失效時使用票種原價。
+// 售出數量以預熱時的總庫存扣除目前庫存計算（含保留中的數量），與扣減在同一個腳本內，階段的切換點不會被併發請求超賣。
+const quotePriceLua = `
+	local function quote_price(phases_key, base_price, total, stock, qty, now)
+		local phases = redis.call('LRANGE', phases_key, 0, -1)
+		local sold = nil
+		if total then
+			sold = tonumber(total) - tonumber(stock)
+		end
+		for _, raw in ipairs(phases) do
+			local phase = cjson.decode(raw)
+			local in_time = phase.ends_at == nil or now < phase.ends_at
+			local in_quota = phase.sold_limit == nil or (sold ~= nil and sold + qty <= phase.sold_limit)
+			if in_time and in_quota then
+				return phase.price, phase.name
+			end
+		end
+		return tostring(base_price), ''
+	end
+`
+
+// eventLimitLua 活動每人跨票種的購買上限：上限保存於活動設定的 max_per_user（0 或不存在為不限），
+// 各票種的購買數量另外累計到活動購買紀錄，與票種的個人限購在同一個腳本內檢查及累計。
+// 活動的 key 由呼叫端以 ticketEventKeys 讀取 ticket info 的 event_id 後在 KEYS 宣告，event_id 一併放在 ARGV 供腳本比對。
+const eventLimitLua = `
+	-- 呼叫端讀取的 event_id 與 ticket info 不一致（讀取後才預熱）時回傳 false，KEYS 內的活動 key 不可用；
+	-- 票種未記錄活動時回傳 nil，不檢查也不累計
+	local function resolve_event_keys(ticket_event_id, event_id, info_key, users_key)
+		if (ticket_event_id or '') ~= event_id then
+			return false
+		end
+		if not ticket_event_id then
+			return nil
+		end
+		return {info_key, users_key}
+	end
+
+	local function exceeds_event_limit(event_keys, user_id, qty)
+		if not event_keys then
+			return false
+		end
+		local limit = tonumber(redis.call('HGET', event_keys[1], 'max_per_user') or '0')
+		if limit <= 0 then
+			return false
+		end
+		local bought = tonumber(redis.call('HGET', event_keys[2], user_id) or '0')
+		return bought + qty > limit
+	end
+
+	local function add_event_bought(event_keys, user_id, qty)
+		if event_keys then
+			redis.call('HINCRBY', event_keys[2], user_id, qty)
+		end
+	end
+`
+
 // Pre-compiled Lua scripts — loaded once and executed via EVALSHA to avoid
 // retransmitting the full script body on every hot-path call.
 var (
-	decreStockScript = redis.NewScript(`
+	getInfoScript = redis.NewScript(quotePriceLua + `
+		if redis.call('EXISTS', KEYS[1]) == 0 then
+			return {}
+		end
+		local info = redis.call('HMGET', KEYS[1], 'stock', 'price', 'limit', 'total')
+		local price, phase = info[2] or '', ''
+		if info[1] and info[2] then
+			price, phase = quote_price(KEYS[2], info[2], info[4], info[1], 1, tonumber(ARGV[1]))
+		end
+		return {info[1] or '', price, info[3] or '', phase}
+	`)
+
+	decreStockScript = redis.NewScript(quotePriceLua + presaleLua + eventLimitLua + `
 		local ticket_key = KEYS[1]
 		local users_key = KEYS[2]
 		local user_id = tonumber(ARGV[1])
 		local request_qty = tonumber(ARGV[2])
-		local ticket_info = redis.call('HMGET', ticket_key, 'stock', 'price', 'limit')
+		local ticket_info = redis.call('HMGET', ticket_key, 'stock', 'price', 'limit', 'seated', 'total', 'presale', 'event_id', 'waitlist_demand')
 		local stock = ticket_info[1]
 		local price = ticket_info[2]
 		local limit = ticket_info[3]
 		if not stock or not price or not limit then
 			return {-3, '0.0'}
 		end
-		if tonumber(stock) < request_qty then
+		if ticket_info[4] == '1' then
+			return {-4, '0.0'}
+		end
+		local event_keys = resolve_event_keys(ticket_info[7], ARGV[6], KEYS[7], KEYS[8])
+		if event_keys == false then
+			return {-3, '0.0'}
+		end
+		local presale_keys = {KEYS[4], KEYS[5], KEYS[6]}
+		local presale_code, access_code = check_presale(presale_keys, ticket_info[6], user_id, ARGV[5])
+		if presale_code ~= 0 then
+			return {presale_code, '0.0'}
+		end
+		-- 扣除候補者尚未遞補的需求（waitlist_demand）後才開放一般購買
+		if tonumber(stock) - tonumber(ticket_info[8] or '0') < request_qty then
 			return {-1, '0.0'}
 		end
 		local user_bought = redis.call('HGET', users_key, user_id) or '0'
 		if tonumber(user_bought) + request_qty > tonumber(limit) then
 			return {-2, '0.0'}
 		end
-		redis.call('HINCRBY', ticket_key, 'stock', -request_qty)
+		if exceeds_event_limit(event_keys, user_id, request_qty) then
+			return {-7, '0.0'}
+		end
+		local unit_price, phase = quote_price(KEYS[3], price, ticket_info[5], stock, request_qty, tonumber(ARGV[4]))
+		local new_stock = redis.call('HINCRBY', ticket_key, 'stock', -request_qty)
 		redis.call('HINCRBY', users_key, user_id, request_qty)
-		return {1, tostring(price)}
+		add_event_bought(event_keys, user_id, request_qty)
+		consume_access_code(presale_keys, access_code)
+		redis.call('PUBLISH', ARGV[3], new_stock)
+		return {1, unit_price, phase, access_code or ''}
 	`)
 
-	rollbackStockScript = redis.NewScript(`
+	rollbackStockScript = redis.NewScript(eventLimitLua + `
 		local ticket_key = KEYS[1]
 		local users_key = KEYS[2]
 		local user_id = tonumber(ARGV[1])
 		local rollback_qty = tonumber(ARGV[2])
-		redis.call('HINCRBY', ticket_key, 'stock', rollback_qty)
+		if redis.call('EXISTS', ticket_key) == 0 then
+			return "OK"
+		end
+		local new_stock = redis.call('HINCRBY', ticket_key, 'stock', rollback_qty)
 		redis.call('HINCRBY', users_key, user_id, -rollback_qty)
+		add_event_bought(resolve_event_keys(redis.call('HGET', ticket_key, 'event_id'), ARGV[4], KEYS[3], KEYS[4]), user_id, -rollback_qty)
+		redis.call('PUBLISH', ARGV[3], new_stock)
 		return "OK"
 	`)
+
+	updateInfoScript = redis.NewScript(`
+		local ticket_key = KEYS[1]
+		if redis.call('EXISTS', ticket_key) == 0 then
+			return 0
+		end
+		redis.call('HSET', ticket_key, 'price', ARGV[1], 'limit', ARGV[2])
+		return 1
+	`)
+
+	setPricePhasesScript = redis.NewScript(`
+		if redis.call('EXISTS', KEYS[1]) == 0 then
+			return 0
+		end
+		redis.call('DEL', KEYS[2])
+		if #ARGV > 0 then
+			redis.call('RPUSH', KEYS[2], unpack(ARGV))
+		end
+		return 1
+	`)
+
+	adjustStockScript = redis.NewScript(`
+		local ticket_key = KEYS[1]
+		local delta = tonumber(ARGV[1])
+		local stock = redis.call('HGET', ticket_key, 'stock')
+		if not stock then
+			return 0
+		end
+		if tonumber(stock) + delta < 0 then
+			return -1
+		end
+		if redis.call('HEXISTS', ticket_key, 'total') == 1 then
+			redis.call('HINCRBY', ticket_key, 'total', delta)
+		end
+		local new_stock = redis.call('HINCRBY', ticket_key, 'stock', delta)
+		redis.call('PUBLISH', ARGV[2], new_stock)
+		return 1
+	`)
 )
 
 type RedisTicketInventoryManagerImpl struct {
@@ -87,12 +252,46 @@ func (m *RedisTicketInventoryManagerImpl) getUsersKey(ticketID int) string {
 	return fmt.Sprintf("ticket:%d:users", ticketID)
 }
 
-func (m *RedisTicketInventoryManagerImpl) WarmUpInventory(ctx context.Context, tickelID int, stock int, price float64, limit int) error {
+// 庫存變動的 pub/sub channel
+func (m *RedisTicketInventoryManagerImpl) getStockChannel(ticketID int) string {
+	return fmt.Sprintf("ticket:%d:stock", ticketID)
+}
+
+// 價格階段的 key（list，依套用順序保存 pricePhaseEntry 的 JSON）
+func (m *RedisTicketInventoryManagerImpl) getPhasesKey(ticketID int) string {
+	return fmt.Sprintf("ticket:%d:phases", ticketID)
+}
+
+// 活動設定的 hash，max_per_user 為活動每人跨票種的購買上限
+func eventInfoKey(eventID int) string {
+	return fmt.Sprintf("event:%d:info", eventID)
+}
+
+// 活動內使用者跨票種購買數量的 hash（user id → 數量）
+func eventUsersKey(eventID int) string {
+	return fmt.Sprintf("event:%d:users", eventID)
+}
+
+// ticketEventKeys 讀取票種所屬的活動（預熱時寫入 ticket info 的 event_id，之後不會改變），
+// 回傳 event_id 及 eventLimitLua 需宣告的活動設定、活動購買紀錄 key。
+// 票種未預熱或未記錄活動時 event_id 為空字串，key 以活動 0 佔位，腳本不會存取。
+func ticketEventKeys(ctx context.Context, client *redis.Client, ticketKey string) (string, []string, error) {
+	eventID, err := client.HGet(ctx, ticketKey, "event_id").Result()
+	if err != nil && err != redis.Nil {
+		return "", nil, err
+	}
+	id, _ := strconv.Atoi(eventID)
+	return eventID, []string{eventInfoKey(id), eventUsersKey(id)}, nil
+}
+
+func (m *RedisTicketInventoryManagerImpl) WarmUpInventory(ctx context.Context, tickelID int, eventID int, stock int, price float64, limit int) error {
 	key := m.getInfoKey(tickelID)
 	return m.client.HSet(ctx, key, map[string]interface{}{
-		"stock": stock,
-		"price": price,
-		"limit": limit,
+		"stock":    stock,
+		"price":    price,
+		"limit":    limit,
+		"total":    stock, // 計算價格階段的累計售出數量
+		"event_id": eventID,
 	}).Err()
 }
 
@@ -107,8 +306,8 @@ func (m *RedisTicketInventoryManagerImpl) GetStock(ctx context.Context, ticketID
 }
 
 func (m *RedisTicketInventoryManagerImpl) GetInfo(ctx context.Context, ticketID int) (RedisTicketInfo, error) {
-	key := m.getInfoKey(ticketID)
-	result, err := m.client.HGetAll(ctx, key).Result()
+	keys := []string{m.getInfoKey(ticketID), m.getPhasesKey(ticketID)}
+	result, err := getInfoScript.Run(ctx, m.client, keys, time.Now().UTC().UnixMilli()).StringSlice()
 	if err != nil {
 		return RedisTicketInfo{}, err
 	}
@@ -118,17 +317,17 @@ func (m *RedisTicketInventoryManagerImpl) GetInfo(ctx context.Context, ticketID
 		return RedisTicketInfo{}, app_errors.ErrTicketNotFound
 	}
 
-	stock, err := strconv.Atoi(result["stock"])
+	stock, err := strconv.Atoi(result[0])
 	if err != nil {
 		return RedisTicketInfo{}, fmt.Errorf("invalid stock: %v", err)
 	}
 
-	price, err := strconv.ParseFloat(result["price"], 64)
+	price, err := strconv.ParseFloat(result[1], 64)
 	if err != nil {
 		return RedisTicketInfo{}, fmt.Errorf("invalid price: %v", err)
 	}
 
-	limit, err := strconv.Atoi(result["limit"])
+	limit, err := strconv.Atoi(result[2])
 	if err != nil {
 		return RedisTicketInfo{}, fmt.Errorf("invalid limit: %v", err)
 	}
@@ -137,6 +336,7 @@ func (m *RedisTicketInventoryManagerImpl) GetInfo(ctx context.Context, ticketID
 		Stock: stock,
 		Price: price,
 		Limit: limit,
+		Phase: result[3],
 	}, nil
 }
 
@@ -145,48 +345,167 @@ func (m *RedisTicketInventoryManagerImpl) GetInfo(ctx context.Context, ticketID
 
 	減少票的庫存 (使用Lua腳本確保原子性)
 	1. 檢查總庫存
-	2. 檢查個人已購數量
+	2. 檢查個人已購數量（票種限購及活動跨票種上限）
 	3. 執行扣減與紀錄
 	4.
+	預售票種需為名單內的使用者或帶有效的存取碼，存取碼於扣減成功時一併扣除使用次數
+	對號座票種（由 RedisSeatHoldManager 預熱）需先保留座位，改走 CommitSeats
+	有人候補時釋出的庫存保留給候補者，一般購買視同庫存不足
 */
-func (m *RedisTicketInventoryManagerImpl) DecreStock(ctx context.Context, ticketID int, quantity int, userID int) (bool, float64, error) {
+func (m *RedisTicketInventoryManagerImpl) DecreStock(ctx context.Context, ticketID int, quantity int, userID int, accessCode string) (bool, PriceQuote, error) {
 	key := m.getInfoKey(ticketID)
 	usersKey := m.getUsersKey(ticketID)
 
-	result, err := decreStockScript.Run(ctx, m.client, []string{key, usersKey}, userID, quantity).Result()
+	eventID, eventKeys, err := ticketEventKeys(ctx, m.client, key)
+	if err != nil {
+		return false, PriceQuote{}, err
+	}
+
+	keys := append([]string{key, usersKey, m.getPhasesKey(ticketID)}, presaleKeys(ticketID)...)
+	keys = append(keys, eventKeys...)
+	result, err := decreStockScript.Run(ctx, m.client, keys,
+		userID, quantity, m.getStockChannel(ticketID), time.Now().UTC().UnixMilli(), accessCode, eventID,
+	).Result()
 	if err != nil {
-		return false, 0, err
+		return false, PriceQuote{}, err
 	}
 
 	resSlice := result.([]interface{})
 	code := resSlice[0].(int64) // Redis 數字通常回傳 int64
-	priceStr := resSlice[1].(string)
 
-	// 轉換價格為 float64
-	price, _ := strconv.ParseFloat(priceStr, 64)
+	if code != 1 {
+		return false, PriceQuote{}, scriptError(code)
+	}
+	return true, parsePriceQuote(resSlice[1:]), nil
+}
 
-	switch code {
-	case 1:
-		return true, price, nil
-	case -1:
-		return false, 0.0, app_errors.ErrInsufficientStock
-	case -2:
-		return false, 0.0, app_errors.ErrExceedsMaxPerUser
-	case -3:
-		return false, 0.0, app_errors.ErrTicketNotFound
-	default:
-		return false, 0.0, errors.New("unexpected result")
+// parsePriceQuote 解析腳本回傳的 {單價, 價格階段, 扣除的存取碼}
+func parsePriceQuote(values []interface{}) PriceQuote {
+	var quote PriceQuote
+	if len(values) > 0 {
+		// 轉換價格為 float64
+		quote.Price, _ = strconv.ParseFloat(values[0].(string), 64)
+	}
+	if len(values) > 1 {
+		quote.Phase, _ = values[1].(string)
+	}
+	if len(values) > 2 {
+		quote.AccessCode, _ = values[2].(string)
 	}
+	return quote
 }
 
 func (m *RedisTicketInventoryManagerImpl) RollbackStock(ctx context.Context, ticketID int, quantity int, userID int) error {
 	key := m.getInfoKey(ticketID)
 	usersKey := m.getUsersKey(ticketID)
 
-	_, err := rollbackStockScript.Run(ctx, m.client, []string{key, usersKey}, userID, quantity).Result()
+	eventID, eventKeys, err := ticketEventKeys(ctx, m.client, key)
+	if err != nil {
+		return err
+	}
+
+	keys := append([]string{key, usersKey}, eventKeys...)
+	_, err = rollbackStockScript.Run(ctx, m.client, keys, userID, quantity, m.getStockChannel(ticketID), eventID).Result()
 	if err != nil {
 		return err
 	}
 
 	return nil
 }
+
+// UpdateInfo 與扣減庫存的腳本互斥執行，扣減時讀到的售價不是舊值就是新值
+func (m *RedisTicketInventoryManagerImpl) UpdateInfo(ctx context.Context, ticketID int, price float64, limit int) error {
+	return updateInfoScript.Run(ctx, m.client, []string{m.getInfoKey(ticketID)}, price, limit).Err()
+}
+
+func (m *RedisTicketInventoryManagerImpl) SetPricePhases(ctx context.Context, ticketID int, phases []*model.TicketPricePhase) error {
+	entries := make([]interface{}, 0, len(phases))
+	for _, phase := range phases {
+		entry := pricePhaseEntry{
+			Name:  phase.Name,
+			Price: strconv.FormatFloat(phase.Price, 'f', -1, 64),
+		}
+		if phase.EndsAt != nil {
+			entry.EndsAt = phase.EndsAt.UnixMilli()
+		}
+		if phase.SoldLimit != nil {
+			entry.SoldLimit = *phase.SoldLimit
+		}
+		raw, err := json.Marshal(entry)
+		if err != nil {
+			return err
+		}
+		entries = append(entries, string(raw))
+	}
+	return setPricePhasesScript.Run(ctx, m.client, []string{m.getInfoKey(ticketID), m.getPhasesKey(ticketID)}, entries...).Err()
+}
+
+func (m *RedisTicketInventoryManagerImpl) AdjustStock(ctx context.Context, ticketID int, delta int) error {
+	if delta == 0 {
+		return app_errors.ErrInvalidInput
+	}
+
+	code, err := adjustStockScript.Run(ctx, m.client, []string{m.getInfoKey(ticketID)}, delta, m.getStockChannel(ticketID)).Int()
+	if err != nil {
+		return err
+	}
+	if code == -1 {
+		return app_errors.ErrInsufficientStock
+	}
+	return nil
+}
+
+// SetEventLimit 活動未開賣時寫入亦不影響購買，票種預熱後（ticket info 有 event_id）才會檢查
+func (m *RedisTicketInventoryManagerImpl) SetEventLimit(ctx context.Context, eventID int, limit int) error {
+	return m.client.HSet(ctx, eventInfoKey(eventID), "max_per_user", limit).Err()
+}
+
+func (m *RedisTicketInventoryManagerImpl) SubscribeStock(ctx context.Context, ticketIDs []int) (<-chan StockUpdate, error) {
+	if len(ticketIDs) == 0 {
+		return nil, app_errors.ErrInvalidInput
+	}
+
+	channels := make([]string, 0, len(ticketIDs))
+	channelTicketIDs := make(map[string]int, len(ticketIDs))
+	for _, id := range ticketIDs {
+		channel := m.getStockChannel(id)
+		channels = append(channels, channel)
+		channelTicketIDs[channel] = id
+	}
+
+	pubsub := m.client.Subscribe(ctx, channels...)
+	// 等待訂閱確認，確保回傳後不會漏掉任何變動
+	if _, err := pubsub.Receive(ctx); err != nil {
+		_ = pubsub.Close()
+		return nil, err
+	}
+
+	out := make(chan StockUpdate)
+	go func() {
+		defer close(out)
+		defer pubsub.Close()
+
+		msgs := pubsub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg, ok := <-msgs:
+				if !ok {
+					return
+				}
+				stock, err := strconv.Atoi(msg.Payload)
+				if err != nil {
+					continue
+				}
+				select {
+				case out <- StockUpdate{TicketID: channelTicketIDs[msg.Channel], Stock: stock}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return out, nil
+}
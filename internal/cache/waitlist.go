@@ -0,0 +1,480 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"go-gin-high-concurrency/internal/model"
+	"go-gin-high-concurrency/pkg/app_errors"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// waitlistTicketsKey 目前有人候補的票種 ID（set），供遞補 worker 逐一處理
+const waitlistTicketsKey = "waitlist:tickets"
+
+// waitlistUnnotifiedKey 已遞補但尚未寫入通知事件的 hold id（set），通知寫入後才移除，失敗時由下一輪補發
+const waitlistUnnotifiedKey = "waitlist:unnotified"
+
+type RedisWaitlistManager interface {
+	// 加入：剩餘庫存扣除候補需求後不足購買數量時加入候補名單，回傳目前順位；預售票種僅開放名單內的使用者
+	Join(ctx context.Context, ticketID int, userID int, quantity int) (int, error)
+	// 離開：離開候補名單
+	Leave(ctx context.Context, ticketID int, userID int) error
+	// 查詢：候補中回傳順位；已遞補回傳專屬保留
+	GetEntry(ctx context.Context, ticketID int, userID int) (*model.WaitlistEntry, error)
+	// 遞補：依加入順序將可用庫存轉為候補者的專屬保留，庫存不足的候補者略過，最多處理 limit 位 (使用Lua腳本確保原子性)；
+	// 遞補的保留記為尚未通知，需以 MarkNotified 標記
+	Promote(ctx context.Context, ticketID int, ttl time.Duration, limit int) ([]*model.TicketHold, error)
+	// 列出已遞補但尚未標記通知的保留；已轉換、釋放或到期的保留不再通知，直接移除
+	ListUnnotified(ctx context.Context) ([]*model.TicketHold, error)
+	// 標記遞補通知已寫入
+	MarkNotified(ctx context.Context, holdIDs []uuid.UUID) error
+	// 列出目前有人候補的票種
+	ListWaitlistedTickets(ctx context.Context) ([]int, error)
+}
+
+var (
+	joinWaitlistScript = redis.NewScript(presaleLua + eventLimitLua + `
+		local ticket_key = KEYS[1]
+		local users_key = KEYS[2]
+		local waitlist_key = KEYS[3]
+		local qty_key = KEYS[4]
+		local seq_key = KEYS[5]
+		local promoted_key = KEYS[6]
+		local user_id = ARGV[1]
+		local request_qty = tonumber(ARGV[2])
+		local info = redis.call('HMGET', ticket_key, 'stock', 'limit', 'seated', 'presale', 'event_id', 'waitlist_demand')
+		if not info[1] or not info[2] then
+			return -3
+		end
+		if info[3] == '1' then
+			return -4
+		end
+		local event_keys = resolve_event_keys(info[5], ARGV[4], KEYS[12], KEYS[13])
+		if event_keys == false then
+			return -3
+		end
+		local presale_code = check_presale({KEYS[9], KEYS[10], KEYS[11]}, info[4], user_id, '')
+		if presale_code ~= 0 then
+			return presale_code
+		end
+		if redis.call('ZSCORE', waitlist_key, user_id) then
+			return -10
+		end
+		local promoted_hold = redis.call('HGET', promoted_key, user_id)
+		if promoted_hold then
+			if redis.call('ZSCORE', KEYS[8], promoted_hold) then
+				return -10
+			end
+			redis.call('HDEL', promoted_key, user_id)
+		end
+		local user_bought = redis.call('HGET', users_key, user_id) or '0'
+		if tonumber(user_bought) + request_qty > tonumber(info[2]) then
+			return -2
+		end
+		if exceeds_event_limit(event_keys, user_id, request_qty) then
+			return -7
+		end
+		if tonumber(info[1]) - tonumber(info[6] or '0') >= request_qty then
+			return -11
+		end
+		redis.call('ZADD', waitlist_key, redis.call('INCR', seq_key), user_id)
+		redis.call('HSET', qty_key, user_id, request_qty)
+		redis.call('HINCRBY', ticket_key, 'waitlist_demand', request_qty)
+		redis.call('SADD', KEYS[7], ARGV[3])
+		return redis.call('ZRANK', waitlist_key, user_id) + 1
+	`)
+
+	leaveWaitlistScript = redis.NewScript(`
+		local qty = tonumber(redis.call('HGET', KEYS[2], ARGV[1]) or '0')
+		local removed = redis.call('ZREM', KEYS[1], ARGV[1])
+		redis.call('HDEL', KEYS[2], ARGV[1])
+		if removed == 1 then
+			redis.call('HINCRBY', KEYS[4], 'waitlist_demand', -qty)
+		end
+		if redis.call('ZCARD', KEYS[1]) == 0 then
+			redis.call('SREM', KEYS[3], ARGV[2])
+		end
+		return removed
+	`)
+
+	// 依加入順序遞補：可用庫存不足以滿足的候補者略過並保留順位，繼續遞補後面數量較少的人，
+	// 避免排在前面的大量需求卡住整個名單；已超過個人限購或活動上限（例如期間另外購買）的候補者直接移出名單。
+	// 離開名單的數量同步從 ticket info 的 waitlist_demand 扣除。
+	// 預先產生的 hold id 依序放在 ARGV[6:]，對應的保留 key 依序放在 KEYS[12:]；遞補的 hold id 加入尚未通知的 set（KEYS[9]）。
+	promoteWaitlistScript = redis.NewScript(quotePriceLua + eventLimitLua + `
+		local ticket_key = KEYS[1]
+		local users_key = KEYS[2]
+		local waitlist_key = KEYS[3]
+		local qty_key = KEYS[4]
+		local expiry_key = KEYS[5]
+		local ttl = tonumber(ARGV[1])
+		local expires_at = tonumber(ARGV[2]) + ttl
+		local ticket_id = ARGV[4]
+		local info = redis.call('HMGET', ticket_key, 'stock', 'price', 'limit', 'total', 'event_id')
+		if not info[1] or not info[2] or not info[3] then
+			return {}
+		end
+		local event_keys = resolve_event_keys(info[5], ARGV[5], KEYS[10], KEYS[11])
+		if event_keys == false then
+			return {}
+		end
+		local stock = tonumber(info[1])
+		local limit = tonumber(info[3])
+		local promoted = {}
+		local dequeued = 0
+		local next_hold = 6
+		for _, user_id in ipairs(redis.call('ZRANGE', waitlist_key, 0, -1)) do
+			if next_hold > #ARGV or stock <= 0 then
+				break
+			end
+			local qty = tonumber(redis.call('HGET', qty_key, user_id) or '0')
+			local user_bought = tonumber(redis.call('HGET', users_key, user_id) or '0')
+			if qty <= 0 or user_bought + qty > limit or exceeds_event_limit(event_keys, user_id, qty) then
+				redis.call('ZREM', waitlist_key, user_id)
+				redis.call('HDEL', qty_key, user_id)
+				dequeued = dequeued + math.max(qty, 0)
+			elseif qty <= stock then
+				local hold_id = ARGV[next_hold]
+				local hold_key = KEYS[next_hold + 6]
+				next_hold = next_hold + 1
+				local unit_price, phase = quote_price(KEYS[7], info[2], info[4], stock, qty, tonumber(ARGV[2]))
+				stock = stock - qty
+				redis.call('HINCRBY', users_key, user_id, qty)
+				add_event_bought(event_keys, user_id, qty)
+				redis.call('HSET', hold_key, 'ticket_id', ticket_id, 'user_id', user_id, 'quantity', qty, 'price', unit_price, 'phase', phase)
+				redis.call('ZADD', expiry_key, expires_at, hold_id)
+				redis.call('HSET', KEYS[8], user_id, hold_id)
+				redis.call('SADD', KEYS[9], hold_id)
+				redis.call('ZREM', waitlist_key, user_id)
+				redis.call('HDEL', qty_key, user_id)
+				dequeued = dequeued + qty
+				table.insert(promoted, user_id)
+				table.insert(promoted, qty)
+				table.insert(promoted, hold_id)
+				table.insert(promoted, unit_price)
+				table.insert(promoted, phase)
+			end
+		end
+		if dequeued > 0 then
+			redis.call('HINCRBY', ticket_key, 'waitlist_demand', -dequeued)
+		end
+		if #promoted > 0 then
+			redis.call('HSET', ticket_key, 'stock', stock)
+			redis.call('PUBLISH', ARGV[3], stock)
+		end
+		if redis.call('ZCARD', waitlist_key) == 0 then
+			redis.call('SREM', KEYS[6], ticket_id)
+		end
+		return promoted
+	`)
+)
+
+type RedisWaitlistManagerImpl struct {
+	client *redis.Client
+}
+
+func NewRedisWaitlistManager(client *redis.Client) RedisWaitlistManager {
+	return &RedisWaitlistManagerImpl{
+		client: client,
+	}
+}
+
+// 庫存 key（與 RedisTicketInventoryManager 共用）
+func (m *RedisWaitlistManagerImpl) getInfoKey(ticketID int) string {
+	return fmt.Sprintf("ticket:%d:info", ticketID)
+}
+
+// 用戶購買紀錄的 key（與 RedisTicketInventoryManager 共用）
+func (m *RedisWaitlistManagerImpl) getUsersKey(ticketID int) string {
+	return fmt.Sprintf("ticket:%d:users", ticketID)
+}
+
+// 庫存變動的 pub/sub channel（與 RedisTicketInventoryManager 共用）
+func (m *RedisWaitlistManagerImpl) getStockChannel(ticketID int) string {
+	return fmt.Sprintf("ticket:%d:stock", ticketID)
+}
+
+// 候補名單（sorted set，score 為加入順序，member 為 user id）
+func (m *RedisWaitlistManagerImpl) getWaitlistKey(ticketID int) string {
+	return fmt.Sprintf("ticket:%d:waitlist", ticketID)
+}
+
+// 候補數量（hash，user id → 數量）
+func (m *RedisWaitlistManagerImpl) getQuantityKey(ticketID int) string {
+	return fmt.Sprintf("ticket:%d:waitlist:qty", ticketID)
+}
+
+// 候補加入順序的遞增序號
+func (m *RedisWaitlistManagerImpl) getSeqKey(ticketID int) string {
+	return fmt.Sprintf("ticket:%d:waitlist:seq", ticketID)
+}
+
+// 價格階段的 key（與 RedisTicketInventoryManager 共用）
+func (m *RedisWaitlistManagerImpl) getPhasesKey(ticketID int) string {
+	return fmt.Sprintf("ticket:%d:phases", ticketID)
+}
+
+// 已遞補使用者的專屬保留（hash，user id → hold id），保留轉換或歸還時移除；
+// 以單一 hash 保存，遞補時不需事先知道使用者即可在 KEYS 宣告
+func (m *RedisWaitlistManagerImpl) getPromotedKey(ticketID int) string {
+	return fmt.Sprintf("ticket:%d:waitlist:promoted", ticketID)
+}
+
+// 單一保留的 hash（與 RedisTicketHoldManager 共用）
+func (m *RedisWaitlistManagerImpl) getHoldKey(holdID string) string {
+	return fmt.Sprintf("hold:%s", holdID)
+}
+
+func (m *RedisWaitlistManagerImpl) Join(ctx context.Context, ticketID int, userID int, quantity int) (int, error) {
+	if quantity <= 0 {
+		return 0, app_errors.ErrInvalidInput
+	}
+
+	eventID, eventKeys, err := ticketEventKeys(ctx, m.client, m.getInfoKey(ticketID))
+	if err != nil {
+		return 0, err
+	}
+
+	keys := []string{
+		m.getInfoKey(ticketID),
+		m.getUsersKey(ticketID),
+		m.getWaitlistKey(ticketID),
+		m.getQuantityKey(ticketID),
+		m.getSeqKey(ticketID),
+		m.getPromotedKey(ticketID),
+		waitlistTicketsKey,
+		holdExpiryKey,
+	}
+	keys = append(keys, presaleKeys(ticketID)...)
+	keys = append(keys, eventKeys...)
+	code, err := joinWaitlistScript.Run(ctx, m.client, keys, userID, quantity, ticketID, eventID).Int64()
+	if err != nil {
+		return 0, err
+	}
+	// 成功時回傳候補順位（從 1 開始）
+	if code <= 0 {
+		return 0, scriptError(code)
+	}
+	return int(code), nil
+}
+
+func (m *RedisWaitlistManagerImpl) Leave(ctx context.Context, ticketID int, userID int) error {
+	keys := []string{m.getWaitlistKey(ticketID), m.getQuantityKey(ticketID), waitlistTicketsKey, m.getInfoKey(ticketID)}
+	removed, err := leaveWaitlistScript.Run(ctx, m.client, keys, userID, ticketID).Int()
+	if err != nil {
+		return err
+	}
+	if removed == 0 {
+		return app_errors.ErrWaitlistEntryNotFound
+	}
+	return nil
+}
+
+func (m *RedisWaitlistManagerImpl) GetEntry(ctx context.Context, ticketID int, userID int) (*model.WaitlistEntry, error) {
+	member := strconv.Itoa(userID)
+	pipe := m.client.Pipeline()
+	rankCmd := pipe.ZRank(ctx, m.getWaitlistKey(ticketID), member)
+	qtyCmd := pipe.HGet(ctx, m.getQuantityKey(ticketID), member)
+	promotedCmd := pipe.HGet(ctx, m.getPromotedKey(ticketID), member)
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return nil, err
+	}
+
+	if rank, err := rankCmd.Result(); err == nil {
+		quantity, _ := qtyCmd.Int()
+		return &model.WaitlistEntry{
+			UserID:   userID,
+			Quantity: quantity,
+			Status:   model.WaitlistStatusWaiting,
+			Position: int(rank) + 1,
+		}, nil
+	}
+
+	holdID, err := uuid.Parse(promotedCmd.Val())
+	if err != nil {
+		return nil, app_errors.ErrWaitlistEntryNotFound
+	}
+	hold, err := m.client.HMGet(ctx, m.getHoldKey(holdID.String()), "quantity").Result()
+	if err != nil {
+		return nil, err
+	}
+	expiresAt, err := m.client.ZScore(ctx, holdExpiryKey, holdID.String()).Result()
+	if err == redis.Nil || hold[0] == nil {
+		return nil, app_errors.ErrWaitlistEntryNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	quantity, _ := strconv.Atoi(hold[0].(string))
+	holdExpiresAt := time.UnixMilli(int64(expiresAt)).UTC()
+	return &model.WaitlistEntry{
+		UserID:        userID,
+		Quantity:      quantity,
+		Status:        model.WaitlistStatusPromoted,
+		HoldID:        &holdID,
+		HoldExpiresAt: &holdExpiresAt,
+	}, nil
+}
+
+func (m *RedisWaitlistManagerImpl) Promote(ctx context.Context, ticketID int, ttl time.Duration, limit int) ([]*model.TicketHold, error) {
+	if ttl <= 0 || limit <= 0 {
+		return nil, app_errors.ErrInvalidInput
+	}
+
+	// 先確認有可遞補的候補者才產生 hold id，避免每一輪都為沒有需求的票種準備 limit 個 key
+	limit, err := m.deliverableCount(ctx, ticketID, limit)
+	if err != nil || limit == 0 {
+		return nil, err
+	}
+
+	eventID, eventKeys, err := ticketEventKeys(ctx, m.client, m.getInfoKey(ticketID))
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	keys := []string{
+		m.getInfoKey(ticketID),
+		m.getUsersKey(ticketID),
+		m.getWaitlistKey(ticketID),
+		m.getQuantityKey(ticketID),
+		holdExpiryKey,
+		waitlistTicketsKey,
+		m.getPhasesKey(ticketID),
+		m.getPromotedKey(ticketID),
+		waitlistUnnotifiedKey,
+	}
+	keys = append(keys, eventKeys...)
+	// 腳本無法產生 UUID，預先準備 limit 個 hold id 及對應的保留 key
+	args := []interface{}{ttl.Milliseconds(), now.UnixMilli(), m.getStockChannel(ticketID), ticketID, eventID}
+	for i := 0; i < limit; i++ {
+		holdID := uuid.New().String()
+		args = append(args, holdID)
+		keys = append(keys, m.getHoldKey(holdID))
+	}
+
+	result, err := promoteWaitlistScript.Run(ctx, m.client, keys, args...).Slice()
+	if err != nil {
+		return nil, err
+	}
+
+	// 每位遞補者回傳 user id、數量、hold id、單價、價格階段
+	holds := make([]*model.TicketHold, 0, len(result)/5)
+	expiresAt := time.UnixMilli(now.UnixMilli() + ttl.Milliseconds()).UTC()
+	for i := 0; i+4 < len(result); i += 5 {
+		userID, _ := strconv.Atoi(result[i].(string))
+		holdID, err := uuid.Parse(result[i+2].(string))
+		if err != nil {
+			return nil, err
+		}
+		quote := parsePriceQuote(result[i+3 : i+5])
+		holds = append(holds, &model.TicketHold{
+			HoldID:     holdID,
+			TicketID:   ticketID,
+			UserID:     userID,
+			Quantity:   int(result[i+1].(int64)),
+			Price:      quote.Price,
+			PricePhase: quote.Phase,
+			ExpiresAt:  expiresAt,
+		})
+	}
+	return holds, nil
+}
+
+// deliverableCount 目前庫存可滿足的候補人數（最多 limit）；候補數量大於庫存的候補者不會遞補，不需準備 hold id。
+// 僅為預估，實際遞補仍由腳本依當下庫存及限購決定
+func (m *RedisWaitlistManagerImpl) deliverableCount(ctx context.Context, ticketID int, limit int) (int, error) {
+	pipe := m.client.Pipeline()
+	stockCmd := pipe.HGet(ctx, m.getInfoKey(ticketID), "stock")
+	qtyCmd := pipe.HVals(ctx, m.getQuantityKey(ticketID))
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return 0, err
+	}
+	stock, err := stockCmd.Int()
+	if err != nil || stock <= 0 {
+		return 0, nil
+	}
+
+	count := 0
+	for _, value := range qtyCmd.Val() {
+		if qty, err := strconv.Atoi(value); err == nil && qty > 0 && qty <= stock {
+			count++
+			if count == limit {
+				break
+			}
+		}
+	}
+	return count, nil
+}
+
+func (m *RedisWaitlistManagerImpl) ListUnnotified(ctx context.Context) ([]*model.TicketHold, error) {
+	members, err := m.client.SMembers(ctx, waitlistUnnotifiedKey).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	holds := make([]*model.TicketHold, 0, len(members))
+	for _, member := range members {
+		holdID, err := uuid.Parse(member)
+		if err != nil {
+			m.client.SRem(ctx, waitlistUnnotifiedKey, member)
+			continue
+		}
+		pipe := m.client.Pipeline()
+		holdCmd := pipe.HMGet(ctx, m.getHoldKey(member), "ticket_id", "user_id", "quantity", "price", "phase")
+		expiryCmd := pipe.ZScore(ctx, holdExpiryKey, member)
+		if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+			return nil, err
+		}
+		hold := holdCmd.Val()
+		if hold[0] == nil || expiryCmd.Err() == redis.Nil {
+			m.client.SRem(ctx, waitlistUnnotifiedKey, member)
+			continue
+		}
+		ticketID, _ := strconv.Atoi(hold[0].(string))
+		userID, _ := strconv.Atoi(hold[1].(string))
+		quantity, _ := strconv.Atoi(hold[2].(string))
+		quote := parsePriceQuote(hold[3:5])
+		holds = append(holds, &model.TicketHold{
+			HoldID:     holdID,
+			TicketID:   ticketID,
+			UserID:     userID,
+			Quantity:   quantity,
+			Price:      quote.Price,
+			PricePhase: quote.Phase,
+			ExpiresAt:  time.UnixMilli(int64(expiryCmd.Val())).UTC(),
+		})
+	}
+	return holds, nil
+}
+
+func (m *RedisWaitlistManagerImpl) MarkNotified(ctx context.Context, holdIDs []uuid.UUID) error {
+	if len(holdIDs) == 0 {
+		return nil
+	}
+	members := make([]interface{}, 0, len(holdIDs))
+	for _, holdID := range holdIDs {
+		members = append(members, holdID.String())
+	}
+	return m.client.SRem(ctx, waitlistUnnotifiedKey, members...).Err()
+}
+
+func (m *RedisWaitlistManagerImpl) ListWaitlistedTickets(ctx context.Context) ([]int, error) {
+	members, err := m.client.SMembers(ctx, waitlistTicketsKey).Result()
+	if err != nil {
+		return nil, err
+	}
+	ticketIDs := make([]int, 0, len(members))
+	for _, member := range members {
+		ticketID, err := strconv.Atoi(member)
+		if err != nil {
+			continue
+		}
+		ticketIDs = append(ticketIDs, ticketID)
+	}
+	return ticketIDs, nil
+}
@@ -0,0 +1,320 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-gin-high-concurrency/pkg/app_errors"
+	"hash/crc32"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// NilVersion 尚未套用任何 migration 時的版本
+const NilVersion = -1
+
+const defaultMigrationsTable = "schema_migrations"
+
+// advisoryLockIDSalt 與 golang-migrate 相同，兩者計算出相同的 advisory lock id，可互相排斥
+const advisoryLockIDSalt uint32 = 1486364155
+
+var migrationFilePattern = regexp.MustCompile(`^(\d+)_(.+)\.(up|down)\.sql$`)
+
+// Migration 一個版本的 up / down SQL
+type Migration struct {
+	Version int
+	Name    string
+	Up      string
+	Down    string
+}
+
+// MigrationStatus 目前版本及各 migration 是否已套用
+type MigrationStatus struct {
+	Version    int              `json:"version"`
+	Dirty      bool             `json:"dirty"`
+	Migrations []MigrationState `json:"migrations"`
+}
+
+type MigrationState struct {
+	Version int    `json:"version"`
+	Name    string `json:"name"`
+	Applied bool   `json:"applied"`
+}
+
+// Migrator 內建的 migration 執行器，版本記錄於與 golang-migrate 相容的 schema_migrations 表（version, dirty）。
+// 每個操作都先取得 PostgreSQL advisory lock，多個實例同時啟動時依序執行。
+type Migrator interface {
+	// 依序套用所有尚未套用的 migration，回傳本次套用的數量
+	Up(ctx context.Context) (int, error)
+	// 依序回滾最近套用的 steps 個 migration，回傳本次回滾的數量
+	Down(ctx context.Context, steps int) (int, error)
+	// 查詢目前版本、是否 dirty 及各 migration 是否已套用
+	Status(ctx context.Context) (*MigrationStatus, error)
+	// 強制設定版本並清除 dirty，不執行任何 SQL；用於手動修復失敗的 migration 後，version 為 NilVersion 時清除版本
+	Force(ctx context.Context, version int) error
+}
+
+// MigratorConfig 可注入的設定；nil 或零值時使用預設。
+type MigratorConfig struct {
+	TableName string // 記錄版本的資料表，預設為 schema_migrations
+}
+
+type MigratorImpl struct {
+	pool       *pgxpool.Pool
+	migrations []Migration
+	table      string
+}
+
+// NewMigrator 從 fsys 載入 migration 檔建立 Migrator。config 可為 nil，則使用 schema_migrations 記錄版本。
+func NewMigrator(pool *pgxpool.Pool, fsys fs.FS, config *MigratorConfig) (Migrator, error) {
+	migrations, err := LoadMigrations(fsys)
+	if err != nil {
+		return nil, err
+	}
+	table := defaultMigrationsTable
+	if config != nil && config.TableName != "" {
+		table = config.TableName
+	}
+	return &MigratorImpl{
+		pool:       pool,
+		migrations: migrations,
+		table:      table,
+	}, nil
+}
+
+// LoadMigrations 讀取 fsys 根目錄下的 <版本>_<名稱>.up.sql / .down.sql，依版本排序；其他檔案略過
+func LoadMigrations(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, fmt.Errorf("read migrations: %w", err)
+	}
+
+	byVersion := make(map[int]*Migration)
+	for _, entry := range entries {
+		match := migrationFilePattern.FindStringSubmatch(entry.Name())
+		if entry.IsDir() || match == nil {
+			continue
+		}
+		version, err := strconv.Atoi(match[1])
+		if err != nil {
+			return nil, fmt.Errorf("parse migration version %q: %w", entry.Name(), err)
+		}
+		content, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("read migration %s: %w", entry.Name(), err)
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = m
+		} else if m.Name != match[2] {
+			return nil, fmt.Errorf("duplicate migration version %d: %s and %s", version, m.Name, match[2])
+		}
+		if match[3] == "up" {
+			m.Up = string(content)
+		} else {
+			m.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Up == "" {
+			return nil, fmt.Errorf("migration %d_%s has no up file", m.Version, m.Name)
+		}
+		migrations = append(migrations, *m)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+func (m *MigratorImpl) Up(ctx context.Context) (int, error) {
+	applied := 0
+	err := m.withLock(ctx, func(conn *pgxpool.Conn) error {
+		current, err := m.cleanVersion(ctx, conn)
+		if err != nil {
+			return err
+		}
+		for _, migration := range m.migrations {
+			if migration.Version <= current {
+				continue
+			}
+			if err := m.run(ctx, conn, migration.Version, migration.Up); err != nil {
+				return fmt.Errorf("apply migration %d_%s: %w", migration.Version, migration.Name, err)
+			}
+			applied++
+		}
+		return nil
+	})
+	return applied, err
+}
+
+func (m *MigratorImpl) Down(ctx context.Context, steps int) (int, error) {
+	if steps <= 0 {
+		return 0, app_errors.ErrInvalidInput
+	}
+	reverted := 0
+	err := m.withLock(ctx, func(conn *pgxpool.Conn) error {
+		current, err := m.cleanVersion(ctx, conn)
+		if err != nil {
+			return err
+		}
+		for i := m.index(current); i >= 0 && reverted < steps; i-- {
+			migration := m.migrations[i]
+			if migration.Down == "" {
+				return fmt.Errorf("migration %d_%s has no down file", migration.Version, migration.Name)
+			}
+			target := NilVersion
+			if i > 0 {
+				target = m.migrations[i-1].Version
+			}
+			if err := m.run(ctx, conn, target, migration.Down); err != nil {
+				return fmt.Errorf("revert migration %d_%s: %w", migration.Version, migration.Name, err)
+			}
+			reverted++
+		}
+		return nil
+	})
+	return reverted, err
+}
+
+func (m *MigratorImpl) Status(ctx context.Context) (*MigrationStatus, error) {
+	var status *MigrationStatus
+	err := m.withLock(ctx, func(conn *pgxpool.Conn) error {
+		version, dirty, err := m.readVersion(ctx, conn)
+		if err != nil {
+			return err
+		}
+		status = &MigrationStatus{Version: version, Dirty: dirty, Migrations: make([]MigrationState, 0, len(m.migrations))}
+		for _, migration := range m.migrations {
+			status.Migrations = append(status.Migrations, MigrationState{
+				Version: migration.Version,
+				Name:    migration.Name,
+				// dirty 時目前版本的 migration 未完整套用
+				Applied: migration.Version < version || (migration.Version == version && !dirty),
+			})
+		}
+		return nil
+	})
+	return status, err
+}
+
+func (m *MigratorImpl) Force(ctx context.Context, version int) error {
+	if version != NilVersion && m.index(version) < 0 {
+		return fmt.Errorf("%w: %d", app_errors.ErrMigrationNotFound, version)
+	}
+	return m.withLock(ctx, func(conn *pgxpool.Conn) error {
+		return m.setVersion(ctx, conn, version, false)
+	})
+}
+
+// run 先將版本標記為 dirty 再執行 SQL，成功後清除 dirty；失敗時保留 dirty，需手動修復後以 Force 設定版本
+func (m *MigratorImpl) run(ctx context.Context, conn *pgxpool.Conn, version int, sql string) error {
+	if err := m.setVersion(ctx, conn, version, true); err != nil {
+		return err
+	}
+	// 不帶參數時以 simple protocol 執行，可包含多個 statement
+	if _, err := conn.Exec(ctx, sql); err != nil {
+		return err
+	}
+	return m.setVersion(ctx, conn, version, false)
+}
+
+// cleanVersion 目前版本；dirty 或版本不在 migration 檔中時回傳錯誤
+func (m *MigratorImpl) cleanVersion(ctx context.Context, conn *pgxpool.Conn) (int, error) {
+	version, dirty, err := m.readVersion(ctx, conn)
+	if err != nil {
+		return 0, err
+	}
+	if dirty {
+		return 0, fmt.Errorf("%w (version %d)", app_errors.ErrMigrationDirty, version)
+	}
+	if version != NilVersion && m.index(version) < 0 {
+		return 0, fmt.Errorf("%w: database is at version %d", app_errors.ErrMigrationNotFound, version)
+	}
+	return version, nil
+}
+
+func (m *MigratorImpl) readVersion(ctx context.Context, conn *pgxpool.Conn) (int, bool, error) {
+	var version int64
+	var dirty bool
+	err := conn.QueryRow(ctx, "SELECT version, dirty FROM "+m.quotedTable()+" LIMIT 1").Scan(&version, &dirty)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return NilVersion, false, nil
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("read migration version: %w", err)
+	}
+	return int(version), dirty, nil
+}
+
+// setVersion 與 golang-migrate 相同，資料表只保留一筆目前版本；NilVersion 時清空
+func (m *MigratorImpl) setVersion(ctx context.Context, conn *pgxpool.Conn, version int, dirty bool) error {
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, "TRUNCATE "+m.quotedTable()); err != nil {
+		return fmt.Errorf("set migration version: %w", err)
+	}
+	if version != NilVersion {
+		if _, err := tx.Exec(ctx, "INSERT INTO "+m.quotedTable()+" (version, dirty) VALUES ($1, $2)", version, dirty); err != nil {
+			return fmt.Errorf("set migration version: %w", err)
+		}
+	}
+	return tx.Commit(ctx)
+}
+
+// withLock 在同一個連線上取得 advisory lock、確保版本表存在後執行 fn，結束時釋放
+func (m *MigratorImpl) withLock(ctx context.Context, fn func(conn *pgxpool.Conn) error) error {
+	conn, err := m.pool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("acquire connection: %w", err)
+	}
+	defer conn.Release()
+
+	var databaseName, schemaName string
+	if err := conn.QueryRow(ctx, "SELECT current_database(), current_schema()").Scan(&databaseName, &schemaName); err != nil {
+		return fmt.Errorf("read current schema: %w", err)
+	}
+	lockID := advisoryLockID(databaseName, schemaName, m.table)
+	if _, err := conn.Exec(ctx, "SELECT pg_advisory_lock($1)", lockID); err != nil {
+		return fmt.Errorf("acquire migration lock: %w", err)
+	}
+	// ctx 取消時仍需釋放 lock，避免連線歸還連接池後繼續持有
+	defer conn.Exec(context.Background(), "SELECT pg_advisory_unlock($1)", lockID)
+
+	if _, err := conn.Exec(ctx, "CREATE TABLE IF NOT EXISTS "+m.quotedTable()+" (version bigint NOT NULL PRIMARY KEY, dirty boolean NOT NULL)"); err != nil {
+		return fmt.Errorf("create migrations table: %w", err)
+	}
+	return fn(conn)
+}
+
+// index 版本在 migrations 中的位置，不存在時回傳 -1（NilVersion 亦回傳 -1）
+func (m *MigratorImpl) index(version int) int {
+	for i, migration := range m.migrations {
+		if migration.Version == version {
+			return i
+		}
+	}
+	return -1
+}
+
+func (m *MigratorImpl) quotedTable() string {
+	return pgx.Identifier{m.table}.Sanitize()
+}
+
+// advisoryLockID 與 golang-migrate 的 GenerateAdvisoryLockId 相同的計算方式
+func advisoryLockID(databaseName string, additionalNames ...string) int64 {
+	name := strings.Join(append(append([]string{}, additionalNames...), databaseName), "\x00")
+	return int64(crc32.ChecksumIEEE([]byte(name)) * advisoryLockIDSalt)
+}
@@ -0,0 +1,197 @@
+package grpcserver
+
+import (
+	"net/http"
+	"time"
+
+	ticketingv1 "go-gin-high-concurrency/api/ticketing/v1"
+	"go-gin-high-concurrency/internal/model"
+	apperrors "go-gin-high-concurrency/pkg/app_errors"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// 與 model.CreateOrderRequest 的 binding tag 相同的長度上限
+const (
+	maxCodeLength        = 50
+	maxFingerprintLength = 128
+)
+
+var orderStatuses = map[model.OrderStatus]ticketingv1.OrderStatus{
+	model.OrderStatusPending:   ticketingv1.OrderStatus_ORDER_STATUS_PENDING,
+	model.OrderStatusConfirmed: ticketingv1.OrderStatus_ORDER_STATUS_CONFIRMED,
+	model.OrderStatusCancelled: ticketingv1.OrderStatus_ORDER_STATUS_CANCELLED,
+	model.OrderStatusExpired:   ticketingv1.OrderStatus_ORDER_STATUS_EXPIRED,
+	model.OrderStatusRefunded:  ticketingv1.OrderStatus_ORDER_STATUS_REFUNDED,
+}
+
+var stockStatuses = map[model.StockStatus]ticketingv1.StockStatus{
+	model.StockStatusAvailable: ticketingv1.StockStatus_STOCK_STATUS_AVAILABLE,
+	model.StockStatusLowStock:  ticketingv1.StockStatus_STOCK_STATUS_LOW_STOCK,
+	model.StockStatusSoldOut:   ticketingv1.StockStatus_STOCK_STATUS_SOLD_OUT,
+}
+
+func toEvent(event *model.Event) *ticketingv1.Event {
+	pb := &ticketingv1.Event{
+		Id:          int32(event.ID),
+		EventId:     event.EventID.String(),
+		Name:        event.Name,
+		Description: event.Description,
+		CreatedAt:   timestamp(event.CreatedAt),
+		UpdatedAt:   timestamp(event.UpdatedAt),
+	}
+	if event.MaxPerUser != nil {
+		maxPerUser := int32(*event.MaxPerUser)
+		pb.MaxPerUser = &maxPerUser
+	}
+	return pb
+}
+
+func toTicket(ticket *model.Ticket) *ticketingv1.Ticket {
+	return &ticketingv1.Ticket{
+		Id:             int32(ticket.ID),
+		TicketId:       ticket.TicketID.String(),
+		EventId:        int32(ticket.EventID),
+		Name:           ticket.Name,
+		Price:          ticket.Price,
+		TotalStock:     int32(ticket.TotalStock),
+		RemainingStock: int32(ticket.RemainingStock),
+		MaxPerUser:     int32(ticket.MaxPerUser),
+		Seated:         ticket.SectionID != nil,
+		Presale:        ticket.Presale,
+		CreatedAt:      timestamp(ticket.CreatedAt),
+		UpdatedAt:      timestamp(ticket.UpdatedAt),
+	}
+}
+
+func toTicketAvailability(ticket *model.TicketResponse) *ticketingv1.TicketAvailability {
+	return &ticketingv1.TicketAvailability{
+		Id:             int32(ticket.ID),
+		TicketId:       ticket.TicketID.String(),
+		EventId:        int32(ticket.EventID),
+		Name:           ticket.Name,
+		Price:          ticket.Price,
+		PricePhase:     ticket.PricePhase,
+		Presale:        ticket.Presale,
+		TotalStock:     int32(ticket.TotalStock),
+		RemainingStock: int32(ticket.RemainingStock),
+		Available:      ticket.Available,
+		Status:         stockStatuses[ticket.Status],
+	}
+}
+
+// toOrder 寫入資料庫前的訂單沒有 order_id 及時間，對應的欄位留空
+func toOrder(order *model.Order) *ticketingv1.Order {
+	pb := &ticketingv1.Order{
+		RequestId:      order.RequestID,
+		UserId:         int32(order.UserID),
+		TicketId:       int32(order.TicketID),
+		Quantity:       int32(order.Quantity),
+		TotalPrice:     order.TotalPrice,
+		DiscountAmount: order.DiscountAmount,
+		PricePhase:     order.PricePhase,
+		PromoCode:      order.PromoCode,
+		SeatIds:        int32s(order.SeatIDs),
+		Status:         orderStatuses[order.Status],
+		CreatedAt:      timestamp(order.CreatedAt),
+		UpdatedAt:      timestamp(order.UpdatedAt),
+	}
+	if order.OrderID != uuid.Nil {
+		pb.OrderId = order.OrderID.String()
+	}
+	return pb
+}
+
+// toCreateOrderRequest 轉換並檢查下單請求，規則與 HTTP API 的 binding tag 相同，一次回傳所有不合格的欄位
+func toCreateOrderRequest(req *ticketingv1.CreateOrderRequest) (model.CreateOrderRequest, error) {
+	orderReq := model.CreateOrderRequest{
+		UserID:             int(req.GetUserId()),
+		TicketID:           int(req.GetTicketId()),
+		Quantity:           int(req.GetQuantity()),
+		SeatIDs:            ints(req.GetSeatIds()),
+		ExpectedPrice:      req.ExpectedPrice,
+		PromoCode:          req.PromoCode,
+		AccessCode:         req.AccessCode,
+		PaymentFingerprint: req.PaymentFingerprint,
+		DeviceFingerprint:  req.GetDeviceFingerprint(),
+	}
+
+	var details []apperrors.FieldError
+	if orderReq.UserID == 0 {
+		details = append(details, apperrors.FieldError{Field: "user_id", Rule: "required", Message: "user_id is required"})
+	}
+	if orderReq.TicketID == 0 {
+		details = append(details, apperrors.FieldError{Field: "ticket_id", Rule: "required", Message: "ticket_id is required"})
+	}
+	if orderReq.Quantity < 1 {
+		details = append(details, apperrors.FieldError{Field: "quantity", Rule: "min", Message: "quantity must be at least 1"})
+	}
+	if req.HoldId != nil {
+		holdID, err := uuid.Parse(req.GetHoldId())
+		if err != nil {
+			details = append(details, apperrors.FieldError{Field: "hold_id", Rule: "uuid", Message: "hold_id must be a valid UUID"})
+		} else {
+			orderReq.HoldID = &holdID
+		}
+	}
+	if req.ExpectedPrice != nil && req.GetExpectedPrice() <= 0 {
+		details = append(details, apperrors.FieldError{Field: "expected_price", Rule: "gt", Message: "expected_price must be greater than 0"})
+	}
+	details = appendMaxLength(details, "promo_code", req.GetPromoCode(), maxCodeLength)
+	details = appendMaxLength(details, "access_code", req.GetAccessCode(), maxCodeLength)
+	details = appendMaxLength(details, "payment_fingerprint", req.GetPaymentFingerprint(), maxFingerprintLength)
+	details = appendMaxLength(details, "device_fingerprint", req.GetDeviceFingerprint(), maxFingerprintLength)
+
+	if len(details) > 0 {
+		return model.CreateOrderRequest{}, apperrors.New(http.StatusBadRequest, apperrors.CodeValidationFailed, "Request validation failed").WithDetails(details...)
+	}
+	return orderReq, nil
+}
+
+func appendMaxLength(details []apperrors.FieldError, field string, value string, max int) []apperrors.FieldError {
+	if len(value) <= max {
+		return details
+	}
+	return append(details, apperrors.FieldError{Field: field, Rule: "max", Message: field + " is too long"})
+}
+
+// parseUUID 解析請求中的 UUID 欄位，格式錯誤時回傳 INVALID_INPUT
+func parseUUID(value string, field string) (uuid.UUID, error) {
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.Nil, apperrors.New(http.StatusBadRequest, apperrors.CodeInvalidInput, "Invalid "+field).
+			WithDetails(apperrors.FieldError{Field: field, Rule: "uuid", Message: field + " must be a valid UUID"})
+	}
+	return id, nil
+}
+
+// timestamp 零值時間（尚未寫入資料庫）回傳 nil
+func timestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+func int32s(values []int) []int32 {
+	if len(values) == 0 {
+		return nil
+	}
+	out := make([]int32, len(values))
+	for i, v := range values {
+		out[i] = int32(v)
+	}
+	return out
+}
+
+func ints(values []int32) []int {
+	if len(values) == 0 {
+		return nil
+	}
+	out := make([]int, len(values))
+	for i, v := range values {
+		out[i] = int(v)
+	}
+	return out
+}
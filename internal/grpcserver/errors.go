@@ -0,0 +1,85 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	apperrors "go-gin-high-concurrency/pkg/app_errors"
+	"go-gin-high-concurrency/pkg/logger"
+
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// ErrorDomain 錯誤的 ErrorInfo.domain，reason 為 app_errors 的錯誤碼
+const ErrorDomain = "ticketing"
+
+// httpStatusCodes HTTP 狀態碼對應的 gRPC status code；未列出的 4xx 視為 InvalidArgument、5xx 視為 Internal
+var httpStatusCodes = map[int]codes.Code{
+	http.StatusBadRequest:          codes.InvalidArgument,
+	http.StatusForbidden:           codes.PermissionDenied,
+	http.StatusNotFound:            codes.NotFound,
+	http.StatusConflict:            codes.FailedPrecondition,
+	http.StatusUnprocessableEntity: codes.InvalidArgument,
+	http.StatusMethodNotAllowed:    codes.Unimplemented,
+	http.StatusServiceUnavailable:  codes.Unavailable,
+}
+
+// statusError 與 HTTP handler 的 respondError 相同：以 apperrors.From 轉換錯誤並記錄日誌（4xx 記為 warn、5xx 記為 error），
+// 回傳帶有 ErrorInfo（錯誤碼）及 BadRequest（欄位驗證錯誤）的 gRPC status
+func statusError(ctx context.Context, err error, operation string) error {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return status.FromContextError(err).Err()
+	}
+
+	appErr := apperrors.From(err)
+	fields := []zap.Field{
+		zap.String("operation", operation),
+		zap.String("code", appErr.Code),
+		zap.Error(err),
+	}
+	log := logger.WithContext(ctx, logger.GRPC)
+	if appErr.Status >= http.StatusInternalServerError {
+		log.Error("request failed", fields...)
+	} else {
+		log.Warn("request failed", fields...)
+	}
+
+	return toStatus(appErr).Err()
+}
+
+func toStatus(appErr *apperrors.AppError) *status.Status {
+	st := status.New(grpcCode(appErr.Status), appErr.Message)
+	details := []protoadapt.MessageV1{&errdetails.ErrorInfo{Reason: appErr.Code, Domain: ErrorDomain}}
+	if len(appErr.Details) > 0 {
+		badRequest := &errdetails.BadRequest{}
+		for _, detail := range appErr.Details {
+			badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       detail.Field,
+				Description: detail.Message,
+				Reason:      detail.Rule,
+			})
+		}
+		details = append(details, badRequest)
+	}
+
+	withDetails, err := st.WithDetails(details...)
+	if err != nil {
+		return st
+	}
+	return withDetails
+}
+
+func grpcCode(httpStatus int) codes.Code {
+	if code, ok := httpStatusCodes[httpStatus]; ok {
+		return code
+	}
+	if httpStatus >= http.StatusBadRequest && httpStatus < http.StatusInternalServerError {
+		return codes.InvalidArgument
+	}
+	return codes.Internal
+}
@@ -0,0 +1,126 @@
+package grpcserver
+
+import (
+	"context"
+	"time"
+
+	"go-gin-high-concurrency/pkg/logger"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// RequestIDMetadataKey 請求及回應 header 中的 request id，與 HTTP API 的 X-Request-ID 相同（gRPC metadata key 為小寫）
+const RequestIDMetadataKey = "x-request-id"
+
+// UnaryRequestID 沿用呼叫端 metadata 帶入的 x-request-id，沒有或格式不合時產生新的；存入 context 供日誌及佇列訊息使用，並回寫到回應 header
+func UnaryRequestID() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		return handler(withRequestID(ctx), req)
+	}
+}
+
+func StreamRequestID() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &contextStream{ServerStream: ss, ctx: withRequestID(ss.Context())})
+	}
+}
+
+func withRequestID(ctx context.Context) context.Context {
+	var id string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(RequestIDMetadataKey); len(values) > 0 {
+			id = values[0]
+		}
+	}
+	if !logger.ValidRequestID(id) {
+		id = uuid.New().String()
+	}
+	_ = grpc.SetHeader(ctx, metadata.Pairs(RequestIDMetadataKey, id))
+	return logger.WithRequestID(ctx, id)
+}
+
+// UnaryAccessLog 以 zap 記錄每個呼叫的方法、status code、耗時及對端 IP；伺服器端錯誤記為 error、其餘失敗記為 warn
+func UnaryAccessLog() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		logAccess(ctx, info.FullMethod, start, err)
+		return resp, err
+	}
+}
+
+func StreamAccessLog() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, ss)
+		logAccess(ss.Context(), info.FullMethod, start, err)
+		return err
+	}
+}
+
+func logAccess(ctx context.Context, method string, start time.Time, err error) {
+	code := status.Code(err)
+	fields := []zap.Field{
+		zap.String("method", method),
+		zap.String("code", code.String()),
+		zap.Duration("latency", time.Since(start)),
+		zap.String("client_ip", clientIP(ctx)),
+	}
+
+	log := logger.WithContext(ctx, logger.GRPC)
+	switch code {
+	case codes.OK:
+		log.Info("request", fields...)
+	case codes.Internal, codes.Unknown, codes.DataLoss, codes.Unavailable:
+		log.Error("request", fields...)
+	default:
+		log.Warn("request", fields...)
+	}
+}
+
+// UnaryRecovery panic 時以 zap 記錄（含 request id）並回傳 Internal，避免整個程序結束
+func UnaryRecovery() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				err = recoverPanic(ctx, info.FullMethod, recovered)
+			}
+		}()
+		return handler(ctx, req)
+	}
+}
+
+func StreamRecovery() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				err = recoverPanic(ss.Context(), info.FullMethod, recovered)
+			}
+		}()
+		return handler(srv, ss)
+	}
+}
+
+func recoverPanic(ctx context.Context, method string, recovered any) error {
+	logger.WithContext(ctx, logger.GRPC).Error("panic recovered",
+		zap.Any("panic", recovered),
+		zap.String("method", method),
+		zap.Stack("stack"),
+	)
+	return status.Error(codes.Internal, "Internal server error")
+}
+
+// contextStream 以替換後的 context 包裝 ServerStream
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,260 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"net"
+	"time"
+
+	ticketingv1 "go-gin-high-concurrency/api/ticketing/v1"
+	"go-gin-high-concurrency/internal/model"
+	"go-gin-high-concurrency/internal/service"
+	apperrors "go-gin-high-concurrency/pkg/app_errors"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const defaultWatchInterval = time.Second
+
+// Config gRPC Server 的設定，nil 或零值欄位使用預設值
+type Config struct {
+	WatchInterval time.Duration // WatchOrder 查詢訂單狀態的間隔
+}
+
+// TicketingServer 實作 ticketing.v1.TicketingService，與 HTTP handler 共用同一層 Service
+type TicketingServer struct {
+	ticketingv1.UnimplementedTicketingServiceServer
+
+	eventService  service.EventService
+	ticketService service.TicketService
+	orderService  service.OrderService
+	watchInterval time.Duration
+}
+
+func NewTicketingServer(eventService service.EventService, ticketService service.TicketService, orderService service.OrderService, cfg *Config) *TicketingServer {
+	watchInterval := defaultWatchInterval
+	if cfg != nil && cfg.WatchInterval > 0 {
+		watchInterval = cfg.WatchInterval
+	}
+	return &TicketingServer{
+		eventService:  eventService,
+		ticketService: ticketService,
+		orderService:  orderService,
+		watchInterval: watchInterval,
+	}
+}
+
+// NewServer 建立已註冊 TicketingService 的 grpc.Server，並掛上 request id、存取日誌及 panic recovery 的 interceptor
+func NewServer(ticketing ticketingv1.TicketingServiceServer, opts ...grpc.ServerOption) *grpc.Server {
+	opts = append([]grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(UnaryRequestID(), UnaryAccessLog(), UnaryRecovery()),
+		grpc.ChainStreamInterceptor(StreamRequestID(), StreamAccessLog(), StreamRecovery()),
+	}, opts...)
+	srv := grpc.NewServer(opts...)
+	ticketingv1.RegisterTicketingServiceServer(srv, ticketing)
+	return srv
+}
+
+func (s *TicketingServer) ListEvents(ctx context.Context, _ *ticketingv1.ListEventsRequest) (*ticketingv1.ListEventsResponse, error) {
+	events, err := s.eventService.List(ctx)
+	if err != nil {
+		return nil, statusError(ctx, err, "ListEvents")
+	}
+	resp := &ticketingv1.ListEventsResponse{Events: make([]*ticketingv1.Event, 0, len(events))}
+	for _, event := range events {
+		resp.Events = append(resp.Events, toEvent(event))
+	}
+	return resp, nil
+}
+
+func (s *TicketingServer) GetEvent(ctx context.Context, req *ticketingv1.GetEventRequest) (*ticketingv1.Event, error) {
+	eventID, err := parseUUID(req.GetEventId(), "event_id")
+	if err != nil {
+		return nil, statusError(ctx, err, "GetEvent")
+	}
+	event, err := s.eventService.GetByEventID(ctx, eventID)
+	if err != nil {
+		return nil, statusError(ctx, err, "GetEvent")
+	}
+	return toEvent(event), nil
+}
+
+func (s *TicketingServer) ListTickets(ctx context.Context, _ *ticketingv1.ListTicketsRequest) (*ticketingv1.ListTicketsResponse, error) {
+	tickets, err := s.ticketService.List(ctx)
+	if err != nil {
+		return nil, statusError(ctx, err, "ListTickets")
+	}
+	resp := &ticketingv1.ListTicketsResponse{Tickets: make([]*ticketingv1.Ticket, 0, len(tickets))}
+	for _, ticket := range tickets {
+		resp.Tickets = append(resp.Tickets, toTicket(ticket))
+	}
+	return resp, nil
+}
+
+func (s *TicketingServer) GetTicket(ctx context.Context, req *ticketingv1.GetTicketRequest) (*ticketingv1.Ticket, error) {
+	ticketID, err := parseUUID(req.GetTicketId(), "ticket_id")
+	if err != nil {
+		return nil, statusError(ctx, err, "GetTicket")
+	}
+	ticket, err := s.ticketService.GetByTicketID(ctx, ticketID)
+	if err != nil {
+		return nil, statusError(ctx, err, "GetTicket")
+	}
+	return toTicket(ticket), nil
+}
+
+func (s *TicketingServer) GetTicketAvailability(ctx context.Context, req *ticketingv1.GetTicketAvailabilityRequest) (*ticketingv1.TicketAvailability, error) {
+	ticketID, err := parseUUID(req.GetTicketId(), "ticket_id")
+	if err != nil {
+		return nil, statusError(ctx, err, "GetTicketAvailability")
+	}
+	availability, err := s.ticketService.GetAvailability(ctx, ticketID)
+	if err != nil {
+		return nil, statusError(ctx, err, "GetTicketAvailability")
+	}
+	return toTicketAvailability(availability), nil
+}
+
+func (s *TicketingServer) ListEventAvailability(ctx context.Context, req *ticketingv1.ListEventAvailabilityRequest) (*ticketingv1.ListEventAvailabilityResponse, error) {
+	eventID, err := parseUUID(req.GetEventId(), "event_id")
+	if err != nil {
+		return nil, statusError(ctx, err, "ListEventAvailability")
+	}
+	tickets, err := s.eventService.ListAvailability(ctx, eventID)
+	if err != nil {
+		return nil, statusError(ctx, err, "ListEventAvailability")
+	}
+	resp := &ticketingv1.ListEventAvailabilityResponse{Tickets: make([]*ticketingv1.TicketAvailability, 0, len(tickets))}
+	for _, ticket := range tickets {
+		resp.Tickets = append(resp.Tickets, toTicketAvailability(ticket))
+	}
+	return resp, nil
+}
+
+// CreateOrder 與 HTTP 下單相同呼叫 PrepareOrder；風險評分使用的 client IP 取自連線的 peer
+func (s *TicketingServer) CreateOrder(ctx context.Context, req *ticketingv1.CreateOrderRequest) (*ticketingv1.Order, error) {
+	orderReq, err := toCreateOrderRequest(req)
+	if err != nil {
+		return nil, statusError(ctx, err, "CreateOrder")
+	}
+	orderReq.ClientIP = clientIP(ctx)
+
+	order, err := s.orderService.PrepareOrder(ctx, orderReq)
+	if err != nil {
+		return nil, statusError(ctx, err, "CreateOrder")
+	}
+	return toOrder(order), nil
+}
+
+func (s *TicketingServer) GetOrder(ctx context.Context, req *ticketingv1.GetOrderRequest) (*ticketingv1.Order, error) {
+	lookup, err := newOrderLookup(req.GetOrderId(), req.GetRequestId())
+	if err != nil {
+		return nil, statusError(ctx, err, "GetOrder")
+	}
+	order, err := s.findOrder(ctx, lookup)
+	if err != nil {
+		return nil, statusError(ctx, err, "GetOrder")
+	}
+	return toOrder(order), nil
+}
+
+// CancelOrder 取消訂單並回傳取消後的訂單；狀態紀錄的執行者固定為使用者
+func (s *TicketingServer) CancelOrder(ctx context.Context, req *ticketingv1.CancelOrderRequest) (*ticketingv1.Order, error) {
+	orderID, err := parseUUID(req.GetOrderId(), "order_id")
+	if err != nil {
+		return nil, statusError(ctx, err, "CancelOrder")
+	}
+	change := model.OrderStatusChange{Actor: model.OrderActorUser}
+	if reason := req.GetReason(); reason != "" {
+		change.Reason = &reason
+	}
+
+	if err := s.orderService.CancelOrderByOrderID(ctx, orderID, change); err != nil {
+		return nil, statusError(ctx, err, "CancelOrder")
+	}
+	order, err := s.orderService.GetOrderByOrderID(ctx, orderID)
+	if err != nil {
+		return nil, statusError(ctx, err, "CancelOrder")
+	}
+	return toOrder(order), nil
+}
+
+// WatchOrder 每隔 watchInterval 查詢一次訂單，狀態改變時送出；訂單進入終態或呼叫端取消時結束。
+// 以 request_id 追蹤時，訂單尚未寫入資料庫視為等待中，不回傳 NOT_FOUND
+func (s *TicketingServer) WatchOrder(req *ticketingv1.WatchOrderRequest, stream ticketingv1.TicketingService_WatchOrderServer) error {
+	ctx := stream.Context()
+	lookup, err := newOrderLookup(req.GetOrderId(), req.GetRequestId())
+	if err != nil {
+		return statusError(ctx, err, "WatchOrder")
+	}
+
+	ticker := time.NewTicker(s.watchInterval)
+	defer ticker.Stop()
+
+	var lastStatus model.OrderStatus
+	for {
+		order, err := s.findOrder(ctx, lookup)
+		switch {
+		case err == nil:
+			if order.Status != lastStatus {
+				if err := stream.Send(toOrder(order)); err != nil {
+					return err
+				}
+				lastStatus = order.Status
+			}
+			if order.Status.IsTerminal() {
+				return nil
+			}
+		case errors.Is(err, apperrors.ErrOrderNotFound) && lookup.requestID != "":
+			// 訂單仍在佇列中，等待 Worker 寫入
+		default:
+			return statusError(ctx, err, "WatchOrder")
+		}
+
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// orderLookup 以 order_id 或下單時的 request_id 查詢訂單，兩者擇一
+type orderLookup struct {
+	orderID   uuid.UUID
+	requestID string
+}
+
+func newOrderLookup(orderID string, requestID string) (orderLookup, error) {
+	if requestID != "" {
+		return orderLookup{requestID: requestID}, nil
+	}
+	id, err := parseUUID(orderID, "order_id")
+	if err != nil {
+		return orderLookup{}, err
+	}
+	return orderLookup{orderID: id}, nil
+}
+
+func (s *TicketingServer) findOrder(ctx context.Context, lookup orderLookup) (*model.Order, error) {
+	if lookup.requestID != "" {
+		return s.orderService.GetOrderByRequestID(ctx, lookup.requestID)
+	}
+	return s.orderService.GetOrderByOrderID(ctx, lookup.orderID)
+}
+
+// clientIP 連線對端的 IP；非 TCP 連線（例如 unix socket）沒有 port，回傳完整位址
+func clientIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
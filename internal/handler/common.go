@@ -1,16 +1,24 @@
 package handler
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	apperrors "go-gin-high-concurrency/pkg/app_errors"
+	"go-gin-high-concurrency/pkg/logger"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
 )
 
 func BindJson(c *gin.Context, obj interface{}) error {
 	if err := c.ShouldBindJSON(obj); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "Invalid request format",
-		})
+		writeError(c, bindError(err))
 		return err
 	}
 	return nil
@@ -18,9 +26,7 @@ func BindJson(c *gin.Context, obj interface{}) error {
 
 func BindQuery(c *gin.Context, obj interface{}) error {
 	if err := c.ShouldBindQuery(obj); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "Invalid request format",
-		})
+		writeError(c, bindError(err))
 		return err
 	}
 	return nil
@@ -28,10 +34,87 @@ func BindQuery(c *gin.Context, obj interface{}) error {
 
 func BindUri(c *gin.Context, obj interface{}) error {
 	if err := c.ShouldBindUri(obj); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "Invalid request format",
-		})
+		writeError(c, bindError(err))
 		return err
 	}
 	return nil
 }
+
+// parseUUIDParam 解析路徑中的 UUID 參數，失敗時直接回應 400
+func parseUUIDParam(c *gin.Context, name string, message string) (uuid.UUID, bool) {
+	id, err := uuid.Parse(c.Param(name))
+	if err != nil {
+		writeError(c, invalidInput(message))
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+// respondError 將 service 回傳的錯誤依 sentinel error 對應為狀態碼及錯誤碼後回應；
+// 4xx 記為 warn、5xx 記為 error，所有 handler 共用同一份對應
+func respondError(c *gin.Context, err error, operation string) {
+	appErr := apperrors.From(err)
+	log := logger.WithContext(c, logger.Handler).With(zap.String("operation", operation), zap.String("code", appErr.Code), zap.Error(err))
+	if appErr.Status >= http.StatusInternalServerError {
+		log.Error("Unexpected error")
+	} else {
+		log.Warn(appErr.Message)
+	}
+	writeError(c, appErr)
+}
+
+// writeError 以統一的錯誤格式回應，並附上 request id 供回報問題時查詢日誌
+func writeError(c *gin.Context, appErr *apperrors.AppError) {
+	c.JSON(appErr.Status, apperrors.ErrorResponse{Error: appErr, RequestID: logger.RequestID(c)})
+}
+
+// RegisterFallbackRoutes 不存在的路徑及不支援的 HTTP 方法也以統一的錯誤格式回應
+func RegisterFallbackRoutes(r *gin.Engine) {
+	r.HandleMethodNotAllowed = true
+	r.NoRoute(func(c *gin.Context) {
+		writeError(c, apperrors.New(http.StatusNotFound, apperrors.CodeNotFound, "Route not found"))
+	})
+	r.NoMethod(func(c *gin.Context) {
+		writeError(c, apperrors.New(http.StatusMethodNotAllowed, apperrors.CodeMethodNotAllowed, "Method not allowed"))
+	})
+}
+
+// invalidInput handler 自行檢查請求時的 400 錯誤
+func invalidInput(message string) *apperrors.AppError {
+	return apperrors.New(http.StatusBadRequest, apperrors.CodeInvalidInput, message)
+}
+
+// cacheableJSON 以內容雜湊產生弱 ETag 並設定短效 Cache-Control；
+// If-None-Match 命中時回應 304 而不送出內容
+func cacheableJSON(c *gin.Context, maxAge time.Duration, obj interface{}) {
+	body, err := json.Marshal(obj)
+	if err != nil {
+		respondError(c, err, "cacheableJSON")
+		return
+	}
+	sum := sha256.Sum256(body)
+	etag := fmt.Sprintf(`W/"%s"`, hex.EncodeToString(sum[:8]))
+
+	c.Header("Cache-Control", fmt.Sprintf("public, max-age=%d", int(maxAge.Seconds())))
+	c.Header("ETag", etag)
+	if etagMatches(c.GetHeader("If-None-Match"), etag) {
+		c.Status(http.StatusNotModified)
+		return
+	}
+	c.Data(http.StatusOK, "application/json; charset=utf-8", body)
+}
+
+// etagMatches 依 RFC 9110 的弱比較判斷 If-None-Match 是否命中
+func etagMatches(ifNoneMatch string, etag string) bool {
+	if ifNoneMatch == "" {
+		return false
+	}
+	target := strings.TrimPrefix(etag, "W/")
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == target {
+			return true
+		}
+	}
+	return false
+}
@@ -1,15 +1,14 @@
 package handler
 
 import (
+	"fmt"
 	"go-gin-high-concurrency/internal/model"
 	"go-gin-high-concurrency/internal/service"
-	apperrors "go-gin-high-concurrency/pkg/app_errors"
-	"go-gin-high-concurrency/pkg/logger"
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
-	"go.uber.org/zap"
 )
 
 type EventHandler struct {
@@ -28,25 +27,32 @@ func (h *EventHandler) RegisterRoutes(r *gin.Engine) {
 		router.POST("events", h.Create)
 		router.PUT("events/:uuid", h.UpdateByEventID)
 		router.POST("events/:uuid/open-for-sale", h.OpenForSale)
+		router.GET("events/:uuid/stock/stream", h.StreamStock)
+		router.GET("events/:uuid/availability", h.ListAvailability)
 	}
 }
 
+// stockStreamHeartbeat SSE 心跳間隔，避免閒置連線被代理伺服器切斷
+const stockStreamHeartbeat = 15 * time.Second
+
 // CreateEventRequest 建立活動請求
 type CreateEventRequest struct {
 	Name        string  `json:"name" binding:"required"`
 	Description *string `json:"description"`
+	MaxPerUser  *int    `json:"max_per_user" binding:"omitempty,min=1"` // 每人跨票種的購買上限，未帶入為不限
 }
 
 // UpdateEventRequest 更新活動請求
 type UpdateEventRequest struct {
 	Name        *string `json:"name"`
 	Description *string `json:"description"`
+	MaxPerUser  *int    `json:"max_per_user" binding:"omitempty,min=0"` // 0 為取消上限
 }
 
 func (h *EventHandler) List(c *gin.Context) {
 	events, err := h.service.List(c)
 	if err != nil {
-		h.handleError(c, err, "List")
+		respondError(c, err, "List")
 		return
 	}
 	c.JSON(http.StatusOK, events)
@@ -56,12 +62,12 @@ func (h *EventHandler) GetByEventID(c *gin.Context) {
 	uuidStr := c.Param("uuid")
 	eventID, err := uuid.Parse(uuidStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid event uuid"})
+		writeError(c, invalidInput("Invalid event uuid"))
 		return
 	}
 	event, err := h.service.GetByEventID(c, eventID)
 	if err != nil {
-		h.handleError(c, err, "GetByEventID")
+		respondError(c, err, "GetByEventID")
 		return
 	}
 	c.JSON(http.StatusOK, event)
@@ -75,10 +81,11 @@ func (h *EventHandler) Create(c *gin.Context) {
 	event := &model.Event{
 		Name:        req.Name,
 		Description: req.Description,
+		MaxPerUser:  req.MaxPerUser,
 	}
 	created, err := h.service.Create(c, event)
 	if err != nil {
-		h.handleError(c, err, "Create")
+		respondError(c, err, "Create")
 		return
 	}
 	c.JSON(http.StatusCreated, created)
@@ -88,24 +95,25 @@ func (h *EventHandler) UpdateByEventID(c *gin.Context) {
 	uuidStr := c.Param("uuid")
 	eventID, err := uuid.Parse(uuidStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid event uuid"})
+		writeError(c, invalidInput("Invalid event uuid"))
 		return
 	}
 	var req UpdateEventRequest
 	if err := BindJson(c, &req); err != nil {
 		return
 	}
-	if req.Name == nil && req.Description == nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "At least one of name or description is required"})
+	if req.Name == nil && req.Description == nil && req.MaxPerUser == nil {
+		writeError(c, invalidInput("At least one of name, description or max_per_user is required"))
 		return
 	}
 	params := model.UpdateEventParams{
 		Name:        req.Name,
 		Description: req.Description,
+		MaxPerUser:  req.MaxPerUser,
 	}
 	updated, err := h.service.UpdateByEventID(c, eventID, params)
 	if err != nil {
-		h.handleError(c, err, "UpdateByEventID")
+		respondError(c, err, "UpdateByEventID")
 		return
 	}
 	c.JSON(http.StatusOK, updated)
@@ -116,27 +124,75 @@ func (h *EventHandler) OpenForSale(c *gin.Context) {
 	uuidStr := c.Param("uuid")
 	eventID, err := uuid.Parse(uuidStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid event uuid"})
+		writeError(c, invalidInput("Invalid event uuid"))
 		return
 	}
 	if err := h.service.OpenForSale(c, eventID); err != nil {
-		h.handleError(c, err, "OpenForSale")
+		respondError(c, err, "OpenForSale")
 		return
 	}
 	c.JSON(http.StatusOK, gin.H{"message": "event opened for sale"})
 }
 
-func (h *EventHandler) handleError(c *gin.Context, err error, operation string) {
-	log := logger.Handler.With(zap.String("operation", operation), zap.Error(err))
-	switch {
-	case err == apperrors.ErrEventNotFound:
-		log.Warn("Event not found")
-		c.JSON(http.StatusNotFound, gin.H{"error": "Event not found"})
-	case err == apperrors.ErrInvalidInput:
-		log.Warn("Invalid input")
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input"})
-	default:
-		log.Error("Unexpected error")
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+// ListAvailability 活動底下所有票種的即時庫存，可被短暫快取
+func (h *EventHandler) ListAvailability(c *gin.Context) {
+	eventID, ok := parseUUIDParam(c, "uuid", "Invalid event uuid")
+	if !ok {
+		return
+	}
+	availability, err := h.service.ListAvailability(c, eventID)
+	if err != nil {
+		respondError(c, err, "ListAvailability")
+		return
+	}
+	cacheableJSON(c, availabilityMaxAge, availability)
+}
+
+// StreamStock 以 SSE 推送活動底下各票種的即時庫存（資料來源為 Redis）：
+// 每次變動送出 stock 事件；票種狀態變為 low_stock / sold_out 時額外送出同名事件
+func (h *EventHandler) StreamStock(c *gin.Context) {
+	uuidStr := c.Param("uuid")
+	eventID, err := uuid.Parse(uuidStr)
+	if err != nil {
+		writeError(c, invalidInput("Invalid event uuid"))
+		return
+	}
+	ctx := c.Request.Context()
+	stocks, err := h.service.SubscribeStock(ctx, eventID)
+	if err != nil {
+		respondError(c, err, "StreamStock")
+		return
+	}
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+	c.Writer.Flush()
+
+	heartbeat := time.NewTicker(stockStreamHeartbeat)
+	defer heartbeat.Stop()
+
+	lastStatus := make(map[uuid.UUID]model.StockStatus)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-heartbeat.C:
+			fmt.Fprint(c.Writer, ": ping\n\n")
+			c.Writer.Flush()
+		case stock, ok := <-stocks:
+			if !ok {
+				return
+			}
+			c.SSEvent("stock", stock)
+			prev, seen := lastStatus[stock.TicketID]
+			if stock.Status != model.StockStatusAvailable && (!seen || prev != stock.Status) {
+				c.SSEvent(string(stock.Status), stock)
+			}
+			lastStatus[stock.TicketID] = stock.Status
+			c.Writer.Flush()
+		}
 	}
 }
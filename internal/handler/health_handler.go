@@ -0,0 +1,40 @@
+package handler
+
+import (
+	"go-gin-high-concurrency/internal/model"
+	"go-gin-high-concurrency/internal/service"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type HealthHandler struct {
+	service service.HealthService
+}
+
+func NewHealthHandler(service service.HealthService) *HealthHandler {
+	return &HealthHandler{service: service}
+}
+
+func (h *HealthHandler) RegisterRoutes(r *gin.Engine) {
+	r.GET("/healthz", h.Liveness)
+	r.GET("/readyz", h.Readiness)
+}
+
+// Liveness 存活檢查，失敗時回傳 503
+func (h *HealthHandler) Liveness(c *gin.Context) {
+	h.respond(c, h.service.Liveness(c))
+}
+
+// Readiness 就緒檢查，相依異常或關閉中時回傳 503
+func (h *HealthHandler) Readiness(c *gin.Context) {
+	h.respond(c, h.service.Readiness(c))
+}
+
+func (h *HealthHandler) respond(c *gin.Context, report *model.HealthReport) {
+	status := http.StatusOK
+	if report.Status != model.HealthStatusOK {
+		status = http.StatusServiceUnavailable
+	}
+	c.JSON(status, report)
+}
@@ -0,0 +1,57 @@
+package handler
+
+import (
+	"go-gin-high-concurrency/internal/middleware"
+	"go-gin-high-concurrency/internal/model"
+	"go-gin-high-concurrency/internal/service"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type HoldHandler struct {
+	service service.HoldService
+}
+
+func NewHoldHandler(service service.HoldService) *HoldHandler {
+	return &HoldHandler{service: service}
+}
+
+func (h *HoldHandler) RegisterRoutes(r *gin.Engine) {
+	router := r.Group("/api/v1")
+	{
+		router.POST("holds", h.CreateHold)
+		router.POST("holds/:uuid/release", h.ReleaseHold)
+	}
+}
+
+func (h *HoldHandler) CreateHold(c *gin.Context) {
+	var req model.CreateHoldRequest
+	if err := BindJson(c, &req); err != nil {
+		return
+	}
+	middleware.SetUserID(c, req.UserID)
+	hold, err := h.service.CreateHold(c, req)
+	if err != nil {
+		respondError(c, err, "CreateHold")
+		return
+	}
+	c.JSON(http.StatusCreated, hold)
+}
+
+func (h *HoldHandler) ReleaseHold(c *gin.Context) {
+	holdID, ok := parseUUIDParam(c, "uuid", "Invalid hold uuid")
+	if !ok {
+		return
+	}
+	var req model.ReleaseHoldRequest
+	if err := BindJson(c, &req); err != nil {
+		return
+	}
+	middleware.SetUserID(c, req.UserID)
+	if err := h.service.ReleaseHold(c, holdID, req.UserID); err != nil {
+		respondError(c, err, "ReleaseHold")
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,53 @@
+package handler
+
+import (
+	"encoding/json"
+	"go-gin-high-concurrency/pkg/openapi"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// swaggerUIPage 從 CDN 載入 Swagger UI 顯示 /openapi.json
+const swaggerUIPage = `<!DOCTYPE html>
+<html lang="en">
+<head>
+  <meta charset="utf-8">
+  <title>API Docs</title>
+  <link rel="stylesheet" href="https://unpkg.com/swagger-ui-dist@5/swagger-ui.css">
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="https://unpkg.com/swagger-ui-dist@5/swagger-ui-bundle.js" crossorigin></script>
+  <script>
+    window.onload = () => { window.ui = SwaggerUIBundle({ url: "/openapi.json", dom_id: "#swagger-ui" }); };
+  </script>
+</body>
+</html>`
+
+// OpenAPIHandler 提供 OpenAPI 文件（/openapi.json）及 Swagger UI（/docs）
+type OpenAPIHandler struct {
+	spec []byte
+}
+
+// NewOpenAPIHandler 文件於建立時序列化一次，之後每個請求直接回應
+func NewOpenAPIHandler(doc *openapi.Document) (*OpenAPIHandler, error) {
+	spec, err := json.Marshal(doc)
+	if err != nil {
+		return nil, err
+	}
+	return &OpenAPIHandler{spec: spec}, nil
+}
+
+func (h *OpenAPIHandler) RegisterRoutes(r *gin.Engine) {
+	r.GET("/openapi.json", h.GetSpec)
+	r.GET("/docs", h.SwaggerUI)
+}
+
+func (h *OpenAPIHandler) GetSpec(c *gin.Context) {
+	c.Data(http.StatusOK, "application/json; charset=utf-8", h.spec)
+}
+
+func (h *OpenAPIHandler) SwaggerUI(c *gin.Context) {
+	c.Data(http.StatusOK, "text/html; charset=utf-8", []byte(swaggerUIPage))
+}
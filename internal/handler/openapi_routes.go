@@ -0,0 +1,220 @@
+package handler
+
+import (
+	"go-gin-high-concurrency/config"
+	"go-gin-high-concurrency/internal/model"
+	apperrors "go-gin-high-concurrency/pkg/app_errors"
+	"go-gin-high-concurrency/pkg/openapi"
+	"net/http"
+)
+
+// openAPIInfo API 文件的標題及版本；API 有不相容的變更時調整版本
+var openAPIInfo = openapi.Info{
+	Title:       "go-gin-high-concurrency API",
+	Description: "High-concurrency ticketing API. Every error response uses the same envelope; the X-Request-ID response header identifies the request in the logs.",
+	Version:     "1.0.0",
+}
+
+// waitlistEntryQuery GetWaitlistEntry 的 query 參數
+type waitlistEntryQuery struct {
+	UserID int `form:"user_id" binding:"required,min=1"`
+}
+
+// messageResponse 只有訊息的回應，例如活動開賣
+type messageResponse struct {
+	Message string `json:"message"`
+}
+
+// allowlistResponse 預售名單
+type allowlistResponse struct {
+	UserIDs []int `json:"user_ids"`
+}
+
+// OpenAPIDocument 產生所有 handler RegisterRoutes 註冊的路由的 OpenAPI 文件；
+// 新增或調整路由時須同步更新 OpenAPIRoutes，否則測試會失敗
+func OpenAPIDocument() (*openapi.Document, error) {
+	return openapi.NewBuilder(openAPIInfo, apperrors.ErrorResponse{}).Add(OpenAPIRoutes()...).Build()
+}
+
+// OpenAPIRoutes 各 handler 路由的請求及回應格式
+func OpenAPIRoutes() []openapi.Route {
+	ok := func(body any) openapi.Reply { return openapi.Reply{Status: http.StatusOK, Body: body} }
+	created := func(body any) openapi.Reply { return openapi.Reply{Status: http.StatusCreated, Body: body} }
+	noContent := openapi.Reply{Status: http.StatusNoContent}
+	notModified := openapi.Reply{Status: http.StatusNotModified, Description: "ETag matched If-None-Match"}
+	ifNoneMatch := map[string]string{"If-None-Match": "ETag from a previous response"}
+
+	return []openapi.Route{
+		// Orders
+		{Method: http.MethodGet, Path: "/api/v1/orders", Tag: "Orders", Summary: "List orders",
+			Replies: []openapi.Reply{ok([]*model.Order{})}},
+		{Method: http.MethodGet, Path: "/api/v1/orders/:uuid", Tag: "Orders", Summary: "Get an order",
+			Replies: []openapi.Reply{ok(model.Order{})}},
+		{Method: http.MethodPost, Path: "/api/v1/orders", Tag: "Orders", Summary: "Create an order",
+			Description: "Stock is reserved immediately and the order is persisted asynchronously; the returned order is pending.",
+			Headers:     map[string]string{DeviceFingerprintHeader: "Device fingerprint used by the risk check"},
+			Request:     model.CreateOrderRequest{},
+			Replies:     []openapi.Reply{created(model.Order{})}},
+		{Method: http.MethodPut, Path: "/api/v1/orders/:uuid/confirm", Tag: "Orders", Summary: "Confirm an order",
+			Request: model.UpdateOrderStatusRequest{}, RequestOptional: true,
+			Replies: []openapi.Reply{{Status: http.StatusOK}}},
+		{Method: http.MethodPut, Path: "/api/v1/orders/:uuid/cancel", Tag: "Orders", Summary: "Cancel an order",
+			Request: model.UpdateOrderStatusRequest{}, RequestOptional: true,
+			Replies: []openapi.Reply{{Status: http.StatusOK}}},
+		{Method: http.MethodPut, Path: "/api/v1/orders/:uuid/expire", Tag: "Orders", Summary: "Expire a pending order",
+			Description: "Releases the reserved stock or seats; actor defaults to system.",
+			Request:     model.UpdateOrderStatusRequest{}, RequestOptional: true,
+			Replies: []openapi.Reply{{Status: http.StatusOK}}},
+		{Method: http.MethodPut, Path: "/api/v1/orders/:uuid/refund", Tag: "Orders", Summary: "Refund a confirmed order",
+			Description: "Returns the tickets to stock and releases any seats, promo code and access code use.",
+			Request:     model.UpdateOrderStatusRequest{}, RequestOptional: true,
+			Replies: []openapi.Reply{{Status: http.StatusOK}}},
+		{Method: http.MethodGet, Path: "/api/v1/orders/:uuid/history", Tag: "Orders", Summary: "List order status changes",
+			Replies: []openapi.Reply{ok([]*model.OrderStatusHistory{})}},
+
+		// Events
+		{Method: http.MethodGet, Path: "/api/v1/events", Tag: "Events", Summary: "List events",
+			Replies: []openapi.Reply{ok([]*model.Event{})}},
+		{Method: http.MethodGet, Path: "/api/v1/events/:uuid", Tag: "Events", Summary: "Get an event",
+			Replies: []openapi.Reply{ok(model.Event{})}},
+		{Method: http.MethodPost, Path: "/api/v1/events", Tag: "Events", Summary: "Create an event",
+			Request: CreateEventRequest{},
+			Replies: []openapi.Reply{created(model.Event{})}},
+		{Method: http.MethodPut, Path: "/api/v1/events/:uuid", Tag: "Events", Summary: "Update an event",
+			Description: "At least one field is required; max_per_user 0 removes the limit.",
+			Request:     UpdateEventRequest{},
+			Replies:     []openapi.Reply{ok(model.Event{})}},
+		{Method: http.MethodPost, Path: "/api/v1/events/:uuid/open-for-sale", Tag: "Events", Summary: "Open an event for sale",
+			Description: "Warms up the Redis stock of every ticket of the event.",
+			Replies:     []openapi.Reply{ok(messageResponse{})}},
+		{Method: http.MethodGet, Path: "/api/v1/events/:uuid/stock/stream", Tag: "Events", Summary: "Stream ticket stock (SSE)",
+			Description: "Server-sent events: a stock event on every change, plus low_stock / sold_out events when a ticket changes status.",
+			Replies:     []openapi.Reply{{Status: http.StatusOK, Body: model.TicketStock{}, ContentType: "text/event-stream"}}},
+		{Method: http.MethodGet, Path: "/api/v1/events/:uuid/availability", Tag: "Events", Summary: "List ticket availability of an event",
+			Headers: ifNoneMatch,
+			Replies: []openapi.Reply{ok([]*model.TicketResponse{}), notModified}},
+
+		// Tickets
+		{Method: http.MethodGet, Path: "/api/v1/tickets", Tag: "Tickets", Summary: "List tickets",
+			Replies: []openapi.Reply{ok([]*model.Ticket{})}},
+		{Method: http.MethodGet, Path: "/api/v1/tickets/:uuid", Tag: "Tickets", Summary: "Get a ticket",
+			Replies: []openapi.Reply{ok(model.Ticket{})}},
+		{Method: http.MethodGet, Path: "/api/v1/tickets/:uuid/availability", Tag: "Tickets", Summary: "Get ticket availability",
+			Headers: ifNoneMatch,
+			Replies: []openapi.Reply{ok(model.TicketResponse{}), notModified}},
+		{Method: http.MethodPost, Path: "/api/v1/tickets", Tag: "Tickets", Summary: "Create a ticket",
+			Request: CreateTicketRequest{},
+			Replies: []openapi.Reply{created(model.Ticket{})}},
+		{Method: http.MethodPut, Path: "/api/v1/tickets/:uuid", Tag: "Tickets", Summary: "Update a ticket",
+			Request: UpdateTicketRequest{},
+			Replies: []openapi.Reply{ok(model.Ticket{})}},
+		{Method: http.MethodDelete, Path: "/api/v1/tickets/:uuid", Tag: "Tickets", Summary: "Delete a ticket",
+			Replies: []openapi.Reply{noContent}},
+		{Method: http.MethodPost, Path: "/api/v1/tickets/:uuid/stock", Tag: "Tickets", Summary: "Adjust ticket stock",
+			Request: model.AdjustStockRequest{},
+			Replies: []openapi.Reply{ok(model.InventoryAdjustment{})}},
+		{Method: http.MethodGet, Path: "/api/v1/tickets/:uuid/stock/adjustments", Tag: "Tickets", Summary: "List stock adjustments",
+			Replies: []openapi.Reply{ok([]*model.InventoryAdjustment{})}},
+		{Method: http.MethodGet, Path: "/api/v1/tickets/:uuid/price-phases", Tag: "Tickets", Summary: "List price phases",
+			Replies: []openapi.Reply{ok([]*model.TicketPricePhase{})}},
+		{Method: http.MethodPut, Path: "/api/v1/tickets/:uuid/price-phases", Tag: "Tickets", Summary: "Replace price phases",
+			Request: model.SetPricePhasesRequest{},
+			Replies: []openapi.Reply{ok([]*model.TicketPricePhase{})}},
+
+		// Seating
+		{Method: http.MethodPost, Path: "/api/v1/venues", Tag: "Seating", Summary: "Create a venue",
+			Request: CreateVenueRequest{},
+			Replies: []openapi.Reply{created(model.Venue{})}},
+		{Method: http.MethodGet, Path: "/api/v1/venues/:uuid", Tag: "Seating", Summary: "Get a venue",
+			Replies: []openapi.Reply{ok(model.Venue{})}},
+		{Method: http.MethodGet, Path: "/api/v1/tickets/:uuid/seats", Tag: "Seating", Summary: "List seat availability",
+			Headers: ifNoneMatch,
+			Replies: []openapi.Reply{ok([]*model.SeatAvailability{}), notModified}},
+		{Method: http.MethodPost, Path: "/api/v1/tickets/:uuid/seats/hold", Tag: "Seating", Summary: "Hold seats",
+			Request: SeatHoldRequest{},
+			Replies: []openapi.Reply{ok(model.SeatHold{})}},
+		{Method: http.MethodPost, Path: "/api/v1/tickets/:uuid/seats/release", Tag: "Seating", Summary: "Release held seats",
+			Request: SeatHoldRequest{},
+			Replies: []openapi.Reply{noContent}},
+
+		// Holds
+		{Method: http.MethodPost, Path: "/api/v1/holds", Tag: "Holds", Summary: "Hold tickets",
+			Request: model.CreateHoldRequest{},
+			Replies: []openapi.Reply{created(model.TicketHold{})}},
+		{Method: http.MethodPost, Path: "/api/v1/holds/:uuid/release", Tag: "Holds", Summary: "Release a hold",
+			Request: model.ReleaseHoldRequest{},
+			Replies: []openapi.Reply{noContent}},
+
+		// Waitlist
+		{Method: http.MethodPost, Path: "/api/v1/tickets/:uuid/waitlist", Tag: "Waitlist", Summary: "Join the waitlist",
+			Request: model.JoinWaitlistRequest{},
+			Replies: []openapi.Reply{created(model.WaitlistEntry{})}},
+		{Method: http.MethodGet, Path: "/api/v1/tickets/:uuid/waitlist", Tag: "Waitlist", Summary: "Get a waitlist entry",
+			Query:   waitlistEntryQuery{},
+			Replies: []openapi.Reply{ok(model.WaitlistEntry{})}},
+		{Method: http.MethodPost, Path: "/api/v1/tickets/:uuid/waitlist/leave", Tag: "Waitlist", Summary: "Leave the waitlist",
+			Request: model.LeaveWaitlistRequest{},
+			Replies: []openapi.Reply{noContent}},
+
+		// Promo codes
+		{Method: http.MethodGet, Path: "/api/v1/promo-codes", Tag: "Promo codes", Summary: "List promo codes",
+			Replies: []openapi.Reply{ok([]*model.PromoCode{})}},
+		{Method: http.MethodPost, Path: "/api/v1/promo-codes", Tag: "Promo codes", Summary: "Create a promo code",
+			Request: CreatePromoCodeRequest{},
+			Replies: []openapi.Reply{created(model.PromoCode{})}},
+		{Method: http.MethodGet, Path: "/api/v1/promo-codes/:code", Tag: "Promo codes", Summary: "Get a promo code",
+			Replies: []openapi.Reply{ok(model.PromoCode{})}},
+		{Method: http.MethodGet, Path: "/api/v1/promo-codes/:code/redemptions", Tag: "Promo codes", Summary: "List promo code redemptions",
+			Replies: []openapi.Reply{ok([]*model.PromoRedemption{})}},
+
+		// Presale
+		{Method: http.MethodGet, Path: "/api/v1/tickets/:uuid/presale/access-codes", Tag: "Presale", Summary: "List presale access codes",
+			Replies: []openapi.Reply{ok([]*model.PresaleAccessCode{})}},
+		{Method: http.MethodPost, Path: "/api/v1/tickets/:uuid/presale/access-codes", Tag: "Presale", Summary: "Create a presale access code",
+			Request: model.CreateAccessCodeRequest{},
+			Replies: []openapi.Reply{created(model.PresaleAccessCode{})}},
+		{Method: http.MethodGet, Path: "/api/v1/tickets/:uuid/presale/allowlist", Tag: "Presale", Summary: "List the presale allow-list",
+			Replies: []openapi.Reply{ok(allowlistResponse{})}},
+		{Method: http.MethodPost, Path: "/api/v1/tickets/:uuid/presale/allowlist", Tag: "Presale", Summary: "Add users to the presale allow-list",
+			Request: model.PresaleAllowlistRequest{},
+			Replies: []openapi.Reply{noContent}},
+		{Method: http.MethodDelete, Path: "/api/v1/tickets/:uuid/presale/allowlist/:user_id", Tag: "Presale", Summary: "Remove a user from the presale allow-list",
+			Replies: []openapi.Reply{noContent}},
+
+		// Risk
+		{Method: http.MethodGet, Path: "/api/v1/events/:uuid/orders/flagged", Tag: "Risk", Summary: "List orders flagged by the risk check",
+			Replies: []openapi.Reply{ok([]*model.Order{})}},
+
+		// Webhooks
+		{Method: http.MethodGet, Path: "/api/v1/events/:uuid/webhooks", Tag: "Webhooks", Summary: "List webhook subscriptions of an event",
+			Replies: []openapi.Reply{ok([]*model.WebhookSubscription{})}},
+		{Method: http.MethodPost, Path: "/api/v1/events/:uuid/webhooks", Tag: "Webhooks", Summary: "Create a webhook subscription",
+			Description: "The signing secret is only returned once, in this response.",
+			Request:     CreateWebhookRequest{},
+			Replies:     []openapi.Reply{created(CreateWebhookResponse{})}},
+		{Method: http.MethodGet, Path: "/api/v1/webhooks/:uuid", Tag: "Webhooks", Summary: "Get a webhook subscription",
+			Replies: []openapi.Reply{ok(model.WebhookSubscription{})}},
+		{Method: http.MethodPut, Path: "/api/v1/webhooks/:uuid", Tag: "Webhooks", Summary: "Update a webhook subscription",
+			Request: UpdateWebhookRequest{},
+			Replies: []openapi.Reply{ok(model.WebhookSubscription{})}},
+		{Method: http.MethodDelete, Path: "/api/v1/webhooks/:uuid", Tag: "Webhooks", Summary: "Delete a webhook subscription",
+			Replies: []openapi.Reply{noContent}},
+		{Method: http.MethodGet, Path: "/api/v1/webhooks/:uuid/deliveries", Tag: "Webhooks", Summary: "List webhook deliveries",
+			Replies: []openapi.Reply{ok([]*model.WebhookDelivery{})}},
+		{Method: http.MethodPost, Path: "/api/v1/webhooks/:uuid/deliveries/:delivery_uuid/redeliver", Tag: "Webhooks", Summary: "Redeliver a webhook delivery",
+			Replies: []openapi.Reply{{Status: http.StatusAccepted, Body: model.WebhookDelivery{}}}},
+
+		// Health
+		{Method: http.MethodGet, Path: "/healthz", Tag: "Health", Summary: "Liveness check",
+			Replies: []openapi.Reply{ok(model.HealthReport{}), {Status: http.StatusServiceUnavailable, Body: model.HealthReport{}}}},
+		{Method: http.MethodGet, Path: "/readyz", Tag: "Health", Summary: "Readiness check",
+			Replies: []openapi.Reply{ok(model.HealthReport{}), {Status: http.StatusServiceUnavailable, Body: model.HealthReport{}}}},
+
+		// Admin
+		{Method: http.MethodGet, Path: "/api/v1/admin/settings", Tag: "Admin", Summary: "Get runtime settings",
+			Replies: []openapi.Reply{ok(config.RuntimeSettings{})}},
+		{Method: http.MethodPost, Path: "/api/v1/admin/settings/reload", Tag: "Admin", Summary: "Reload runtime settings",
+			Description: "Reloads configuration from the startup sources; responds 422 and keeps the current settings when the new configuration is invalid.",
+			Replies:     []openapi.Reply{ok(config.RuntimeSettings{})}},
+	}
+}
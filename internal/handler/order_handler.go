@@ -1,18 +1,17 @@
 package handler
 
 import (
-	"errors"
+	"go-gin-high-concurrency/internal/middleware"
 	"go-gin-high-concurrency/internal/model"
 	"go-gin-high-concurrency/internal/service"
-	apperrors "go-gin-high-concurrency/pkg/app_errors"
-	"go-gin-high-concurrency/pkg/logger"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
-	"github.com/google/uuid"
-	"go.uber.org/zap"
 )
 
+// DeviceFingerprintHeader 前端帶入的裝置指紋，供下單風險評分使用
+const DeviceFingerprintHeader = "X-Device-Fingerprint"
+
 type OrderHandler struct {
 	service service.OrderService
 }
@@ -29,6 +28,9 @@ func (h *OrderHandler) RegisterRoutes(r *gin.Engine) {
 		router.POST("orders", h.CreateOrder)
 		router.PUT("orders/:uuid/confirm", h.ConfirmOrder)
 		router.PUT("orders/:uuid/cancel", h.CancelOrder)
+		router.PUT("orders/:uuid/expire", h.ExpireOrder)
+		router.PUT("orders/:uuid/refund", h.RefundOrder)
+		router.GET("orders/:uuid/history", h.GetOrderHistory)
 	}
 }
 
@@ -38,10 +40,14 @@ func (h *OrderHandler) CreateOrder(c *gin.Context) {
 	if err := BindJson(c, &orderReq); err != nil {
 		return
 	}
+	middleware.SetUserID(c, orderReq.UserID)
+	// 風險評分使用的請求特徵
+	orderReq.ClientIP = c.ClientIP()
+	orderReq.DeviceFingerprint = c.GetHeader(DeviceFingerprintHeader)
 
 	created, err := h.service.PrepareOrder(c, orderReq)
 	if err != nil {
-		h.handleOrderError(c, err, "CreateOrder")
+		respondError(c, err, "CreateOrder")
 		return
 	}
 
@@ -49,15 +55,13 @@ func (h *OrderHandler) CreateOrder(c *gin.Context) {
 }
 
 func (h *OrderHandler) GetOrder(c *gin.Context) {
-	uuidStr := c.Param("uuid")
-	orderID, err := uuid.Parse(uuidStr)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid order uuid"})
+	orderID, ok := parseUUIDParam(c, "uuid", "Invalid order uuid")
+	if !ok {
 		return
 	}
 	order, err := h.service.GetOrderByOrderID(c, orderID)
 	if err != nil {
-		h.handleOrderError(c, err, "GetOrder")
+		respondError(c, err, "GetOrder")
 		return
 	}
 
@@ -67,7 +71,7 @@ func (h *OrderHandler) GetOrder(c *gin.Context) {
 func (h *OrderHandler) GetOrders(c *gin.Context) {
 	orders, err := h.service.OrderList(c)
 	if err != nil {
-		h.handleOrderError(c, err, "GetOrders")
+		respondError(c, err, "GetOrders")
 		return
 	}
 
@@ -75,15 +79,17 @@ func (h *OrderHandler) GetOrders(c *gin.Context) {
 }
 
 func (h *OrderHandler) ConfirmOrder(c *gin.Context) {
-	uuidStr := c.Param("uuid")
-	orderID, err := uuid.Parse(uuidStr)
+	orderID, ok := parseUUIDParam(c, "uuid", "Invalid order uuid")
+	if !ok {
+		return
+	}
+	change, err := h.bindStatusChange(c, model.OrderActorUser)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid order uuid"})
 		return
 	}
-	err = h.service.ConfirmOrderByOrderID(c, orderID)
+	err = h.service.ConfirmOrderByOrderID(c, orderID, change)
 	if err != nil {
-		h.handleOrderError(c, err, "ConfirmOrder")
+		respondError(c, err, "ConfirmOrder")
 		return
 	}
 
@@ -91,57 +97,84 @@ func (h *OrderHandler) ConfirmOrder(c *gin.Context) {
 }
 
 func (h *OrderHandler) CancelOrder(c *gin.Context) {
-	uuidStr := c.Param("uuid")
-	orderID, err := uuid.Parse(uuidStr)
+	orderID, ok := parseUUIDParam(c, "uuid", "Invalid order uuid")
+	if !ok {
+		return
+	}
+	change, err := h.bindStatusChange(c, model.OrderActorUser)
+	if err != nil {
+		return
+	}
+	err = h.service.CancelOrderByOrderID(c, orderID, change)
+	if err != nil {
+		respondError(c, err, "CancelOrder")
+		return
+	}
+
+	h.handleOrderSuccess(c, nil, http.StatusOK)
+}
+
+func (h *OrderHandler) ExpireOrder(c *gin.Context) {
+	orderID, ok := parseUUIDParam(c, "uuid", "Invalid order uuid")
+	if !ok {
+		return
+	}
+	change, err := h.bindStatusChange(c, model.OrderActorSystem)
+	if err != nil {
+		return
+	}
+	err = h.service.ExpireOrderByOrderID(c, orderID, change)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid order uuid"})
+		respondError(c, err, "ExpireOrder")
+		return
+	}
+
+	h.handleOrderSuccess(c, nil, http.StatusOK)
+}
+
+func (h *OrderHandler) RefundOrder(c *gin.Context) {
+	orderID, ok := parseUUIDParam(c, "uuid", "Invalid order uuid")
+	if !ok {
 		return
 	}
-	err = h.service.CancelOrderByOrderID(c, orderID)
+	change, err := h.bindStatusChange(c, model.OrderActorAdmin)
 	if err != nil {
-		h.handleOrderError(c, err, "CancelOrder")
+		return
+	}
+	err = h.service.RefundOrderByOrderID(c, orderID, change)
+	if err != nil {
+		respondError(c, err, "RefundOrder")
 		return
 	}
 
 	h.handleOrderSuccess(c, nil, http.StatusOK)
 }
 
+func (h *OrderHandler) GetOrderHistory(c *gin.Context) {
+	orderID, ok := parseUUIDParam(c, "uuid", "Invalid order uuid")
+	if !ok {
+		return
+	}
+	history, err := h.service.GetOrderStatusHistory(c, orderID)
+	if err != nil {
+		respondError(c, err, "GetOrderHistory")
+		return
+	}
+
+	h.handleOrderSuccess(c, history, http.StatusOK)
+}
+
 // Helper functions
 
-func (h *OrderHandler) handleOrderError(c *gin.Context, err error, operation string) {
-	log := logger.Handler.With(zap.String("operation", operation), zap.Error(err))
-	switch {
-	case errors.Is(err, apperrors.ErrInsufficientStock):
-		log.Warn("Insufficient stock")
-		c.JSON(http.StatusConflict, gin.H{
-			"error": "Insufficient stock",
-		})
-	case errors.Is(err, apperrors.ErrExceedsMaxPerUser):
-		log.Warn("Exceeds max per user")
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "Exceeds max per user",
-		})
-	case errors.Is(err, apperrors.ErrTicketNotFound):
-		log.Warn("Ticket not found")
-		c.JSON(http.StatusNotFound, gin.H{
-			"error": "Ticket not found",
-		})
-	case errors.Is(err, apperrors.ErrOrderNotFound):
-		log.Warn("Order not found")
-		c.JSON(http.StatusNotFound, gin.H{
-			"error": "Order not found",
-		})
-	case errors.Is(err, apperrors.ErrInvalidOrderStatus):
-		log.Warn("Invalid order status")
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "Invalid order status",
-		})
-	default:
-		log.Error("Unexpected error")
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "Internal server error",
-		})
+// bindStatusChange 解析訂單狀態變更的選填 body；執行者由各路由決定，不接受請求指定，避免竄改狀態紀錄
+func (h *OrderHandler) bindStatusChange(c *gin.Context, actor string) (model.OrderStatusChange, error) {
+	var req model.UpdateOrderStatusRequest
+	if c.Request.ContentLength != 0 {
+		if err := BindJson(c, &req); err != nil {
+			return model.OrderStatusChange{}, err
+		}
 	}
+	return model.OrderStatusChange{Actor: actor, Reason: req.Reason}, nil
 }
 
 func (h *OrderHandler) handleOrderSuccess(c *gin.Context, data interface{}, statusCode int) {
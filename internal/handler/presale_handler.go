@@ -0,0 +1,105 @@
+package handler
+
+import (
+	"go-gin-high-concurrency/internal/model"
+	"go-gin-high-concurrency/internal/service"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PresaleHandler struct {
+	service service.PresaleService
+}
+
+func NewPresaleHandler(service service.PresaleService) *PresaleHandler {
+	return &PresaleHandler{service: service}
+}
+
+func (h *PresaleHandler) RegisterRoutes(r *gin.Engine) {
+	router := r.Group("/api/v1")
+	{
+		router.GET("tickets/:uuid/presale/access-codes", h.ListAccessCodes)
+		router.POST("tickets/:uuid/presale/access-codes", h.CreateAccessCode)
+		router.GET("tickets/:uuid/presale/allowlist", h.ListAllowlist)
+		router.POST("tickets/:uuid/presale/allowlist", h.AddAllowlist)
+		router.DELETE("tickets/:uuid/presale/allowlist/:user_id", h.RemoveAllowlist)
+	}
+}
+
+func (h *PresaleHandler) ListAccessCodes(c *gin.Context) {
+	ticketID, ok := parseUUIDParam(c, "uuid", "Invalid ticket uuid")
+	if !ok {
+		return
+	}
+	accessCodes, err := h.service.ListAccessCodes(c, ticketID)
+	if err != nil {
+		respondError(c, err, "ListAccessCodes")
+		return
+	}
+	c.JSON(http.StatusOK, accessCodes)
+}
+
+func (h *PresaleHandler) CreateAccessCode(c *gin.Context) {
+	ticketID, ok := parseUUIDParam(c, "uuid", "Invalid ticket uuid")
+	if !ok {
+		return
+	}
+	var req model.CreateAccessCodeRequest
+	if err := BindJson(c, &req); err != nil {
+		return
+	}
+	accessCode, err := h.service.CreateAccessCode(c, ticketID, req)
+	if err != nil {
+		respondError(c, err, "CreateAccessCode")
+		return
+	}
+	c.JSON(http.StatusCreated, accessCode)
+}
+
+func (h *PresaleHandler) ListAllowlist(c *gin.Context) {
+	ticketID, ok := parseUUIDParam(c, "uuid", "Invalid ticket uuid")
+	if !ok {
+		return
+	}
+	userIDs, err := h.service.ListAllowlist(c, ticketID)
+	if err != nil {
+		respondError(c, err, "ListAllowlist")
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"user_ids": userIDs})
+}
+
+func (h *PresaleHandler) AddAllowlist(c *gin.Context) {
+	ticketID, ok := parseUUIDParam(c, "uuid", "Invalid ticket uuid")
+	if !ok {
+		return
+	}
+	var req model.PresaleAllowlistRequest
+	if err := BindJson(c, &req); err != nil {
+		return
+	}
+	if err := h.service.AddAllowlist(c, ticketID, req.UserIDs); err != nil {
+		respondError(c, err, "AddAllowlist")
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func (h *PresaleHandler) RemoveAllowlist(c *gin.Context) {
+	ticketID, ok := parseUUIDParam(c, "uuid", "Invalid ticket uuid")
+	if !ok {
+		return
+	}
+	userID, err := strconv.Atoi(c.Param("user_id"))
+	if err != nil || userID <= 0 {
+		writeError(c, invalidInput("Invalid user_id"))
+		return
+	}
+	if err := h.service.RemoveAllowlist(c, ticketID, userID); err != nil {
+		respondError(c, err, "RemoveAllowlist")
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
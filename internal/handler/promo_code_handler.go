@@ -0,0 +1,92 @@
+package handler
+
+import (
+	"go-gin-high-concurrency/internal/model"
+	"go-gin-high-concurrency/internal/service"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PromoCodeHandler struct {
+	service service.PromoCodeService
+}
+
+func NewPromoCodeHandler(service service.PromoCodeService) *PromoCodeHandler {
+	return &PromoCodeHandler{service: service}
+}
+
+func (h *PromoCodeHandler) RegisterRoutes(r *gin.Engine) {
+	router := r.Group("/api/v1")
+	{
+		router.GET("promo-codes", h.List)
+		router.POST("promo-codes", h.Create)
+		router.GET("promo-codes/:code", h.GetByCode)
+		router.GET("promo-codes/:code/redemptions", h.ListRedemptions)
+	}
+}
+
+// CreatePromoCodeRequest 建立優惠碼請求；event_id / ticket_id 限定適用範圍，未設定的使用次數限制為不限
+type CreatePromoCodeRequest struct {
+	Code           string             `json:"code" binding:"required,max=50"`
+	DiscountType   model.DiscountType `json:"discount_type" binding:"required,oneof=percentage fixed"`
+	DiscountValue  float64            `json:"discount_value" binding:"required,gt=0"`
+	EventID        *int               `json:"event_id"`
+	TicketID       *int               `json:"ticket_id"`
+	MaxRedemptions *int               `json:"max_redemptions" binding:"omitempty,min=1"`
+	MaxPerUser     *int               `json:"max_per_user" binding:"omitempty,min=1"`
+	StartsAt       *time.Time         `json:"starts_at"`
+	EndsAt         *time.Time         `json:"ends_at"`
+}
+
+func (h *PromoCodeHandler) List(c *gin.Context) {
+	promos, err := h.service.List(c)
+	if err != nil {
+		respondError(c, err, "List")
+		return
+	}
+	c.JSON(http.StatusOK, promos)
+}
+
+func (h *PromoCodeHandler) Create(c *gin.Context) {
+	var req CreatePromoCodeRequest
+	if err := BindJson(c, &req); err != nil {
+		return
+	}
+	promo := &model.PromoCode{
+		Code:           req.Code,
+		DiscountType:   req.DiscountType,
+		DiscountValue:  req.DiscountValue,
+		EventID:        req.EventID,
+		TicketID:       req.TicketID,
+		MaxRedemptions: req.MaxRedemptions,
+		MaxPerUser:     req.MaxPerUser,
+		StartsAt:       req.StartsAt,
+		EndsAt:         req.EndsAt,
+	}
+	created, err := h.service.Create(c, promo)
+	if err != nil {
+		respondError(c, err, "Create")
+		return
+	}
+	c.JSON(http.StatusCreated, created)
+}
+
+func (h *PromoCodeHandler) GetByCode(c *gin.Context) {
+	promo, err := h.service.GetByCode(c, c.Param("code"))
+	if err != nil {
+		respondError(c, err, "GetByCode")
+		return
+	}
+	c.JSON(http.StatusOK, promo)
+}
+
+func (h *PromoCodeHandler) ListRedemptions(c *gin.Context) {
+	redemptions, err := h.service.ListRedemptions(c, c.Param("code"))
+	if err != nil {
+		respondError(c, err, "ListRedemptions")
+		return
+	}
+	c.JSON(http.StatusOK, redemptions)
+}
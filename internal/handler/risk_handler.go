@@ -0,0 +1,36 @@
+package handler
+
+import (
+	"go-gin-high-concurrency/internal/service"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type RiskHandler struct {
+	service service.RiskService
+}
+
+func NewRiskHandler(service service.RiskService) *RiskHandler {
+	return &RiskHandler{service: service}
+}
+
+func (h *RiskHandler) RegisterRoutes(r *gin.Engine) {
+	router := r.Group("/api/v1")
+	{
+		router.GET("events/:uuid/orders/flagged", h.ListFlaggedOrders)
+	}
+}
+
+func (h *RiskHandler) ListFlaggedOrders(c *gin.Context) {
+	eventID, ok := parseUUIDParam(c, "uuid", "Invalid event uuid")
+	if !ok {
+		return
+	}
+	orders, err := h.service.ListFlaggedOrders(c, eventID)
+	if err != nil {
+		respondError(c, err, "ListFlaggedOrders")
+		return
+	}
+	c.JSON(http.StatusOK, orders)
+}
@@ -0,0 +1,139 @@
+package handler
+
+import (
+	"go-gin-high-concurrency/internal/middleware"
+	"go-gin-high-concurrency/internal/model"
+	"go-gin-high-concurrency/internal/service"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type SeatHandler struct {
+	service service.SeatService
+}
+
+func NewSeatHandler(service service.SeatService) *SeatHandler {
+	return &SeatHandler{service: service}
+}
+
+func (h *SeatHandler) RegisterRoutes(r *gin.Engine) {
+	router := r.Group("/api/v1")
+	{
+		router.POST("venues", h.CreateVenue)
+		router.GET("venues/:uuid", h.GetVenue)
+		router.GET("tickets/:uuid/seats", h.ListSeatAvailability)
+		router.POST("tickets/:uuid/seats/hold", h.HoldSeats)
+		router.POST("tickets/:uuid/seats/release", h.ReleaseSeats)
+	}
+}
+
+// CreateVenueRequest 建立場地請求：每個區域由多排座位組成，座號自 1 起編
+type CreateVenueRequest struct {
+	Name     string                      `json:"name" binding:"required"`
+	Sections []CreateVenueSectionRequest `json:"sections" binding:"required,min=1,dive"`
+}
+
+// CreateVenueSectionRequest 場地區域
+type CreateVenueSectionRequest struct {
+	Name string                  `json:"name" binding:"required"`
+	Rows []CreateVenueRowRequest `json:"rows" binding:"required,min=1,dive"`
+}
+
+// CreateVenueRowRequest 區域內的一排座位
+type CreateVenueRowRequest struct {
+	Label string `json:"label" binding:"required"`
+	Seats int    `json:"seats" binding:"required,min=1"`
+}
+
+// SeatHoldRequest 保留 / 釋放座位請求
+type SeatHoldRequest struct {
+	UserID  int   `json:"user_id" binding:"required"`
+	SeatIDs []int `json:"seat_ids" binding:"required,min=1"`
+	// 預售存取碼：僅保留時使用，預售票種的使用者不在名單內時必填
+	AccessCode string `json:"access_code,omitempty" binding:"omitempty,max=50"`
+}
+
+func (h *SeatHandler) CreateVenue(c *gin.Context) {
+	var req CreateVenueRequest
+	if err := BindJson(c, &req); err != nil {
+		return
+	}
+	venue := &model.Venue{Name: req.Name}
+	for _, s := range req.Sections {
+		section := &model.VenueSection{Name: s.Name}
+		for _, row := range s.Rows {
+			for number := 1; number <= row.Seats; number++ {
+				section.Seats = append(section.Seats, &model.Seat{Row: row.Label, Number: number})
+			}
+		}
+		venue.Sections = append(venue.Sections, section)
+	}
+	created, err := h.service.CreateVenue(c, venue)
+	if err != nil {
+		respondError(c, err, "CreateVenue")
+		return
+	}
+	c.JSON(http.StatusCreated, created)
+}
+
+func (h *SeatHandler) GetVenue(c *gin.Context) {
+	venueID, ok := parseUUIDParam(c, "uuid", "Invalid venue uuid")
+	if !ok {
+		return
+	}
+	venue, err := h.service.GetVenue(c, venueID)
+	if err != nil {
+		respondError(c, err, "GetVenue")
+		return
+	}
+	c.JSON(http.StatusOK, venue)
+}
+
+func (h *SeatHandler) ListSeatAvailability(c *gin.Context) {
+	ticketID, ok := parseUUIDParam(c, "uuid", "Invalid ticket uuid")
+	if !ok {
+		return
+	}
+	availability, err := h.service.ListSeatAvailability(c, ticketID)
+	if err != nil {
+		respondError(c, err, "ListSeatAvailability")
+		return
+	}
+	cacheableJSON(c, availabilityMaxAge, availability)
+}
+
+func (h *SeatHandler) HoldSeats(c *gin.Context) {
+	ticketID, ok := parseUUIDParam(c, "uuid", "Invalid ticket uuid")
+	if !ok {
+		return
+	}
+	var req SeatHoldRequest
+	if err := BindJson(c, &req); err != nil {
+		return
+	}
+	middleware.SetUserID(c, req.UserID)
+	hold, err := h.service.HoldSeats(c, ticketID, req.UserID, req.SeatIDs, req.AccessCode)
+	if err != nil {
+		respondError(c, err, "HoldSeats")
+		return
+	}
+	c.JSON(http.StatusOK, hold)
+}
+
+func (h *SeatHandler) ReleaseSeats(c *gin.Context) {
+	ticketID, ok := parseUUIDParam(c, "uuid", "Invalid ticket uuid")
+	if !ok {
+		return
+	}
+	var req SeatHoldRequest
+	if err := BindJson(c, &req); err != nil {
+		return
+	}
+	middleware.SetUserID(c, req.UserID)
+	if err := h.service.ReleaseSeats(c, ticketID, req.UserID, req.SeatIDs); err != nil {
+		respondError(c, err, "ReleaseSeats")
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
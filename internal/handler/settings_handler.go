@@ -0,0 +1,44 @@
+package handler
+
+import (
+	"go-gin-high-concurrency/config"
+	apperrors "go-gin-high-concurrency/pkg/app_errors"
+	"go-gin-high-concurrency/pkg/logger"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// SettingsHandler 查詢及重新載入可於執行中調整的設定
+type SettingsHandler struct {
+	reloader config.Reloader
+}
+
+func NewSettingsHandler(reloader config.Reloader) *SettingsHandler {
+	return &SettingsHandler{reloader: reloader}
+}
+
+func (h *SettingsHandler) RegisterRoutes(r *gin.Engine) {
+	router := r.Group("/api/v1/admin")
+	{
+		router.GET("settings", h.GetSettings)
+		router.POST("settings/reload", h.ReloadSettings)
+	}
+}
+
+func (h *SettingsHandler) GetSettings(c *gin.Context) {
+	c.JSON(http.StatusOK, h.reloader.Current())
+}
+
+// ReloadSettings 重新載入設定；設定無效時回傳 422 及驗證錯誤，並保留目前的設定
+func (h *SettingsHandler) ReloadSettings(c *gin.Context) {
+	settings, err := h.reloader.Reload()
+	if err != nil {
+		logger.WithContext(c, logger.Handler).Warn("Reload settings failed", zap.String("operation", "ReloadSettings"), zap.Error(err))
+		// 驗證錯誤只來自本機設定檔，訊息可直接回應給管理者
+		writeError(c, apperrors.New(http.StatusUnprocessableEntity, apperrors.CodeInvalidConfig, err.Error()).Wrap(err))
+		return
+	}
+	c.JSON(http.StatusOK, settings)
+}
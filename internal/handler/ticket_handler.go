@@ -3,13 +3,11 @@ package handler
 import (
 	"go-gin-high-concurrency/internal/model"
 	"go-gin-high-concurrency/internal/service"
-	apperrors "go-gin-high-concurrency/pkg/app_errors"
-	"go-gin-high-concurrency/pkg/logger"
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
-	"go.uber.org/zap"
 )
 
 type TicketHandler struct {
@@ -25,19 +23,30 @@ func (h *TicketHandler) RegisterRoutes(r *gin.Engine) {
 	{
 		router.GET("tickets", h.List)
 		router.GET("tickets/:uuid", h.GetByTicketID)
+		router.GET("tickets/:uuid/availability", h.GetAvailability)
 		router.POST("tickets", h.Create)
 		router.PUT("tickets/:uuid", h.UpdateByTicketID)
 		router.DELETE("tickets/:uuid", h.DeleteByTicketID)
+		router.POST("tickets/:uuid/stock", h.AdjustStock)
+		router.GET("tickets/:uuid/stock/adjustments", h.ListStockAdjustments)
+		router.GET("tickets/:uuid/price-phases", h.ListPricePhases)
+		router.PUT("tickets/:uuid/price-phases", h.SetPricePhases)
 	}
 }
 
-// CreateTicketRequest 建立票券請求
+// availabilityMaxAge 即時庫存允許被快取的時間，短到搶票時不會誤導使用者
+const availabilityMaxAge = 2 * time.Second
+
+// CreateTicketRequest 建立票券請求；指定 section_id 為對號座票種，庫存由區域座位數決定；
+// presale 為預售票種，僅接受名單內的使用者或帶有效存取碼的訂單
 type CreateTicketRequest struct {
 	EventID    int     `json:"event_id" binding:"required"`
 	Name       string  `json:"name" binding:"required"`
 	Price      float64 `json:"price" binding:"required"`
-	TotalStock int     `json:"total_stock" binding:"required"`
+	TotalStock int     `json:"total_stock" binding:"required_without=SectionID"`
 	MaxPerUser int     `json:"max_per_user" binding:"required"`
+	SectionID  *int    `json:"section_id"`
+	Presale    bool    `json:"presale"`
 }
 
 // UpdateTicketRequest 更新票券請求
@@ -50,7 +59,7 @@ type UpdateTicketRequest struct {
 func (h *TicketHandler) List(c *gin.Context) {
 	tickets, err := h.service.List(c)
 	if err != nil {
-		h.handleError(c, err, "List")
+		respondError(c, err, "List")
 		return
 	}
 	c.JSON(http.StatusOK, tickets)
@@ -60,17 +69,30 @@ func (h *TicketHandler) GetByTicketID(c *gin.Context) {
 	uuidStr := c.Param("uuid")
 	ticketID, err := uuid.Parse(uuidStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ticket uuid"})
+		writeError(c, invalidInput("Invalid ticket uuid"))
 		return
 	}
 	ticket, err := h.service.GetByTicketID(c, ticketID)
 	if err != nil {
-		h.handleError(c, err, "GetByTicketID")
+		respondError(c, err, "GetByTicketID")
 		return
 	}
 	c.JSON(http.StatusOK, ticket)
 }
 
+func (h *TicketHandler) GetAvailability(c *gin.Context) {
+	ticketID, ok := parseUUIDParam(c, "uuid", "Invalid ticket uuid")
+	if !ok {
+		return
+	}
+	availability, err := h.service.GetAvailability(c, ticketID)
+	if err != nil {
+		respondError(c, err, "GetAvailability")
+		return
+	}
+	cacheableJSON(c, availabilityMaxAge, availability)
+}
+
 func (h *TicketHandler) Create(c *gin.Context) {
 	var req CreateTicketRequest
 	if err := BindJson(c, &req); err != nil {
@@ -83,10 +105,12 @@ func (h *TicketHandler) Create(c *gin.Context) {
 		TotalStock:     req.TotalStock,
 		RemainingStock: req.TotalStock,
 		MaxPerUser:     req.MaxPerUser,
+		SectionID:      req.SectionID,
+		Presale:        req.Presale,
 	}
 	created, err := h.service.Create(c, ticket)
 	if err != nil {
-		h.handleError(c, err, "Create")
+		respondError(c, err, "Create")
 		return
 	}
 	c.JSON(http.StatusCreated, created)
@@ -96,7 +120,7 @@ func (h *TicketHandler) UpdateByTicketID(c *gin.Context) {
 	uuidStr := c.Param("uuid")
 	ticketID, err := uuid.Parse(uuidStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ticket uuid"})
+		writeError(c, invalidInput("Invalid ticket uuid"))
 		return
 	}
 	var req UpdateTicketRequest
@@ -104,7 +128,7 @@ func (h *TicketHandler) UpdateByTicketID(c *gin.Context) {
 		return
 	}
 	if req.Name == nil && req.Price == nil && req.MaxPerUser == nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "At least one of name, price or max_per_user is required"})
+		writeError(c, invalidInput("At least one of name, price or max_per_user is required"))
 		return
 	}
 	params := model.UpdateTicketParams{
@@ -114,7 +138,7 @@ func (h *TicketHandler) UpdateByTicketID(c *gin.Context) {
 	}
 	updated, err := h.service.UpdateByTicketID(c, ticketID, params)
 	if err != nil {
-		h.handleError(c, err, "UpdateByTicketID")
+		respondError(c, err, "UpdateByTicketID")
 		return
 	}
 	c.JSON(http.StatusOK, updated)
@@ -124,28 +148,73 @@ func (h *TicketHandler) DeleteByTicketID(c *gin.Context) {
 	uuidStr := c.Param("uuid")
 	ticketID, err := uuid.Parse(uuidStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ticket uuid"})
+		writeError(c, invalidInput("Invalid ticket uuid"))
 		return
 	}
 	err = h.service.DeleteByTicketID(c, ticketID)
 	if err != nil {
-		h.handleError(c, err, "DeleteByTicketID")
+		respondError(c, err, "DeleteByTicketID")
 		return
 	}
 	c.Status(http.StatusNoContent)
 }
 
-func (h *TicketHandler) handleError(c *gin.Context, err error, operation string) {
-	log := logger.Handler.With(zap.String("operation", operation), zap.Error(err))
-	switch {
-	case err == apperrors.ErrTicketNotFound:
-		log.Warn("Ticket not found")
-		c.JSON(http.StatusNotFound, gin.H{"error": "Ticket not found"})
-	case err == apperrors.ErrInvalidInput:
-		log.Warn("Invalid input")
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input"})
-	default:
-		log.Error("Unexpected error")
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+func (h *TicketHandler) AdjustStock(c *gin.Context) {
+	ticketID, ok := parseUUIDParam(c, "uuid", "Invalid ticket uuid")
+	if !ok {
+		return
+	}
+	var req model.AdjustStockRequest
+	if err := BindJson(c, &req); err != nil {
+		return
+	}
+	adjustment, err := h.service.AdjustStock(c, ticketID, req)
+	if err != nil {
+		respondError(c, err, "AdjustStock")
+		return
+	}
+	c.JSON(http.StatusOK, adjustment)
+}
+
+func (h *TicketHandler) ListStockAdjustments(c *gin.Context) {
+	ticketID, ok := parseUUIDParam(c, "uuid", "Invalid ticket uuid")
+	if !ok {
+		return
+	}
+	adjustments, err := h.service.ListStockAdjustments(c, ticketID)
+	if err != nil {
+		respondError(c, err, "ListStockAdjustments")
+		return
+	}
+	c.JSON(http.StatusOK, adjustments)
+}
+
+func (h *TicketHandler) ListPricePhases(c *gin.Context) {
+	ticketID, ok := parseUUIDParam(c, "uuid", "Invalid ticket uuid")
+	if !ok {
+		return
+	}
+	phases, err := h.service.ListPricePhases(c, ticketID)
+	if err != nil {
+		respondError(c, err, "ListPricePhases")
+		return
+	}
+	c.JSON(http.StatusOK, phases)
+}
+
+func (h *TicketHandler) SetPricePhases(c *gin.Context) {
+	ticketID, ok := parseUUIDParam(c, "uuid", "Invalid ticket uuid")
+	if !ok {
+		return
+	}
+	var req model.SetPricePhasesRequest
+	if err := BindJson(c, &req); err != nil {
+		return
+	}
+	phases, err := h.service.SetPricePhases(c, ticketID, req)
+	if err != nil {
+		respondError(c, err, "SetPricePhases")
+		return
 	}
+	c.JSON(http.StatusOK, phases)
 }
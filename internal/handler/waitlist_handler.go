@@ -0,0 +1,83 @@
+package handler
+
+import (
+	"go-gin-high-concurrency/internal/middleware"
+	"go-gin-high-concurrency/internal/model"
+	"go-gin-high-concurrency/internal/service"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type WaitlistHandler struct {
+	service service.WaitlistService
+}
+
+func NewWaitlistHandler(service service.WaitlistService) *WaitlistHandler {
+	return &WaitlistHandler{service: service}
+}
+
+func (h *WaitlistHandler) RegisterRoutes(r *gin.Engine) {
+	router := r.Group("/api/v1")
+	{
+		router.POST("tickets/:uuid/waitlist", h.JoinWaitlist)
+		router.GET("tickets/:uuid/waitlist", h.GetWaitlistEntry)
+		router.POST("tickets/:uuid/waitlist/leave", h.LeaveWaitlist)
+	}
+}
+
+func (h *WaitlistHandler) JoinWaitlist(c *gin.Context) {
+	ticketID, ok := parseUUIDParam(c, "uuid", "Invalid ticket uuid")
+	if !ok {
+		return
+	}
+	var req model.JoinWaitlistRequest
+	if err := BindJson(c, &req); err != nil {
+		return
+	}
+	middleware.SetUserID(c, req.UserID)
+	entry, err := h.service.Join(c, ticketID, req)
+	if err != nil {
+		respondError(c, err, "JoinWaitlist")
+		return
+	}
+	c.JSON(http.StatusCreated, entry)
+}
+
+// GetWaitlistEntry 以 query string 的 user_id 查詢候補順位
+func (h *WaitlistHandler) GetWaitlistEntry(c *gin.Context) {
+	ticketID, ok := parseUUIDParam(c, "uuid", "Invalid ticket uuid")
+	if !ok {
+		return
+	}
+	userID, err := strconv.Atoi(c.Query("user_id"))
+	if err != nil || userID <= 0 {
+		writeError(c, invalidInput("Invalid user_id"))
+		return
+	}
+	middleware.SetUserID(c, userID)
+	entry, err := h.service.GetEntry(c, ticketID, userID)
+	if err != nil {
+		respondError(c, err, "GetWaitlistEntry")
+		return
+	}
+	c.JSON(http.StatusOK, entry)
+}
+
+func (h *WaitlistHandler) LeaveWaitlist(c *gin.Context) {
+	ticketID, ok := parseUUIDParam(c, "uuid", "Invalid ticket uuid")
+	if !ok {
+		return
+	}
+	var req model.LeaveWaitlistRequest
+	if err := BindJson(c, &req); err != nil {
+		return
+	}
+	middleware.SetUserID(c, req.UserID)
+	if err := h.service.Leave(c, ticketID, req.UserID); err != nil {
+		respondError(c, err, "LeaveWaitlist")
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
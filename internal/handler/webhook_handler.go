@@ -0,0 +1,166 @@
+package handler
+
+import (
+	"go-gin-high-concurrency/internal/model"
+	"go-gin-high-concurrency/internal/service"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type WebhookHandler struct {
+	service service.WebhookService
+}
+
+func NewWebhookHandler(service service.WebhookService) *WebhookHandler {
+	return &WebhookHandler{service: service}
+}
+
+func (h *WebhookHandler) RegisterRoutes(r *gin.Engine) {
+	router := r.Group("/api/v1")
+	{
+		router.GET("events/:uuid/webhooks", h.ListByEventID)
+		router.POST("events/:uuid/webhooks", h.Create)
+		router.GET("webhooks/:uuid", h.GetBySubscriptionID)
+		router.PUT("webhooks/:uuid", h.UpdateBySubscriptionID)
+		router.DELETE("webhooks/:uuid", h.DeleteBySubscriptionID)
+		router.GET("webhooks/:uuid/deliveries", h.ListDeliveries)
+		router.POST("webhooks/:uuid/deliveries/:delivery_uuid/redeliver", h.Redeliver)
+	}
+}
+
+// CreateWebhookRequest 建立 webhook 訂閱請求；secret 未提供時由系統產生
+type CreateWebhookRequest struct {
+	URL        string   `json:"url" binding:"required"`
+	Secret     string   `json:"secret"`
+	EventTypes []string `json:"event_types" binding:"required"`
+}
+
+// UpdateWebhookRequest 更新 webhook 訂閱請求
+type UpdateWebhookRequest struct {
+	URL        *string  `json:"url"`
+	EventTypes []string `json:"event_types"`
+	Active     *bool    `json:"active"`
+}
+
+// CreateWebhookResponse 建立成功時唯一一次回傳 secret
+type CreateWebhookResponse struct {
+	*model.WebhookSubscription
+	Secret string `json:"secret"`
+}
+
+func (h *WebhookHandler) ListByEventID(c *gin.Context) {
+	eventID, ok := parseUUIDParam(c, "uuid", "Invalid event uuid")
+	if !ok {
+		return
+	}
+	subscriptions, err := h.service.ListSubscriptions(c, eventID)
+	if err != nil {
+		respondError(c, err, "ListByEventID")
+		return
+	}
+	c.JSON(http.StatusOK, subscriptions)
+}
+
+func (h *WebhookHandler) Create(c *gin.Context) {
+	eventID, ok := parseUUIDParam(c, "uuid", "Invalid event uuid")
+	if !ok {
+		return
+	}
+	var req CreateWebhookRequest
+	if err := BindJson(c, &req); err != nil {
+		return
+	}
+	subscription := &model.WebhookSubscription{
+		URL:        req.URL,
+		Secret:     req.Secret,
+		EventTypes: req.EventTypes,
+	}
+	created, err := h.service.CreateSubscription(c, eventID, subscription)
+	if err != nil {
+		respondError(c, err, "Create")
+		return
+	}
+	c.JSON(http.StatusCreated, CreateWebhookResponse{WebhookSubscription: created, Secret: created.Secret})
+}
+
+func (h *WebhookHandler) GetBySubscriptionID(c *gin.Context) {
+	subscriptionID, ok := parseUUIDParam(c, "uuid", "Invalid webhook uuid")
+	if !ok {
+		return
+	}
+	subscription, err := h.service.GetSubscription(c, subscriptionID)
+	if err != nil {
+		respondError(c, err, "GetBySubscriptionID")
+		return
+	}
+	c.JSON(http.StatusOK, subscription)
+}
+
+func (h *WebhookHandler) UpdateBySubscriptionID(c *gin.Context) {
+	subscriptionID, ok := parseUUIDParam(c, "uuid", "Invalid webhook uuid")
+	if !ok {
+		return
+	}
+	var req UpdateWebhookRequest
+	if err := BindJson(c, &req); err != nil {
+		return
+	}
+	if req.URL == nil && req.EventTypes == nil && req.Active == nil {
+		writeError(c, invalidInput("At least one of url, event_types or active is required"))
+		return
+	}
+	params := model.UpdateWebhookSubscriptionParams{
+		URL:        req.URL,
+		EventTypes: req.EventTypes,
+		Active:     req.Active,
+	}
+	updated, err := h.service.UpdateSubscription(c, subscriptionID, params)
+	if err != nil {
+		respondError(c, err, "UpdateBySubscriptionID")
+		return
+	}
+	c.JSON(http.StatusOK, updated)
+}
+
+func (h *WebhookHandler) DeleteBySubscriptionID(c *gin.Context) {
+	subscriptionID, ok := parseUUIDParam(c, "uuid", "Invalid webhook uuid")
+	if !ok {
+		return
+	}
+	if err := h.service.DeleteSubscription(c, subscriptionID); err != nil {
+		respondError(c, err, "DeleteBySubscriptionID")
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func (h *WebhookHandler) ListDeliveries(c *gin.Context) {
+	subscriptionID, ok := parseUUIDParam(c, "uuid", "Invalid webhook uuid")
+	if !ok {
+		return
+	}
+	deliveries, err := h.service.ListDeliveries(c, subscriptionID)
+	if err != nil {
+		respondError(c, err, "ListDeliveries")
+		return
+	}
+	c.JSON(http.StatusOK, deliveries)
+}
+
+func (h *WebhookHandler) Redeliver(c *gin.Context) {
+	subscriptionID, ok := parseUUIDParam(c, "uuid", "Invalid webhook uuid")
+	if !ok {
+		return
+	}
+	deliveryID, ok := parseUUIDParam(c, "delivery_uuid", "Invalid delivery uuid")
+	if !ok {
+		return
+	}
+	delivery, err := h.service.Redeliver(c, subscriptionID, deliveryID)
+	if err != nil {
+		respondError(c, err, "Redeliver")
+		return
+	}
+	c.JSON(http.StatusAccepted, delivery)
+}
@@ -0,0 +1,77 @@
+package middleware
+
+import (
+	"io"
+	"net/http"
+	"time"
+
+	apperrors "go-gin-high-concurrency/pkg/app_errors"
+	"go-gin-high-concurrency/pkg/logger"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// UserIDKey handler 解析出使用者後以 c.Set 存放的 key，存取日誌會一併記錄
+const UserIDKey = "user_id"
+
+// SetUserID 記錄本次請求的使用者，供存取日誌使用
+func SetUserID(c *gin.Context, userID int) {
+	c.Set(UserIDKey, userID)
+}
+
+// AccessLog 以 zap 記錄每個請求的路由、狀態碼、耗時及使用者；5xx 記為 error、4xx 記為 warn。
+// quietPaths（例如健康檢查）成功時不記錄，避免探測請求洗版
+func AccessLog(quietPaths ...string) gin.HandlerFunc {
+	quiet := make(map[string]bool, len(quietPaths))
+	for _, path := range quietPaths {
+		quiet[path] = true
+	}
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		if quiet[c.Request.URL.Path] && c.Writer.Status() < http.StatusBadRequest {
+			return
+		}
+
+		fields := []zap.Field{
+			zap.String("method", c.Request.Method),
+			zap.String("route", c.FullPath()),
+			zap.String("path", c.Request.URL.Path),
+			zap.Int("status", c.Writer.Status()),
+			zap.Duration("latency", time.Since(start)),
+			zap.String("client_ip", c.ClientIP()),
+			zap.Int("response_size", c.Writer.Size()),
+		}
+		if userID, ok := c.Get(UserIDKey); ok {
+			fields = append(fields, zap.Any("user_id", userID))
+		}
+		if len(c.Errors) > 0 {
+			fields = append(fields, zap.String("errors", c.Errors.String()))
+		}
+
+		log := logger.WithContext(c, logger.HTTP)
+		switch status := c.Writer.Status(); {
+		case status >= http.StatusInternalServerError:
+			log.Error("request", fields...)
+		case status >= http.StatusBadRequest:
+			log.Warn("request", fields...)
+		default:
+			log.Info("request", fields...)
+		}
+	}
+}
+
+// Recovery panic 時以 zap 記錄（含 request id）並回應 500，取代 gin 預設輸出到 stderr 的純文字
+func Recovery() gin.HandlerFunc {
+	return gin.CustomRecoveryWithWriter(io.Discard, func(c *gin.Context, recovered any) {
+		logger.WithContext(c, logger.HTTP).Error("panic recovered",
+			zap.Any("panic", recovered),
+			zap.String("route", c.FullPath()),
+			zap.Stack("stack"),
+		)
+		appErr := apperrors.New(http.StatusInternalServerError, apperrors.CodeInternal, "Internal server error")
+		c.AbortWithStatusJSON(appErr.Status, apperrors.ErrorResponse{Error: appErr, RequestID: logger.RequestID(c)})
+	})
+}
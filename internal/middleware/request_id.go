@@ -0,0 +1,26 @@
+package middleware
+
+import (
+	"go-gin-high-concurrency/pkg/logger"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// RequestIDHeader 請求及回應中的 request id header
+const RequestIDHeader = "X-Request-ID"
+
+// RequestID 沿用呼叫端帶入的 X-Request-ID，沒有或格式不合時產生新的；
+// 存入 gin.Context 及 request context 供日誌及佇列訊息使用，並回寫到回應 header
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader(RequestIDHeader)
+		if !logger.ValidRequestID(id) {
+			id = uuid.New().String()
+		}
+		c.Set(logger.RequestIDKey, id)
+		c.Request = c.Request.WithContext(logger.WithRequestID(c.Request.Context(), id))
+		c.Header(RequestIDHeader, id)
+		c.Next()
+	}
+}
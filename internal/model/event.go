@@ -11,6 +11,7 @@ type Event struct {
 	EventID     uuid.UUID `json:"event_id" db:"event_id"`
 	Name        string    `json:"name" db:"name"`
 	Description *string   `json:"description,omitempty" db:"description"`
+	MaxPerUser  *int      `json:"max_per_user,omitempty" db:"max_per_user"` // 每人跨票種的購買上限，nil 為不限
 	CreatedAt   time.Time `json:"created_at" db:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at" db:"updated_at"`
 }
@@ -18,4 +19,5 @@ type Event struct {
 type UpdateEventParams struct {
 	Name        *string
 	Description *string
+	MaxPerUser  *int // 0 為取消上限
 }
@@ -0,0 +1,22 @@
+package model
+
+// HealthStatus 健康檢查的結果
+type HealthStatus string
+
+const (
+	HealthStatusOK   HealthStatus = "ok"
+	HealthStatusFail HealthStatus = "fail"
+)
+
+// HealthReport /healthz 及 /readyz 的回應：任一項檢查失敗時整體為 fail
+type HealthReport struct {
+	Status HealthStatus                 `json:"status"`
+	Checks map[string]HealthCheckResult `json:"checks"`
+}
+
+// HealthCheckResult 單項檢查的結果
+type HealthCheckResult struct {
+	Status     HealthStatus `json:"status"`
+	Error      string       `json:"error,omitempty"`
+	DurationMs int64        `json:"duration_ms"`
+}
@@ -0,0 +1,30 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TicketHold 一般票種的暫時保留：保留期間庫存已扣除，逾時未轉為訂單則由 sweeper 歸還
+type TicketHold struct {
+	HoldID     uuid.UUID `json:"hold_id"`
+	TicketID   int       `json:"ticket_id"`
+	UserID     int       `json:"user_id"`
+	Quantity   int       `json:"quantity"`
+	Price      float64   `json:"price"`
+	PricePhase string    `json:"price_phase,omitempty"` // 保留當下套用的價格階段，轉為訂單時沿用
+	ExpiresAt  time.Time `json:"expires_at"`
+}
+
+// CreateHoldRequest 建立保留請求
+type CreateHoldRequest struct {
+	UserID   int `json:"user_id" binding:"required"`
+	TicketID int `json:"ticket_id" binding:"required"`
+	Quantity int `json:"quantity" binding:"required,min=1"`
+}
+
+// ReleaseHoldRequest 提前釋放保留的請求
+type ReleaseHoldRequest struct {
+	UserID int `json:"user_id" binding:"required"`
+}
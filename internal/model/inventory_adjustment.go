@@ -0,0 +1,25 @@
+package model
+
+import "time"
+
+// InventoryActorAdmin 後台調整庫存未帶 actor 時的預設執行者
+const InventoryActorAdmin = "admin"
+
+// InventoryAdjustment 票種總庫存的調整紀錄（加開或收回），保存調整後的庫存快照
+type InventoryAdjustment struct {
+	ID             int       `json:"-" db:"id"`
+	TicketID       int       `json:"-" db:"ticket_id"`
+	Delta          int       `json:"delta" db:"delta"` // 正數為加開，負數為收回
+	TotalStock     int       `json:"total_stock" db:"total_stock"`
+	RemainingStock int       `json:"remaining_stock" db:"remaining_stock"`
+	Actor          string    `json:"actor" db:"actor"`
+	Reason         *string   `json:"reason,omitempty" db:"reason"`
+	CreatedAt      time.Time `json:"created_at" db:"created_at"`
+}
+
+// AdjustStockRequest 調整總庫存請求，收回的數量不可超過尚未售出的庫存
+type AdjustStockRequest struct {
+	Delta  int     `json:"delta" binding:"required"`
+	Actor  string  `json:"actor"`
+	Reason *string `json:"reason"`
+}
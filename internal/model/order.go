@@ -13,30 +13,63 @@ const (
 	OrderStatusPending   OrderStatus = "pending"
 	OrderStatusConfirmed OrderStatus = "confirmed"
 	OrderStatusCancelled OrderStatus = "cancelled"
+	OrderStatusExpired   OrderStatus = "expired"
+	OrderStatusRefunded  OrderStatus = "refunded"
 )
 
+// orderStatusTransitions 訂單狀態機：key 為目前狀態，value 為允許轉換的下一個狀態
+// 未列在 key 中的狀態（cancelled、expired、refunded）為終態
+var orderStatusTransitions = map[OrderStatus][]OrderStatus{
+	OrderStatusPending:   {OrderStatusConfirmed, OrderStatusCancelled, OrderStatusExpired},
+	OrderStatusConfirmed: {OrderStatusRefunded},
+}
+
 // IsValid 驗證狀態是否有效
 func (s OrderStatus) IsValid() bool {
 	switch s {
-	case OrderStatusPending, OrderStatusConfirmed, OrderStatusCancelled:
+	case OrderStatusPending, OrderStatusConfirmed, OrderStatusCancelled, OrderStatusExpired, OrderStatusRefunded:
 		return true
 	}
 	return false
 }
 
+// IsTerminal 終態不會再有任何狀態變更
+func (s OrderStatus) IsTerminal() bool {
+	return s.IsValid() && len(orderStatusTransitions[s]) == 0
+}
+
+// CanTransitionTo 檢查是否允許從目前狀態轉換到 next
+func (s OrderStatus) CanTransitionTo(next OrderStatus) bool {
+	for _, allowed := range orderStatusTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
 // Order 訂單模型
 type Order struct {
-	ID         int         `json:"-" db:"id"` // 內部主鍵，不對外暴露
-	OrderID    uuid.UUID   `json:"order_id" db:"order_id"`
-	UserID     int         `json:"user_id" db:"user_id"`
-	TicketID   int         `json:"ticket_id" db:"ticket_id"`
-	RequestID  string      `json:"request_id" db:"request_id"` // 訂單請求ID, 防止重複請求
-	Quantity   int         `json:"quantity" db:"quantity"`
-	TotalPrice float64     `json:"total_price" db:"total_price"`
-	Status     OrderStatus `json:"status" db:"status"`
-	CreatedAt  time.Time   `json:"created_at" db:"created_at"`
-	UpdatedAt  time.Time   `json:"updated_at" db:"updated_at"`
-	DeletedAt  *time.Time  `json:"deleted_at,omitempty" db:"deleted_at"`
+	ID             int         `json:"-" db:"id"` // 內部主鍵，不對外暴露
+	OrderID        uuid.UUID   `json:"order_id" db:"order_id"`
+	UserID         int         `json:"user_id" db:"user_id"`
+	TicketID       int         `json:"ticket_id" db:"ticket_id"`
+	RequestID      string      `json:"request_id" db:"request_id"` // 訂單請求ID, 防止重複請求
+	Quantity       int         `json:"quantity" db:"quantity"`
+	TotalPrice     float64     `json:"total_price" db:"total_price"`
+	PricePhase     *string     `json:"price_phase,omitempty" db:"price_phase"` // 成立時套用的價格階段，nil 為票種原價
+	PromoCode      *string     `json:"promo_code,omitempty" db:"promo_code"`   // 套用的優惠碼，TotalPrice 為折扣後金額
+	DiscountAmount float64     `json:"discount_amount" db:"discount_amount"`
+	AccessCode     *string     `json:"access_code,omitempty" db:"access_code"` // 預售票種消耗的存取碼，名單內的使用者為 nil
+	RiskScore      int         `json:"risk_score,omitempty" db:"risk_score"`   // 下單前的風險評分，0 為未觸發任何標記
+	RiskFlags      []string    `json:"risk_flags,omitempty" db:"risk_flags"`   // 觸發的風險標記，供主辦方審核
+	Status         OrderStatus `json:"status" db:"status"`
+	CreatedAt      time.Time   `json:"created_at" db:"created_at"`
+	UpdatedAt      time.Time   `json:"updated_at" db:"updated_at"`
+	DeletedAt      *time.Time  `json:"deleted_at,omitempty" db:"deleted_at"`
+
+	SeatIDs       []int  `json:"seat_ids,omitempty" db:"-"`       // 對號座訂單選定的座位，寫入 order_seats
+	CorrelationID string `json:"correlation_id,omitempty" db:"-"` // 下單 HTTP 請求的 X-Request-ID，隨隊列訊息傳給 Worker 串接日誌，不寫入資料庫
 }
 
 // IsDeleted 檢查訂單是否已刪除
@@ -49,4 +82,25 @@ type CreateOrderRequest struct {
 	UserID   int `json:"user_id" binding:"required"`
 	TicketID int `json:"ticket_id" binding:"required"`
 	Quantity int `json:"quantity" binding:"required,min=1"`
+	// 對號座票種必填，數量需與 Quantity 相同，且須先保留座位
+	SeatIDs []int `json:"seat_ids"`
+	// 由保留轉為訂單時帶入，票種與數量需與保留相同，不再重新扣減庫存
+	HoldID *uuid.UUID `json:"hold_id"`
+	// 價格鎖定：帶入使用者看到的單價，成立訂單時的售價不同則拒絕並歸還庫存
+	ExpectedPrice *float64 `json:"expected_price" binding:"omitempty,gt=0"`
+	// 優惠碼：使用次數於 Redis 原子扣除，訂單取消時歸還
+	PromoCode *string `json:"promo_code" binding:"omitempty,max=50"`
+	// 預售存取碼：預售票種的使用者不在名單內時必填，使用次數於 Redis 原子扣除，訂單取消時歸還
+	AccessCode *string `json:"access_code" binding:"omitempty,max=50"`
+	// 付款識別（付款工具的指紋），用於偵測多個帳號共用同一付款方式
+	PaymentFingerprint *string `json:"payment_fingerprint" binding:"omitempty,max=128"`
+
+	// 由 handler 從請求填入，供風險評分使用
+	ClientIP          string `json:"-"`
+	DeviceFingerprint string `json:"-"`
+}
+
+// UpdateOrderStatusRequest 訂單狀態變更的請求（body 可省略）；執行者由伺服器依路由記錄
+type UpdateOrderStatusRequest struct {
+	Reason *string `json:"reason"`
 }
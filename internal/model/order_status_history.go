@@ -0,0 +1,27 @@
+package model
+
+import "time"
+
+// 狀態變更的執行者
+const (
+	OrderActorUser   = "user"
+	OrderActorSystem = "system"
+	OrderActorAdmin  = "admin"
+)
+
+// OrderStatusHistory 訂單狀態變更紀錄
+type OrderStatusHistory struct {
+	ID         int          `json:"-" db:"id"`
+	OrderID    int          `json:"-" db:"order_id"`
+	FromStatus *OrderStatus `json:"from_status" db:"from_status"` // 訂單建立時為 nil
+	ToStatus   OrderStatus  `json:"to_status" db:"to_status"`
+	Actor      string       `json:"actor" db:"actor"`
+	Reason     *string      `json:"reason,omitempty" db:"reason"`
+	CreatedAt  time.Time    `json:"created_at" db:"created_at"`
+}
+
+// OrderStatusChange 狀態變更的附帶資訊（誰、為什麼）
+type OrderStatusChange struct {
+	Actor  string
+	Reason *string
+}
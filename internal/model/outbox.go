@@ -0,0 +1,80 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// 領域事件的聚合類型
+const (
+	AggregateTypeOrder  = "order"
+	AggregateTypeTicket = "ticket"
+	AggregateTypeHold   = "hold"
+)
+
+// 領域事件類型
+const (
+	EventTypeOrderCreated   = "order.created"
+	EventTypeOrderConfirmed = "order.confirmed"
+	EventTypeOrderCancelled = "order.cancelled"
+	EventTypeOrderExpired   = "order.expired"
+	EventTypeOrderRefunded  = "order.refunded"
+	EventTypeTicketSoldOut  = "ticket.sold_out"
+	// 候補遞補：通知使用者已取得專屬保留
+	EventTypeWaitlistPromoted = "waitlist.promoted"
+)
+
+// OutboxEvent 交易內寫入的領域事件，由 relay 非同步發佈
+type OutboxEvent struct {
+	ID            int64           `json:"id" db:"id"`
+	AggregateType string          `json:"aggregate_type" db:"aggregate_type"`
+	AggregateID   uuid.UUID       `json:"aggregate_id" db:"aggregate_id"`
+	EventType     string          `json:"event_type" db:"event_type"`
+	Payload       json.RawMessage `json:"payload" db:"payload"`
+	CreatedAt     time.Time       `json:"created_at" db:"created_at"`
+	PublishedAt   *time.Time      `json:"published_at,omitempty" db:"published_at"`
+}
+
+// NewOrderEvent 以訂單快照建立訂單相關的領域事件
+func NewOrderEvent(eventType string, order *Order) (*OutboxEvent, error) {
+	payload, err := json.Marshal(order)
+	if err != nil {
+		return nil, err
+	}
+	return &OutboxEvent{
+		AggregateType: AggregateTypeOrder,
+		AggregateID:   order.OrderID,
+		EventType:     eventType,
+		Payload:       payload,
+	}, nil
+}
+
+// NewTicketEvent 以票券快照建立票券相關的領域事件
+func NewTicketEvent(eventType string, ticket *Ticket) (*OutboxEvent, error) {
+	payload, err := json.Marshal(ticket)
+	if err != nil {
+		return nil, err
+	}
+	return &OutboxEvent{
+		AggregateType: AggregateTypeTicket,
+		AggregateID:   ticket.TicketID,
+		EventType:     eventType,
+		Payload:       payload,
+	}, nil
+}
+
+// NewHoldEvent 以保留快照建立保留相關的領域事件
+func NewHoldEvent(eventType string, hold *TicketHold) (*OutboxEvent, error) {
+	payload, err := json.Marshal(hold)
+	if err != nil {
+		return nil, err
+	}
+	return &OutboxEvent{
+		AggregateType: AggregateTypeHold,
+		AggregateID:   hold.HoldID,
+		EventType:     eventType,
+		Payload:       payload,
+	}, nil
+}
@@ -0,0 +1,23 @@
+package model
+
+import "time"
+
+// PresaleAccessCode 預售票種的存取碼（粉絲俱樂部等）；每筆成立的訂單消耗一次，訂單取消時歸還
+type PresaleAccessCode struct {
+	ID        int       `json:"-" db:"id"`
+	TicketID  int       `json:"-" db:"ticket_id"`
+	Code      string    `json:"code" db:"code"`
+	MaxUses   *int      `json:"max_uses,omitempty" db:"max_uses"` // 可成立的訂單數，nil 為不限
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+}
+
+// CreateAccessCodeRequest 建立預售存取碼請求；存取碼不分大小寫
+type CreateAccessCodeRequest struct {
+	Code    string `json:"code" binding:"required,max=50"`
+	MaxUses *int   `json:"max_uses" binding:"omitempty,min=1"`
+}
+
+// PresaleAllowlistRequest 將使用者加入預售名單，已在名單內的使用者略過
+type PresaleAllowlistRequest struct {
+	UserIDs []int `json:"user_ids" binding:"required,min=1,dive,gt=0"`
+}
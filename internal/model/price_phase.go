@@ -0,0 +1,29 @@
+package model
+
+import "time"
+
+// TicketPricePhase 票種的價格階段（早鳥、正式、現場）；依 Position 由小到大套用第一個仍有效的階段，
+// 全部失效時以票種原價販售
+type TicketPricePhase struct {
+	ID        int        `json:"-" db:"id"`
+	TicketID  int        `json:"-" db:"ticket_id"`
+	Name      string     `json:"name" db:"name"`
+	Price     float64    `json:"price" db:"price"`
+	EndsAt    *time.Time `json:"ends_at,omitempty" db:"ends_at"`       // 到期後不再適用，NULL 為不限時間
+	SoldLimit *int       `json:"sold_limit,omitempty" db:"sold_limit"` // 票種累計售出達此數量後不再適用，NULL 為不限數量
+	Position  int        `json:"position" db:"position"`
+	CreatedAt time.Time  `json:"created_at" db:"created_at"`
+}
+
+// PricePhaseRequest 單一價格階段的設定
+type PricePhaseRequest struct {
+	Name      string     `json:"name" binding:"required,max=100"`
+	Price     float64    `json:"price" binding:"min=0"`
+	EndsAt    *time.Time `json:"ends_at"`
+	SoldLimit *int       `json:"sold_limit" binding:"omitempty,min=1"`
+}
+
+// SetPricePhasesRequest 以新的階段整批取代票種現有的價格階段，依陣列順序套用；空陣列為移除所有階段
+type SetPricePhasesRequest struct {
+	Phases []PricePhaseRequest `json:"phases" binding:"dive"`
+}
@@ -0,0 +1,62 @@
+package model
+
+import (
+	"math"
+	"time"
+)
+
+// DiscountType 優惠碼折扣類型
+type DiscountType string
+
+const (
+	DiscountTypePercentage DiscountType = "percentage" // 依訂單金額打折，DiscountValue 為百分比
+	DiscountTypeFixed      DiscountType = "fixed"      // 每筆訂單折抵固定金額
+)
+
+// IsValid 驗證折扣類型是否有效
+func (t DiscountType) IsValid() bool {
+	switch t {
+	case DiscountTypePercentage, DiscountTypeFixed:
+		return true
+	}
+	return false
+}
+
+// PromoCode 優惠碼；EventID / TicketID 限定適用範圍，皆為 nil 時適用所有票種
+type PromoCode struct {
+	ID             int          `json:"-" db:"id"`
+	Code           string       `json:"code" db:"code"`
+	DiscountType   DiscountType `json:"discount_type" db:"discount_type"`
+	DiscountValue  float64      `json:"discount_value" db:"discount_value"`
+	EventID        *int         `json:"event_id,omitempty" db:"event_id"`
+	TicketID       *int         `json:"ticket_id,omitempty" db:"ticket_id"`
+	MaxRedemptions *int         `json:"max_redemptions,omitempty" db:"max_redemptions"` // 全域可使用次數，NULL 為不限
+	MaxPerUser     *int         `json:"max_per_user,omitempty" db:"max_per_user"`       // 每位使用者可使用次數，NULL 為不限
+	StartsAt       *time.Time   `json:"starts_at,omitempty" db:"starts_at"`
+	EndsAt         *time.Time   `json:"ends_at,omitempty" db:"ends_at"`
+	CreatedAt      time.Time    `json:"created_at" db:"created_at"`
+	UpdatedAt      time.Time    `json:"updated_at" db:"updated_at"`
+}
+
+// Discount 計算訂單金額 subtotal 可折抵的金額（四捨五入到分），不超過 subtotal
+func (p *PromoCode) Discount(subtotal float64) float64 {
+	var discount float64
+	switch p.DiscountType {
+	case DiscountTypePercentage:
+		discount = math.Round(subtotal*p.DiscountValue) / 100
+	case DiscountTypeFixed:
+		discount = p.DiscountValue
+	}
+	return math.Min(discount, subtotal)
+}
+
+// PromoRedemption 優惠碼的使用紀錄，與訂單一起寫入；訂單取消時標記 ReturnedAt 歸還使用次數
+type PromoRedemption struct {
+	ID             int        `json:"-" db:"id"`
+	PromoCodeID    int        `json:"-" db:"promo_code_id"`
+	OrderID        int        `json:"-" db:"order_id"`
+	UserID         int        `json:"user_id" db:"user_id"`
+	DiscountAmount float64    `json:"discount_amount" db:"discount_amount"`
+	CreatedAt      time.Time  `json:"created_at" db:"created_at"`
+	ReturnedAt     *time.Time `json:"returned_at,omitempty" db:"returned_at"`
+}
@@ -0,0 +1,60 @@
+package model
+
+// RiskDecision 下單前風險評分的結果
+type RiskDecision string
+
+const (
+	RiskDecisionAllow RiskDecision = "allow"
+	RiskDecisionFlag  RiskDecision = "flag"  // 照常成立訂單，記錄風險標記供主辦方審核
+	RiskDecisionBlock RiskDecision = "block" // 拒絕下單，不扣減庫存
+)
+
+// 風險標記，寫入 orders.risk_flags
+const (
+	RiskFlagUserVelocity   = "user_velocity"         // 同一使用者短時間內大量下單
+	RiskFlagIPVelocity     = "ip_velocity"           // 同一 IP 短時間內大量下單
+	RiskFlagDeviceVelocity = "device_velocity"       // 同一裝置指紋短時間內大量下單
+	RiskFlagStockMisses    = "repeated_stock_misses" // 反覆搶購已售完的票種
+	RiskFlagSharedPayment  = "shared_payment"        // 同一付款識別被多個使用者共用
+)
+
+// RiskSignals 風險評分使用的請求特徵；IP 及裝置指紋由 handler 從請求取得，未提供時為空字串並略過該項檢查
+type RiskSignals struct {
+	UserID             int
+	TicketID           int
+	Quantity           int
+	ClientIP           string
+	DeviceFingerprint  string
+	PaymentFingerprint string
+}
+
+// NewRiskSignals 由下單請求取出風險評分使用的特徵
+func NewRiskSignals(req CreateOrderRequest) RiskSignals {
+	signals := RiskSignals{
+		UserID:            req.UserID,
+		TicketID:          req.TicketID,
+		Quantity:          req.Quantity,
+		ClientIP:          req.ClientIP,
+		DeviceFingerprint: req.DeviceFingerprint,
+	}
+	if req.PaymentFingerprint != nil {
+		signals.PaymentFingerprint = *req.PaymentFingerprint
+	}
+	return signals
+}
+
+// RiskAssessment 風險評分結果；Flags 為觸發的風險標記，Score 為各標記權重的總和
+type RiskAssessment struct {
+	Decision RiskDecision
+	Score    int
+	Flags    []string
+}
+
+// RiskCounters 風險評分視窗內累計的計數；未提供的特徵計數為 0
+type RiskCounters struct {
+	UserAttempts   int // 使用者在視窗內的下單次數（含本次）
+	IPAttempts     int // IP 在視窗內的下單次數（含本次）
+	DeviceAttempts int // 裝置指紋在視窗內的下單次數（含本次）
+	StockMisses    int // 使用者在視窗內因庫存不足下單失敗的次數
+	PaymentUsers   int // 共用同一付款識別的使用者數（含本次）
+}
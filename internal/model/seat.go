@@ -0,0 +1,69 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Venue 場地模型：場地 → 區域 → 座位
+type Venue struct {
+	ID        int             `json:"-" db:"id"`
+	VenueID   uuid.UUID       `json:"venue_id" db:"venue_id"`
+	Name      string          `json:"name" db:"name"`
+	CreatedAt time.Time       `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time       `json:"updated_at" db:"updated_at"`
+	Sections  []*VenueSection `json:"sections" db:"-"`
+}
+
+// VenueSection 場地區域，對號座票種以區域為單位販售
+type VenueSection struct {
+	ID        int       `json:"id" db:"id"`
+	VenueID   int       `json:"-" db:"venue_id"`
+	Name      string    `json:"name" db:"name"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	Seats     []*Seat   `json:"seats" db:"-"`
+}
+
+// Seat 座位
+type Seat struct {
+	ID        int    `json:"id" db:"id"`
+	SectionID int    `json:"section_id" db:"section_id"`
+	Row       string `json:"row" db:"row_label"`
+	Number    int    `json:"number" db:"seat_number"`
+}
+
+// SeatStatus 座位狀態
+type SeatStatus string
+
+const (
+	SeatStatusAvailable SeatStatus = "available"
+	SeatStatusHeld      SeatStatus = "held"
+	SeatStatusSold      SeatStatus = "sold"
+)
+
+// SeatAvailability 座位的即時狀態
+type SeatAvailability struct {
+	SeatID int        `json:"seat_id"`
+	Row    string     `json:"row"`
+	Number int        `json:"number"`
+	Status SeatStatus `json:"status"`
+}
+
+// SeatHold 使用者暫時保留的座位，到期前需下單，否則自動釋放
+type SeatHold struct {
+	TicketID  uuid.UUID `json:"ticket_id"`
+	UserID    int       `json:"user_id"`
+	SeatIDs   []int     `json:"seat_ids"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// OrderSeat 訂單的座位指派；訂單取消時標記 released_at 釋出座位
+type OrderSeat struct {
+	ID         int        `json:"id" db:"id"`
+	OrderID    int        `json:"order_id" db:"order_id"`
+	TicketID   int        `json:"ticket_id" db:"ticket_id"`
+	SeatID     int        `json:"seat_id" db:"seat_id"`
+	CreatedAt  time.Time  `json:"created_at" db:"created_at"`
+	ReleasedAt *time.Time `json:"released_at,omitempty" db:"released_at"`
+}
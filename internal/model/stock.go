@@ -0,0 +1,45 @@
+package model
+
+import "github.com/google/uuid"
+
+// LowStockThreshold 剩餘庫存小於等於此值時視為即將售罄
+const LowStockThreshold = 10
+
+// StockStatus 票種的庫存狀態
+type StockStatus string
+
+const (
+	StockStatusAvailable StockStatus = "available"
+	StockStatusLowStock  StockStatus = "low_stock"
+	StockStatusSoldOut   StockStatus = "sold_out"
+)
+
+// StockStatusOf 依剩餘庫存判斷庫存狀態
+func StockStatusOf(stock int) StockStatus {
+	switch {
+	case stock <= 0:
+		return StockStatusSoldOut
+	case stock <= LowStockThreshold:
+		return StockStatusLowStock
+	default:
+		return StockStatusAvailable
+	}
+}
+
+// TicketStock 推送給前端的即時庫存
+type TicketStock struct {
+	TicketID uuid.UUID   `json:"ticket_id"`
+	Name     string      `json:"name"`
+	Stock    int         `json:"stock"`
+	Status   StockStatus `json:"status"`
+}
+
+// NewTicketStock 以票券與目前庫存建立即時庫存
+func NewTicketStock(ticket *Ticket, stock int) *TicketStock {
+	return &TicketStock{
+		TicketID: ticket.TicketID,
+		Name:     ticket.Name,
+		Stock:    stock,
+		Status:   StockStatusOf(stock),
+	}
+}
@@ -16,6 +16,8 @@ type Ticket struct {
 	TotalStock     int        `json:"total_stock" db:"total_stock"`
 	RemainingStock int        `json:"remaining_stock" db:"remaining_stock"`
 	MaxPerUser     int        `json:"max_per_user" db:"max_per_user"`
+	SectionID      *int       `json:"section_id,omitempty" db:"section_id"` // 對號座票種綁定的區域，NULL 為一般票種
+	Presale        bool       `json:"presale" db:"is_presale"`              // 預售票種僅接受有效存取碼或名單內的使用者
 	CreatedAt      time.Time  `json:"created_at" db:"created_at"`
 	UpdatedAt      time.Time  `json:"updated_at" db:"updated_at"`
 	DeletedAt      *time.Time `json:"deleted_at,omitempty" db:"deleted_at"`
@@ -34,6 +36,11 @@ func (t *Ticket) IsDeleted() bool {
 	return t.DeletedAt != nil
 }
 
+// IsSeated 檢查是否為對號座票種
+func (t *Ticket) IsSeated() bool {
+	return t.SectionID != nil
+}
+
 // IsAvailable 檢查票券是否可購買
 func (t *Ticket) IsAvailable() bool {
 	return !t.IsDeleted() && t.RemainingStock > 0
@@ -41,11 +48,31 @@ func (t *Ticket) IsAvailable() bool {
 
 // TicketResponse 票券響應
 type TicketResponse struct {
-	ID             int     `json:"id"`
-	EventID        int     `json:"event_id"`
-	Name           string  `json:"name"`
-	Price          float64 `json:"price"`
-	TotalStock     int     `json:"total_stock"`
-	RemainingStock int     `json:"remaining_stock"`
-	Available      bool    `json:"available"`
+	ID             int         `json:"id"`
+	TicketID       uuid.UUID   `json:"ticket_id"`
+	EventID        int         `json:"event_id"`
+	Name           string      `json:"name"`
+	Price          float64     `json:"price"`
+	PricePhase     string      `json:"price_phase,omitempty"` // 目前適用的價格階段
+	Presale        bool        `json:"presale"`
+	TotalStock     int         `json:"total_stock"`
+	RemainingStock int         `json:"remaining_stock"`
+	Available      bool        `json:"available"`
+	Status         StockStatus `json:"status"`
+}
+
+// NewTicketResponse 以票券與即時庫存、售價建立票券響應
+func NewTicketResponse(ticket *Ticket, remainingStock int, price float64) *TicketResponse {
+	return &TicketResponse{
+		ID:             ticket.ID,
+		TicketID:       ticket.TicketID,
+		EventID:        ticket.EventID,
+		Name:           ticket.Name,
+		Price:          price,
+		Presale:        ticket.Presale,
+		TotalStock:     ticket.TotalStock,
+		RemainingStock: remainingStock,
+		Available:      !ticket.IsDeleted() && remainingStock > 0,
+		Status:         StockStatusOf(remainingStock),
+	}
 }
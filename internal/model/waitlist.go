@@ -0,0 +1,37 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WaitlistStatus 候補狀態
+type WaitlistStatus string
+
+const (
+	WaitlistStatusWaiting  WaitlistStatus = "waiting"
+	WaitlistStatusPromoted WaitlistStatus = "promoted" // 已取得專屬保留，需在到期前下單
+)
+
+// WaitlistEntry 使用者在票種候補名單中的狀態
+type WaitlistEntry struct {
+	TicketID      uuid.UUID      `json:"ticket_id"`
+	UserID        int            `json:"user_id"`
+	Quantity      int            `json:"quantity"`
+	Status        WaitlistStatus `json:"status"`
+	Position      int            `json:"position,omitempty"` // 從 1 起算，已遞補時為 0
+	HoldID        *uuid.UUID     `json:"hold_id,omitempty"`
+	HoldExpiresAt *time.Time     `json:"hold_expires_at,omitempty"`
+}
+
+// JoinWaitlistRequest 加入候補請求
+type JoinWaitlistRequest struct {
+	UserID   int `json:"user_id" binding:"required"`
+	Quantity int `json:"quantity" binding:"required,min=1"`
+}
+
+// LeaveWaitlistRequest 離開候補請求
+type LeaveWaitlistRequest struct {
+	UserID int `json:"user_id" binding:"required"`
+}
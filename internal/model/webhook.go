@@ -0,0 +1,132 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WebhookEventTypes 可訂閱的事件類型
+var WebhookEventTypes = []string{
+	EventTypeOrderCreated,
+	EventTypeOrderConfirmed,
+	EventTypeOrderCancelled,
+	EventTypeOrderExpired,
+	EventTypeOrderRefunded,
+	EventTypeTicketSoldOut,
+}
+
+// IsWebhookEventType 檢查事件類型是否可訂閱
+func IsWebhookEventType(eventType string) bool {
+	for _, t := range WebhookEventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookDeliveryStatus 投遞狀態類型
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliveryStatusSucceeded WebhookDeliveryStatus = "succeeded"
+	WebhookDeliveryStatusFailed    WebhookDeliveryStatus = "failed"
+)
+
+// WebhookSubscription 主辦方對單一活動的 webhook 訂閱
+type WebhookSubscription struct {
+	ID             int        `json:"-" db:"id"` // 內部主鍵，不對外暴露
+	SubscriptionID uuid.UUID  `json:"subscription_id" db:"subscription_id"`
+	EventID        int        `json:"event_id" db:"event_id"`
+	URL            string     `json:"url" db:"url"`
+	Secret         string     `json:"-" db:"secret"` // 僅在建立時回傳一次
+	EventTypes     []string   `json:"event_types" db:"event_types"`
+	Active         bool       `json:"active" db:"active"`
+	CreatedAt      time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at" db:"updated_at"`
+	DeletedAt      *time.Time `json:"deleted_at,omitempty" db:"deleted_at"`
+}
+
+type UpdateWebhookSubscriptionParams struct {
+	URL        *string
+	EventTypes []string
+	Active     *bool
+}
+
+// WebhookDelivery 單次事件對單一訂閱的投遞紀錄
+type WebhookDelivery struct {
+	ID             int64                 `json:"-" db:"id"`
+	DeliveryID     uuid.UUID             `json:"delivery_id" db:"delivery_id"`
+	SubscriptionID int                   `json:"-" db:"subscription_id"`
+	OutboxEventID  int64                 `json:"outbox_event_id" db:"outbox_event_id"`
+	EventType      string                `json:"event_type" db:"event_type"`
+	Payload        json.RawMessage       `json:"payload" db:"payload"`
+	Status         WebhookDeliveryStatus `json:"status" db:"status"`
+	Attempts       int                   `json:"attempts" db:"attempts"`
+	NextAttemptAt  time.Time             `json:"next_attempt_at" db:"next_attempt_at"`
+	LastStatusCode *int                  `json:"last_status_code,omitempty" db:"last_status_code"`
+	LastError      *string               `json:"last_error,omitempty" db:"last_error"`
+	DeliveredAt    *time.Time            `json:"delivered_at,omitempty" db:"delivered_at"`
+	CreatedAt      time.Time             `json:"created_at" db:"created_at"`
+	UpdatedAt      time.Time             `json:"updated_at" db:"updated_at"`
+}
+
+// WebhookDeliveryTask dispatcher 領取到的投遞任務（含目標 URL 與簽章金鑰）
+type WebhookDeliveryTask struct {
+	Delivery *WebhookDelivery
+	URL      string
+	Secret   string
+}
+
+// WebhookDeliveryAttempt 一次投遞嘗試的結果
+type WebhookDeliveryAttempt struct {
+	Status        WebhookDeliveryStatus
+	StatusCode    *int
+	Error         *string
+	NextAttemptAt time.Time
+}
+
+// WebhookPayload 實際送給接收端的 JSON 內容
+type WebhookPayload struct {
+	ID        uuid.UUID       `json:"id"` // 即 delivery_id，接收端可據此去重
+	Type      string          `json:"type"`
+	CreatedAt time.Time       `json:"created_at"`
+	Data      json.RawMessage `json:"data"`
+}
+
+// WebhookOrder 訂單事件送給主辦方的內容：不含預售存取碼、風險評分等內部資料
+type WebhookOrder struct {
+	OrderID        uuid.UUID   `json:"order_id"`
+	UserID         int         `json:"user_id"`
+	TicketID       int         `json:"ticket_id"`
+	Quantity       int         `json:"quantity"`
+	TotalPrice     float64     `json:"total_price"`
+	PricePhase     *string     `json:"price_phase,omitempty"`
+	PromoCode      *string     `json:"promo_code,omitempty"`
+	DiscountAmount float64     `json:"discount_amount"`
+	SeatIDs        []int       `json:"seat_ids,omitempty"`
+	Status         OrderStatus `json:"status"`
+	CreatedAt      time.Time   `json:"created_at"`
+	UpdatedAt      time.Time   `json:"updated_at"`
+}
+
+// NewWebhookOrder 從訂單快照取出可對外公開的欄位
+func NewWebhookOrder(order *Order) *WebhookOrder {
+	return &WebhookOrder{
+		OrderID:        order.OrderID,
+		UserID:         order.UserID,
+		TicketID:       order.TicketID,
+		Quantity:       order.Quantity,
+		TotalPrice:     order.TotalPrice,
+		PricePhase:     order.PricePhase,
+		PromoCode:      order.PromoCode,
+		DiscountAmount: order.DiscountAmount,
+		SeatIDs:        order.SeatIDs,
+		Status:         order.Status,
+		CreatedAt:      order.CreatedAt,
+		UpdatedAt:      order.UpdatedAt,
+	}
+}
@@ -0,0 +1,74 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+	"go-gin-high-concurrency/internal/model"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	EventStreamKey = "events:stream"
+)
+
+type EventPublisher interface {
+	// 發佈領域事件給下游系統
+	Publish(ctx context.Context, event *model.OutboxEvent) error
+}
+
+type RedisStreamEventPublisherImpl struct {
+	client    *redis.Client
+	streamKey string
+}
+
+// NewRedisStreamEventPublisher 建立 Redis Stream 版 EventPublisher。streamKey 為空時使用 EventStreamKey。
+func NewRedisStreamEventPublisher(client *redis.Client, streamKey string) EventPublisher {
+	if streamKey == "" {
+		streamKey = EventStreamKey
+	}
+	return &RedisStreamEventPublisherImpl{
+		client:    client,
+		streamKey: streamKey,
+	}
+}
+
+func (p *RedisStreamEventPublisherImpl) Publish(ctx context.Context, event *model.OutboxEvent) error {
+	// event_id 為 outbox 主鍵，下游可據此去重（at-least-once 可能重複投遞）
+	err := p.client.XAdd(ctx, &redis.XAddArgs{
+		Stream: p.streamKey,
+		Values: map[string]interface{}{
+			"event_id":       strconv.FormatInt(event.ID, 10),
+			"aggregate_type": event.AggregateType,
+			"aggregate_id":   event.AggregateID.String(),
+			"event_type":     event.EventType,
+			"payload":        string(event.Payload),
+			"created_at":     event.CreatedAt.UTC().Format(time.RFC3339Nano),
+		},
+	}).Err()
+	if err != nil {
+		return fmt.Errorf("publish event %d: %w", event.ID, err)
+	}
+	return nil
+}
+
+type FanoutEventPublisherImpl struct {
+	publishers []EventPublisher
+}
+
+// NewFanoutEventPublisher 依序將事件交給多個 publisher；任一失敗即回傳錯誤，由 relay 整筆重試
+// （已成功的 publisher 可能收到重複事件，下游需以 event_id 去重）
+func NewFanoutEventPublisher(publishers ...EventPublisher) EventPublisher {
+	return &FanoutEventPublisherImpl{publishers: publishers}
+}
+
+func (p *FanoutEventPublisherImpl) Publish(ctx context.Context, event *model.OutboxEvent) error {
+	for _, publisher := range p.publishers {
+		if err := publisher.Publish(ctx, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
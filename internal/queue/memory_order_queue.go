@@ -0,0 +1,226 @@
+package queue
+
+import (
+	"context"
+	"go-gin-high-concurrency/internal/model"
+	"go-gin-high-concurrency/pkg/app_errors"
+	"go-gin-high-concurrency/pkg/logger"
+	"slices"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// MemoryOrderQueueConfig 可注入的容量與重試設定；nil 或零值時使用預設。
+type MemoryOrderQueueConfig struct {
+	Capacity        int           // 佇列中的訊息上限（含處理中及等待重新投遞），超過時 PublishOrder 回傳 ErrQueueFull
+	RedeliveryDelay time.Duration // Nack(requeue) 後等待此時間才重新投遞
+	MaxRetryCount   int           // 投遞超過此次數視為毒藥消息並丟棄
+}
+
+func defaultMemoryQueueConfig() MemoryOrderQueueConfig {
+	return MemoryOrderQueueConfig{
+		Capacity:        1000,
+		RedeliveryDelay: time.Second,
+		MaxRetryCount:   5,
+	}
+}
+
+// memoryMessage 佇列中的一筆訂單；order 為發送時的副本，每次投遞再複製一份，Worker 修改內容不影響重新投遞
+type memoryMessage struct {
+	order      *model.Order
+	deliveries int
+}
+
+// ConfigurableMemoryOrderQueue 可於執行中調整重試設定的 OrderQueue（記憶體版）
+type ConfigurableMemoryOrderQueue interface {
+	OrderQueue
+	// 更新重新投遞延遲與重試次數，零值的欄位保留原值；容量不可於執行中變更
+	UpdateConfig(config MemoryOrderQueueConfig)
+}
+
+// MemoryOrderQueueImpl 單一程序內的 OrderQueue，供測試及單機開發使用：
+// 容量有上限、Publish 及 Nack 都不會阻塞，Nack(requeue) 的訊息延遲後重新投遞，語意與 Redis Stream 版相同
+type MemoryOrderQueueImpl struct {
+	mu      sync.Mutex
+	cfg     MemoryOrderQueueConfig
+	ready   []*memoryMessage // 等待投遞的訊息（FIFO）
+	pending int              // 已投遞尚未 Ack，及 Nack 後等待重新投遞的訊息數
+	signal  chan struct{}    // 有訊息可投遞時通知 subscriber
+}
+
+// NewMemoryOrderQueue 建立記憶體版 OrderQueue。config 可為 nil，則使用預設容量與重試設定。
+func NewMemoryOrderQueue(config *MemoryOrderQueueConfig) OrderQueue {
+	cfg := defaultMemoryQueueConfig()
+	if config != nil {
+		if config.Capacity > 0 {
+			cfg.Capacity = config.Capacity
+		}
+		if config.RedeliveryDelay > 0 {
+			cfg.RedeliveryDelay = config.RedeliveryDelay
+		}
+		if config.MaxRetryCount > 0 {
+			cfg.MaxRetryCount = config.MaxRetryCount
+		}
+	}
+	return &MemoryOrderQueueImpl{
+		cfg:    cfg,
+		signal: make(chan struct{}, 1),
+	}
+}
+
+func (q *MemoryOrderQueueImpl) UpdateConfig(config MemoryOrderQueueConfig) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if config.RedeliveryDelay > 0 {
+		q.cfg.RedeliveryDelay = config.RedeliveryDelay
+	}
+	if config.MaxRetryCount > 0 {
+		q.cfg.MaxRetryCount = config.MaxRetryCount
+	}
+}
+
+// config 目前生效的設定快照
+func (q *MemoryOrderQueueImpl) config() MemoryOrderQueueConfig {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.cfg
+}
+
+// PublishOrder 佇列已滿時立即回傳 ErrQueueFull，不等待空位
+func (q *MemoryOrderQueueImpl) PublishOrder(ctx context.Context, order *model.Order) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	q.mu.Lock()
+	if len(q.ready)+q.pending >= q.cfg.Capacity {
+		q.mu.Unlock()
+		return app_errors.ErrQueueFull
+	}
+	q.ready = append(q.ready, &memoryMessage{order: cloneOrder(order)})
+	q.mu.Unlock()
+	q.notify()
+	return nil
+}
+
+// SubscribeOrders 可多次呼叫，多個 subscriber 競爭同一個佇列的訊息
+func (q *MemoryOrderQueueImpl) SubscribeOrders(ctx context.Context) (<-chan Delivery, error) {
+	out := make(chan Delivery)
+	go func() {
+		defer close(out)
+		for {
+			msg, ok := q.next(ctx)
+			if !ok {
+				return
+			}
+			select {
+			case out <- q.newDelivery(msg):
+			case <-ctx.Done():
+				// 尚未交給 Worker，放回佇列開頭
+				q.putBack(msg)
+				return
+			}
+		}
+	}()
+	return out, nil
+}
+
+func (q *MemoryOrderQueueImpl) Stats(ctx context.Context) (*QueueStats, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return &QueueStats{Pending: int64(q.pending), Lag: int64(len(q.ready))}, nil
+}
+
+// next 取出下一筆訊息並計入 pending，沒有訊息時等待，ctx 結束時回傳 false
+func (q *MemoryOrderQueueImpl) next(ctx context.Context) (*memoryMessage, bool) {
+	for {
+		q.mu.Lock()
+		if len(q.ready) > 0 {
+			msg := q.ready[0]
+			q.ready[0] = nil
+			q.ready = q.ready[1:]
+			q.pending++
+			msg.deliveries++
+			remaining := len(q.ready)
+			q.mu.Unlock()
+			// signal 容量為 1，還有訊息時轉交給其他 subscriber
+			if remaining > 0 {
+				q.notify()
+			}
+			return msg, true
+		}
+		q.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return nil, false
+		case <-q.signal:
+		}
+	}
+}
+
+func (q *MemoryOrderQueueImpl) putBack(msg *memoryMessage) {
+	q.mu.Lock()
+	q.pending--
+	msg.deliveries--
+	q.ready = append([]*memoryMessage{msg}, q.ready...)
+	q.mu.Unlock()
+	q.notify()
+}
+
+// requeue 將等待重新投遞的訊息移回佇列尾端；仍計入容量，因此不會超過上限
+func (q *MemoryOrderQueueImpl) requeue(msg *memoryMessage) {
+	q.mu.Lock()
+	q.pending--
+	q.ready = append(q.ready, msg)
+	q.mu.Unlock()
+	q.notify()
+}
+
+func (q *MemoryOrderQueueImpl) settle() {
+	q.mu.Lock()
+	q.pending--
+	q.mu.Unlock()
+}
+
+func (q *MemoryOrderQueueImpl) notify() {
+	select {
+	case q.signal <- struct{}{}:
+	default:
+	}
+}
+
+// newDelivery 組裝 Delivery；Ack / Nack 只有第一次呼叫有效
+func (q *MemoryOrderQueueImpl) newDelivery(msg *memoryMessage) Delivery {
+	var once sync.Once
+	return Delivery{
+		Data: cloneOrder(msg.order),
+		Ack: func() {
+			once.Do(q.settle)
+		},
+		Nack: func(requeue bool) {
+			once.Do(func() {
+				if !requeue {
+					q.settle()
+					return
+				}
+				cfg := q.config()
+				if msg.deliveries >= cfg.MaxRetryCount {
+					logger.MQ.Warn("discard poison message", zap.String("request_id", msg.order.RequestID), zap.Int("retries", msg.deliveries), zap.Int("max_retries", cfg.MaxRetryCount))
+					q.settle()
+					return
+				}
+				// 延遲後重新投遞，不阻塞呼叫端
+				time.AfterFunc(cfg.RedeliveryDelay, func() { q.requeue(msg) })
+			})
+		},
+	}
+}
+
+func cloneOrder(order *model.Order) *model.Order {
+	copied := *order
+	copied.SeatIDs = slices.Clone(order.SeatIDs)
+	copied.RiskFlags = slices.Clone(order.RiskFlags)
+	return &copied
+}
@@ -0,0 +1,248 @@
+package queue
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go-gin-high-concurrency/internal/model"
+	"go-gin-high-concurrency/pkg/app_errors"
+	"go-gin-high-concurrency/pkg/logger"
+	"sync"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"go.uber.org/zap"
+)
+
+const (
+	NATSStreamName    = "ORDERS"
+	NATSOrderSubject  = "orders.created"
+	NATSDurableName   = "order-workers"
+	natsSetupTimeout  = 10 * time.Second
+	natsFetchMaxBatch = 10
+	// 取得訊息失敗（例如連線中斷）時的重試間隔，連續失敗時加倍至上限，成功後重置
+	natsNextInitialBackoff = 100 * time.Millisecond
+	natsNextMaxBackoff     = 5 * time.Second
+)
+
+// NATSOrderQueueConfig 可注入的 stream、consumer 與重試設定；nil 或零值時使用預設。
+type NATSOrderQueueConfig struct {
+	Stream          string        // JetStream stream 名稱，不存在時自動建立
+	Subject         string        // 訂單訊息的 subject
+	Durable         string        // 所有 Worker 共用的 durable consumer 名稱
+	AckWait         time.Duration // 投遞後超過此時間未 Ack，由伺服器重新投遞（例如 Worker 當機）
+	RedeliveryDelay time.Duration // Nack(requeue) 後等待此時間才重新投遞
+	MaxRetryCount   int           // 投遞超過此次數視為毒藥消息並丟棄
+}
+
+func defaultNATSQueueConfig() NATSOrderQueueConfig {
+	return NATSOrderQueueConfig{
+		Stream:          NATSStreamName,
+		Subject:         NATSOrderSubject,
+		Durable:         NATSDurableName,
+		AckWait:         30 * time.Second,
+		RedeliveryDelay: 5 * time.Second,
+		MaxRetryCount:   5,
+	}
+}
+
+// ConfigurableNATSOrderQueue 可於執行中調整重試設定的 OrderQueue（NATS JetStream 版）
+type ConfigurableNATSOrderQueue interface {
+	OrderQueue
+	// 更新重新投遞延遲與重試次數，零值的欄位保留原值；stream、subject、consumer 及 AckWait 不可於執行中變更
+	UpdateConfig(config NATSOrderQueueConfig)
+}
+
+// NATSOrderQueueImpl NATS JetStream 版 OrderQueue：stream 使用 work-queue retention，訊息 Ack 後即從 stream 移除；
+// 以 request_id 作為 Nats-Msg-Id，重複發送的訂單由伺服器去重
+type NATSOrderQueueImpl struct {
+	js       jetstream.JetStream
+	consumer jetstream.Consumer
+	cfgMu    sync.RWMutex
+	cfg      NATSOrderQueueConfig
+}
+
+// NewNATSOrderQueue 建立 NATS JetStream 版 OrderQueue，並建立（或更新）stream 及 durable consumer。config 可為 nil，則使用預設值。
+func NewNATSOrderQueue(nc *nats.Conn, config *NATSOrderQueueConfig) (OrderQueue, error) {
+	cfg := defaultNATSQueueConfig()
+	if config != nil {
+		if config.Stream != "" {
+			cfg.Stream = config.Stream
+		}
+		if config.Subject != "" {
+			cfg.Subject = config.Subject
+		}
+		if config.Durable != "" {
+			cfg.Durable = config.Durable
+		}
+		if config.AckWait > 0 {
+			cfg.AckWait = config.AckWait
+		}
+		if config.RedeliveryDelay > 0 {
+			cfg.RedeliveryDelay = config.RedeliveryDelay
+		}
+		if config.MaxRetryCount > 0 {
+			cfg.MaxRetryCount = config.MaxRetryCount
+		}
+	}
+
+	js, err := jetstream.New(nc)
+	if err != nil {
+		return nil, fmt.Errorf("create jetstream context: %w", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), natsSetupTimeout)
+	defer cancel()
+
+	if _, err := js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+		Name:      cfg.Stream,
+		Subjects:  []string{cfg.Subject},
+		Retention: jetstream.WorkQueuePolicy,
+		Storage:   jetstream.FileStorage,
+	}); err != nil {
+		return nil, fmt.Errorf("ensure stream %s: %w", cfg.Stream, err)
+	}
+	consumer, err := js.CreateOrUpdateConsumer(ctx, cfg.Stream, jetstream.ConsumerConfig{
+		Durable:       cfg.Durable,
+		FilterSubject: cfg.Subject,
+		AckPolicy:     jetstream.AckExplicitPolicy,
+		AckWait:       cfg.AckWait,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("ensure consumer %s: %w", cfg.Durable, err)
+	}
+
+	return &NATSOrderQueueImpl{js: js, consumer: consumer, cfg: cfg}, nil
+}
+
+func (q *NATSOrderQueueImpl) UpdateConfig(config NATSOrderQueueConfig) {
+	q.cfgMu.Lock()
+	defer q.cfgMu.Unlock()
+	if config.RedeliveryDelay > 0 {
+		q.cfg.RedeliveryDelay = config.RedeliveryDelay
+	}
+	if config.MaxRetryCount > 0 {
+		q.cfg.MaxRetryCount = config.MaxRetryCount
+	}
+}
+
+// config 目前生效的設定快照
+func (q *NATSOrderQueueImpl) config() NATSOrderQueueConfig {
+	q.cfgMu.RLock()
+	defer q.cfgMu.RUnlock()
+	return q.cfg
+}
+
+func (q *NATSOrderQueueImpl) PublishOrder(ctx context.Context, order *model.Order) error {
+	orderJSON, err := json.Marshal(order)
+	if err != nil {
+		return fmt.Errorf("marshal order: %w", err)
+	}
+	var opts []jetstream.PublishOpt
+	if order.RequestID != "" {
+		opts = append(opts, jetstream.WithMsgID(order.RequestID))
+	}
+	if _, err := q.js.Publish(ctx, q.cfg.Subject, orderJSON, opts...); err != nil {
+		return fmt.Errorf("jetstream publish: %w", err)
+	}
+	return nil
+}
+
+func (q *NATSOrderQueueImpl) SubscribeOrders(ctx context.Context) (<-chan Delivery, error) {
+	messages, err := q.consumer.Messages(jetstream.PullMaxMessages(natsFetchMaxBatch))
+	if err != nil {
+		return nil, fmt.Errorf("subscribe consumer %s: %w", q.cfg.Durable, err)
+	}
+
+	out := make(chan Delivery)
+	go func() {
+		defer close(out)
+		defer messages.Stop()
+		backoff := natsNextInitialBackoff
+		for {
+			msg, err := messages.Next(jetstream.NextContext(ctx))
+			if err != nil {
+				if ctx.Err() != nil || errors.Is(err, jetstream.ErrMsgIteratorClosed) {
+					return
+				}
+				logger.MQ.Error("jetstream next failed", zap.Duration("retry_in", backoff), zap.Error(err))
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(backoff):
+				}
+				backoff = min(backoff*2, natsNextMaxBackoff)
+				continue
+			}
+			backoff = natsNextInitialBackoff
+			d := q.newDelivery(msg)
+			if d == nil {
+				continue
+			}
+			select {
+			case out <- *d:
+			case <-ctx.Done():
+				// 尚未交給 Worker，立即讓伺服器重新投遞
+				_ = msg.Nak()
+				return
+			}
+		}
+	}()
+	return out, nil
+}
+
+// Stats 以 durable consumer 的狀態回報積壓：Pending 為已投遞尚未 Ack，Lag 為尚未投遞的訊息數
+func (q *NATSOrderQueueImpl) Stats(ctx context.Context) (*QueueStats, error) {
+	info, err := q.consumer.Info(ctx)
+	if err != nil {
+		if errors.Is(err, jetstream.ErrConsumerNotFound) {
+			return nil, app_errors.ErrConsumerGroupNotFound
+		}
+		return nil, fmt.Errorf("consumer info: %w", err)
+	}
+	return &QueueStats{Pending: int64(info.NumAckPending), Lag: int64(info.NumPending)}, nil
+}
+
+// newDelivery 從 JetStream 訊息組裝 Delivery；無法解析或超過重試次數的訊息直接 Term，不再投遞
+func (q *NATSOrderQueueImpl) newDelivery(msg jetstream.Msg) *Delivery {
+	meta, err := msg.Metadata()
+	if err != nil {
+		logger.MQ.Warn("invalid message: missing metadata", zap.String("subject", msg.Subject()), zap.Error(err))
+		_ = msg.Term()
+		return nil
+	}
+	cfg := q.config()
+	if deliveries := int(meta.NumDelivered); deliveries > cfg.MaxRetryCount {
+		logger.MQ.Warn("discard poison message", zap.Uint64("stream_seq", meta.Sequence.Stream), zap.Int("retries", deliveries-1), zap.Int("max_retries", cfg.MaxRetryCount))
+		_ = msg.Term()
+		return nil
+	}
+
+	order := new(model.Order)
+	if err := json.Unmarshal(msg.Data(), order); err != nil {
+		logger.MQ.Warn("unmarshal order failed", zap.Uint64("stream_seq", meta.Sequence.Stream), zap.Error(err))
+		_ = msg.Term()
+		return nil
+	}
+	seq := meta.Sequence.Stream
+	return &Delivery{
+		Data: order,
+		Ack: func() {
+			if err := msg.Ack(); err != nil {
+				logger.MQ.Error("jetstream ack failed", zap.Uint64("stream_seq", seq), zap.Error(err))
+			}
+		},
+		Nack: func(requeue bool) {
+			if requeue {
+				if err := msg.NakWithDelay(q.config().RedeliveryDelay); err != nil {
+					logger.MQ.Error("jetstream nak failed", zap.Uint64("stream_seq", seq), zap.Error(err))
+				}
+				return
+			}
+			if err := msg.Term(); err != nil {
+				logger.MQ.Error("jetstream term failed", zap.Uint64("stream_seq", seq), zap.Error(err))
+			}
+		},
+	}
+}
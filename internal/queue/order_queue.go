@@ -18,50 +18,7 @@ type OrderQueue interface {
 	SubscribeOrders(ctx context.Context) (<-chan Delivery, error)
 }
 
-type OrderQueueImpl struct {
-	// 使用 Go channel 來模擬 MQ 隊列
-	ch chan *model.Order
-}
-
+// NewOrderQueue 建立容量為 bufferSize 的記憶體佇列，其餘設定使用預設值
 func NewOrderQueue(bufferSize int) OrderQueue {
-	return &OrderQueueImpl{
-		ch: make(chan *model.Order, bufferSize),
-	}
-}
-
-func (q *OrderQueueImpl) PublishOrder(ctx context.Context, order *model.Order) error {
-	// 模擬 MQ 發送
-	q.ch <- order
-	return nil
-}
-
-func (q *OrderQueueImpl) SubscribeOrders(ctx context.Context) (<-chan Delivery, error) {
-	out := make(chan Delivery)
-
-	go func() {
-		defer close(out)
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case order, ok := <-q.ch:
-				if !ok {
-					return
-				}
-
-				// 將原始 Order 包裝成 Delivery 格式給 Worker
-				out <- Delivery{
-					Data: order,
-					Ack:  func() { /* 記憶體版不用做特別動作 */ },
-					Nack: func(requeue bool) {
-						if requeue {
-							q.ch <- order // 簡單模擬重回隊列
-						}
-					},
-				}
-			}
-		}
-	}()
-
-	return out, nil
+	return NewMemoryOrderQueue(&MemoryOrderQueueConfig{Capacity: bufferSize})
 }
@@ -5,7 +5,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"go-gin-high-concurrency/internal/model"
+	"go-gin-high-concurrency/pkg/app_errors"
 	"go-gin-high-concurrency/pkg/logger"
+	"strings"
 	"sync"
 	"time"
 
@@ -22,6 +24,7 @@ const (
 
 // RedisStreamOrderQueueConfig 可注入的逾時與重試設定；nil 或零值時使用預設。
 type RedisStreamOrderQueueConfig struct {
+	StreamKey          string        // 訂單佇列的 stream key，空字串時使用 StreamKey
 	ClaimMinIdleTime   time.Duration // PEL 中超過此時間才被 XAUTOCLAIM 領取
 	MaxRetryCount      int           // 超過此次數視為毒藥消息並丟棄
 	ReadGroupBlockTime time.Duration // XReadGroup 阻塞時間
@@ -32,6 +35,7 @@ func defaultRedisStreamConfig() RedisStreamOrderQueueConfig {
 		ClaimMinIdleTime:   5 * time.Second,
 		MaxRetryCount:      5,
 		ReadGroupBlockTime: 2 * time.Second,
+		StreamKey:          StreamKey,
 	}
 }
 
@@ -40,10 +44,31 @@ type RedisStreamOrderQueueImpl struct {
 	streamKey    string
 	groupName    string
 	consumerName string
+	cfgMu        sync.RWMutex
 	cfg          RedisStreamOrderQueueConfig
 	orderPool    sync.Pool
 }
 
+// ConfigurableOrderQueue 可於執行中調整逾時與重試設定的 OrderQueue（Redis Stream 版）
+type ConfigurableOrderQueue interface {
+	OrderQueue
+	// 更新逾時與重試設定，零值的欄位保留原值；stream key 不可於執行中變更
+	UpdateConfig(config RedisStreamOrderQueueConfig)
+}
+
+// QueueStats 訂單佇列 consumer group 的積壓狀況
+type QueueStats struct {
+	Pending int64 // 已投遞但尚未 Ack 的訊息數（PEL）
+	Lag     int64 // 尚未投遞給 consumer group 的訊息數；無法判斷時為 -1
+}
+
+// InspectableOrderQueue 可查詢 consumer group 積壓狀況的 OrderQueue（Redis Stream 版），供健康檢查使用
+type InspectableOrderQueue interface {
+	OrderQueue
+	// 查詢 consumer group 的積壓狀況，consumer group 不存在時回傳 ErrConsumerGroupNotFound
+	Stats(ctx context.Context) (*QueueStats, error)
+}
+
 // NewRedisStreamOrderQueue 建立 Redis Stream 版 OrderQueue。config 可為 nil，則使用預設逾時與重試次數。
 func NewRedisStreamOrderQueue(client *redis.Client, consumerID string, config *RedisStreamOrderQueueConfig) (OrderQueue, error) {
 	if consumerID == "" {
@@ -51,6 +76,9 @@ func NewRedisStreamOrderQueue(client *redis.Client, consumerID string, config *R
 	}
 	cfg := defaultRedisStreamConfig()
 	if config != nil {
+		if config.StreamKey != "" {
+			cfg.StreamKey = config.StreamKey
+		}
 		if config.ClaimMinIdleTime > 0 {
 			cfg.ClaimMinIdleTime = config.ClaimMinIdleTime
 		}
@@ -63,7 +91,7 @@ func NewRedisStreamOrderQueue(client *redis.Client, consumerID string, config *R
 	}
 	q := &RedisStreamOrderQueueImpl{
 		client:       client,
-		streamKey:    StreamKey,
+		streamKey:    cfg.StreamKey,
 		groupName:    ConsumerGroupName,
 		consumerName: fmt.Sprintf("%s:%s", ConsumerNamePrefix, consumerID),
 		cfg:          cfg,
@@ -78,6 +106,44 @@ func NewRedisStreamOrderQueue(client *redis.Client, consumerID string, config *R
 	return q, nil
 }
 
+func (q *RedisStreamOrderQueueImpl) UpdateConfig(config RedisStreamOrderQueueConfig) {
+	q.cfgMu.Lock()
+	defer q.cfgMu.Unlock()
+	if config.ClaimMinIdleTime > 0 {
+		q.cfg.ClaimMinIdleTime = config.ClaimMinIdleTime
+	}
+	if config.MaxRetryCount > 0 {
+		q.cfg.MaxRetryCount = config.MaxRetryCount
+	}
+	if config.ReadGroupBlockTime > 0 {
+		q.cfg.ReadGroupBlockTime = config.ReadGroupBlockTime
+	}
+}
+
+// config 目前生效的設定快照
+func (q *RedisStreamOrderQueueImpl) config() RedisStreamOrderQueueConfig {
+	q.cfgMu.RLock()
+	defer q.cfgMu.RUnlock()
+	return q.cfg
+}
+
+func (q *RedisStreamOrderQueueImpl) Stats(ctx context.Context) (*QueueStats, error) {
+	groups, err := q.client.XInfoGroups(ctx, q.streamKey).Result()
+	if err != nil {
+		// stream 不存在時 consumer group 也不存在
+		if strings.Contains(err.Error(), "no such key") {
+			return nil, app_errors.ErrConsumerGroupNotFound
+		}
+		return nil, fmt.Errorf("xinfo groups: %w", err)
+	}
+	for _, group := range groups {
+		if group.Name == q.groupName {
+			return &QueueStats{Pending: group.Pending, Lag: group.Lag}, nil
+		}
+	}
+	return nil, app_errors.ErrConsumerGroupNotFound
+}
+
 func (q *RedisStreamOrderQueueImpl) ensureConsumerGroup(ctx context.Context) error {
 	err := q.client.XGroupCreateMkStream(ctx, q.streamKey, q.groupName, "0").Err()
 	if err != nil && err.Error() != "BUSYGROUP Consumer Group name already exists" {
@@ -132,7 +198,7 @@ func (q *RedisStreamOrderQueueImpl) readAndDeliver(ctx context.Context, out chan
 		Consumer: q.consumerName,
 		Streams:  []string{q.streamKey, ">"},
 		Count:    10,
-		Block:    q.cfg.ReadGroupBlockTime,
+		Block:    q.config().ReadGroupBlockTime,
 	}).Result()
 
 	if err == redis.Nil {
@@ -171,8 +237,8 @@ func (q *RedisStreamOrderQueueImpl) shouldProcessMessage(ctx context.Context, me
 		logger.MQ.Warn("getMessageRetryCount failed", zap.String("message_id", messageID), zap.Error(err))
 		return true
 	}
-	if n >= q.cfg.MaxRetryCount {
-		logger.MQ.Warn("discard poison message", zap.String("message_id", messageID), zap.Int("retries", n), zap.Int("max_retries", q.cfg.MaxRetryCount))
+	if maxRetryCount := q.config().MaxRetryCount; n >= maxRetryCount {
+		logger.MQ.Warn("discard poison message", zap.String("message_id", messageID), zap.Int("retries", n), zap.Int("max_retries", maxRetryCount))
 		_ = q.client.XAck(ctx, q.streamKey, q.groupName, messageID).Err()
 		return false
 	}
@@ -201,7 +267,8 @@ func (q *RedisStreamOrderQueueImpl) getMessageRetryCount(ctx context.Context, me
 
 // runAutoClaim 定時用 XAUTOCLAIM 領取超時未處理的消息
 func (q *RedisStreamOrderQueueImpl) runAutoClaim(ctx context.Context, out chan<- Delivery) {
-	ticker := time.NewTicker(q.cfg.ClaimMinIdleTime)
+	claimMinIdleTime := q.config().ClaimMinIdleTime
+	ticker := time.NewTicker(claimMinIdleTime)
 	defer ticker.Stop()
 	startID := "0-0"
 
@@ -210,11 +277,16 @@ func (q *RedisStreamOrderQueueImpl) runAutoClaim(ctx context.Context, out chan<-
 		case <-ctx.Done():
 			return
 		case <-ticker.C:
+			// 領取閒置時間於執行中調整時，同步調整輪詢間隔
+			if current := q.config().ClaimMinIdleTime; current != claimMinIdleTime {
+				claimMinIdleTime = current
+				ticker.Reset(claimMinIdleTime)
+			}
 			claimed, nextID, err := q.client.XAutoClaim(ctx, &redis.XAutoClaimArgs{
 				Stream:   q.streamKey,
 				Group:    q.groupName,
 				Consumer: q.consumerName,
-				MinIdle:  q.cfg.ClaimMinIdleTime,
+				MinIdle:  claimMinIdleTime,
 				Count:    10,
 				Start:    startID,
 			}).Result()
@@ -273,7 +345,7 @@ func (q *RedisStreamOrderQueueImpl) newDelivery(ctx context.Context, msg redis.X
 			q.orderPool.Put(order)
 			if requeue {
 				// 不做任何事：消息留在 PEL，等 ClaimMinIdleTime 後由 XAUTOCLAIM 領取，形成延遲重試
-				logger.MQ.Info("message nack(requeue), will retry", zap.String("message_id", msgID), zap.Duration("claim_min_idle", q.cfg.ClaimMinIdleTime))
+				logger.MQ.Info("message nack(requeue), will retry", zap.String("message_id", msgID), zap.Duration("claim_min_idle", q.config().ClaimMinIdleTime))
 				return
 			}
 			if err := q.client.XAck(ctx, q.streamKey, q.groupName, msgID).Err(); err != nil {
@@ -34,17 +34,18 @@ func NewEventRepository(pool *pgxpool.Pool) EventRepository {
 
 func (r *EventRepositoryImpl) Create(ctx context.Context, event *model.Event) (*model.Event, error) {
 	query := `
-		INSERT INTO events (event_id, name, description)
-		VALUES ($1, $2, $3)
-		RETURNING id, event_id, name, description, created_at, updated_at
+		INSERT INTO events (event_id, name, description, max_per_user)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, event_id, name, description, max_per_user, created_at, updated_at
 	`
 	err := r.pool.QueryRow(ctx, query,
-		event.EventID, event.Name, event.Description,
+		event.EventID, event.Name, event.Description, event.MaxPerUser,
 	).Scan(
 		&event.ID,
 		&event.EventID,
 		&event.Name,
 		&event.Description,
+		&event.MaxPerUser,
 		&event.CreatedAt,
 		&event.UpdatedAt,
 	)
@@ -56,7 +57,7 @@ func (r *EventRepositoryImpl) Create(ctx context.Context, event *model.Event) (*
 
 func (r *EventRepositoryImpl) List(ctx context.Context) ([]*model.Event, error) {
 	query := `
-		SELECT id, event_id, name, description, created_at, updated_at
+		SELECT id, event_id, name, description, max_per_user, created_at, updated_at
 		FROM events
 		ORDER BY created_at DESC
 	`
@@ -74,6 +75,7 @@ func (r *EventRepositoryImpl) List(ctx context.Context) ([]*model.Event, error)
 			&event.EventID,
 			&event.Name,
 			&event.Description,
+			&event.MaxPerUser,
 			&event.CreatedAt,
 			&event.UpdatedAt,
 		)
@@ -87,7 +89,7 @@ func (r *EventRepositoryImpl) List(ctx context.Context) ([]*model.Event, error)
 
 func (r *EventRepositoryImpl) FindByID(ctx context.Context, id int) (*model.Event, error) {
 	query := `
-		SELECT id, event_id, name, description, created_at, updated_at
+		SELECT id, event_id, name, description, max_per_user, created_at, updated_at
 		FROM events
 		WHERE id = $1
 	`
@@ -98,6 +100,7 @@ func (r *EventRepositoryImpl) FindByID(ctx context.Context, id int) (*model.Even
 		&event.EventID,
 		&event.Name,
 		&event.Description,
+		&event.MaxPerUser,
 		&event.CreatedAt,
 		&event.UpdatedAt,
 	)
@@ -113,7 +116,7 @@ func (r *EventRepositoryImpl) FindByID(ctx context.Context, id int) (*model.Even
 
 func (r *EventRepositoryImpl) FindByEventID(ctx context.Context, eventID uuid.UUID) (*model.Event, error) {
 	query := `
-		SELECT id, event_id, name, description, created_at, updated_at
+		SELECT id, event_id, name, description, max_per_user, created_at, updated_at
 		FROM events
 		WHERE event_id = $1
 	`
@@ -124,6 +127,7 @@ func (r *EventRepositoryImpl) FindByEventID(ctx context.Context, eventID uuid.UU
 		&event.EventID,
 		&event.Name,
 		&event.Description,
+		&event.MaxPerUser,
 		&event.CreatedAt,
 		&event.UpdatedAt,
 	)
@@ -154,6 +158,12 @@ func (r *EventRepositoryImpl) Update(ctx context.Context, id int, params model.U
 		argPos++
 	}
 
+	if params.MaxPerUser != nil {
+		sets = append(sets, fmt.Sprintf("max_per_user = NULLIF($%d, 0)", argPos))
+		args = append(args, *params.MaxPerUser)
+		argPos++
+	}
+
 	if len(sets) == 0 {
 		return nil, apperrors.ErrInvalidInput
 	}
@@ -170,7 +180,7 @@ func (r *EventRepositoryImpl) Update(ctx context.Context, id int, params model.U
 		UPDATE events
 		SET %s
 		WHERE id = $%d
-        RETURNING id, event_id, name, description, created_at, updated_at
+        RETURNING id, event_id, name, description, max_per_user, created_at, updated_at
 	`, strings.Join(sets, ", "), argPos)
 
 	var event model.Event
@@ -180,6 +190,7 @@ func (r *EventRepositoryImpl) Update(ctx context.Context, id int, params model.U
 		&event.EventID,
 		&event.Name,
 		&event.Description,
+		&event.MaxPerUser,
 		&event.CreatedAt,
 		&event.UpdatedAt,
 	)
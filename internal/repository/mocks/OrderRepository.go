@@ -114,6 +114,80 @@ func (_c *MockOrderRepository_Create_Call) RunAndReturn(run func(ctx context.Con
 	return _c
 }
 
+// CreateStatusHistory provides a mock function for the type MockOrderRepository
+func (_mock *MockOrderRepository) CreateStatusHistory(ctx context.Context, tx pgx.Tx, history *model.OrderStatusHistory) (*model.OrderStatusHistory, error) {
+	ret := _mock.Called(ctx, tx, history)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateStatusHistory")
+	}
+
+	var r0 *model.OrderStatusHistory
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgx.Tx, *model.OrderStatusHistory) (*model.OrderStatusHistory, error)); ok {
+		return returnFunc(ctx, tx, history)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgx.Tx, *model.OrderStatusHistory) *model.OrderStatusHistory); ok {
+		r0 = returnFunc(ctx, tx, history)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.OrderStatusHistory)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgx.Tx, *model.OrderStatusHistory) error); ok {
+		r1 = returnFunc(ctx, tx, history)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOrderRepository_CreateStatusHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateStatusHistory'
+type MockOrderRepository_CreateStatusHistory_Call struct {
+	*mock.Call
+}
+
+// CreateStatusHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx pgx.Tx
+//   - history *model.OrderStatusHistory
+func (_e *MockOrderRepository_Expecter) CreateStatusHistory(ctx interface{}, tx interface{}, history interface{}) *MockOrderRepository_CreateStatusHistory_Call {
+	return &MockOrderRepository_CreateStatusHistory_Call{Call: _e.mock.On("CreateStatusHistory", ctx, tx, history)}
+}
+
+func (_c *MockOrderRepository_CreateStatusHistory_Call) Run(run func(ctx context.Context, tx pgx.Tx, history *model.OrderStatusHistory)) *MockOrderRepository_CreateStatusHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgx.Tx
+		if args[1] != nil {
+			arg1 = args[1].(pgx.Tx)
+		}
+		var arg2 *model.OrderStatusHistory
+		if args[2] != nil {
+			arg2 = args[2].(*model.OrderStatusHistory)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOrderRepository_CreateStatusHistory_Call) Return(orderStatusHistory *model.OrderStatusHistory, err error) *MockOrderRepository_CreateStatusHistory_Call {
+	_c.Call.Return(orderStatusHistory, err)
+	return _c
+}
+
+func (_c *MockOrderRepository_CreateStatusHistory_Call) RunAndReturn(run func(ctx context.Context, tx pgx.Tx, history *model.OrderStatusHistory) (*model.OrderStatusHistory, error)) *MockOrderRepository_CreateStatusHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Delete provides a mock function for the type MockOrderRepository
 func (_mock *MockOrderRepository) Delete(ctx context.Context, id int) error {
 	ret := _mock.Called(ctx, id)
@@ -239,6 +313,80 @@ func (_c *MockOrderRepository_FindByID_Call) RunAndReturn(run func(ctx context.C
 	return _c
 }
 
+// FindByIDWithLock provides a mock function for the type MockOrderRepository
+func (_mock *MockOrderRepository) FindByIDWithLock(ctx context.Context, tx pgx.Tx, id int) (*model.Order, error) {
+	ret := _mock.Called(ctx, tx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByIDWithLock")
+	}
+
+	var r0 *model.Order
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgx.Tx, int) (*model.Order, error)); ok {
+		return returnFunc(ctx, tx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgx.Tx, int) *model.Order); ok {
+		r0 = returnFunc(ctx, tx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Order)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgx.Tx, int) error); ok {
+		r1 = returnFunc(ctx, tx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOrderRepository_FindByIDWithLock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByIDWithLock'
+type MockOrderRepository_FindByIDWithLock_Call struct {
+	*mock.Call
+}
+
+// FindByIDWithLock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx pgx.Tx
+//   - id int
+func (_e *MockOrderRepository_Expecter) FindByIDWithLock(ctx interface{}, tx interface{}, id interface{}) *MockOrderRepository_FindByIDWithLock_Call {
+	return &MockOrderRepository_FindByIDWithLock_Call{Call: _e.mock.On("FindByIDWithLock", ctx, tx, id)}
+}
+
+func (_c *MockOrderRepository_FindByIDWithLock_Call) Run(run func(ctx context.Context, tx pgx.Tx, id int)) *MockOrderRepository_FindByIDWithLock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgx.Tx
+		if args[1] != nil {
+			arg1 = args[1].(pgx.Tx)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOrderRepository_FindByIDWithLock_Call) Return(order *model.Order, err error) *MockOrderRepository_FindByIDWithLock_Call {
+	_c.Call.Return(order, err)
+	return _c
+}
+
+func (_c *MockOrderRepository_FindByIDWithLock_Call) RunAndReturn(run func(ctx context.Context, tx pgx.Tx, id int) (*model.Order, error)) *MockOrderRepository_FindByIDWithLock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindByOrderID provides a mock function for the type MockOrderRepository
 func (_mock *MockOrderRepository) FindByOrderID(ctx context.Context, orderID uuid.UUID) (*model.Order, error) {
 	ret := _mock.Called(ctx, orderID)
@@ -307,6 +455,74 @@ func (_c *MockOrderRepository_FindByOrderID_Call) RunAndReturn(run func(ctx cont
 	return _c
 }
 
+// FindByRequestID provides a mock function for the type MockOrderRepository
+func (_mock *MockOrderRepository) FindByRequestID(ctx context.Context, requestID string) (*model.Order, error) {
+	ret := _mock.Called(ctx, requestID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByRequestID")
+	}
+
+	var r0 *model.Order
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*model.Order, error)); ok {
+		return returnFunc(ctx, requestID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *model.Order); ok {
+		r0 = returnFunc(ctx, requestID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Order)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, requestID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOrderRepository_FindByRequestID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByRequestID'
+type MockOrderRepository_FindByRequestID_Call struct {
+	*mock.Call
+}
+
+// FindByRequestID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - requestID string
+func (_e *MockOrderRepository_Expecter) FindByRequestID(ctx interface{}, requestID interface{}) *MockOrderRepository_FindByRequestID_Call {
+	return &MockOrderRepository_FindByRequestID_Call{Call: _e.mock.On("FindByRequestID", ctx, requestID)}
+}
+
+func (_c *MockOrderRepository_FindByRequestID_Call) Run(run func(ctx context.Context, requestID string)) *MockOrderRepository_FindByRequestID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOrderRepository_FindByRequestID_Call) Return(order *model.Order, err error) *MockOrderRepository_FindByRequestID_Call {
+	_c.Call.Return(order, err)
+	return _c
+}
+
+func (_c *MockOrderRepository_FindByRequestID_Call) RunAndReturn(run func(ctx context.Context, requestID string) (*model.Order, error)) *MockOrderRepository_FindByRequestID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindByUserID provides a mock function for the type MockOrderRepository
 func (_mock *MockOrderRepository) FindByUserID(ctx context.Context, userID int) ([]*model.Order, error) {
 	ret := _mock.Called(ctx, userID)
@@ -515,6 +731,142 @@ func (_c *MockOrderRepository_List_Call) RunAndReturn(run func(ctx context.Conte
 	return _c
 }
 
+// ListFlaggedByEventID provides a mock function for the type MockOrderRepository
+func (_mock *MockOrderRepository) ListFlaggedByEventID(ctx context.Context, eventID int) ([]*model.Order, error) {
+	ret := _mock.Called(ctx, eventID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListFlaggedByEventID")
+	}
+
+	var r0 []*model.Order
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) ([]*model.Order, error)); ok {
+		return returnFunc(ctx, eventID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) []*model.Order); ok {
+		r0 = returnFunc(ctx, eventID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Order)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = returnFunc(ctx, eventID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOrderRepository_ListFlaggedByEventID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListFlaggedByEventID'
+type MockOrderRepository_ListFlaggedByEventID_Call struct {
+	*mock.Call
+}
+
+// ListFlaggedByEventID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventID int
+func (_e *MockOrderRepository_Expecter) ListFlaggedByEventID(ctx interface{}, eventID interface{}) *MockOrderRepository_ListFlaggedByEventID_Call {
+	return &MockOrderRepository_ListFlaggedByEventID_Call{Call: _e.mock.On("ListFlaggedByEventID", ctx, eventID)}
+}
+
+func (_c *MockOrderRepository_ListFlaggedByEventID_Call) Run(run func(ctx context.Context, eventID int)) *MockOrderRepository_ListFlaggedByEventID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOrderRepository_ListFlaggedByEventID_Call) Return(orders []*model.Order, err error) *MockOrderRepository_ListFlaggedByEventID_Call {
+	_c.Call.Return(orders, err)
+	return _c
+}
+
+func (_c *MockOrderRepository_ListFlaggedByEventID_Call) RunAndReturn(run func(ctx context.Context, eventID int) ([]*model.Order, error)) *MockOrderRepository_ListFlaggedByEventID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListStatusHistory provides a mock function for the type MockOrderRepository
+func (_mock *MockOrderRepository) ListStatusHistory(ctx context.Context, orderID int) ([]*model.OrderStatusHistory, error) {
+	ret := _mock.Called(ctx, orderID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListStatusHistory")
+	}
+
+	var r0 []*model.OrderStatusHistory
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) ([]*model.OrderStatusHistory, error)); ok {
+		return returnFunc(ctx, orderID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) []*model.OrderStatusHistory); ok {
+		r0 = returnFunc(ctx, orderID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.OrderStatusHistory)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = returnFunc(ctx, orderID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOrderRepository_ListStatusHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStatusHistory'
+type MockOrderRepository_ListStatusHistory_Call struct {
+	*mock.Call
+}
+
+// ListStatusHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderID int
+func (_e *MockOrderRepository_Expecter) ListStatusHistory(ctx interface{}, orderID interface{}) *MockOrderRepository_ListStatusHistory_Call {
+	return &MockOrderRepository_ListStatusHistory_Call{Call: _e.mock.On("ListStatusHistory", ctx, orderID)}
+}
+
+func (_c *MockOrderRepository_ListStatusHistory_Call) Run(run func(ctx context.Context, orderID int)) *MockOrderRepository_ListStatusHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOrderRepository_ListStatusHistory_Call) Return(orderStatusHistorys []*model.OrderStatusHistory, err error) *MockOrderRepository_ListStatusHistory_Call {
+	_c.Call.Return(orderStatusHistorys, err)
+	return _c
+}
+
+func (_c *MockOrderRepository_ListStatusHistory_Call) RunAndReturn(run func(ctx context.Context, orderID int) ([]*model.OrderStatusHistory, error)) *MockOrderRepository_ListStatusHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateStatusWithLock provides a mock function for the type MockOrderRepository
 func (_mock *MockOrderRepository) UpdateStatusWithLock(ctx context.Context, tx pgx.Tx, id int, status model.OrderStatus) (*model.Order, error) {
 	ret := _mock.Called(ctx, tx, id, status)
@@ -40,38 +40,49 @@ func (_m *MockTicketRepository) EXPECT() *MockTicketRepository_Expecter {
 	return &MockTicketRepository_Expecter{mock: &_m.Mock}
 }
 
-// AddStock provides a mock function for the type MockTicketRepository
-func (_mock *MockTicketRepository) AddStock(ctx context.Context, tx pgx.Tx, id int, quantity int) error {
-	ret := _mock.Called(ctx, tx, id, quantity)
+// AdjustStock provides a mock function for the type MockTicketRepository
+func (_mock *MockTicketRepository) AdjustStock(ctx context.Context, tx pgx.Tx, id int, delta int) (*model.Ticket, error) {
+	ret := _mock.Called(ctx, tx, id, delta)
 
 	if len(ret) == 0 {
-		panic("no return value specified for AddStock")
+		panic("no return value specified for AdjustStock")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, pgx.Tx, int, int) error); ok {
-		r0 = returnFunc(ctx, tx, id, quantity)
+	var r0 *model.Ticket
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgx.Tx, int, int) (*model.Ticket, error)); ok {
+		return returnFunc(ctx, tx, id, delta)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgx.Tx, int, int) *model.Ticket); ok {
+		r0 = returnFunc(ctx, tx, id, delta)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Ticket)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgx.Tx, int, int) error); ok {
+		r1 = returnFunc(ctx, tx, id, delta)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockTicketRepository_AddStock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddStock'
-type MockTicketRepository_AddStock_Call struct {
+// MockTicketRepository_AdjustStock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AdjustStock'
+type MockTicketRepository_AdjustStock_Call struct {
 	*mock.Call
 }
 
-// AddStock is a helper method to define mock.On call
+// AdjustStock is a helper method to define mock.On call
 //   - ctx context.Context
 //   - tx pgx.Tx
 //   - id int
-//   - quantity int
-func (_e *MockTicketRepository_Expecter) AddStock(ctx interface{}, tx interface{}, id interface{}, quantity interface{}) *MockTicketRepository_AddStock_Call {
-	return &MockTicketRepository_AddStock_Call{Call: _e.mock.On("AddStock", ctx, tx, id, quantity)}
+//   - delta int
+func (_e *MockTicketRepository_Expecter) AdjustStock(ctx interface{}, tx interface{}, id interface{}, delta interface{}) *MockTicketRepository_AdjustStock_Call {
+	return &MockTicketRepository_AdjustStock_Call{Call: _e.mock.On("AdjustStock", ctx, tx, id, delta)}
 }
 
-func (_c *MockTicketRepository_AddStock_Call) Run(run func(ctx context.Context, tx pgx.Tx, id int, quantity int)) *MockTicketRepository_AddStock_Call {
+func (_c *MockTicketRepository_AdjustStock_Call) Run(run func(ctx context.Context, tx pgx.Tx, id int, delta int)) *MockTicketRepository_AdjustStock_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -99,12 +110,12 @@ func (_c *MockTicketRepository_AddStock_Call) Run(run func(ctx context.Context,
 	return _c
 }
 
-func (_c *MockTicketRepository_AddStock_Call) Return(err error) *MockTicketRepository_AddStock_Call {
-	_c.Call.Return(err)
+func (_c *MockTicketRepository_AdjustStock_Call) Return(ticket *model.Ticket, err error) *MockTicketRepository_AdjustStock_Call {
+	_c.Call.Return(ticket, err)
 	return _c
 }
 
-func (_c *MockTicketRepository_AddStock_Call) RunAndReturn(run func(ctx context.Context, tx pgx.Tx, id int, quantity int) error) *MockTicketRepository_AddStock_Call {
+func (_c *MockTicketRepository_AdjustStock_Call) RunAndReturn(run func(ctx context.Context, tx pgx.Tx, id int, delta int) (*model.Ticket, error)) *MockTicketRepository_AdjustStock_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -177,21 +188,106 @@ func (_c *MockTicketRepository_Create_Call) RunAndReturn(run func(ctx context.Co
 	return _c
 }
 
+// CreateInventoryAdjustment provides a mock function for the type MockTicketRepository
+func (_mock *MockTicketRepository) CreateInventoryAdjustment(ctx context.Context, tx pgx.Tx, adjustment *model.InventoryAdjustment) (*model.InventoryAdjustment, error) {
+	ret := _mock.Called(ctx, tx, adjustment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateInventoryAdjustment")
+	}
+
+	var r0 *model.InventoryAdjustment
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgx.Tx, *model.InventoryAdjustment) (*model.InventoryAdjustment, error)); ok {
+		return returnFunc(ctx, tx, adjustment)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgx.Tx, *model.InventoryAdjustment) *model.InventoryAdjustment); ok {
+		r0 = returnFunc(ctx, tx, adjustment)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.InventoryAdjustment)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgx.Tx, *model.InventoryAdjustment) error); ok {
+		r1 = returnFunc(ctx, tx, adjustment)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTicketRepository_CreateInventoryAdjustment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateInventoryAdjustment'
+type MockTicketRepository_CreateInventoryAdjustment_Call struct {
+	*mock.Call
+}
+
+// CreateInventoryAdjustment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx pgx.Tx
+//   - adjustment *model.InventoryAdjustment
+func (_e *MockTicketRepository_Expecter) CreateInventoryAdjustment(ctx interface{}, tx interface{}, adjustment interface{}) *MockTicketRepository_CreateInventoryAdjustment_Call {
+	return &MockTicketRepository_CreateInventoryAdjustment_Call{Call: _e.mock.On("CreateInventoryAdjustment", ctx, tx, adjustment)}
+}
+
+func (_c *MockTicketRepository_CreateInventoryAdjustment_Call) Run(run func(ctx context.Context, tx pgx.Tx, adjustment *model.InventoryAdjustment)) *MockTicketRepository_CreateInventoryAdjustment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgx.Tx
+		if args[1] != nil {
+			arg1 = args[1].(pgx.Tx)
+		}
+		var arg2 *model.InventoryAdjustment
+		if args[2] != nil {
+			arg2 = args[2].(*model.InventoryAdjustment)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTicketRepository_CreateInventoryAdjustment_Call) Return(inventoryAdjustment *model.InventoryAdjustment, err error) *MockTicketRepository_CreateInventoryAdjustment_Call {
+	_c.Call.Return(inventoryAdjustment, err)
+	return _c
+}
+
+func (_c *MockTicketRepository_CreateInventoryAdjustment_Call) RunAndReturn(run func(ctx context.Context, tx pgx.Tx, adjustment *model.InventoryAdjustment) (*model.InventoryAdjustment, error)) *MockTicketRepository_CreateInventoryAdjustment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DecrementStock provides a mock function for the type MockTicketRepository
-func (_mock *MockTicketRepository) DecrementStock(ctx context.Context, tx pgx.Tx, id int, quantity int) error {
+func (_mock *MockTicketRepository) DecrementStock(ctx context.Context, tx pgx.Tx, id int, quantity int) (*model.Ticket, error) {
 	ret := _mock.Called(ctx, tx, id, quantity)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DecrementStock")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, pgx.Tx, int, int) error); ok {
+	var r0 *model.Ticket
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgx.Tx, int, int) (*model.Ticket, error)); ok {
+		return returnFunc(ctx, tx, id, quantity)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgx.Tx, int, int) *model.Ticket); ok {
 		r0 = returnFunc(ctx, tx, id, quantity)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Ticket)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgx.Tx, int, int) error); ok {
+		r1 = returnFunc(ctx, tx, id, quantity)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // MockTicketRepository_DecrementStock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DecrementStock'
@@ -236,12 +332,12 @@ func (_c *MockTicketRepository_DecrementStock_Call) Run(run func(ctx context.Con
 	return _c
 }
 
-func (_c *MockTicketRepository_DecrementStock_Call) Return(err error) *MockTicketRepository_DecrementStock_Call {
-	_c.Call.Return(err)
+func (_c *MockTicketRepository_DecrementStock_Call) Return(ticket *model.Ticket, err error) *MockTicketRepository_DecrementStock_Call {
+	_c.Call.Return(ticket, err)
 	return _c
 }
 
-func (_c *MockTicketRepository_DecrementStock_Call) RunAndReturn(run func(ctx context.Context, tx pgx.Tx, id int, quantity int) error) *MockTicketRepository_DecrementStock_Call {
+func (_c *MockTicketRepository_DecrementStock_Call) RunAndReturn(run func(ctx context.Context, tx pgx.Tx, id int, quantity int) (*model.Ticket, error)) *MockTicketRepository_DecrementStock_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -712,9 +808,225 @@ func (_c *MockTicketRepository_ListByEventID_Call) RunAndReturn(run func(ctx con
 	return _c
 }
 
+// ListInventoryAdjustments provides a mock function for the type MockTicketRepository
+func (_mock *MockTicketRepository) ListInventoryAdjustments(ctx context.Context, ticketID int) ([]*model.InventoryAdjustment, error) {
+	ret := _mock.Called(ctx, ticketID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListInventoryAdjustments")
+	}
+
+	var r0 []*model.InventoryAdjustment
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) ([]*model.InventoryAdjustment, error)); ok {
+		return returnFunc(ctx, ticketID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) []*model.InventoryAdjustment); ok {
+		r0 = returnFunc(ctx, ticketID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.InventoryAdjustment)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = returnFunc(ctx, ticketID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTicketRepository_ListInventoryAdjustments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListInventoryAdjustments'
+type MockTicketRepository_ListInventoryAdjustments_Call struct {
+	*mock.Call
+}
+
+// ListInventoryAdjustments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ticketID int
+func (_e *MockTicketRepository_Expecter) ListInventoryAdjustments(ctx interface{}, ticketID interface{}) *MockTicketRepository_ListInventoryAdjustments_Call {
+	return &MockTicketRepository_ListInventoryAdjustments_Call{Call: _e.mock.On("ListInventoryAdjustments", ctx, ticketID)}
+}
+
+func (_c *MockTicketRepository_ListInventoryAdjustments_Call) Run(run func(ctx context.Context, ticketID int)) *MockTicketRepository_ListInventoryAdjustments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTicketRepository_ListInventoryAdjustments_Call) Return(inventoryAdjustments []*model.InventoryAdjustment, err error) *MockTicketRepository_ListInventoryAdjustments_Call {
+	_c.Call.Return(inventoryAdjustments, err)
+	return _c
+}
+
+func (_c *MockTicketRepository_ListInventoryAdjustments_Call) RunAndReturn(run func(ctx context.Context, ticketID int) ([]*model.InventoryAdjustment, error)) *MockTicketRepository_ListInventoryAdjustments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListPricePhases provides a mock function for the type MockTicketRepository
+func (_mock *MockTicketRepository) ListPricePhases(ctx context.Context, ticketID int) ([]*model.TicketPricePhase, error) {
+	ret := _mock.Called(ctx, ticketID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPricePhases")
+	}
+
+	var r0 []*model.TicketPricePhase
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) ([]*model.TicketPricePhase, error)); ok {
+		return returnFunc(ctx, ticketID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) []*model.TicketPricePhase); ok {
+		r0 = returnFunc(ctx, ticketID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.TicketPricePhase)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = returnFunc(ctx, ticketID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTicketRepository_ListPricePhases_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPricePhases'
+type MockTicketRepository_ListPricePhases_Call struct {
+	*mock.Call
+}
+
+// ListPricePhases is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ticketID int
+func (_e *MockTicketRepository_Expecter) ListPricePhases(ctx interface{}, ticketID interface{}) *MockTicketRepository_ListPricePhases_Call {
+	return &MockTicketRepository_ListPricePhases_Call{Call: _e.mock.On("ListPricePhases", ctx, ticketID)}
+}
+
+func (_c *MockTicketRepository_ListPricePhases_Call) Run(run func(ctx context.Context, ticketID int)) *MockTicketRepository_ListPricePhases_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTicketRepository_ListPricePhases_Call) Return(ticketPricePhases []*model.TicketPricePhase, err error) *MockTicketRepository_ListPricePhases_Call {
+	_c.Call.Return(ticketPricePhases, err)
+	return _c
+}
+
+func (_c *MockTicketRepository_ListPricePhases_Call) RunAndReturn(run func(ctx context.Context, ticketID int) ([]*model.TicketPricePhase, error)) *MockTicketRepository_ListPricePhases_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReplacePricePhases provides a mock function for the type MockTicketRepository
+func (_mock *MockTicketRepository) ReplacePricePhases(ctx context.Context, tx pgx.Tx, ticketID int, phases []*model.TicketPricePhase) ([]*model.TicketPricePhase, error) {
+	ret := _mock.Called(ctx, tx, ticketID, phases)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplacePricePhases")
+	}
+
+	var r0 []*model.TicketPricePhase
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgx.Tx, int, []*model.TicketPricePhase) ([]*model.TicketPricePhase, error)); ok {
+		return returnFunc(ctx, tx, ticketID, phases)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgx.Tx, int, []*model.TicketPricePhase) []*model.TicketPricePhase); ok {
+		r0 = returnFunc(ctx, tx, ticketID, phases)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.TicketPricePhase)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgx.Tx, int, []*model.TicketPricePhase) error); ok {
+		r1 = returnFunc(ctx, tx, ticketID, phases)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTicketRepository_ReplacePricePhases_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplacePricePhases'
+type MockTicketRepository_ReplacePricePhases_Call struct {
+	*mock.Call
+}
+
+// ReplacePricePhases is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx pgx.Tx
+//   - ticketID int
+//   - phases []*model.TicketPricePhase
+func (_e *MockTicketRepository_Expecter) ReplacePricePhases(ctx interface{}, tx interface{}, ticketID interface{}, phases interface{}) *MockTicketRepository_ReplacePricePhases_Call {
+	return &MockTicketRepository_ReplacePricePhases_Call{Call: _e.mock.On("ReplacePricePhases", ctx, tx, ticketID, phases)}
+}
+
+func (_c *MockTicketRepository_ReplacePricePhases_Call) Run(run func(ctx context.Context, tx pgx.Tx, ticketID int, phases []*model.TicketPricePhase)) *MockTicketRepository_ReplacePricePhases_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 pgx.Tx
+		if args[1] != nil {
+			arg1 = args[1].(pgx.Tx)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 []*model.TicketPricePhase
+		if args[3] != nil {
+			arg3 = args[3].([]*model.TicketPricePhase)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTicketRepository_ReplacePricePhases_Call) Return(ticketPricePhases []*model.TicketPricePhase, err error) *MockTicketRepository_ReplacePricePhases_Call {
+	_c.Call.Return(ticketPricePhases, err)
+	return _c
+}
+
+func (_c *MockTicketRepository_ReplacePricePhases_Call) RunAndReturn(run func(ctx context.Context, tx pgx.Tx, ticketID int, phases []*model.TicketPricePhase) ([]*model.TicketPricePhase, error)) *MockTicketRepository_ReplacePricePhases_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function for the type MockTicketRepository
-func (_mock *MockTicketRepository) Update(ctx context.Context, ticketID uuid.UUID, params model.UpdateTicketParams) (*model.Ticket, error) {
-	ret := _mock.Called(ctx, ticketID, params)
+func (_mock *MockTicketRepository) Update(ctx context.Context, tx pgx.Tx, ticketID uuid.UUID, params model.UpdateTicketParams) (*model.Ticket, error) {
+	ret := _mock.Called(ctx, tx, ticketID, params)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Update")
@@ -722,18 +1034,18 @@ func (_mock *MockTicketRepository) Update(ctx context.Context, ticketID uuid.UUI
 
 	var r0 *model.Ticket
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, model.UpdateTicketParams) (*model.Ticket, error)); ok {
-		return returnFunc(ctx, ticketID, params)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgx.Tx, uuid.UUID, model.UpdateTicketParams) (*model.Ticket, error)); ok {
+		return returnFunc(ctx, tx, ticketID, params)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, model.UpdateTicketParams) *model.Ticket); ok {
-		r0 = returnFunc(ctx, ticketID, params)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, pgx.Tx, uuid.UUID, model.UpdateTicketParams) *model.Ticket); ok {
+		r0 = returnFunc(ctx, tx, ticketID, params)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.Ticket)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, model.UpdateTicketParams) error); ok {
-		r1 = returnFunc(ctx, ticketID, params)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, pgx.Tx, uuid.UUID, model.UpdateTicketParams) error); ok {
+		r1 = returnFunc(ctx, tx, ticketID, params)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -747,30 +1059,36 @@ type MockTicketRepository_Update_Call struct {
 
 // Update is a helper method to define mock.On call
 //   - ctx context.Context
+//   - tx pgx.Tx
 //   - ticketID uuid.UUID
 //   - params model.UpdateTicketParams
-func (_e *MockTicketRepository_Expecter) Update(ctx interface{}, ticketID interface{}, params interface{}) *MockTicketRepository_Update_Call {
-	return &MockTicketRepository_Update_Call{Call: _e.mock.On("Update", ctx, ticketID, params)}
+func (_e *MockTicketRepository_Expecter) Update(ctx interface{}, tx interface{}, ticketID interface{}, params interface{}) *MockTicketRepository_Update_Call {
+	return &MockTicketRepository_Update_Call{Call: _e.mock.On("Update", ctx, tx, ticketID, params)}
 }
 
-func (_c *MockTicketRepository_Update_Call) Run(run func(ctx context.Context, ticketID uuid.UUID, params model.UpdateTicketParams)) *MockTicketRepository_Update_Call {
+func (_c *MockTicketRepository_Update_Call) Run(run func(ctx context.Context, tx pgx.Tx, ticketID uuid.UUID, params model.UpdateTicketParams)) *MockTicketRepository_Update_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 uuid.UUID
+		var arg1 pgx.Tx
 		if args[1] != nil {
-			arg1 = args[1].(uuid.UUID)
+			arg1 = args[1].(pgx.Tx)
 		}
-		var arg2 model.UpdateTicketParams
+		var arg2 uuid.UUID
 		if args[2] != nil {
-			arg2 = args[2].(model.UpdateTicketParams)
+			arg2 = args[2].(uuid.UUID)
+		}
+		var arg3 model.UpdateTicketParams
+		if args[3] != nil {
+			arg3 = args[3].(model.UpdateTicketParams)
 		}
 		run(
 			arg0,
 			arg1,
 			arg2,
+			arg3,
 		)
 	})
 	return _c
@@ -781,7 +1099,7 @@ func (_c *MockTicketRepository_Update_Call) Return(ticket *model.Ticket, err err
 	return _c
 }
 
-func (_c *MockTicketRepository_Update_Call) RunAndReturn(run func(ctx context.Context, ticketID uuid.UUID, params model.UpdateTicketParams) (*model.Ticket, error)) *MockTicketRepository_Update_Call {
+func (_c *MockTicketRepository_Update_Call) RunAndReturn(run func(ctx context.Context, tx pgx.Tx, ticketID uuid.UUID, params model.UpdateTicketParams) (*model.Ticket, error)) *MockTicketRepository_Update_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -16,13 +16,20 @@ type OrderRepository interface {
 	List(ctx context.Context) ([]*model.Order, error)
 	FindByID(ctx context.Context, id int) (*model.Order, error)
 	FindByOrderID(ctx context.Context, orderID uuid.UUID) (*model.Order, error)
+	// 以下單時的 request_id 查詢；訂單由 worker 非同步寫入，寫入前回傳 ErrOrderNotFound
+	FindByRequestID(ctx context.Context, requestID string) (*model.Order, error)
 	FindByUserID(ctx context.Context, userID int) ([]*model.Order, error)
+	// 活動下有風險標記（risk_score > 0）的訂單，供主辦方審核
+	ListFlaggedByEventID(ctx context.Context, eventID int) ([]*model.Order, error)
 	Delete(ctx context.Context, id int) error
+	ListStatusHistory(ctx context.Context, orderID int) ([]*model.OrderStatusHistory, error)
 
 	// Transaction methods
 	Create(ctx context.Context, tx pgx.Tx, order *model.Order) (*model.Order, error)
+	FindByIDWithLock(ctx context.Context, tx pgx.Tx, id int) (*model.Order, error)
 	UpdateStatusWithLock(ctx context.Context, tx pgx.Tx, id int, status model.OrderStatus) (*model.Order, error)
 	GetUserTicketOrderCount(ctx context.Context, tx pgx.Tx, userID int, ticketID int) (int, error)
+	CreateStatusHistory(ctx context.Context, tx pgx.Tx, history *model.OrderStatusHistory) (*model.OrderStatusHistory, error)
 }
 
 type OrderRepositoryImpl struct {
@@ -37,13 +44,13 @@ func NewOrderRepository(pool *pgxpool.Pool) OrderRepository {
 
 func (r *OrderRepositoryImpl) Create(ctx context.Context, tx pgx.Tx, order *model.Order) (*model.Order, error) {
 	query := `
-		INSERT INTO orders (request_id, user_id, ticket_id, quantity, total_price, status)
-		VALUES ($1, $2, $3, $4, $5, $6)
-		RETURNING id, order_id, request_id, user_id, ticket_id, quantity, total_price, status, created_at, updated_at
+		INSERT INTO orders (request_id, user_id, ticket_id, quantity, total_price, price_phase, promo_code, discount_amount, access_code, risk_score, risk_flags, status)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, COALESCE($11::text[], '{}'), $12)
+		RETURNING id, order_id, request_id, user_id, ticket_id, quantity, total_price, price_phase, promo_code, discount_amount, access_code, risk_score, risk_flags, status, created_at, updated_at
 	`
 
 	err := tx.QueryRow(ctx, query,
-		order.RequestID, order.UserID, order.TicketID, order.Quantity, order.TotalPrice, order.Status,
+		order.RequestID, order.UserID, order.TicketID, order.Quantity, order.TotalPrice, order.PricePhase, order.PromoCode, order.DiscountAmount, order.AccessCode, order.RiskScore, order.RiskFlags, order.Status,
 	).Scan(
 		&order.ID,
 		&order.OrderID,
@@ -52,6 +59,12 @@ func (r *OrderRepositoryImpl) Create(ctx context.Context, tx pgx.Tx, order *mode
 		&order.TicketID,
 		&order.Quantity,
 		&order.TotalPrice,
+		&order.PricePhase,
+		&order.PromoCode,
+		&order.DiscountAmount,
+		&order.AccessCode,
+		&order.RiskScore,
+		&order.RiskFlags,
 		&order.Status,
 		&order.CreatedAt,
 		&order.UpdatedAt,
@@ -66,7 +79,7 @@ func (r *OrderRepositoryImpl) Create(ctx context.Context, tx pgx.Tx, order *mode
 
 func (r *OrderRepositoryImpl) List(ctx context.Context) ([]*model.Order, error) {
 	query := `
-		SELECT id, order_id, request_id, user_id, ticket_id, quantity, total_price, status,
+		SELECT id, order_id, request_id, user_id, ticket_id, quantity, total_price, price_phase, promo_code, discount_amount, access_code, risk_score, risk_flags, status,
 		       created_at, updated_at, deleted_at
 		FROM orders
 		WHERE deleted_at IS NULL
@@ -91,6 +104,12 @@ func (r *OrderRepositoryImpl) List(ctx context.Context) ([]*model.Order, error)
 			&order.TicketID,
 			&order.Quantity,
 			&order.TotalPrice,
+			&order.PricePhase,
+			&order.PromoCode,
+			&order.DiscountAmount,
+			&order.AccessCode,
+			&order.RiskScore,
+			&order.RiskFlags,
 			&order.Status,
 			&order.CreatedAt,
 			&order.UpdatedAt,
@@ -111,7 +130,7 @@ func (r *OrderRepositoryImpl) List(ctx context.Context) ([]*model.Order, error)
 
 func (r *OrderRepositoryImpl) FindByID(ctx context.Context, id int) (*model.Order, error) {
 	query := `
-		SELECT id, order_id, request_id, user_id, ticket_id, quantity, total_price, status,
+		SELECT id, order_id, request_id, user_id, ticket_id, quantity, total_price, price_phase, promo_code, discount_amount, access_code, risk_score, risk_flags, status,
 		       created_at, updated_at, deleted_at
 		FROM orders
 		WHERE id = $1 AND deleted_at IS NULL
@@ -126,6 +145,12 @@ func (r *OrderRepositoryImpl) FindByID(ctx context.Context, id int) (*model.Orde
 		&order.TicketID,
 		&order.Quantity,
 		&order.TotalPrice,
+		&order.PricePhase,
+		&order.PromoCode,
+		&order.DiscountAmount,
+		&order.AccessCode,
+		&order.RiskScore,
+		&order.RiskFlags,
 		&order.Status,
 		&order.CreatedAt,
 		&order.UpdatedAt,
@@ -144,7 +169,7 @@ func (r *OrderRepositoryImpl) FindByID(ctx context.Context, id int) (*model.Orde
 
 func (r *OrderRepositoryImpl) FindByOrderID(ctx context.Context, orderID uuid.UUID) (*model.Order, error) {
 	query := `
-		SELECT id, order_id, request_id, user_id, ticket_id, quantity, total_price, status,
+		SELECT id, order_id, request_id, user_id, ticket_id, quantity, total_price, price_phase, promo_code, discount_amount, access_code, risk_score, risk_flags, status,
 		       created_at, updated_at, deleted_at
 		FROM orders
 		WHERE order_id = $1 AND deleted_at IS NULL
@@ -159,6 +184,51 @@ func (r *OrderRepositoryImpl) FindByOrderID(ctx context.Context, orderID uuid.UU
 		&order.TicketID,
 		&order.Quantity,
 		&order.TotalPrice,
+		&order.PricePhase,
+		&order.PromoCode,
+		&order.DiscountAmount,
+		&order.AccessCode,
+		&order.RiskScore,
+		&order.RiskFlags,
+		&order.Status,
+		&order.CreatedAt,
+		&order.UpdatedAt,
+		&order.DeletedAt,
+	)
+
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, apperrors.ErrOrderNotFound
+		}
+		return nil, err
+	}
+
+	return &order, nil
+}
+
+func (r *OrderRepositoryImpl) FindByRequestID(ctx context.Context, requestID string) (*model.Order, error) {
+	query := `
+		SELECT id, order_id, request_id, user_id, ticket_id, quantity, total_price, price_phase, promo_code, discount_amount, access_code, risk_score, risk_flags, status,
+		       created_at, updated_at, deleted_at
+		FROM orders
+		WHERE request_id = $1 AND deleted_at IS NULL
+	`
+
+	var order model.Order
+	err := r.pool.QueryRow(ctx, query, requestID).Scan(
+		&order.ID,
+		&order.OrderID,
+		&order.RequestID,
+		&order.UserID,
+		&order.TicketID,
+		&order.Quantity,
+		&order.TotalPrice,
+		&order.PricePhase,
+		&order.PromoCode,
+		&order.DiscountAmount,
+		&order.AccessCode,
+		&order.RiskScore,
+		&order.RiskFlags,
 		&order.Status,
 		&order.CreatedAt,
 		&order.UpdatedAt,
@@ -177,7 +247,7 @@ func (r *OrderRepositoryImpl) FindByOrderID(ctx context.Context, orderID uuid.UU
 
 func (r *OrderRepositoryImpl) FindByUserID(ctx context.Context, userID int) ([]*model.Order, error) {
 	query := `
-		SELECT id, order_id, request_id, user_id, ticket_id, quantity, total_price, status,
+		SELECT id, order_id, request_id, user_id, ticket_id, quantity, total_price, price_phase, promo_code, discount_amount, access_code, risk_score, risk_flags, status,
 		       created_at, updated_at, deleted_at
 		FROM orders
 		WHERE user_id = $1 AND deleted_at IS NULL
@@ -202,6 +272,64 @@ func (r *OrderRepositoryImpl) FindByUserID(ctx context.Context, userID int) ([]*
 			&order.TicketID,
 			&order.Quantity,
 			&order.TotalPrice,
+			&order.PricePhase,
+			&order.PromoCode,
+			&order.DiscountAmount,
+			&order.AccessCode,
+			&order.RiskScore,
+			&order.RiskFlags,
+			&order.Status,
+			&order.CreatedAt,
+			&order.UpdatedAt,
+			&order.DeletedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		orders = append(orders, &order)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return orders, nil
+}
+
+func (r *OrderRepositoryImpl) ListFlaggedByEventID(ctx context.Context, eventID int) ([]*model.Order, error) {
+	query := `
+		SELECT o.id, o.order_id, o.request_id, o.user_id, o.ticket_id, o.quantity, o.total_price, o.price_phase, o.promo_code, o.discount_amount, o.access_code, o.risk_score, o.risk_flags, o.status,
+		       o.created_at, o.updated_at, o.deleted_at
+		FROM orders o
+		JOIN tickets t ON t.id = o.ticket_id
+		WHERE t.event_id = $1 AND o.risk_score > 0 AND o.deleted_at IS NULL
+		ORDER BY o.created_at DESC
+	`
+
+	rows, err := r.pool.Query(ctx, query, eventID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	orders := make([]*model.Order, 0, 16)
+
+	for rows.Next() {
+		var order model.Order
+		err := rows.Scan(
+			&order.ID,
+			&order.OrderID,
+			&order.RequestID,
+			&order.UserID,
+			&order.TicketID,
+			&order.Quantity,
+			&order.TotalPrice,
+			&order.PricePhase,
+			&order.PromoCode,
+			&order.DiscountAmount,
+			&order.AccessCode,
+			&order.RiskScore,
+			&order.RiskFlags,
 			&order.Status,
 			&order.CreatedAt,
 			&order.UpdatedAt,
@@ -220,6 +348,46 @@ func (r *OrderRepositoryImpl) FindByUserID(ctx context.Context, userID int) ([]*
 	return orders, nil
 }
 
+func (r *OrderRepositoryImpl) FindByIDWithLock(ctx context.Context, tx pgx.Tx, id int) (*model.Order, error) {
+	query := `
+		SELECT id, order_id, request_id, user_id, ticket_id, quantity, total_price, price_phase, promo_code, discount_amount, access_code, risk_score, risk_flags, status,
+		       created_at, updated_at, deleted_at
+		FROM orders
+		WHERE id = $1 AND deleted_at IS NULL
+		FOR UPDATE
+	`
+
+	var order model.Order
+	err := tx.QueryRow(ctx, query, id).Scan(
+		&order.ID,
+		&order.OrderID,
+		&order.RequestID,
+		&order.UserID,
+		&order.TicketID,
+		&order.Quantity,
+		&order.TotalPrice,
+		&order.PricePhase,
+		&order.PromoCode,
+		&order.DiscountAmount,
+		&order.AccessCode,
+		&order.RiskScore,
+		&order.RiskFlags,
+		&order.Status,
+		&order.CreatedAt,
+		&order.UpdatedAt,
+		&order.DeletedAt,
+	)
+
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, apperrors.ErrOrderNotFound
+		}
+		return nil, err
+	}
+
+	return &order, nil
+}
+
 func (r *OrderRepositoryImpl) UpdateStatusWithLock(
 	ctx context.Context,
 	tx pgx.Tx,
@@ -230,7 +398,7 @@ func (r *OrderRepositoryImpl) UpdateStatusWithLock(
 		UPDATE orders
 		SET status = $1, updated_at = $2
 		WHERE id = $3
-		RETURNING id, order_id, request_id, user_id, ticket_id, quantity, total_price, status, created_at, updated_at
+		RETURNING id, order_id, request_id, user_id, ticket_id, quantity, total_price, price_phase, promo_code, discount_amount, access_code, risk_score, risk_flags, status, created_at, updated_at
 	`
 
 	var order model.Order
@@ -243,6 +411,12 @@ func (r *OrderRepositoryImpl) UpdateStatusWithLock(
 		&order.TicketID,
 		&order.Quantity,
 		&order.TotalPrice,
+		&order.PricePhase,
+		&order.PromoCode,
+		&order.DiscountAmount,
+		&order.AccessCode,
+		&order.RiskScore,
+		&order.RiskFlags,
 		&order.Status,
 		&order.CreatedAt,
 		&order.UpdatedAt,
@@ -284,15 +458,83 @@ func (r *OrderRepositoryImpl) GetUserTicketOrderCount(ctx context.Context, tx pg
 		FROM orders
 		WHERE user_id = $1 
 		  AND ticket_id = $2 
-		  AND status != $3
+		  AND status NOT IN ($3, $4, $5)
 		  AND deleted_at IS NULL
 	`
 
 	var totalQuantity int
-	err := tx.QueryRow(ctx, query, userID, ticketID, model.OrderStatusCancelled).Scan(&totalQuantity)
+	err := tx.QueryRow(ctx, query, userID, ticketID,
+		model.OrderStatusCancelled, model.OrderStatusExpired, model.OrderStatusRefunded,
+	).Scan(&totalQuantity)
 	if err != nil {
 		return 0, err
 	}
 
 	return totalQuantity, nil
 }
+
+func (r *OrderRepositoryImpl) CreateStatusHistory(ctx context.Context, tx pgx.Tx, history *model.OrderStatusHistory) (*model.OrderStatusHistory, error) {
+	query := `
+		INSERT INTO order_status_history (order_id, from_status, to_status, actor, reason)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, order_id, from_status, to_status, actor, reason, created_at
+	`
+
+	err := tx.QueryRow(ctx, query,
+		history.OrderID, history.FromStatus, history.ToStatus, history.Actor, history.Reason,
+	).Scan(
+		&history.ID,
+		&history.OrderID,
+		&history.FromStatus,
+		&history.ToStatus,
+		&history.Actor,
+		&history.Reason,
+		&history.CreatedAt,
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to create order status history: %w", err)
+	}
+
+	return history, nil
+}
+
+func (r *OrderRepositoryImpl) ListStatusHistory(ctx context.Context, orderID int) ([]*model.OrderStatusHistory, error) {
+	query := `
+		SELECT id, order_id, from_status, to_status, actor, reason, created_at
+		FROM order_status_history
+		WHERE order_id = $1
+		ORDER BY id ASC
+	`
+
+	rows, err := r.pool.Query(ctx, query, orderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	histories := make([]*model.OrderStatusHistory, 0, 4)
+
+	for rows.Next() {
+		var history model.OrderStatusHistory
+		err := rows.Scan(
+			&history.ID,
+			&history.OrderID,
+			&history.FromStatus,
+			&history.ToStatus,
+			&history.Actor,
+			&history.Reason,
+			&history.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		histories = append(histories, &history)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return histories, nil
+}
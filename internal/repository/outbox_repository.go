@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"go-gin-high-concurrency/internal/model"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type OutboxRepository interface {
+	// Transaction methods
+	Create(ctx context.Context, tx pgx.Tx, event *model.OutboxEvent) (*model.OutboxEvent, error)
+	// 依寫入順序取出未發佈事件並鎖定（FOR UPDATE），多個 relay 併發時會依序處理，確保發佈順序
+	ListUnpublishedWithLock(ctx context.Context, tx pgx.Tx, limit int) ([]*model.OutboxEvent, error)
+	MarkPublished(ctx context.Context, tx pgx.Tx, ids []int64) error
+}
+
+type OutboxRepositoryImpl struct {
+	pool *pgxpool.Pool
+}
+
+func NewOutboxRepository(pool *pgxpool.Pool) OutboxRepository {
+	return &OutboxRepositoryImpl{
+		pool: pool,
+	}
+}
+
+func (r *OutboxRepositoryImpl) Create(ctx context.Context, tx pgx.Tx, event *model.OutboxEvent) (*model.OutboxEvent, error) {
+	query := `
+		INSERT INTO outbox (aggregate_type, aggregate_id, event_type, payload)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, aggregate_type, aggregate_id, event_type, payload, created_at
+	`
+
+	err := tx.QueryRow(ctx, query,
+		event.AggregateType, event.AggregateID, event.EventType, event.Payload,
+	).Scan(
+		&event.ID,
+		&event.AggregateType,
+		&event.AggregateID,
+		&event.EventType,
+		&event.Payload,
+		&event.CreatedAt,
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to create outbox event: %w", err)
+	}
+
+	return event, nil
+}
+
+func (r *OutboxRepositoryImpl) ListUnpublishedWithLock(ctx context.Context, tx pgx.Tx, limit int) ([]*model.OutboxEvent, error) {
+	query := `
+		SELECT id, aggregate_type, aggregate_id, event_type, payload, created_at, published_at
+		FROM outbox
+		WHERE published_at IS NULL
+		ORDER BY id ASC
+		LIMIT $1
+		FOR UPDATE
+	`
+
+	rows, err := tx.Query(ctx, query, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	events := make([]*model.OutboxEvent, 0, limit)
+
+	for rows.Next() {
+		var event model.OutboxEvent
+		err := rows.Scan(
+			&event.ID,
+			&event.AggregateType,
+			&event.AggregateID,
+			&event.EventType,
+			&event.Payload,
+			&event.CreatedAt,
+			&event.PublishedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, &event)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+func (r *OutboxRepositoryImpl) MarkPublished(ctx context.Context, tx pgx.Tx, ids []int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	query := `
+		UPDATE outbox
+		SET published_at = $1
+		WHERE id = ANY($2) AND published_at IS NULL
+	`
+
+	_, err := tx.Exec(ctx, query, time.Now().UTC(), ids)
+	if err != nil {
+		return fmt.Errorf("failed to mark outbox events published: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,188 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go-gin-high-concurrency/internal/model"
+	apperrors "go-gin-high-concurrency/pkg/app_errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// foreignKeyViolation PostgreSQL foreign_key_violation 錯誤碼
+const foreignKeyViolation = "23503"
+
+type PresaleRepository interface {
+	CreateAccessCode(ctx context.Context, accessCode *model.PresaleAccessCode) (*model.PresaleAccessCode, error)
+	ListAccessCodes(ctx context.Context, ticketID int) ([]*model.PresaleAccessCode, error)
+	// 各存取碼已成立且未取消的訂單數（code -> 次數），供 Redis 重建使用次數
+	CountActiveAccessCodeUses(ctx context.Context, ticketID int) (map[string]int, error)
+
+	// Allowlist methods
+	// 已在名單內的使用者略過；使用者不存在時回傳 ErrUserNotFound
+	AddAllowlistUsers(ctx context.Context, ticketID int, userIDs []int) error
+	RemoveAllowlistUser(ctx context.Context, ticketID int, userID int) error
+	ListAllowlistUserIDs(ctx context.Context, ticketID int) ([]int, error)
+}
+
+type PresaleRepositoryImpl struct {
+	pool *pgxpool.Pool
+}
+
+func NewPresaleRepository(pool *pgxpool.Pool) PresaleRepository {
+	return &PresaleRepositoryImpl{
+		pool: pool,
+	}
+}
+
+func (r *PresaleRepositoryImpl) CreateAccessCode(ctx context.Context, accessCode *model.PresaleAccessCode) (*model.PresaleAccessCode, error) {
+	query := `
+		INSERT INTO presale_access_codes (ticket_id, code, max_uses)
+		VALUES ($1, $2, $3)
+		RETURNING id, ticket_id, code, max_uses, created_at
+	`
+
+	err := r.pool.QueryRow(ctx, query, accessCode.TicketID, accessCode.Code, accessCode.MaxUses).Scan(
+		&accessCode.ID,
+		&accessCode.TicketID,
+		&accessCode.Code,
+		&accessCode.MaxUses,
+		&accessCode.CreatedAt,
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+			return nil, apperrors.ErrAlreadyExists
+		}
+		return nil, fmt.Errorf("failed to create presale access code: %w", err)
+	}
+	return accessCode, nil
+}
+
+func (r *PresaleRepositoryImpl) ListAccessCodes(ctx context.Context, ticketID int) ([]*model.PresaleAccessCode, error) {
+	query := `
+		SELECT id, ticket_id, code, max_uses, created_at
+		FROM presale_access_codes
+		WHERE ticket_id = $1
+		ORDER BY id ASC
+	`
+
+	rows, err := r.pool.Query(ctx, query, ticketID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	accessCodes := make([]*model.PresaleAccessCode, 0)
+	for rows.Next() {
+		var a model.PresaleAccessCode
+		if err := rows.Scan(&a.ID, &a.TicketID, &a.Code, &a.MaxUses, &a.CreatedAt); err != nil {
+			return nil, err
+		}
+		accessCodes = append(accessCodes, &a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return accessCodes, nil
+}
+
+func (r *PresaleRepositoryImpl) CountActiveAccessCodeUses(ctx context.Context, ticketID int) (map[string]int, error) {
+	query := `
+		SELECT access_code, COUNT(*)
+		FROM orders
+		WHERE ticket_id = $1 AND access_code IS NOT NULL
+			AND status NOT IN ('cancelled', 'expired') AND deleted_at IS NULL
+		GROUP BY access_code
+	`
+
+	rows, err := r.pool.Query(ctx, query, ticketID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := make(map[string]int)
+	for rows.Next() {
+		var code string
+		var count int
+		if err := rows.Scan(&code, &count); err != nil {
+			return nil, err
+		}
+		counts[code] = count
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return counts, nil
+}
+
+func (r *PresaleRepositoryImpl) AddAllowlistUsers(ctx context.Context, ticketID int, userIDs []int) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	query := `
+		INSERT INTO presale_allowlist (ticket_id, user_id)
+		VALUES ($1, $2)
+		ON CONFLICT (ticket_id, user_id) DO NOTHING
+	`
+
+	batch := &pgx.Batch{}
+	for _, userID := range userIDs {
+		batch.Queue(query, ticketID, userID)
+	}
+
+	if err := r.pool.SendBatch(ctx, batch).Close(); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolation {
+			return apperrors.ErrUserNotFound
+		}
+		return fmt.Errorf("failed to add presale allowlist users: %w", err)
+	}
+	return nil
+}
+
+func (r *PresaleRepositoryImpl) RemoveAllowlistUser(ctx context.Context, ticketID int, userID int) error {
+	query := `
+		DELETE FROM presale_allowlist
+		WHERE ticket_id = $1 AND user_id = $2
+	`
+
+	if _, err := r.pool.Exec(ctx, query, ticketID, userID); err != nil {
+		return fmt.Errorf("failed to remove presale allowlist user: %w", err)
+	}
+	return nil
+}
+
+func (r *PresaleRepositoryImpl) ListAllowlistUserIDs(ctx context.Context, ticketID int) ([]int, error) {
+	query := `
+		SELECT user_id
+		FROM presale_allowlist
+		WHERE ticket_id = $1
+		ORDER BY user_id ASC
+	`
+
+	rows, err := r.pool.Query(ctx, query, ticketID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	userIDs := make([]int, 0)
+	for rows.Next() {
+		var userID int
+		if err := rows.Scan(&userID); err != nil {
+			return nil, err
+		}
+		userIDs = append(userIDs, userID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return userIDs, nil
+}
@@ -0,0 +1,240 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go-gin-high-concurrency/internal/model"
+	apperrors "go-gin-high-concurrency/pkg/app_errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PromoCodeRepository interface {
+	Create(ctx context.Context, promo *model.PromoCode) (*model.PromoCode, error)
+	List(ctx context.Context) ([]*model.PromoCode, error)
+	FindByCode(ctx context.Context, code string) (*model.PromoCode, error)
+
+	// Redemption methods
+	CreateRedemption(ctx context.Context, tx pgx.Tx, redemption *model.PromoRedemption) (*model.PromoRedemption, error)
+	// 訂單取消時歸還該訂單的使用紀錄；訂單未使用優惠碼時不做任何事
+	ReturnRedemption(ctx context.Context, tx pgx.Tx, orderID int) error
+	ListRedemptions(ctx context.Context, promoCodeID int) ([]*model.PromoRedemption, error)
+	// 各使用者尚未歸還的使用次數（user_id -> 次數），供 Redis 重建使用次數
+	CountActiveRedemptionsByUser(ctx context.Context, promoCodeID int) (map[int]int, error)
+}
+
+type PromoCodeRepositoryImpl struct {
+	pool *pgxpool.Pool
+}
+
+func NewPromoCodeRepository(pool *pgxpool.Pool) PromoCodeRepository {
+	return &PromoCodeRepositoryImpl{
+		pool: pool,
+	}
+}
+
+const promoCodeColumns = `id, code, discount_type, discount_value, event_id, ticket_id, max_redemptions, max_per_user,
+		starts_at, ends_at, created_at, updated_at`
+
+const promoRedemptionColumns = `id, promo_code_id, order_id, user_id, discount_amount, created_at, returned_at`
+
+func scanPromoCode(row pgx.Row) (*model.PromoCode, error) {
+	var promo model.PromoCode
+	err := row.Scan(
+		&promo.ID,
+		&promo.Code,
+		&promo.DiscountType,
+		&promo.DiscountValue,
+		&promo.EventID,
+		&promo.TicketID,
+		&promo.MaxRedemptions,
+		&promo.MaxPerUser,
+		&promo.StartsAt,
+		&promo.EndsAt,
+		&promo.CreatedAt,
+		&promo.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &promo, nil
+}
+
+func scanPromoRedemption(row pgx.Row) (*model.PromoRedemption, error) {
+	var redemption model.PromoRedemption
+	err := row.Scan(
+		&redemption.ID,
+		&redemption.PromoCodeID,
+		&redemption.OrderID,
+		&redemption.UserID,
+		&redemption.DiscountAmount,
+		&redemption.CreatedAt,
+		&redemption.ReturnedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &redemption, nil
+}
+
+func (r *PromoCodeRepositoryImpl) Create(ctx context.Context, promo *model.PromoCode) (*model.PromoCode, error) {
+	query := `
+		INSERT INTO promo_codes (code, discount_type, discount_value, event_id, ticket_id, max_redemptions, max_per_user, starts_at, ends_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING ` + promoCodeColumns
+
+	created, err := scanPromoCode(r.pool.QueryRow(ctx, query,
+		promo.Code,
+		promo.DiscountType,
+		promo.DiscountValue,
+		promo.EventID,
+		promo.TicketID,
+		promo.MaxRedemptions,
+		promo.MaxPerUser,
+		promo.StartsAt,
+		promo.EndsAt,
+	))
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+			return nil, apperrors.ErrAlreadyExists
+		}
+		return nil, fmt.Errorf("failed to create promo code: %w", err)
+	}
+	return created, nil
+}
+
+func (r *PromoCodeRepositoryImpl) List(ctx context.Context) ([]*model.PromoCode, error) {
+	query := `
+		SELECT ` + promoCodeColumns + `
+		FROM promo_codes
+		ORDER BY id ASC
+	`
+
+	rows, err := r.pool.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	promos := make([]*model.PromoCode, 0)
+	for rows.Next() {
+		promo, err := scanPromoCode(rows)
+		if err != nil {
+			return nil, err
+		}
+		promos = append(promos, promo)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return promos, nil
+}
+
+func (r *PromoCodeRepositoryImpl) FindByCode(ctx context.Context, code string) (*model.PromoCode, error) {
+	query := `
+		SELECT ` + promoCodeColumns + `
+		FROM promo_codes
+		WHERE code = $1
+	`
+
+	promo, err := scanPromoCode(r.pool.QueryRow(ctx, query, code))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, apperrors.ErrPromoCodeNotFound
+		}
+		return nil, err
+	}
+	return promo, nil
+}
+
+func (r *PromoCodeRepositoryImpl) CreateRedemption(ctx context.Context, tx pgx.Tx, redemption *model.PromoRedemption) (*model.PromoRedemption, error) {
+	query := `
+		INSERT INTO promo_redemptions (promo_code_id, order_id, user_id, discount_amount)
+		VALUES ($1, $2, $3, $4)
+		RETURNING ` + promoRedemptionColumns
+
+	created, err := scanPromoRedemption(tx.QueryRow(ctx, query,
+		redemption.PromoCodeID,
+		redemption.OrderID,
+		redemption.UserID,
+		redemption.DiscountAmount,
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create promo redemption: %w", err)
+	}
+	return created, nil
+}
+
+func (r *PromoCodeRepositoryImpl) ReturnRedemption(ctx context.Context, tx pgx.Tx, orderID int) error {
+	query := `
+		UPDATE promo_redemptions
+		SET returned_at = CURRENT_TIMESTAMP
+		WHERE order_id = $1 AND returned_at IS NULL
+	`
+
+	if _, err := tx.Exec(ctx, query, orderID); err != nil {
+		return fmt.Errorf("failed to return promo redemption: %w", err)
+	}
+	return nil
+}
+
+func (r *PromoCodeRepositoryImpl) ListRedemptions(ctx context.Context, promoCodeID int) ([]*model.PromoRedemption, error) {
+	query := `
+		SELECT ` + promoRedemptionColumns + `
+		FROM promo_redemptions
+		WHERE promo_code_id = $1
+		ORDER BY id ASC
+	`
+
+	rows, err := r.pool.Query(ctx, query, promoCodeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	redemptions := make([]*model.PromoRedemption, 0)
+	for rows.Next() {
+		redemption, err := scanPromoRedemption(rows)
+		if err != nil {
+			return nil, err
+		}
+		redemptions = append(redemptions, redemption)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return redemptions, nil
+}
+
+func (r *PromoCodeRepositoryImpl) CountActiveRedemptionsByUser(ctx context.Context, promoCodeID int) (map[int]int, error) {
+	query := `
+		SELECT user_id, COUNT(*)
+		FROM promo_redemptions
+		WHERE promo_code_id = $1 AND returned_at IS NULL
+		GROUP BY user_id
+	`
+
+	rows, err := r.pool.Query(ctx, query, promoCodeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := make(map[int]int)
+	for rows.Next() {
+		var userID, count int
+		if err := rows.Scan(&userID, &count); err != nil {
+			return nil, err
+		}
+		counts[userID] = count
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return counts, nil
+}
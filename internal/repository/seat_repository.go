@@ -0,0 +1,279 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-gin-high-concurrency/internal/model"
+	apperrors "go-gin-high-concurrency/pkg/app_errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// uniqueViolation PostgreSQL unique_violation 錯誤碼
+const uniqueViolation = "23505"
+
+type SeatRepository interface {
+	// FindVenueByVenueID 取得場地及其所有區域與座位
+	FindVenueByVenueID(ctx context.Context, venueID uuid.UUID) (*model.Venue, error)
+	ListSeatsBySectionID(ctx context.Context, sectionID int) ([]*model.Seat, error)
+	// ListSoldSeatIDs 票種目前已售出（未釋放）的座位
+	ListSoldSeatIDs(ctx context.Context, ticketID int) ([]int, error)
+
+	// Transaction methods
+	CreateVenue(ctx context.Context, tx pgx.Tx, venue *model.Venue) (*model.Venue, error)
+	CreateSection(ctx context.Context, tx pgx.Tx, section *model.VenueSection) (*model.VenueSection, error)
+	CreateSeats(ctx context.Context, tx pgx.Tx, seats []*model.Seat) error
+	// CreateOrderSeats 寫入訂單的座位指派，座位已被其他訂單佔用時回傳 ErrSeatUnavailable
+	CreateOrderSeats(ctx context.Context, tx pgx.Tx, orderSeats []*model.OrderSeat) error
+	// ReleaseOrderSeats 釋放訂單的座位並回傳被釋放的座位
+	ReleaseOrderSeats(ctx context.Context, tx pgx.Tx, orderID int) ([]int, error)
+}
+
+type SeatRepositoryImpl struct {
+	pool *pgxpool.Pool
+}
+
+func NewSeatRepository(pool *pgxpool.Pool) SeatRepository {
+	return &SeatRepositoryImpl{
+		pool: pool,
+	}
+}
+
+func (r *SeatRepositoryImpl) CreateVenue(ctx context.Context, tx pgx.Tx, venue *model.Venue) (*model.Venue, error) {
+	query := `
+		INSERT INTO venues (venue_id, name)
+		VALUES ($1, $2)
+		RETURNING id, venue_id, name, created_at, updated_at
+	`
+
+	err := tx.QueryRow(ctx, query, venue.VenueID, venue.Name).Scan(
+		&venue.ID,
+		&venue.VenueID,
+		&venue.Name,
+		&venue.CreatedAt,
+		&venue.UpdatedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create venue: %w", err)
+	}
+	return venue, nil
+}
+
+func (r *SeatRepositoryImpl) CreateSection(ctx context.Context, tx pgx.Tx, section *model.VenueSection) (*model.VenueSection, error) {
+	query := `
+		INSERT INTO venue_sections (venue_id, name)
+		VALUES ($1, $2)
+		RETURNING id, venue_id, name, created_at
+	`
+
+	err := tx.QueryRow(ctx, query, section.VenueID, section.Name).Scan(
+		&section.ID,
+		&section.VenueID,
+		&section.Name,
+		&section.CreatedAt,
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+			return nil, apperrors.ErrInvalidInput
+		}
+		return nil, fmt.Errorf("failed to create venue section: %w", err)
+	}
+	return section, nil
+}
+
+func (r *SeatRepositoryImpl) CreateSeats(ctx context.Context, tx pgx.Tx, seats []*model.Seat) error {
+	if len(seats) == 0 {
+		return nil
+	}
+
+	query := `
+		INSERT INTO seats (section_id, row_label, seat_number)
+		VALUES ($1, $2, $3)
+	`
+
+	batch := &pgx.Batch{}
+	for _, s := range seats {
+		batch.Queue(query, s.SectionID, s.Row, s.Number)
+	}
+
+	if err := tx.SendBatch(ctx, batch).Close(); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+			return apperrors.ErrInvalidInput
+		}
+		return fmt.Errorf("failed to create seats: %w", err)
+	}
+	return nil
+}
+
+func (r *SeatRepositoryImpl) FindVenueByVenueID(ctx context.Context, venueID uuid.UUID) (*model.Venue, error) {
+	query := `
+		SELECT id, venue_id, name, created_at, updated_at
+		FROM venues
+		WHERE venue_id = $1
+	`
+
+	var venue model.Venue
+	err := r.pool.QueryRow(ctx, query, venueID).Scan(
+		&venue.ID,
+		&venue.VenueID,
+		&venue.Name,
+		&venue.CreatedAt,
+		&venue.UpdatedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, apperrors.ErrVenueNotFound
+		}
+		return nil, err
+	}
+
+	sectionsQuery := `
+		SELECT id, venue_id, name, created_at
+		FROM venue_sections
+		WHERE venue_id = $1
+		ORDER BY id ASC
+	`
+	rows, err := r.pool.Query(ctx, sectionsQuery, venue.ID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	venue.Sections = make([]*model.VenueSection, 0)
+	for rows.Next() {
+		var section model.VenueSection
+		if err := rows.Scan(&section.ID, &section.VenueID, &section.Name, &section.CreatedAt); err != nil {
+			return nil, err
+		}
+		venue.Sections = append(venue.Sections, &section)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, section := range venue.Sections {
+		seats, err := r.ListSeatsBySectionID(ctx, section.ID)
+		if err != nil {
+			return nil, err
+		}
+		section.Seats = seats
+	}
+
+	return &venue, nil
+}
+
+func (r *SeatRepositoryImpl) ListSeatsBySectionID(ctx context.Context, sectionID int) ([]*model.Seat, error) {
+	query := `
+		SELECT id, section_id, row_label, seat_number
+		FROM seats
+		WHERE section_id = $1
+		ORDER BY row_label ASC, seat_number ASC
+	`
+
+	rows, err := r.pool.Query(ctx, query, sectionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	seats := make([]*model.Seat, 0)
+	for rows.Next() {
+		var seat model.Seat
+		if err := rows.Scan(&seat.ID, &seat.SectionID, &seat.Row, &seat.Number); err != nil {
+			return nil, err
+		}
+		seats = append(seats, &seat)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return seats, nil
+}
+
+func (r *SeatRepositoryImpl) ListSoldSeatIDs(ctx context.Context, ticketID int) ([]int, error) {
+	query := `
+		SELECT seat_id
+		FROM order_seats
+		WHERE ticket_id = $1 AND released_at IS NULL
+		ORDER BY seat_id ASC
+	`
+
+	rows, err := r.pool.Query(ctx, query, ticketID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	seatIDs := make([]int, 0)
+	for rows.Next() {
+		var seatID int
+		if err := rows.Scan(&seatID); err != nil {
+			return nil, err
+		}
+		seatIDs = append(seatIDs, seatID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return seatIDs, nil
+}
+
+func (r *SeatRepositoryImpl) CreateOrderSeats(ctx context.Context, tx pgx.Tx, orderSeats []*model.OrderSeat) error {
+	if len(orderSeats) == 0 {
+		return nil
+	}
+
+	query := `
+		INSERT INTO order_seats (order_id, ticket_id, seat_id)
+		VALUES ($1, $2, $3)
+	`
+
+	batch := &pgx.Batch{}
+	for _, s := range orderSeats {
+		batch.Queue(query, s.OrderID, s.TicketID, s.SeatID)
+	}
+
+	if err := tx.SendBatch(ctx, batch).Close(); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+			return apperrors.ErrSeatUnavailable
+		}
+		return fmt.Errorf("failed to create order seats: %w", err)
+	}
+	return nil
+}
+
+func (r *SeatRepositoryImpl) ReleaseOrderSeats(ctx context.Context, tx pgx.Tx, orderID int) ([]int, error) {
+	query := `
+		UPDATE order_seats
+		SET released_at = $1
+		WHERE order_id = $2 AND released_at IS NULL
+		RETURNING seat_id
+	`
+
+	rows, err := tx.Query(ctx, query, time.Now().UTC(), orderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	seatIDs := make([]int, 0)
+	for rows.Next() {
+		var seatID int
+		if err := rows.Scan(&seatID); err != nil {
+			return nil, err
+		}
+		seatIDs = append(seatIDs, seatID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return seatIDs, nil
+}
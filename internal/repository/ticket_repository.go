@@ -19,14 +19,22 @@ type TicketRepository interface {
 	ListByEventID(ctx context.Context, eventID int) ([]*model.Ticket, error)
 	FindByID(ctx context.Context, id int) (*model.Ticket, error)
 	FindByTicketID(ctx context.Context, ticketID uuid.UUID) (*model.Ticket, error)
-	Update(ctx context.Context, ticketID uuid.UUID, params model.UpdateTicketParams) (*model.Ticket, error)
+	Update(ctx context.Context, tx pgx.Tx, ticketID uuid.UUID, params model.UpdateTicketParams) (*model.Ticket, error)
 	Delete(ctx context.Context, ticketID uuid.UUID) error
 
 	// Transaction methods
 	FindByIDWithLock(ctx context.Context, tx pgx.Tx, id int) (*model.Ticket, error)
 	IncrementStock(ctx context.Context, tx pgx.Tx, id int, quantity int) error
-	DecrementStock(ctx context.Context, tx pgx.Tx, id int, quantity int) error
-	AddStock(ctx context.Context, tx pgx.Tx, id int, quantity int) error
+	// 扣減庫存並回傳扣減後的票券（可據此判斷是否售罄）
+	DecrementStock(ctx context.Context, tx pgx.Tx, id int, quantity int) (*model.Ticket, error)
+	// 調整總庫存（delta 可為負數），收回數量不可超過尚未售出的庫存
+	AdjustStock(ctx context.Context, tx pgx.Tx, id int, delta int) (*model.Ticket, error)
+	CreateInventoryAdjustment(ctx context.Context, tx pgx.Tx, adjustment *model.InventoryAdjustment) (*model.InventoryAdjustment, error)
+	ListInventoryAdjustments(ctx context.Context, ticketID int) ([]*model.InventoryAdjustment, error)
+	// 依 position 排序列出票種的價格階段
+	ListPricePhases(ctx context.Context, ticketID int) ([]*model.TicketPricePhase, error)
+	// 以 phases 整批取代票種現有的價格階段，position 依陣列順序重新編號
+	ReplacePricePhases(ctx context.Context, tx pgx.Tx, ticketID int, phases []*model.TicketPricePhase) ([]*model.TicketPricePhase, error)
 }
 
 type TicketRepositoryImpl struct {
@@ -41,15 +49,15 @@ func NewTicketRepository(pool *pgxpool.Pool) TicketRepository {
 
 func (r *TicketRepositoryImpl) Create(ctx context.Context, ticket *model.Ticket) (*model.Ticket, error) {
 	query := `
-		INSERT INTO tickets (event_id, ticket_id, name, price, total_stock, remaining_stock, max_per_user)
-		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		INSERT INTO tickets (event_id, ticket_id, name, price, total_stock, remaining_stock, max_per_user, section_id, is_presale)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 		RETURNING id, event_id, ticket_id, name, price, total_stock,
-			remaining_stock, max_per_user, created_at, updated_at
+			remaining_stock, max_per_user, section_id, is_presale, created_at, updated_at
 	`
 
 	err := r.pool.QueryRow(ctx, query,
 		ticket.EventID, ticket.TicketID, ticket.Name, ticket.Price,
-		ticket.TotalStock, ticket.RemainingStock, ticket.MaxPerUser,
+		ticket.TotalStock, ticket.RemainingStock, ticket.MaxPerUser, ticket.SectionID, ticket.Presale,
 	).Scan(
 		&ticket.ID,
 		&ticket.EventID,
@@ -59,6 +67,8 @@ func (r *TicketRepositoryImpl) Create(ctx context.Context, ticket *model.Ticket)
 		&ticket.TotalStock,
 		&ticket.RemainingStock,
 		&ticket.MaxPerUser,
+		&ticket.SectionID,
+		&ticket.Presale,
 		&ticket.CreatedAt,
 		&ticket.UpdatedAt,
 	)
@@ -73,7 +83,7 @@ func (r *TicketRepositoryImpl) Create(ctx context.Context, ticket *model.Ticket)
 func (r *TicketRepositoryImpl) List(ctx context.Context) ([]*model.Ticket, error) {
 	query := `
 		SELECT id, event_id, ticket_id, name, price,
-				total_stock, remaining_stock, max_per_user,
+				total_stock, remaining_stock, max_per_user, section_id, is_presale,
 				created_at, updated_at, deleted_at
 		FROM tickets
 		WHERE deleted_at IS NULL
@@ -99,6 +109,8 @@ func (r *TicketRepositoryImpl) List(ctx context.Context) ([]*model.Ticket, error
 			&ticket.TotalStock,
 			&ticket.RemainingStock,
 			&ticket.MaxPerUser,
+			&ticket.SectionID,
+			&ticket.Presale,
 			&ticket.CreatedAt,
 			&ticket.UpdatedAt,
 			&ticket.DeletedAt,
@@ -119,7 +131,7 @@ func (r *TicketRepositoryImpl) List(ctx context.Context) ([]*model.Ticket, error
 func (r *TicketRepositoryImpl) ListByEventID(ctx context.Context, eventID int) ([]*model.Ticket, error) {
 	query := `
 		SELECT id, event_id, ticket_id, name, price,
-				total_stock, remaining_stock, max_per_user,
+				total_stock, remaining_stock, max_per_user, section_id, is_presale,
 				created_at, updated_at, deleted_at
 		FROM tickets
 		WHERE event_id = $1 AND deleted_at IS NULL
@@ -143,6 +155,8 @@ func (r *TicketRepositoryImpl) ListByEventID(ctx context.Context, eventID int) (
 			&ticket.TotalStock,
 			&ticket.RemainingStock,
 			&ticket.MaxPerUser,
+			&ticket.SectionID,
+			&ticket.Presale,
 			&ticket.CreatedAt,
 			&ticket.UpdatedAt,
 			&ticket.DeletedAt,
@@ -161,7 +175,7 @@ func (r *TicketRepositoryImpl) ListByEventID(ctx context.Context, eventID int) (
 func (r *TicketRepositoryImpl) FindByID(ctx context.Context, id int) (*model.Ticket, error) {
 	query := `
 		SELECT id, event_id, ticket_id, name, price,
-				total_stock, remaining_stock, max_per_user,
+				total_stock, remaining_stock, max_per_user, section_id, is_presale,
 				created_at, updated_at, deleted_at
 		FROM tickets
 		WHERE id = $1 AND deleted_at IS NULL
@@ -177,6 +191,8 @@ func (r *TicketRepositoryImpl) FindByID(ctx context.Context, id int) (*model.Tic
 		&ticket.TotalStock,
 		&ticket.RemainingStock,
 		&ticket.MaxPerUser,
+		&ticket.SectionID,
+		&ticket.Presale,
 		&ticket.CreatedAt,
 		&ticket.UpdatedAt,
 		&ticket.DeletedAt,
@@ -195,7 +211,7 @@ func (r *TicketRepositoryImpl) FindByID(ctx context.Context, id int) (*model.Tic
 func (r *TicketRepositoryImpl) FindByTicketID(ctx context.Context, ticketID uuid.UUID) (*model.Ticket, error) {
 	query := `
 		SELECT id, event_id, ticket_id, name, price,
-				total_stock, remaining_stock, max_per_user,
+				total_stock, remaining_stock, max_per_user, section_id, is_presale,
 				created_at, updated_at, deleted_at
 		FROM tickets
 		WHERE ticket_id = $1 AND deleted_at IS NULL
@@ -211,6 +227,8 @@ func (r *TicketRepositoryImpl) FindByTicketID(ctx context.Context, ticketID uuid
 		&ticket.TotalStock,
 		&ticket.RemainingStock,
 		&ticket.MaxPerUser,
+		&ticket.SectionID,
+		&ticket.Presale,
 		&ticket.CreatedAt,
 		&ticket.UpdatedAt,
 		&ticket.DeletedAt,
@@ -229,7 +247,7 @@ func (r *TicketRepositoryImpl) FindByTicketID(ctx context.Context, ticketID uuid
 func (r *TicketRepositoryImpl) FindByIDWithLock(ctx context.Context, tx pgx.Tx, id int) (*model.Ticket, error) {
 	query := `
 		SELECT id, event_id, ticket_id, name, price,
-				total_stock, remaining_stock, max_per_user,
+				total_stock, remaining_stock, max_per_user, section_id, is_presale,
 				created_at, updated_at, deleted_at
 		FROM tickets
 		WHERE id = $1 AND deleted_at IS NULL
@@ -246,6 +264,8 @@ func (r *TicketRepositoryImpl) FindByIDWithLock(ctx context.Context, tx pgx.Tx,
 		&ticket.TotalStock,
 		&ticket.RemainingStock,
 		&ticket.MaxPerUser,
+		&ticket.SectionID,
+		&ticket.Presale,
 		&ticket.CreatedAt,
 		&ticket.UpdatedAt,
 		&ticket.DeletedAt,
@@ -261,7 +281,7 @@ func (r *TicketRepositoryImpl) FindByIDWithLock(ctx context.Context, tx pgx.Tx,
 	return &ticket, nil
 }
 
-func (r *TicketRepositoryImpl) Update(ctx context.Context, ticketID uuid.UUID, params model.UpdateTicketParams) (*model.Ticket, error) {
+func (r *TicketRepositoryImpl) Update(ctx context.Context, tx pgx.Tx, ticketID uuid.UUID, params model.UpdateTicketParams) (*model.Ticket, error) {
 	sets := []string{}
 	args := []interface{}{}
 	argPos := 1
@@ -301,12 +321,12 @@ func (r *TicketRepositoryImpl) Update(ctx context.Context, ticketID uuid.UUID, p
 		SET %s
 		WHERE ticket_id = $%d AND deleted_at IS NULL
         RETURNING id, event_id, ticket_id, name, price, total_stock, 
-                  remaining_stock, max_per_user, created_at, updated_at
+                  remaining_stock, max_per_user, section_id, is_presale, created_at, updated_at
 	`, strings.Join(sets, ", "), argPos)
 
 	var ticket model.Ticket
 
-	err := r.pool.QueryRow(ctx, query, args...).Scan(
+	err := tx.QueryRow(ctx, query, args...).Scan(
 		&ticket.ID,
 		&ticket.EventID,
 		&ticket.TicketID,
@@ -315,6 +335,8 @@ func (r *TicketRepositoryImpl) Update(ctx context.Context, ticketID uuid.UUID, p
 		&ticket.TotalStock,
 		&ticket.RemainingStock,
 		&ticket.MaxPerUser,
+		&ticket.SectionID,
+		&ticket.Presale,
 		&ticket.CreatedAt,
 		&ticket.UpdatedAt,
 	)
@@ -348,23 +370,39 @@ func (r *TicketRepositoryImpl) IncrementStock(ctx context.Context, tx pgx.Tx, id
 	return nil
 }
 
-func (r *TicketRepositoryImpl) DecrementStock(ctx context.Context, tx pgx.Tx, id int, quantity int) error {
+func (r *TicketRepositoryImpl) DecrementStock(ctx context.Context, tx pgx.Tx, id int, quantity int) (*model.Ticket, error) {
 	query := `
 		UPDATE tickets
 		SET remaining_stock = remaining_stock - $1, updated_at = $2
 		WHERE id = $3 AND remaining_stock >= $1
+		RETURNING id, event_id, ticket_id, name, price, total_stock,
+				  remaining_stock, max_per_user, section_id, is_presale, created_at, updated_at
 	`
 
-	result, err := tx.Exec(ctx, query, quantity, time.Now().UTC(), id)
-	if err != nil {
-		return err
-	}
+	var ticket model.Ticket
+	err := tx.QueryRow(ctx, query, quantity, time.Now().UTC(), id).Scan(
+		&ticket.ID,
+		&ticket.EventID,
+		&ticket.TicketID,
+		&ticket.Name,
+		&ticket.Price,
+		&ticket.TotalStock,
+		&ticket.RemainingStock,
+		&ticket.MaxPerUser,
+		&ticket.SectionID,
+		&ticket.Presale,
+		&ticket.CreatedAt,
+		&ticket.UpdatedAt,
+	)
 
-	if result.RowsAffected() == 0 {
-		return apperrors.ErrInsufficientStock
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, apperrors.ErrInsufficientStock
+		}
+		return nil, err
 	}
 
-	return nil
+	return &ticket, nil
 }
 
 func (r *TicketRepositoryImpl) Delete(ctx context.Context, ticketID uuid.UUID) error {
@@ -387,9 +425,9 @@ func (r *TicketRepositoryImpl) Delete(ctx context.Context, ticketID uuid.UUID) e
 	return nil
 }
 
-func (r *TicketRepositoryImpl) AddStock(ctx context.Context, tx pgx.Tx, id int, quantity int) error {
-	if quantity <= 0 {
-		return apperrors.ErrInvalidInput
+func (r *TicketRepositoryImpl) AdjustStock(ctx context.Context, tx pgx.Tx, id int, delta int) (*model.Ticket, error) {
+	if delta == 0 {
+		return nil, apperrors.ErrInvalidInput
 	}
 
 	query := `
@@ -397,17 +435,151 @@ func (r *TicketRepositoryImpl) AddStock(ctx context.Context, tx pgx.Tx, id int,
 		SET total_stock = total_stock + $1,
 			remaining_stock = remaining_stock + $1,
 			updated_at = $2
-		WHERE id = $3 AND deleted_at IS NULL
+		WHERE id = $3 AND deleted_at IS NULL AND remaining_stock + $1 >= 0
+		RETURNING id, event_id, ticket_id, name, price, total_stock,
+				  remaining_stock, max_per_user, section_id, is_presale, created_at, updated_at
 	`
 
-	result, err := tx.Exec(ctx, query, quantity, time.Now().UTC(), id)
+	var ticket model.Ticket
+	err := tx.QueryRow(ctx, query, delta, time.Now().UTC(), id).Scan(
+		&ticket.ID,
+		&ticket.EventID,
+		&ticket.TicketID,
+		&ticket.Name,
+		&ticket.Price,
+		&ticket.TotalStock,
+		&ticket.RemainingStock,
+		&ticket.MaxPerUser,
+		&ticket.SectionID,
+		&ticket.Presale,
+		&ticket.CreatedAt,
+		&ticket.UpdatedAt,
+	)
+	if err == nil {
+		return &ticket, nil
+	}
+	if err != pgx.ErrNoRows {
+		return nil, err
+	}
+
+	// 區分票券不存在與收回數量超過未售出庫存
+	var exists bool
+	err = tx.QueryRow(ctx, `SELECT EXISTS(SELECT 1 FROM tickets WHERE id = $1 AND deleted_at IS NULL)`, id).Scan(&exists)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	if !exists {
+		return nil, apperrors.ErrTicketNotFound
 	}
+	return nil, apperrors.ErrInsufficientStock
+}
 
-	if result.RowsAffected() == 0 {
-		return apperrors.ErrTicketNotFound
+func (r *TicketRepositoryImpl) CreateInventoryAdjustment(ctx context.Context, tx pgx.Tx, adjustment *model.InventoryAdjustment) (*model.InventoryAdjustment, error) {
+	query := `
+		INSERT INTO inventory_adjustments (ticket_id, delta, total_stock, remaining_stock, actor, reason)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id, ticket_id, delta, total_stock, remaining_stock, actor, reason, created_at
+	`
+
+	err := tx.QueryRow(ctx, query,
+		adjustment.TicketID, adjustment.Delta, adjustment.TotalStock, adjustment.RemainingStock,
+		adjustment.Actor, adjustment.Reason,
+	).Scan(
+		&adjustment.ID,
+		&adjustment.TicketID,
+		&adjustment.Delta,
+		&adjustment.TotalStock,
+		&adjustment.RemainingStock,
+		&adjustment.Actor,
+		&adjustment.Reason,
+		&adjustment.CreatedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create inventory adjustment: %w", err)
 	}
+	return adjustment, nil
+}
 
-	return nil
+func (r *TicketRepositoryImpl) ListInventoryAdjustments(ctx context.Context, ticketID int) ([]*model.InventoryAdjustment, error) {
+	query := `
+		SELECT id, ticket_id, delta, total_stock, remaining_stock, actor, reason, created_at
+		FROM inventory_adjustments
+		WHERE ticket_id = $1
+		ORDER BY created_at ASC, id ASC
+	`
+
+	rows, err := r.pool.Query(ctx, query, ticketID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	adjustments := make([]*model.InventoryAdjustment, 0)
+	for rows.Next() {
+		var a model.InventoryAdjustment
+		if err := rows.Scan(&a.ID, &a.TicketID, &a.Delta, &a.TotalStock, &a.RemainingStock, &a.Actor, &a.Reason, &a.CreatedAt); err != nil {
+			return nil, err
+		}
+		adjustments = append(adjustments, &a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return adjustments, nil
+}
+
+func (r *TicketRepositoryImpl) ListPricePhases(ctx context.Context, ticketID int) ([]*model.TicketPricePhase, error) {
+	query := `
+		SELECT id, ticket_id, name, price, ends_at, sold_limit, position, created_at
+		FROM ticket_price_phases
+		WHERE ticket_id = $1
+		ORDER BY position ASC
+	`
+
+	rows, err := r.pool.Query(ctx, query, ticketID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	phases := make([]*model.TicketPricePhase, 0)
+	for rows.Next() {
+		var p model.TicketPricePhase
+		if err := rows.Scan(&p.ID, &p.TicketID, &p.Name, &p.Price, &p.EndsAt, &p.SoldLimit, &p.Position, &p.CreatedAt); err != nil {
+			return nil, err
+		}
+		phases = append(phases, &p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return phases, nil
+}
+
+func (r *TicketRepositoryImpl) ReplacePricePhases(ctx context.Context, tx pgx.Tx, ticketID int, phases []*model.TicketPricePhase) ([]*model.TicketPricePhase, error) {
+	if _, err := tx.Exec(ctx, `DELETE FROM ticket_price_phases WHERE ticket_id = $1`, ticketID); err != nil {
+		return nil, err
+	}
+
+	query := `
+		INSERT INTO ticket_price_phases (ticket_id, name, price, ends_at, sold_limit, position)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id, ticket_id, name, price, ends_at, sold_limit, position, created_at
+	`
+	for i, phase := range phases {
+		err := tx.QueryRow(ctx, query, ticketID, phase.Name, phase.Price, phase.EndsAt, phase.SoldLimit, i+1).Scan(
+			&phase.ID,
+			&phase.TicketID,
+			&phase.Name,
+			&phase.Price,
+			&phase.EndsAt,
+			&phase.SoldLimit,
+			&phase.Position,
+			&phase.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create price phase: %w", err)
+		}
+	}
+	return phases, nil
 }
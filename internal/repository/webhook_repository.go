@@ -0,0 +1,423 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"go-gin-high-concurrency/internal/model"
+	apperrors "go-gin-high-concurrency/pkg/app_errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type WebhookRepository interface {
+	// Subscription methods
+	CreateSubscription(ctx context.Context, subscription *model.WebhookSubscription) (*model.WebhookSubscription, error)
+	ListSubscriptionsByEventID(ctx context.Context, eventID int) ([]*model.WebhookSubscription, error)
+	// 取得該活動中訂閱了 eventType 且啟用中的訂閱
+	ListActiveSubscriptionsByEventType(ctx context.Context, eventID int, eventType string) ([]*model.WebhookSubscription, error)
+	FindSubscriptionBySubscriptionID(ctx context.Context, subscriptionID uuid.UUID) (*model.WebhookSubscription, error)
+	UpdateSubscription(ctx context.Context, id int, params model.UpdateWebhookSubscriptionParams) (*model.WebhookSubscription, error)
+	DeleteSubscription(ctx context.Context, id int) error
+
+	// Delivery methods
+	// 批次建立投遞紀錄；(subscription_id, outbox_event_id) 已存在時略過，使 relay 重送具冪等性
+	CreateDeliveries(ctx context.Context, deliveries []*model.WebhookDelivery) error
+	ListDeliveriesBySubscriptionID(ctx context.Context, subscriptionID int) ([]*model.WebhookDelivery, error)
+	FindDeliveryByDeliveryID(ctx context.Context, subscriptionID int, deliveryID uuid.UUID) (*model.WebhookDelivery, error)
+	// 重設為待投遞（手動重送），重新計算重試次數
+	ResetDelivery(ctx context.Context, id int64) (*model.WebhookDelivery, error)
+	// 領取到期的投遞任務並將 next_attempt_at 延後到 leaseUntil，避免多個 dispatcher 重複投遞
+	ClaimDueDeliveries(ctx context.Context, now time.Time, leaseUntil time.Time, limit int) ([]*model.WebhookDeliveryTask, error)
+	RecordDeliveryAttempt(ctx context.Context, id int64, attempt model.WebhookDeliveryAttempt) error
+}
+
+type WebhookRepositoryImpl struct {
+	pool *pgxpool.Pool
+}
+
+func NewWebhookRepository(pool *pgxpool.Pool) WebhookRepository {
+	return &WebhookRepositoryImpl{
+		pool: pool,
+	}
+}
+
+const webhookSubscriptionColumns = `id, subscription_id, event_id, url, secret, event_types, active, created_at, updated_at, deleted_at`
+
+const webhookDeliveryColumns = `id, delivery_id, subscription_id, outbox_event_id, event_type, payload, status, attempts,
+		next_attempt_at, last_status_code, last_error, delivered_at, created_at, updated_at`
+
+func scanWebhookSubscription(row pgx.Row) (*model.WebhookSubscription, error) {
+	var subscription model.WebhookSubscription
+	err := row.Scan(
+		&subscription.ID,
+		&subscription.SubscriptionID,
+		&subscription.EventID,
+		&subscription.URL,
+		&subscription.Secret,
+		&subscription.EventTypes,
+		&subscription.Active,
+		&subscription.CreatedAt,
+		&subscription.UpdatedAt,
+		&subscription.DeletedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &subscription, nil
+}
+
+func scanWebhookDelivery(row pgx.Row) (*model.WebhookDelivery, error) {
+	var delivery model.WebhookDelivery
+	err := row.Scan(
+		&delivery.ID,
+		&delivery.DeliveryID,
+		&delivery.SubscriptionID,
+		&delivery.OutboxEventID,
+		&delivery.EventType,
+		&delivery.Payload,
+		&delivery.Status,
+		&delivery.Attempts,
+		&delivery.NextAttemptAt,
+		&delivery.LastStatusCode,
+		&delivery.LastError,
+		&delivery.DeliveredAt,
+		&delivery.CreatedAt,
+		&delivery.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &delivery, nil
+}
+
+func (r *WebhookRepositoryImpl) CreateSubscription(ctx context.Context, subscription *model.WebhookSubscription) (*model.WebhookSubscription, error) {
+	query := `
+		INSERT INTO webhook_subscriptions (subscription_id, event_id, url, secret, event_types, active)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING ` + webhookSubscriptionColumns
+
+	created, err := scanWebhookSubscription(r.pool.QueryRow(ctx, query,
+		subscription.SubscriptionID,
+		subscription.EventID,
+		subscription.URL,
+		subscription.Secret,
+		subscription.EventTypes,
+		subscription.Active,
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create webhook subscription: %w", err)
+	}
+	return created, nil
+}
+
+func (r *WebhookRepositoryImpl) ListSubscriptionsByEventID(ctx context.Context, eventID int) ([]*model.WebhookSubscription, error) {
+	query := `
+		SELECT ` + webhookSubscriptionColumns + `
+		FROM webhook_subscriptions
+		WHERE event_id = $1 AND deleted_at IS NULL
+		ORDER BY id ASC
+	`
+	return r.listSubscriptions(ctx, query, eventID)
+}
+
+func (r *WebhookRepositoryImpl) ListActiveSubscriptionsByEventType(ctx context.Context, eventID int, eventType string) ([]*model.WebhookSubscription, error) {
+	query := `
+		SELECT ` + webhookSubscriptionColumns + `
+		FROM webhook_subscriptions
+		WHERE event_id = $1 AND $2 = ANY(event_types) AND active AND deleted_at IS NULL
+		ORDER BY id ASC
+	`
+	return r.listSubscriptions(ctx, query, eventID, eventType)
+}
+
+func (r *WebhookRepositoryImpl) listSubscriptions(ctx context.Context, query string, args ...interface{}) ([]*model.WebhookSubscription, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	subscriptions := make([]*model.WebhookSubscription, 0)
+	for rows.Next() {
+		subscription, err := scanWebhookSubscription(rows)
+		if err != nil {
+			return nil, err
+		}
+		subscriptions = append(subscriptions, subscription)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return subscriptions, nil
+}
+
+func (r *WebhookRepositoryImpl) FindSubscriptionBySubscriptionID(ctx context.Context, subscriptionID uuid.UUID) (*model.WebhookSubscription, error) {
+	query := `
+		SELECT ` + webhookSubscriptionColumns + `
+		FROM webhook_subscriptions
+		WHERE subscription_id = $1 AND deleted_at IS NULL
+	`
+
+	subscription, err := scanWebhookSubscription(r.pool.QueryRow(ctx, query, subscriptionID))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, apperrors.ErrWebhookNotFound
+		}
+		return nil, err
+	}
+	return subscription, nil
+}
+
+func (r *WebhookRepositoryImpl) UpdateSubscription(ctx context.Context, id int, params model.UpdateWebhookSubscriptionParams) (*model.WebhookSubscription, error) {
+	sets := []string{}
+	args := []interface{}{}
+	argPos := 1
+
+	if params.URL != nil {
+		sets = append(sets, fmt.Sprintf("url = $%d", argPos))
+		args = append(args, *params.URL)
+		argPos++
+	}
+
+	if params.EventTypes != nil {
+		sets = append(sets, fmt.Sprintf("event_types = $%d", argPos))
+		args = append(args, params.EventTypes)
+		argPos++
+	}
+
+	if params.Active != nil {
+		sets = append(sets, fmt.Sprintf("active = $%d", argPos))
+		args = append(args, *params.Active)
+		argPos++
+	}
+
+	if len(sets) == 0 {
+		return nil, apperrors.ErrInvalidInput
+	}
+
+	// add updated_at
+	sets = append(sets, fmt.Sprintf("updated_at = $%d", argPos))
+	args = append(args, time.Now().UTC())
+	argPos++
+
+	// add id
+	args = append(args, id)
+
+	query := fmt.Sprintf(`
+		UPDATE webhook_subscriptions
+		SET %s
+		WHERE id = $%d AND deleted_at IS NULL
+		RETURNING %s
+	`, strings.Join(sets, ", "), argPos, webhookSubscriptionColumns)
+
+	subscription, err := scanWebhookSubscription(r.pool.QueryRow(ctx, query, args...))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, apperrors.ErrWebhookNotFound
+		}
+		return nil, err
+	}
+	return subscription, nil
+}
+
+func (r *WebhookRepositoryImpl) DeleteSubscription(ctx context.Context, id int) error {
+	query := `
+		UPDATE webhook_subscriptions
+		SET deleted_at = $1, updated_at = $1
+		WHERE id = $2 AND deleted_at IS NULL
+	`
+
+	result, err := r.pool.Exec(ctx, query, time.Now().UTC(), id)
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return apperrors.ErrWebhookNotFound
+	}
+
+	return nil
+}
+
+func (r *WebhookRepositoryImpl) CreateDeliveries(ctx context.Context, deliveries []*model.WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	query := `
+		INSERT INTO webhook_deliveries (delivery_id, subscription_id, outbox_event_id, event_type, payload)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (subscription_id, outbox_event_id) DO NOTHING
+	`
+
+	batch := &pgx.Batch{}
+	for _, d := range deliveries {
+		batch.Queue(query, d.DeliveryID, d.SubscriptionID, d.OutboxEventID, d.EventType, d.Payload)
+	}
+
+	if err := r.pool.SendBatch(ctx, batch).Close(); err != nil {
+		return fmt.Errorf("failed to create webhook deliveries: %w", err)
+	}
+	return nil
+}
+
+func (r *WebhookRepositoryImpl) ListDeliveriesBySubscriptionID(ctx context.Context, subscriptionID int) ([]*model.WebhookDelivery, error) {
+	query := `
+		SELECT ` + webhookDeliveryColumns + `
+		FROM webhook_deliveries
+		WHERE subscription_id = $1
+		ORDER BY id DESC
+	`
+
+	rows, err := r.pool.Query(ctx, query, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	deliveries := make([]*model.WebhookDelivery, 0)
+	for rows.Next() {
+		delivery, err := scanWebhookDelivery(rows)
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, delivery)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+func (r *WebhookRepositoryImpl) FindDeliveryByDeliveryID(ctx context.Context, subscriptionID int, deliveryID uuid.UUID) (*model.WebhookDelivery, error) {
+	query := `
+		SELECT ` + webhookDeliveryColumns + `
+		FROM webhook_deliveries
+		WHERE subscription_id = $1 AND delivery_id = $2
+	`
+
+	delivery, err := scanWebhookDelivery(r.pool.QueryRow(ctx, query, subscriptionID, deliveryID))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, apperrors.ErrWebhookDeliveryNotFound
+		}
+		return nil, err
+	}
+	return delivery, nil
+}
+
+func (r *WebhookRepositoryImpl) ResetDelivery(ctx context.Context, id int64) (*model.WebhookDelivery, error) {
+	query := `
+		UPDATE webhook_deliveries
+		SET status = $1, attempts = 0, next_attempt_at = $2, updated_at = $2
+		WHERE id = $3
+		RETURNING ` + webhookDeliveryColumns
+
+	delivery, err := scanWebhookDelivery(r.pool.QueryRow(ctx, query, model.WebhookDeliveryStatusPending, time.Now().UTC(), id))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, apperrors.ErrWebhookDeliveryNotFound
+		}
+		return nil, err
+	}
+	return delivery, nil
+}
+
+func (r *WebhookRepositoryImpl) ClaimDueDeliveries(ctx context.Context, now time.Time, leaseUntil time.Time, limit int) ([]*model.WebhookDeliveryTask, error) {
+	// SKIP LOCKED：多個 dispatcher 同時領取時互不等待，各自拿到不同的投遞
+	query := `
+		UPDATE webhook_deliveries d
+		SET next_attempt_at = $2, updated_at = $1
+		FROM webhook_subscriptions s
+		WHERE d.subscription_id = s.id
+		  AND d.id IN (
+			SELECT wd.id
+			FROM webhook_deliveries wd
+			JOIN webhook_subscriptions ws ON ws.id = wd.subscription_id
+			WHERE wd.status = $3 AND wd.next_attempt_at <= $1
+			  AND ws.active AND ws.deleted_at IS NULL
+			ORDER BY wd.next_attempt_at ASC
+			LIMIT $4
+			FOR UPDATE OF wd SKIP LOCKED
+		  )
+		RETURNING d.id, d.delivery_id, d.subscription_id, d.outbox_event_id, d.event_type, d.payload, d.status, d.attempts,
+			d.next_attempt_at, d.last_status_code, d.last_error, d.delivered_at, d.created_at, d.updated_at,
+			s.url, s.secret
+	`
+
+	rows, err := r.pool.Query(ctx, query, now, leaseUntil, model.WebhookDeliveryStatusPending, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tasks := make([]*model.WebhookDeliveryTask, 0, limit)
+	for rows.Next() {
+		var delivery model.WebhookDelivery
+		task := &model.WebhookDeliveryTask{Delivery: &delivery}
+		err := rows.Scan(
+			&delivery.ID,
+			&delivery.DeliveryID,
+			&delivery.SubscriptionID,
+			&delivery.OutboxEventID,
+			&delivery.EventType,
+			&delivery.Payload,
+			&delivery.Status,
+			&delivery.Attempts,
+			&delivery.NextAttemptAt,
+			&delivery.LastStatusCode,
+			&delivery.LastError,
+			&delivery.DeliveredAt,
+			&delivery.CreatedAt,
+			&delivery.UpdatedAt,
+			&task.URL,
+			&task.Secret,
+		)
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, task)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return tasks, nil
+}
+
+func (r *WebhookRepositoryImpl) RecordDeliveryAttempt(ctx context.Context, id int64, attempt model.WebhookDeliveryAttempt) error {
+	now := time.Now().UTC()
+	var deliveredAt *time.Time
+	if attempt.Status == model.WebhookDeliveryStatusSucceeded {
+		deliveredAt = &now
+	}
+
+	query := `
+		UPDATE webhook_deliveries
+		SET status = $1,
+			attempts = attempts + 1,
+			next_attempt_at = $2,
+			last_status_code = $3,
+			last_error = $4,
+			delivered_at = $5,
+			updated_at = $6
+		WHERE id = $7
+	`
+
+	result, err := r.pool.Exec(ctx, query,
+		attempt.Status, attempt.NextAttemptAt, attempt.StatusCode, attempt.Error, deliveredAt, now, id,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to record webhook delivery attempt: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return apperrors.ErrWebhookDeliveryNotFound
+	}
+
+	return nil
+}
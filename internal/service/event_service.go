@@ -2,10 +2,12 @@ package service
 
 import (
 	"context"
+	"errors"
 
 	"go-gin-high-concurrency/internal/cache"
 	"go-gin-high-concurrency/internal/model"
 	"go-gin-high-concurrency/internal/repository"
+	apperrors "go-gin-high-concurrency/pkg/app_errors"
 
 	"github.com/google/uuid"
 )
@@ -17,16 +19,40 @@ type EventService interface {
 	UpdateByEventID(ctx context.Context, eventID uuid.UUID, params model.UpdateEventParams) (*model.Event, error)
 	// OpenForSale 活動開賣：預熱該活動底下所有票種的 Redis 庫存
 	OpenForSale(ctx context.Context, eventID uuid.UUID) error
+	// SubscribeStock 訂閱活動底下所有票種的即時庫存：先送出目前庫存快照，之後每次 Redis 庫存變動推送一次
+	SubscribeStock(ctx context.Context, eventID uuid.UUID) (<-chan *model.TicketStock, error)
+	// ListAvailability 取得活動底下所有票種的即時可購買狀態
+	ListAvailability(ctx context.Context, eventID uuid.UUID) ([]*model.TicketResponse, error)
 }
 
 type EventServiceImpl struct {
 	repo             repository.EventRepository
 	ticketRepo       repository.TicketRepository
+	seatRepo         repository.SeatRepository
+	presaleRepo      repository.PresaleRepository
 	inventoryManager cache.RedisTicketInventoryManager
+	seatHoldManager  cache.RedisSeatHoldManager
+	presaleManager   cache.RedisPresaleManager
 }
 
-func NewEventService(repo repository.EventRepository, ticketRepo repository.TicketRepository, inventoryManager cache.RedisTicketInventoryManager) EventService {
-	return &EventServiceImpl{repo: repo, ticketRepo: ticketRepo, inventoryManager: inventoryManager}
+func NewEventService(
+	repo repository.EventRepository,
+	ticketRepo repository.TicketRepository,
+	seatRepo repository.SeatRepository,
+	presaleRepo repository.PresaleRepository,
+	inventoryManager cache.RedisTicketInventoryManager,
+	seatHoldManager cache.RedisSeatHoldManager,
+	presaleManager cache.RedisPresaleManager,
+) EventService {
+	return &EventServiceImpl{
+		repo:             repo,
+		ticketRepo:       ticketRepo,
+		seatRepo:         seatRepo,
+		presaleRepo:      presaleRepo,
+		inventoryManager: inventoryManager,
+		seatHoldManager:  seatHoldManager,
+		presaleManager:   presaleManager,
+	}
 }
 
 func (s *EventServiceImpl) List(ctx context.Context) ([]*model.Event, error) {
@@ -49,7 +75,17 @@ func (s *EventServiceImpl) UpdateByEventID(ctx context.Context, eventID uuid.UUI
 	if err != nil {
 		return nil, err
 	}
-	return s.repo.Update(ctx, event.ID, params)
+	updated, err := s.repo.Update(ctx, event.ID, params)
+	if err != nil {
+		return nil, err
+	}
+	// 同步活動上限到 Redis，失敗時由下次開賣以資料庫為準修正
+	if params.MaxPerUser != nil {
+		if err := s.inventoryManager.SetEventLimit(ctx, updated.ID, eventLimit(updated)); err != nil {
+			return nil, err
+		}
+	}
+	return updated, nil
 }
 
 func (s *EventServiceImpl) OpenForSale(ctx context.Context, eventID uuid.UUID) error {
@@ -61,10 +97,133 @@ func (s *EventServiceImpl) OpenForSale(ctx context.Context, eventID uuid.UUID) e
 	if err != nil {
 		return err
 	}
+	if err := s.inventoryManager.SetEventLimit(ctx, event.ID, eventLimit(event)); err != nil {
+		return err
+	}
 	for _, t := range tickets {
-		if err := s.inventoryManager.WarmUpInventory(ctx, t.ID, t.TotalStock, t.Price, t.MaxPerUser); err != nil {
+		if err := s.inventoryManager.WarmUpInventory(ctx, t.ID, t.EventID, t.TotalStock, t.Price, t.MaxPerUser); err != nil {
+			return err
+		}
+		phases, err := s.ticketRepo.ListPricePhases(ctx, t.ID)
+		if err != nil {
+			return err
+		}
+		if err := s.inventoryManager.SetPricePhases(ctx, t.ID, phases); err != nil {
+			return err
+		}
+		if t.Presale {
+			if err := warmUpPresale(ctx, s.presaleRepo, s.presaleManager, t.ID); err != nil {
+				return err
+			}
+		}
+		if !t.IsSeated() {
+			continue
+		}
+		// 對號座票種：標記為 seated 並載入已售出的座位
+		soldSeatIDs, err := s.seatRepo.ListSoldSeatIDs(ctx, t.ID)
+		if err != nil {
+			return err
+		}
+		if err := s.seatHoldManager.WarmUpSeats(ctx, t.ID, soldSeatIDs); err != nil {
 			return err
 		}
 	}
 	return nil
 }
+
+func (s *EventServiceImpl) ListAvailability(ctx context.Context, eventID uuid.UUID) ([]*model.TicketResponse, error) {
+	event, err := s.repo.FindByEventID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	tickets, err := s.ticketRepo.ListByEventID(ctx, event.ID)
+	if err != nil {
+		return nil, err
+	}
+	availability := make([]*model.TicketResponse, 0, len(tickets))
+	for _, t := range tickets {
+		response, err := ticketAvailability(ctx, s.inventoryManager, t)
+		if err != nil {
+			return nil, err
+		}
+		availability = append(availability, response)
+	}
+	return availability, nil
+}
+
+func (s *EventServiceImpl) SubscribeStock(ctx context.Context, eventID uuid.UUID) (<-chan *model.TicketStock, error) {
+	event, err := s.repo.FindByEventID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	tickets, err := s.ticketRepo.ListByEventID(ctx, event.ID)
+	if err != nil {
+		return nil, err
+	}
+	if len(tickets) == 0 {
+		return nil, apperrors.ErrTicketNotFound
+	}
+
+	ticketsByID := make(map[int]*model.Ticket, len(tickets))
+	ticketIDs := make([]int, 0, len(tickets))
+	for _, t := range tickets {
+		ticketsByID[t.ID] = t
+		ticketIDs = append(ticketIDs, t.ID)
+	}
+
+	// 先訂閱再讀快照，避免兩者之間的變動被漏掉
+	subCtx, cancel := context.WithCancel(ctx)
+	updates, err := s.inventoryManager.SubscribeStock(subCtx, ticketIDs)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	snapshot := make([]*model.TicketStock, 0, len(tickets))
+	for _, t := range tickets {
+		stock, err := s.inventoryManager.GetStock(ctx, t.ID)
+		if err != nil {
+			// 尚未開賣（Redis 未預熱）時以資料庫庫存為準
+			if !errors.Is(err, apperrors.ErrTicketNotFound) {
+				cancel()
+				return nil, err
+			}
+			stock = t.RemainingStock
+		}
+		snapshot = append(snapshot, model.NewTicketStock(t, stock))
+	}
+
+	out := make(chan *model.TicketStock)
+	go func() {
+		defer close(out)
+		defer cancel()
+		for _, stock := range snapshot {
+			select {
+			case out <- stock:
+			case <-ctx.Done():
+				return
+			}
+		}
+		for update := range updates {
+			ticket, ok := ticketsByID[update.TicketID]
+			if !ok {
+				continue
+			}
+			select {
+			case out <- model.NewTicketStock(ticket, update.Stock):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// eventLimit 活動每人跨票種的購買上限，Redis 中以 0 表示不限
+func eventLimit(event *model.Event) int {
+	if event.MaxPerUser == nil {
+		return 0
+	}
+	return *event.MaxPerUser
+}
@@ -0,0 +1,171 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go-gin-high-concurrency/internal/model"
+	"go-gin-high-concurrency/internal/queue"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/redis/go-redis/v9"
+)
+
+// HealthService liveness 及 readiness 檢查
+type HealthService interface {
+	// 存活：只檢查程序本身（例如 Worker 心跳），不檢查外部相依，避免相依異常時程序被反覆重啟
+	Liveness(ctx context.Context) *model.HealthReport
+	// 就緒：檢查所有相依；關閉中一律回報未就緒，讓負載平衡器停止導入流量
+	Readiness(ctx context.Context) *model.HealthReport
+	// 開始關閉：之後的 readiness 檢查回報 shutdown 失敗
+	StartDraining()
+}
+
+// HealthCheck 單項檢查，Check 回傳 nil 表示正常
+type HealthCheck struct {
+	Name  string
+	Check func(ctx context.Context) error
+}
+
+// HealthServiceConfig 可注入的逾時設定；nil 或零值時使用預設。
+type HealthServiceConfig struct {
+	CheckTimeout time.Duration // 單項檢查的逾時
+}
+
+func defaultHealthServiceConfig() HealthServiceConfig {
+	return HealthServiceConfig{
+		CheckTimeout: 2 * time.Second,
+	}
+}
+
+var errDraining = errors.New("server is shutting down")
+
+type HealthServiceImpl struct {
+	liveness  []HealthCheck
+	readiness []HealthCheck
+	cfg       HealthServiceConfig
+	draining  atomic.Bool
+}
+
+// NewHealthService 建立健康檢查。liveness 及 readiness 為各自執行的檢查，readiness 另外包含關閉中的檢查；config 可為 nil。
+func NewHealthService(liveness []HealthCheck, readiness []HealthCheck, config *HealthServiceConfig) HealthService {
+	cfg := defaultHealthServiceConfig()
+	if config != nil {
+		overrideDuration(&cfg.CheckTimeout, config.CheckTimeout)
+	}
+	s := &HealthServiceImpl{
+		liveness: liveness,
+		cfg:      cfg,
+	}
+	s.readiness = append([]HealthCheck{{Name: "shutdown", Check: s.checkDraining}}, readiness...)
+	return s
+}
+
+func (s *HealthServiceImpl) Liveness(ctx context.Context) *model.HealthReport {
+	return s.run(ctx, s.liveness)
+}
+
+func (s *HealthServiceImpl) Readiness(ctx context.Context) *model.HealthReport {
+	return s.run(ctx, s.readiness)
+}
+
+func (s *HealthServiceImpl) StartDraining() {
+	s.draining.Store(true)
+}
+
+func (s *HealthServiceImpl) checkDraining(context.Context) error {
+	if s.draining.Load() {
+		return errDraining
+	}
+	return nil
+}
+
+// run 並行執行所有檢查，每項各自套用逾時
+func (s *HealthServiceImpl) run(ctx context.Context, checks []HealthCheck) *model.HealthReport {
+	report := &model.HealthReport{
+		Status: model.HealthStatusOK,
+		Checks: make(map[string]model.HealthCheckResult, len(checks)),
+	}
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for _, check := range checks {
+		wg.Add(1)
+		go func(check HealthCheck) {
+			defer wg.Done()
+			checkCtx, cancel := context.WithTimeout(ctx, s.cfg.CheckTimeout)
+			defer cancel()
+
+			start := time.Now()
+			err := check.Check(checkCtx)
+			result := model.HealthCheckResult{Status: model.HealthStatusOK, DurationMs: time.Since(start).Milliseconds()}
+			if err != nil {
+				result.Status = model.HealthStatusFail
+				result.Error = err.Error()
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			report.Checks[check.Name] = result
+			if err != nil {
+				report.Status = model.HealthStatusFail
+			}
+		}(check)
+	}
+	wg.Wait()
+	return report
+}
+
+// PostgresHealthCheck 檢查連接池能否取得連線
+func PostgresHealthCheck(pool *pgxpool.Pool) HealthCheck {
+	return HealthCheck{Name: "postgres", Check: pool.Ping}
+}
+
+// RedisHealthCheck 檢查 Redis PING
+func RedisHealthCheck(client *redis.Client) HealthCheck {
+	return HealthCheck{Name: "redis", Check: func(ctx context.Context) error {
+		return client.Ping(ctx).Err()
+	}}
+}
+
+// ConsumerGroupHealthCheck 檢查訂單佇列的 consumer group 存在
+func ConsumerGroupHealthCheck(q queue.InspectableOrderQueue) HealthCheck {
+	return HealthCheck{Name: "consumer_group", Check: func(ctx context.Context) error {
+		_, err := q.Stats(ctx)
+		return err
+	}}
+}
+
+// StreamLagHealthCheck 檢查訂單佇列的積壓：尚未投遞或尚未 Ack 的訊息數超過門檻時失敗，門檻為 0 時不檢查該項
+func StreamLagHealthCheck(q queue.InspectableOrderQueue, maxLag int64, maxPending int64) HealthCheck {
+	return HealthCheck{Name: "stream_lag", Check: func(ctx context.Context) error {
+		stats, err := q.Stats(ctx)
+		if err != nil {
+			return err
+		}
+		if maxLag > 0 && stats.Lag > maxLag {
+			return fmt.Errorf("lag %d exceeds %d", stats.Lag, maxLag)
+		}
+		if maxPending > 0 && stats.Pending > maxPending {
+			return fmt.Errorf("pending %d exceeds %d", stats.Pending, maxPending)
+		}
+		return nil
+	}}
+}
+
+// HeartbeatHealthCheck 檢查心跳在 maxAge 內更新過；lastHeartbeat 回傳零值表示尚未啟動
+func HeartbeatHealthCheck(name string, lastHeartbeat func() time.Time, maxAge time.Duration) HealthCheck {
+	return HealthCheck{Name: name, Check: func(context.Context) error {
+		last := lastHeartbeat()
+		if last.IsZero() {
+			return errors.New("not started")
+		}
+		if age := time.Since(last); age > maxAge {
+			return fmt.Errorf("last heartbeat %s ago exceeds %s", age.Truncate(time.Millisecond), maxAge)
+		}
+		return nil
+	}}
+}
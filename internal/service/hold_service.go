@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"go-gin-high-concurrency/internal/cache"
+	"go-gin-high-concurrency/internal/model"
+
+	"github.com/google/uuid"
+)
+
+// ticketHoldTTL 一般票種保留時間，逾時未轉為訂單由 HoldSweeper 歸還庫存
+const ticketHoldTTL = 5 * time.Minute
+
+type HoldService interface {
+	// CreateHold 暫時保留票券，需在到期前以 hold_id 建立訂單
+	CreateHold(ctx context.Context, req model.CreateHoldRequest) (*model.TicketHold, error)
+	// ReleaseHold 使用者提前釋放保留
+	ReleaseHold(ctx context.Context, holdID uuid.UUID, userID int) error
+	// ReleaseExpiredHolds 歸還已到期的保留，回傳本次回收的數量
+	ReleaseExpiredHolds(ctx context.Context, limit int) (int, error)
+}
+
+type HoldServiceImpl struct {
+	holdManager cache.RedisTicketHoldManager
+}
+
+func NewHoldService(holdManager cache.RedisTicketHoldManager) HoldService {
+	return &HoldServiceImpl{
+		holdManager: holdManager,
+	}
+}
+
+func (s *HoldServiceImpl) CreateHold(ctx context.Context, req model.CreateHoldRequest) (*model.TicketHold, error) {
+	return s.holdManager.CreateHold(ctx, req.TicketID, req.UserID, req.Quantity, ticketHoldTTL)
+}
+
+func (s *HoldServiceImpl) ReleaseHold(ctx context.Context, holdID uuid.UUID, userID int) error {
+	return s.holdManager.ReleaseHold(ctx, holdID, userID)
+}
+
+func (s *HoldServiceImpl) ReleaseExpiredHolds(ctx context.Context, limit int) (int, error) {
+	return s.holdManager.ReleaseExpired(ctx, time.Now().UTC(), limit)
+}
@@ -237,6 +237,74 @@ func (_c *MockEventService_List_Call) RunAndReturn(run func(ctx context.Context)
 	return _c
 }
 
+// ListAvailability provides a mock function for the type MockEventService
+func (_mock *MockEventService) ListAvailability(ctx context.Context, eventID uuid.UUID) ([]*model.TicketResponse, error) {
+	ret := _mock.Called(ctx, eventID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAvailability")
+	}
+
+	var r0 []*model.TicketResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]*model.TicketResponse, error)); ok {
+		return returnFunc(ctx, eventID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*model.TicketResponse); ok {
+		r0 = returnFunc(ctx, eventID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.TicketResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, eventID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockEventService_ListAvailability_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAvailability'
+type MockEventService_ListAvailability_Call struct {
+	*mock.Call
+}
+
+// ListAvailability is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventID uuid.UUID
+func (_e *MockEventService_Expecter) ListAvailability(ctx interface{}, eventID interface{}) *MockEventService_ListAvailability_Call {
+	return &MockEventService_ListAvailability_Call{Call: _e.mock.On("ListAvailability", ctx, eventID)}
+}
+
+func (_c *MockEventService_ListAvailability_Call) Run(run func(ctx context.Context, eventID uuid.UUID)) *MockEventService_ListAvailability_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEventService_ListAvailability_Call) Return(ticketResponses []*model.TicketResponse, err error) *MockEventService_ListAvailability_Call {
+	_c.Call.Return(ticketResponses, err)
+	return _c
+}
+
+func (_c *MockEventService_ListAvailability_Call) RunAndReturn(run func(ctx context.Context, eventID uuid.UUID) ([]*model.TicketResponse, error)) *MockEventService_ListAvailability_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // OpenForSale provides a mock function for the type MockEventService
 func (_mock *MockEventService) OpenForSale(ctx context.Context, eventID uuid.UUID) error {
 	ret := _mock.Called(ctx, eventID)
@@ -294,6 +362,74 @@ func (_c *MockEventService_OpenForSale_Call) RunAndReturn(run func(ctx context.C
 	return _c
 }
 
+// SubscribeStock provides a mock function for the type MockEventService
+func (_mock *MockEventService) SubscribeStock(ctx context.Context, eventID uuid.UUID) (<-chan *model.TicketStock, error) {
+	ret := _mock.Called(ctx, eventID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubscribeStock")
+	}
+
+	var r0 <-chan *model.TicketStock
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (<-chan *model.TicketStock, error)); ok {
+		return returnFunc(ctx, eventID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) <-chan *model.TicketStock); ok {
+		r0 = returnFunc(ctx, eventID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan *model.TicketStock)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, eventID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockEventService_SubscribeStock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubscribeStock'
+type MockEventService_SubscribeStock_Call struct {
+	*mock.Call
+}
+
+// SubscribeStock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventID uuid.UUID
+func (_e *MockEventService_Expecter) SubscribeStock(ctx interface{}, eventID interface{}) *MockEventService_SubscribeStock_Call {
+	return &MockEventService_SubscribeStock_Call{Call: _e.mock.On("SubscribeStock", ctx, eventID)}
+}
+
+func (_c *MockEventService_SubscribeStock_Call) Run(run func(ctx context.Context, eventID uuid.UUID)) *MockEventService_SubscribeStock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEventService_SubscribeStock_Call) Return(ticketStockCh <-chan *model.TicketStock, err error) *MockEventService_SubscribeStock_Call {
+	_c.Call.Return(ticketStockCh, err)
+	return _c
+}
+
+func (_c *MockEventService_SubscribeStock_Call) RunAndReturn(run func(ctx context.Context, eventID uuid.UUID) (<-chan *model.TicketStock, error)) *MockEventService_SubscribeStock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateByEventID provides a mock function for the type MockEventService
 func (_mock *MockEventService) UpdateByEventID(ctx context.Context, eventID uuid.UUID, params model.UpdateEventParams) (*model.Event, error) {
 	ret := _mock.Called(ctx, eventID, params)
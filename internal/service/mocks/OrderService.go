@@ -40,16 +40,16 @@ func (_m *MockOrderService) EXPECT() *MockOrderService_Expecter {
 }
 
 // CancelOrderByOrderID provides a mock function for the type MockOrderService
-func (_mock *MockOrderService) CancelOrderByOrderID(ctx context.Context, orderID uuid.UUID) error {
-	ret := _mock.Called(ctx, orderID)
+func (_mock *MockOrderService) CancelOrderByOrderID(ctx context.Context, orderID uuid.UUID, change model.OrderStatusChange) error {
+	ret := _mock.Called(ctx, orderID, change)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CancelOrderByOrderID")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
-		r0 = returnFunc(ctx, orderID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, model.OrderStatusChange) error); ok {
+		r0 = returnFunc(ctx, orderID, change)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -64,11 +64,12 @@ type MockOrderService_CancelOrderByOrderID_Call struct {
 // CancelOrderByOrderID is a helper method to define mock.On call
 //   - ctx context.Context
 //   - orderID uuid.UUID
-func (_e *MockOrderService_Expecter) CancelOrderByOrderID(ctx interface{}, orderID interface{}) *MockOrderService_CancelOrderByOrderID_Call {
-	return &MockOrderService_CancelOrderByOrderID_Call{Call: _e.mock.On("CancelOrderByOrderID", ctx, orderID)}
+//   - change model.OrderStatusChange
+func (_e *MockOrderService_Expecter) CancelOrderByOrderID(ctx interface{}, orderID interface{}, change interface{}) *MockOrderService_CancelOrderByOrderID_Call {
+	return &MockOrderService_CancelOrderByOrderID_Call{Call: _e.mock.On("CancelOrderByOrderID", ctx, orderID, change)}
 }
 
-func (_c *MockOrderService_CancelOrderByOrderID_Call) Run(run func(ctx context.Context, orderID uuid.UUID)) *MockOrderService_CancelOrderByOrderID_Call {
+func (_c *MockOrderService_CancelOrderByOrderID_Call) Run(run func(ctx context.Context, orderID uuid.UUID, change model.OrderStatusChange)) *MockOrderService_CancelOrderByOrderID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -78,9 +79,14 @@ func (_c *MockOrderService_CancelOrderByOrderID_Call) Run(run func(ctx context.C
 		if args[1] != nil {
 			arg1 = args[1].(uuid.UUID)
 		}
+		var arg2 model.OrderStatusChange
+		if args[2] != nil {
+			arg2 = args[2].(model.OrderStatusChange)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
@@ -91,22 +97,22 @@ func (_c *MockOrderService_CancelOrderByOrderID_Call) Return(err error) *MockOrd
 	return _c
 }
 
-func (_c *MockOrderService_CancelOrderByOrderID_Call) RunAndReturn(run func(ctx context.Context, orderID uuid.UUID) error) *MockOrderService_CancelOrderByOrderID_Call {
+func (_c *MockOrderService_CancelOrderByOrderID_Call) RunAndReturn(run func(ctx context.Context, orderID uuid.UUID, change model.OrderStatusChange) error) *MockOrderService_CancelOrderByOrderID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // ConfirmOrderByOrderID provides a mock function for the type MockOrderService
-func (_mock *MockOrderService) ConfirmOrderByOrderID(ctx context.Context, orderID uuid.UUID) error {
-	ret := _mock.Called(ctx, orderID)
+func (_mock *MockOrderService) ConfirmOrderByOrderID(ctx context.Context, orderID uuid.UUID, change model.OrderStatusChange) error {
+	ret := _mock.Called(ctx, orderID, change)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ConfirmOrderByOrderID")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
-		r0 = returnFunc(ctx, orderID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, model.OrderStatusChange) error); ok {
+		r0 = returnFunc(ctx, orderID, change)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -121,11 +127,12 @@ type MockOrderService_ConfirmOrderByOrderID_Call struct {
 // ConfirmOrderByOrderID is a helper method to define mock.On call
 //   - ctx context.Context
 //   - orderID uuid.UUID
-func (_e *MockOrderService_Expecter) ConfirmOrderByOrderID(ctx interface{}, orderID interface{}) *MockOrderService_ConfirmOrderByOrderID_Call {
-	return &MockOrderService_ConfirmOrderByOrderID_Call{Call: _e.mock.On("ConfirmOrderByOrderID", ctx, orderID)}
+//   - change model.OrderStatusChange
+func (_e *MockOrderService_Expecter) ConfirmOrderByOrderID(ctx interface{}, orderID interface{}, change interface{}) *MockOrderService_ConfirmOrderByOrderID_Call {
+	return &MockOrderService_ConfirmOrderByOrderID_Call{Call: _e.mock.On("ConfirmOrderByOrderID", ctx, orderID, change)}
 }
 
-func (_c *MockOrderService_ConfirmOrderByOrderID_Call) Run(run func(ctx context.Context, orderID uuid.UUID)) *MockOrderService_ConfirmOrderByOrderID_Call {
+func (_c *MockOrderService_ConfirmOrderByOrderID_Call) Run(run func(ctx context.Context, orderID uuid.UUID, change model.OrderStatusChange)) *MockOrderService_ConfirmOrderByOrderID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -135,9 +142,14 @@ func (_c *MockOrderService_ConfirmOrderByOrderID_Call) Run(run func(ctx context.
 		if args[1] != nil {
 			arg1 = args[1].(uuid.UUID)
 		}
+		var arg2 model.OrderStatusChange
+		if args[2] != nil {
+			arg2 = args[2].(model.OrderStatusChange)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
@@ -148,7 +160,7 @@ func (_c *MockOrderService_ConfirmOrderByOrderID_Call) Return(err error) *MockOr
 	return _c
 }
 
-func (_c *MockOrderService_ConfirmOrderByOrderID_Call) RunAndReturn(run func(ctx context.Context, orderID uuid.UUID) error) *MockOrderService_ConfirmOrderByOrderID_Call {
+func (_c *MockOrderService_ConfirmOrderByOrderID_Call) RunAndReturn(run func(ctx context.Context, orderID uuid.UUID, change model.OrderStatusChange) error) *MockOrderService_ConfirmOrderByOrderID_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -267,6 +279,69 @@ func (_c *MockOrderService_DispatchOrder_Call) RunAndReturn(run func(ctx context
 	return _c
 }
 
+// ExpireOrderByOrderID provides a mock function for the type MockOrderService
+func (_mock *MockOrderService) ExpireOrderByOrderID(ctx context.Context, orderID uuid.UUID, change model.OrderStatusChange) error {
+	ret := _mock.Called(ctx, orderID, change)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpireOrderByOrderID")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, model.OrderStatusChange) error); ok {
+		r0 = returnFunc(ctx, orderID, change)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockOrderService_ExpireOrderByOrderID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpireOrderByOrderID'
+type MockOrderService_ExpireOrderByOrderID_Call struct {
+	*mock.Call
+}
+
+// ExpireOrderByOrderID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderID uuid.UUID
+//   - change model.OrderStatusChange
+func (_e *MockOrderService_Expecter) ExpireOrderByOrderID(ctx interface{}, orderID interface{}, change interface{}) *MockOrderService_ExpireOrderByOrderID_Call {
+	return &MockOrderService_ExpireOrderByOrderID_Call{Call: _e.mock.On("ExpireOrderByOrderID", ctx, orderID, change)}
+}
+
+func (_c *MockOrderService_ExpireOrderByOrderID_Call) Run(run func(ctx context.Context, orderID uuid.UUID, change model.OrderStatusChange)) *MockOrderService_ExpireOrderByOrderID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 model.OrderStatusChange
+		if args[2] != nil {
+			arg2 = args[2].(model.OrderStatusChange)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOrderService_ExpireOrderByOrderID_Call) Return(err error) *MockOrderService_ExpireOrderByOrderID_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockOrderService_ExpireOrderByOrderID_Call) RunAndReturn(run func(ctx context.Context, orderID uuid.UUID, change model.OrderStatusChange) error) *MockOrderService_ExpireOrderByOrderID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetOrderByOrderID provides a mock function for the type MockOrderService
 func (_mock *MockOrderService) GetOrderByOrderID(ctx context.Context, orderID uuid.UUID) (*model.Order, error) {
 	ret := _mock.Called(ctx, orderID)
@@ -335,6 +410,142 @@ func (_c *MockOrderService_GetOrderByOrderID_Call) RunAndReturn(run func(ctx con
 	return _c
 }
 
+// GetOrderByRequestID provides a mock function for the type MockOrderService
+func (_mock *MockOrderService) GetOrderByRequestID(ctx context.Context, requestID string) (*model.Order, error) {
+	ret := _mock.Called(ctx, requestID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrderByRequestID")
+	}
+
+	var r0 *model.Order
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*model.Order, error)); ok {
+		return returnFunc(ctx, requestID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *model.Order); ok {
+		r0 = returnFunc(ctx, requestID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Order)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, requestID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOrderService_GetOrderByRequestID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrderByRequestID'
+type MockOrderService_GetOrderByRequestID_Call struct {
+	*mock.Call
+}
+
+// GetOrderByRequestID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - requestID string
+func (_e *MockOrderService_Expecter) GetOrderByRequestID(ctx interface{}, requestID interface{}) *MockOrderService_GetOrderByRequestID_Call {
+	return &MockOrderService_GetOrderByRequestID_Call{Call: _e.mock.On("GetOrderByRequestID", ctx, requestID)}
+}
+
+func (_c *MockOrderService_GetOrderByRequestID_Call) Run(run func(ctx context.Context, requestID string)) *MockOrderService_GetOrderByRequestID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOrderService_GetOrderByRequestID_Call) Return(order *model.Order, err error) *MockOrderService_GetOrderByRequestID_Call {
+	_c.Call.Return(order, err)
+	return _c
+}
+
+func (_c *MockOrderService_GetOrderByRequestID_Call) RunAndReturn(run func(ctx context.Context, requestID string) (*model.Order, error)) *MockOrderService_GetOrderByRequestID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrderStatusHistory provides a mock function for the type MockOrderService
+func (_mock *MockOrderService) GetOrderStatusHistory(ctx context.Context, orderID uuid.UUID) ([]*model.OrderStatusHistory, error) {
+	ret := _mock.Called(ctx, orderID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrderStatusHistory")
+	}
+
+	var r0 []*model.OrderStatusHistory
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]*model.OrderStatusHistory, error)); ok {
+		return returnFunc(ctx, orderID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*model.OrderStatusHistory); ok {
+		r0 = returnFunc(ctx, orderID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.OrderStatusHistory)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, orderID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOrderService_GetOrderStatusHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrderStatusHistory'
+type MockOrderService_GetOrderStatusHistory_Call struct {
+	*mock.Call
+}
+
+// GetOrderStatusHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderID uuid.UUID
+func (_e *MockOrderService_Expecter) GetOrderStatusHistory(ctx interface{}, orderID interface{}) *MockOrderService_GetOrderStatusHistory_Call {
+	return &MockOrderService_GetOrderStatusHistory_Call{Call: _e.mock.On("GetOrderStatusHistory", ctx, orderID)}
+}
+
+func (_c *MockOrderService_GetOrderStatusHistory_Call) Run(run func(ctx context.Context, orderID uuid.UUID)) *MockOrderService_GetOrderStatusHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOrderService_GetOrderStatusHistory_Call) Return(orderStatusHistorys []*model.OrderStatusHistory, err error) *MockOrderService_GetOrderStatusHistory_Call {
+	_c.Call.Return(orderStatusHistorys, err)
+	return _c
+}
+
+func (_c *MockOrderService_GetOrderStatusHistory_Call) RunAndReturn(run func(ctx context.Context, orderID uuid.UUID) ([]*model.OrderStatusHistory, error)) *MockOrderService_GetOrderStatusHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // OrderList provides a mock function for the type MockOrderService
 func (_mock *MockOrderService) OrderList(ctx context.Context) ([]*model.Order, error) {
 	ret := _mock.Called(ctx)
@@ -464,3 +675,66 @@ func (_c *MockOrderService_PrepareOrder_Call) RunAndReturn(run func(ctx context.
 	_c.Call.Return(run)
 	return _c
 }
+
+// RefundOrderByOrderID provides a mock function for the type MockOrderService
+func (_mock *MockOrderService) RefundOrderByOrderID(ctx context.Context, orderID uuid.UUID, change model.OrderStatusChange) error {
+	ret := _mock.Called(ctx, orderID, change)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefundOrderByOrderID")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, model.OrderStatusChange) error); ok {
+		r0 = returnFunc(ctx, orderID, change)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockOrderService_RefundOrderByOrderID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefundOrderByOrderID'
+type MockOrderService_RefundOrderByOrderID_Call struct {
+	*mock.Call
+}
+
+// RefundOrderByOrderID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderID uuid.UUID
+//   - change model.OrderStatusChange
+func (_e *MockOrderService_Expecter) RefundOrderByOrderID(ctx interface{}, orderID interface{}, change interface{}) *MockOrderService_RefundOrderByOrderID_Call {
+	return &MockOrderService_RefundOrderByOrderID_Call{Call: _e.mock.On("RefundOrderByOrderID", ctx, orderID, change)}
+}
+
+func (_c *MockOrderService_RefundOrderByOrderID_Call) Run(run func(ctx context.Context, orderID uuid.UUID, change model.OrderStatusChange)) *MockOrderService_RefundOrderByOrderID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 model.OrderStatusChange
+		if args[2] != nil {
+			arg2 = args[2].(model.OrderStatusChange)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOrderService_RefundOrderByOrderID_Call) Return(err error) *MockOrderService_RefundOrderByOrderID_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockOrderService_RefundOrderByOrderID_Call) RunAndReturn(run func(ctx context.Context, orderID uuid.UUID, change model.OrderStatusChange) error) *MockOrderService_RefundOrderByOrderID_Call {
+	_c.Call.Return(run)
+	return _c
+}
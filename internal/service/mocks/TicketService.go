@@ -39,6 +39,80 @@ func (_m *MockTicketService) EXPECT() *MockTicketService_Expecter {
 	return &MockTicketService_Expecter{mock: &_m.Mock}
 }
 
+// AdjustStock provides a mock function for the type MockTicketService
+func (_mock *MockTicketService) AdjustStock(ctx context.Context, ticketID uuid.UUID, req model.AdjustStockRequest) (*model.InventoryAdjustment, error) {
+	ret := _mock.Called(ctx, ticketID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AdjustStock")
+	}
+
+	var r0 *model.InventoryAdjustment
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, model.AdjustStockRequest) (*model.InventoryAdjustment, error)); ok {
+		return returnFunc(ctx, ticketID, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, model.AdjustStockRequest) *model.InventoryAdjustment); ok {
+		r0 = returnFunc(ctx, ticketID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.InventoryAdjustment)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, model.AdjustStockRequest) error); ok {
+		r1 = returnFunc(ctx, ticketID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTicketService_AdjustStock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AdjustStock'
+type MockTicketService_AdjustStock_Call struct {
+	*mock.Call
+}
+
+// AdjustStock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ticketID uuid.UUID
+//   - req model.AdjustStockRequest
+func (_e *MockTicketService_Expecter) AdjustStock(ctx interface{}, ticketID interface{}, req interface{}) *MockTicketService_AdjustStock_Call {
+	return &MockTicketService_AdjustStock_Call{Call: _e.mock.On("AdjustStock", ctx, ticketID, req)}
+}
+
+func (_c *MockTicketService_AdjustStock_Call) Run(run func(ctx context.Context, ticketID uuid.UUID, req model.AdjustStockRequest)) *MockTicketService_AdjustStock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 model.AdjustStockRequest
+		if args[2] != nil {
+			arg2 = args[2].(model.AdjustStockRequest)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTicketService_AdjustStock_Call) Return(inventoryAdjustment *model.InventoryAdjustment, err error) *MockTicketService_AdjustStock_Call {
+	_c.Call.Return(inventoryAdjustment, err)
+	return _c
+}
+
+func (_c *MockTicketService_AdjustStock_Call) RunAndReturn(run func(ctx context.Context, ticketID uuid.UUID, req model.AdjustStockRequest) (*model.InventoryAdjustment, error)) *MockTicketService_AdjustStock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function for the type MockTicketService
 func (_mock *MockTicketService) Create(ctx context.Context, ticket *model.Ticket) (*model.Ticket, error) {
 	ret := _mock.Called(ctx, ticket)
@@ -164,6 +238,74 @@ func (_c *MockTicketService_DeleteByTicketID_Call) RunAndReturn(run func(ctx con
 	return _c
 }
 
+// GetAvailability provides a mock function for the type MockTicketService
+func (_mock *MockTicketService) GetAvailability(ctx context.Context, ticketID uuid.UUID) (*model.TicketResponse, error) {
+	ret := _mock.Called(ctx, ticketID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAvailability")
+	}
+
+	var r0 *model.TicketResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*model.TicketResponse, error)); ok {
+		return returnFunc(ctx, ticketID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) *model.TicketResponse); ok {
+		r0 = returnFunc(ctx, ticketID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.TicketResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, ticketID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTicketService_GetAvailability_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAvailability'
+type MockTicketService_GetAvailability_Call struct {
+	*mock.Call
+}
+
+// GetAvailability is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ticketID uuid.UUID
+func (_e *MockTicketService_Expecter) GetAvailability(ctx interface{}, ticketID interface{}) *MockTicketService_GetAvailability_Call {
+	return &MockTicketService_GetAvailability_Call{Call: _e.mock.On("GetAvailability", ctx, ticketID)}
+}
+
+func (_c *MockTicketService_GetAvailability_Call) Run(run func(ctx context.Context, ticketID uuid.UUID)) *MockTicketService_GetAvailability_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTicketService_GetAvailability_Call) Return(ticketResponse *model.TicketResponse, err error) *MockTicketService_GetAvailability_Call {
+	_c.Call.Return(ticketResponse, err)
+	return _c
+}
+
+func (_c *MockTicketService_GetAvailability_Call) RunAndReturn(run func(ctx context.Context, ticketID uuid.UUID) (*model.TicketResponse, error)) *MockTicketService_GetAvailability_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetByTicketID provides a mock function for the type MockTicketService
 func (_mock *MockTicketService) GetByTicketID(ctx context.Context, ticketID uuid.UUID) (*model.Ticket, error) {
 	ret := _mock.Called(ctx, ticketID)
@@ -294,6 +436,216 @@ func (_c *MockTicketService_List_Call) RunAndReturn(run func(ctx context.Context
 	return _c
 }
 
+// ListPricePhases provides a mock function for the type MockTicketService
+func (_mock *MockTicketService) ListPricePhases(ctx context.Context, ticketID uuid.UUID) ([]*model.TicketPricePhase, error) {
+	ret := _mock.Called(ctx, ticketID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPricePhases")
+	}
+
+	var r0 []*model.TicketPricePhase
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]*model.TicketPricePhase, error)); ok {
+		return returnFunc(ctx, ticketID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*model.TicketPricePhase); ok {
+		r0 = returnFunc(ctx, ticketID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.TicketPricePhase)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, ticketID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTicketService_ListPricePhases_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPricePhases'
+type MockTicketService_ListPricePhases_Call struct {
+	*mock.Call
+}
+
+// ListPricePhases is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ticketID uuid.UUID
+func (_e *MockTicketService_Expecter) ListPricePhases(ctx interface{}, ticketID interface{}) *MockTicketService_ListPricePhases_Call {
+	return &MockTicketService_ListPricePhases_Call{Call: _e.mock.On("ListPricePhases", ctx, ticketID)}
+}
+
+func (_c *MockTicketService_ListPricePhases_Call) Run(run func(ctx context.Context, ticketID uuid.UUID)) *MockTicketService_ListPricePhases_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTicketService_ListPricePhases_Call) Return(ticketPricePhases []*model.TicketPricePhase, err error) *MockTicketService_ListPricePhases_Call {
+	_c.Call.Return(ticketPricePhases, err)
+	return _c
+}
+
+func (_c *MockTicketService_ListPricePhases_Call) RunAndReturn(run func(ctx context.Context, ticketID uuid.UUID) ([]*model.TicketPricePhase, error)) *MockTicketService_ListPricePhases_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListStockAdjustments provides a mock function for the type MockTicketService
+func (_mock *MockTicketService) ListStockAdjustments(ctx context.Context, ticketID uuid.UUID) ([]*model.InventoryAdjustment, error) {
+	ret := _mock.Called(ctx, ticketID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListStockAdjustments")
+	}
+
+	var r0 []*model.InventoryAdjustment
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]*model.InventoryAdjustment, error)); ok {
+		return returnFunc(ctx, ticketID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*model.InventoryAdjustment); ok {
+		r0 = returnFunc(ctx, ticketID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.InventoryAdjustment)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, ticketID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTicketService_ListStockAdjustments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStockAdjustments'
+type MockTicketService_ListStockAdjustments_Call struct {
+	*mock.Call
+}
+
+// ListStockAdjustments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ticketID uuid.UUID
+func (_e *MockTicketService_Expecter) ListStockAdjustments(ctx interface{}, ticketID interface{}) *MockTicketService_ListStockAdjustments_Call {
+	return &MockTicketService_ListStockAdjustments_Call{Call: _e.mock.On("ListStockAdjustments", ctx, ticketID)}
+}
+
+func (_c *MockTicketService_ListStockAdjustments_Call) Run(run func(ctx context.Context, ticketID uuid.UUID)) *MockTicketService_ListStockAdjustments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTicketService_ListStockAdjustments_Call) Return(inventoryAdjustments []*model.InventoryAdjustment, err error) *MockTicketService_ListStockAdjustments_Call {
+	_c.Call.Return(inventoryAdjustments, err)
+	return _c
+}
+
+func (_c *MockTicketService_ListStockAdjustments_Call) RunAndReturn(run func(ctx context.Context, ticketID uuid.UUID) ([]*model.InventoryAdjustment, error)) *MockTicketService_ListStockAdjustments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetPricePhases provides a mock function for the type MockTicketService
+func (_mock *MockTicketService) SetPricePhases(ctx context.Context, ticketID uuid.UUID, req model.SetPricePhasesRequest) ([]*model.TicketPricePhase, error) {
+	ret := _mock.Called(ctx, ticketID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetPricePhases")
+	}
+
+	var r0 []*model.TicketPricePhase
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, model.SetPricePhasesRequest) ([]*model.TicketPricePhase, error)); ok {
+		return returnFunc(ctx, ticketID, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, model.SetPricePhasesRequest) []*model.TicketPricePhase); ok {
+		r0 = returnFunc(ctx, ticketID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.TicketPricePhase)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, model.SetPricePhasesRequest) error); ok {
+		r1 = returnFunc(ctx, ticketID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTicketService_SetPricePhases_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetPricePhases'
+type MockTicketService_SetPricePhases_Call struct {
+	*mock.Call
+}
+
+// SetPricePhases is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ticketID uuid.UUID
+//   - req model.SetPricePhasesRequest
+func (_e *MockTicketService_Expecter) SetPricePhases(ctx interface{}, ticketID interface{}, req interface{}) *MockTicketService_SetPricePhases_Call {
+	return &MockTicketService_SetPricePhases_Call{Call: _e.mock.On("SetPricePhases", ctx, ticketID, req)}
+}
+
+func (_c *MockTicketService_SetPricePhases_Call) Run(run func(ctx context.Context, ticketID uuid.UUID, req model.SetPricePhasesRequest)) *MockTicketService_SetPricePhases_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 model.SetPricePhasesRequest
+		if args[2] != nil {
+			arg2 = args[2].(model.SetPricePhasesRequest)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTicketService_SetPricePhases_Call) Return(ticketPricePhases []*model.TicketPricePhase, err error) *MockTicketService_SetPricePhases_Call {
+	_c.Call.Return(ticketPricePhases, err)
+	return _c
+}
+
+func (_c *MockTicketService_SetPricePhases_Call) RunAndReturn(run func(ctx context.Context, ticketID uuid.UUID, req model.SetPricePhasesRequest) ([]*model.TicketPricePhase, error)) *MockTicketService_SetPricePhases_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateByTicketID provides a mock function for the type MockTicketService
 func (_mock *MockTicketService) UpdateByTicketID(ctx context.Context, ticketID uuid.UUID, params model.UpdateTicketParams) (*model.Ticket, error) {
 	ret := _mock.Called(ctx, ticketID, params)
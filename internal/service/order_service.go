@@ -2,12 +2,15 @@ package service
 
 import (
 	"context"
+	"errors"
 	"go-gin-high-concurrency/internal/cache"
 	"go-gin-high-concurrency/internal/model"
 	"go-gin-high-concurrency/internal/queue"
 	"go-gin-high-concurrency/internal/repository"
 	apperrors "go-gin-high-concurrency/pkg/app_errors"
 	"go-gin-high-concurrency/pkg/logger"
+	"math"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
@@ -15,6 +18,9 @@ import (
 	"go.uber.org/zap"
 )
 
+// promoCodeMissingTTL 資料庫查無優惠碼後的快取時間，期間內建立的優惠碼會於預熱時清除紀錄
+const promoCodeMissingTTL = time.Minute
+
 type OrderService interface {
 	// 創建訂單(Redis庫存管理)
 	PrepareOrder(ctx context.Context, req model.CreateOrderRequest) (*model.Order, error)
@@ -22,70 +28,391 @@ type OrderService interface {
 	DispatchOrder(ctx context.Context, order *model.Order) error
 	OrderList(ctx context.Context) ([]*model.Order, error)
 	GetOrderByOrderID(ctx context.Context, orderID uuid.UUID) (*model.Order, error)
-	ConfirmOrderByOrderID(ctx context.Context, orderID uuid.UUID) error
-	CancelOrderByOrderID(ctx context.Context, orderID uuid.UUID) error
+	// 以下單時的 request_id 查詢訂單，供尚未取得 order_id 的呼叫端追蹤
+	GetOrderByRequestID(ctx context.Context, requestID string) (*model.Order, error)
+	ConfirmOrderByOrderID(ctx context.Context, orderID uuid.UUID, change model.OrderStatusChange) error
+	CancelOrderByOrderID(ctx context.Context, orderID uuid.UUID, change model.OrderStatusChange) error
+	// 逾期：未在期限內確認的訂單轉為 expired，歸還庫存 / 座位、優惠碼及存取碼
+	ExpireOrderByOrderID(ctx context.Context, orderID uuid.UUID, change model.OrderStatusChange) error
+	// 退款：已確認的訂單轉為 refunded，票券釋出回庫存 / 座位，並歸還優惠碼及存取碼
+	RefundOrderByOrderID(ctx context.Context, orderID uuid.UUID, change model.OrderStatusChange) error
 	DeleteOrderByOrderID(ctx context.Context, orderID uuid.UUID) error
+	// 訂單狀態變更紀錄
+	GetOrderStatusHistory(ctx context.Context, orderID uuid.UUID) ([]*model.OrderStatusHistory, error)
 }
 
 type OrderServiceImpl struct {
-	pool             *pgxpool.Pool
-	repository       repository.OrderRepository
-	ticketRepository repository.TicketRepository
-	inventoryManager cache.RedisTicketInventoryManager
-	orderQueue       queue.OrderQueue
+	pool                *pgxpool.Pool
+	repository          repository.OrderRepository
+	ticketRepository    repository.TicketRepository
+	seatRepository      repository.SeatRepository
+	outboxRepository    repository.OutboxRepository
+	promoCodeRepository repository.PromoCodeRepository
+	inventoryManager    cache.RedisTicketInventoryManager
+	seatHoldManager     cache.RedisSeatHoldManager
+	holdManager         cache.RedisTicketHoldManager
+	promoCodeManager    cache.RedisPromoCodeManager
+	presaleManager      cache.RedisPresaleManager
+	riskScorer          RiskScorer
+	orderQueue          queue.OrderQueue
 }
 
 func NewOrderService(
 	pool *pgxpool.Pool,
 	orderRepository repository.OrderRepository,
 	ticketRepository repository.TicketRepository,
+	seatRepository repository.SeatRepository,
+	outboxRepository repository.OutboxRepository,
+	promoCodeRepository repository.PromoCodeRepository,
 	inventoryManager cache.RedisTicketInventoryManager,
+	seatHoldManager cache.RedisSeatHoldManager,
+	holdManager cache.RedisTicketHoldManager,
+	promoCodeManager cache.RedisPromoCodeManager,
+	presaleManager cache.RedisPresaleManager,
+	riskScorer RiskScorer,
 	orderQueue queue.OrderQueue,
 ) OrderService {
 	return &OrderServiceImpl{
-		pool:             pool,
-		repository:       orderRepository,
-		ticketRepository: ticketRepository,
-		inventoryManager: inventoryManager,
-		orderQueue:       orderQueue,
+		pool:                pool,
+		repository:          orderRepository,
+		ticketRepository:    ticketRepository,
+		seatRepository:      seatRepository,
+		outboxRepository:    outboxRepository,
+		promoCodeRepository: promoCodeRepository,
+		inventoryManager:    inventoryManager,
+		seatHoldManager:     seatHoldManager,
+		holdManager:         holdManager,
+		promoCodeManager:    promoCodeManager,
+		presaleManager:      presaleManager,
+		riskScorer:          riskScorer,
+		orderQueue:          orderQueue,
 	}
 }
 
 func (s *OrderServiceImpl) PrepareOrder(ctx context.Context, req model.CreateOrderRequest) (*model.Order, error) {
+	// 0. 風險評分：扣減庫存前拒絕高風險的請求，標記的請求照常下單並記錄在訂單上
+	risk := s.assessRisk(ctx, req)
+	if risk.Decision == model.RiskDecisionBlock {
+		logger.WithContext(ctx, logger.Service).Warn("order blocked by risk check",
+			zap.Int("user_id", req.UserID), zap.Int("ticket_id", req.TicketID), zap.Strings("flags", risk.Flags))
+		return nil, apperrors.ErrOrderBlocked
+	}
+
+	var order *model.Order
+	var err error
+	switch {
+	case req.HoldID != nil:
+		order, err = s.prepareHeldOrder(ctx, req, risk)
+	case len(req.SeatIDs) > 0:
+		order, err = s.prepareSeatedOrder(ctx, req, risk)
+	default:
+		order, err = s.prepareStockOrder(ctx, req, risk)
+	}
+	if errors.Is(err, apperrors.ErrInsufficientStock) {
+		s.recordStockMiss(req)
+	}
+	return order, err
+}
+
+// prepareStockOrder 一般票種下單：在 Redis 扣減庫存後送入佇列
+func (s *OrderServiceImpl) prepareStockOrder(ctx context.Context, req model.CreateOrderRequest, risk *model.RiskAssessment) (*model.Order, error) {
 	// 1. 使用 Redis 庫存管理器檢查庫存
-	result, price, err := s.inventoryManager.DecreStock(ctx, req.TicketID, req.Quantity, req.UserID)
+	result, quote, err := s.inventoryManager.DecreStock(ctx, req.TicketID, req.Quantity, req.UserID, requestAccessCode(req))
 	if err != nil {
 		return nil, err
 	}
 	if !result {
 		return nil, apperrors.ErrInsufficientStock
 	}
+	accessCode := consumedAccessCode(quote.AccessCode)
+	if !priceLocked(req, quote.Price) {
+		s.inventoryManager.RollbackStock(context.Background(), req.TicketID, req.Quantity, req.UserID)
+		s.returnAccessCode(req.TicketID, accessCode)
+		return nil, apperrors.ErrPriceChanged
+	}
+	subtotal := quote.Price * float64(req.Quantity)
+	promoCode, discount, err := s.redeemPromoCode(ctx, req, subtotal)
+	if err != nil {
+		s.inventoryManager.RollbackStock(context.Background(), req.TicketID, req.Quantity, req.UserID)
+		s.returnAccessCode(req.TicketID, accessCode)
+		return nil, err
+	}
 
 	requestID := uuid.New().String()
 
 	// 立即返回訂單資訊
 	order := &model.Order{
-		UserID:     req.UserID,
-		RequestID:  requestID,
-		TicketID:   req.TicketID,
-		Quantity:   req.Quantity,
-		TotalPrice: price * float64(req.Quantity),
-		Status:     model.OrderStatusPending,
+		UserID:         req.UserID,
+		RequestID:      requestID,
+		TicketID:       req.TicketID,
+		Quantity:       req.Quantity,
+		TotalPrice:     subtotal - discount,
+		PricePhase:     pricePhase(quote.Phase),
+		PromoCode:      promoCode,
+		DiscountAmount: discount,
+		AccessCode:     accessCode,
+		Status:         model.OrderStatusPending,
+		CorrelationID:  logger.RequestID(ctx),
 	}
+	applyRisk(order, risk)
 
 	// 1. 嘗試發送 MQ：ctx跟隨請求的生命週期，用戶不等了就取消
 	err = s.orderQueue.PublishOrder(ctx, order)
 	if err != nil {
-		logger.Service.Error("failed to publish order", zap.Error(err))
+		logger.WithContext(ctx, logger.Service).Error("failed to publish order", zap.Error(err))
 		// MQ紀錄失敗，回滾庫存(絕對不能讓使用者搶到票, 所以不使用go routine)
 		// 2. 回滾庫存：RollbackStock使用context.Background()傳遞, 確保RollbackStock一定會執行
 		s.inventoryManager.RollbackStock(context.Background(), req.TicketID, req.Quantity, req.UserID)
-		return nil, apperrors.ErrInternalServerError
+		s.returnPromoCode(order)
+		s.returnAccessCode(order.TicketID, order.AccessCode)
+		return nil, publishError(err)
+	}
+
+	return order, nil
+}
+
+// prepareHeldOrder 由保留轉為訂單：庫存已於保留時扣除，轉換成功後保留即失效，不會再被 sweeper 歸還
+func (s *OrderServiceImpl) prepareHeldOrder(ctx context.Context, req model.CreateOrderRequest, risk *model.RiskAssessment) (*model.Order, error) {
+	if len(req.SeatIDs) > 0 {
+		return nil, apperrors.ErrInvalidInput
+	}
+
+	hold, err := s.holdManager.ConvertHold(ctx, *req.HoldID, req.UserID, req.TicketID, req.Quantity)
+	if err != nil {
+		return nil, err
+	}
+	if !priceLocked(req, hold.Price) {
+		s.inventoryManager.RollbackStock(context.Background(), req.TicketID, req.Quantity, req.UserID)
+		return nil, apperrors.ErrPriceChanged
+	}
+	subtotal := hold.Price * float64(req.Quantity)
+	promoCode, discount, err := s.redeemPromoCode(ctx, req, subtotal)
+	if err != nil {
+		s.inventoryManager.RollbackStock(context.Background(), req.TicketID, req.Quantity, req.UserID)
+		return nil, err
+	}
+
+	order := &model.Order{
+		UserID:         req.UserID,
+		RequestID:      uuid.New().String(),
+		TicketID:       req.TicketID,
+		Quantity:       req.Quantity,
+		TotalPrice:     subtotal - discount,
+		PricePhase:     pricePhase(hold.PricePhase),
+		PromoCode:      promoCode,
+		DiscountAmount: discount,
+		Status:         model.OrderStatusPending,
+		CorrelationID:  logger.RequestID(ctx),
+	}
+	applyRisk(order, risk)
+
+	if err := s.orderQueue.PublishOrder(ctx, order); err != nil {
+		logger.WithContext(ctx, logger.Service).Error("failed to publish held order", zap.Error(err))
+		// 保留已轉換，MQ紀錄失敗時直接回滾庫存
+		s.inventoryManager.RollbackStock(context.Background(), req.TicketID, req.Quantity, req.UserID)
+		s.returnPromoCode(order)
+		return nil, publishError(err)
 	}
 
 	return order, nil
 }
 
+// prepareSeatedOrder 對號座下單：使用者先保留座位，這裡將保留的座位轉為售出並扣減 Redis 庫存
+func (s *OrderServiceImpl) prepareSeatedOrder(ctx context.Context, req model.CreateOrderRequest, risk *model.RiskAssessment) (*model.Order, error) {
+	if len(req.SeatIDs) != req.Quantity || hasDuplicateSeat(req.SeatIDs) {
+		return nil, apperrors.ErrInvalidInput
+	}
+
+	quote, err := s.seatHoldManager.CommitSeats(ctx, req.TicketID, req.UserID, req.SeatIDs, requestAccessCode(req))
+	if err != nil {
+		return nil, err
+	}
+	accessCode := consumedAccessCode(quote.AccessCode)
+	if !priceLocked(req, quote.Price) {
+		s.seatHoldManager.RollbackSeats(context.Background(), req.TicketID, req.UserID, req.SeatIDs)
+		s.returnAccessCode(req.TicketID, accessCode)
+		return nil, apperrors.ErrPriceChanged
+	}
+	subtotal := quote.Price * float64(req.Quantity)
+	promoCode, discount, err := s.redeemPromoCode(ctx, req, subtotal)
+	if err != nil {
+		s.seatHoldManager.RollbackSeats(context.Background(), req.TicketID, req.UserID, req.SeatIDs)
+		s.returnAccessCode(req.TicketID, accessCode)
+		return nil, err
+	}
+
+	order := &model.Order{
+		UserID:         req.UserID,
+		RequestID:      uuid.New().String(),
+		TicketID:       req.TicketID,
+		Quantity:       req.Quantity,
+		TotalPrice:     subtotal - discount,
+		PricePhase:     pricePhase(quote.Phase),
+		PromoCode:      promoCode,
+		DiscountAmount: discount,
+		AccessCode:     accessCode,
+		Status:         model.OrderStatusPending,
+		CorrelationID:  logger.RequestID(ctx),
+		SeatIDs:        req.SeatIDs,
+	}
+	applyRisk(order, risk)
+
+	if err := s.orderQueue.PublishOrder(ctx, order); err != nil {
+		logger.WithContext(ctx, logger.Service).Error("failed to publish seated order", zap.Error(err))
+		// MQ紀錄失敗，釋出座位並回滾庫存
+		s.seatHoldManager.RollbackSeats(context.Background(), req.TicketID, req.UserID, req.SeatIDs)
+		s.returnPromoCode(order)
+		s.returnAccessCode(order.TicketID, order.AccessCode)
+		return nil, publishError(err)
+	}
+
+	return order, nil
+}
+
+// assessRisk 呼叫風險評分 hook；評分失敗（例如 Redis 暫時無法連線）時記錄錯誤並放行，不影響正常下單
+func (s *OrderServiceImpl) assessRisk(ctx context.Context, req model.CreateOrderRequest) *model.RiskAssessment {
+	risk, err := s.riskScorer.Assess(ctx, req)
+	if err != nil {
+		logger.WithContext(ctx, logger.Service).Error("failed to assess order risk", zap.Int("user_id", req.UserID), zap.Error(err))
+		return &model.RiskAssessment{Decision: model.RiskDecisionAllow}
+	}
+	return risk
+}
+
+// recordStockMiss 記錄使用者因庫存不足下單失敗，失敗時僅記錄錯誤
+func (s *OrderServiceImpl) recordStockMiss(req model.CreateOrderRequest) {
+	if err := s.riskScorer.RecordInsufficientStock(context.Background(), req); err != nil {
+		logger.Service.Error("failed to record stock miss", zap.Int("user_id", req.UserID), zap.Error(err))
+	}
+}
+
+// applyRisk 將標記的風險評分寫入訂單供主辦方審核，放行的訂單不記錄
+func applyRisk(order *model.Order, risk *model.RiskAssessment) {
+	if risk.Decision != model.RiskDecisionFlag {
+		return
+	}
+	order.RiskScore = risk.Score
+	order.RiskFlags = risk.Flags
+}
+
+// publishError MQ 發送失敗時回傳給呼叫端的錯誤：佇列已滿可稍後重試，其餘視為內部錯誤
+func publishError(err error) error {
+	if errors.Is(err, apperrors.ErrQueueFull) {
+		return apperrors.ErrQueueFull
+	}
+	return apperrors.ErrInternalServerError
+}
+
+// priceLocked 檢查成立訂單時的售價是否為使用者看到的價格，未帶價格鎖定時一律通過；
+// 以分為單位比較，避免階段價格或折扣計算的浮點誤差造成誤判
+func priceLocked(req model.CreateOrderRequest, price float64) bool {
+	return req.ExpectedPrice == nil || math.Round(*req.ExpectedPrice*100) == math.Round(price*100)
+}
+
+// pricePhase 將預約時套用的價格階段轉為訂單欄位，未套用階段（票種原價）時為 nil
+func pricePhase(phase string) *string {
+	if phase == "" {
+		return nil
+	}
+	return &phase
+}
+
+// redeemPromoCode 在 Redis 扣除一次優惠碼使用次數並計算訂單金額 subtotal 的折扣，未帶優惠碼時回傳 nil；
+// 優惠碼尚未載入 Redis（建立時預熱失敗或 Redis 重建）時以資料庫的使用紀錄重建後重試一次
+func (s *OrderServiceImpl) redeemPromoCode(ctx context.Context, req model.CreateOrderRequest, subtotal float64) (*string, float64, error) {
+	if req.PromoCode == nil {
+		return nil, 0, nil
+	}
+	code := normalizePromoCode(*req.PromoCode)
+
+	promo, err := s.promoCodeManager.Redeem(ctx, code, req.TicketID, req.UserID)
+	if errors.Is(err, apperrors.ErrPromoCodeNotFound) {
+		if err := s.loadPromoCode(ctx, code); err != nil {
+			return nil, 0, err
+		}
+		promo, err = s.promoCodeManager.Redeem(ctx, code, req.TicketID, req.UserID)
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+	return &code, promo.Discount(subtotal), nil
+}
+
+// loadPromoCode 以資料庫尚未歸還的使用紀錄重建 Redis 的使用次數；資料庫查無此優惠碼時記錄於 Redis，
+// promoCodeMissingTTL 內不再查詢資料庫，避免不存在的優惠碼讓每次下單都打到資料庫
+func (s *OrderServiceImpl) loadPromoCode(ctx context.Context, code string) error {
+	missing, err := s.promoCodeManager.IsMissing(ctx, code)
+	if err != nil {
+		return err
+	}
+	if missing {
+		return apperrors.ErrPromoCodeNotFound
+	}
+
+	promo, err := s.promoCodeRepository.FindByCode(ctx, code)
+	if errors.Is(err, apperrors.ErrPromoCodeNotFound) {
+		if err := s.promoCodeManager.MarkMissing(ctx, code, promoCodeMissingTTL); err != nil {
+			logger.WithContext(ctx, logger.Service).Warn("failed to mark promo code missing", zap.String("code", code), zap.Error(err))
+		}
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	userRedemptions, err := s.promoCodeRepository.CountActiveRedemptionsByUser(ctx, promo.ID)
+	if err != nil {
+		return err
+	}
+	return s.promoCodeManager.WarmUp(ctx, promo, userRedemptions)
+}
+
+// returnPromoCode 歸還訂單在 Redis 扣除的優惠碼使用次數，失敗時由下次重建以資料庫為準修正
+func (s *OrderServiceImpl) returnPromoCode(order *model.Order) {
+	if order.PromoCode == nil {
+		return
+	}
+	if err := s.promoCodeManager.Return(context.Background(), *order.PromoCode, order.UserID); err != nil {
+		logger.Service.Error("failed to return promo code in redis", zap.String("code", *order.PromoCode), zap.Error(err))
+	}
+}
+
+// requestAccessCode 請求帶入的預售存取碼，未帶入時為空字串
+func requestAccessCode(req model.CreateOrderRequest) string {
+	if req.AccessCode == nil {
+		return ""
+	}
+	return normalizeAccessCode(*req.AccessCode)
+}
+
+// consumedAccessCode 將預約時扣除使用次數的存取碼轉為訂單欄位，未扣除（非預售票種或名單內的使用者）時為 nil
+func consumedAccessCode(code string) *string {
+	if code == "" {
+		return nil
+	}
+	return &code
+}
+
+// returnAccessCode 歸還訂單在 Redis 扣除的預售存取碼使用次數
+func (s *OrderServiceImpl) returnAccessCode(ticketID int, code *string) {
+	if code == nil {
+		return
+	}
+	if err := s.presaleManager.ReturnAccessCode(context.Background(), ticketID, *code); err != nil {
+		logger.Service.Error("failed to return access code in redis", zap.String("code", *code), zap.Error(err))
+	}
+}
+
+func hasDuplicateSeat(seatIDs []int) bool {
+	seen := make(map[int]bool, len(seatIDs))
+	for _, seatID := range seatIDs {
+		if seen[seatID] {
+			return true
+		}
+		seen[seatID] = true
+	}
+	return false
+}
+
 func (s *OrderServiceImpl) DispatchOrder(ctx context.Context, order *model.Order) error {
 	tx, err := s.pool.BeginTx(ctx, pgx.TxOptions{})
 	if err != nil {
@@ -99,12 +426,69 @@ func (s *OrderServiceImpl) DispatchOrder(ctx context.Context, order *model.Order
 		return err
 	}
 
+	// 訂單建立也記錄一筆狀態歷史（from_status 為 NULL）
+	_, err = s.repository.CreateStatusHistory(ctx, tx, &model.OrderStatusHistory{
+		OrderID:  createdOrder.ID,
+		ToStatus: createdOrder.Status,
+		Actor:    model.OrderActorSystem,
+	})
+	if err != nil {
+		return err
+	}
+
+	// 使用優惠碼的訂單：寫入使用紀錄，與訂單一起提交
+	if createdOrder.PromoCode != nil {
+		promo, err := s.promoCodeRepository.FindByCode(ctx, *createdOrder.PromoCode)
+		if err != nil {
+			return err
+		}
+		_, err = s.promoCodeRepository.CreateRedemption(ctx, tx, &model.PromoRedemption{
+			PromoCodeID:    promo.ID,
+			OrderID:        createdOrder.ID,
+			UserID:         createdOrder.UserID,
+			DiscountAmount: createdOrder.DiscountAmount,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// 對號座訂單：寫入座位指派
+	if len(order.SeatIDs) > 0 {
+		orderSeats := make([]*model.OrderSeat, 0, len(order.SeatIDs))
+		for _, seatID := range order.SeatIDs {
+			orderSeats = append(orderSeats, &model.OrderSeat{
+				OrderID:  createdOrder.ID,
+				TicketID: createdOrder.TicketID,
+				SeatID:   seatID,
+			})
+		}
+		if err := s.seatRepository.CreateOrderSeats(ctx, tx, orderSeats); err != nil {
+			return err
+		}
+	}
+
 	// 更新票券庫存（createdOrder.TicketID 即為票券的 DB ID，無需額外查詢）
-	err = s.ticketRepository.DecrementStock(ctx, tx, createdOrder.TicketID, createdOrder.Quantity)
+	ticket, err := s.ticketRepository.DecrementStock(ctx, tx, createdOrder.TicketID, createdOrder.Quantity)
 	if err != nil {
 		return err
 	}
 
+	if err := s.writeOrderEvent(ctx, tx, model.EventTypeOrderCreated, createdOrder); err != nil {
+		return err
+	}
+
+	// 最後一張票由這筆訂單售出時，通知下游該票種已售罄
+	if ticket.RemainingStock == 0 {
+		event, err := model.NewTicketEvent(model.EventTypeTicketSoldOut, ticket)
+		if err != nil {
+			return err
+		}
+		if _, err := s.outboxRepository.Create(ctx, tx, event); err != nil {
+			return err
+		}
+	}
+
 	return tx.Commit(ctx)
 }
 
@@ -116,26 +500,52 @@ func (s *OrderServiceImpl) GetOrderByOrderID(ctx context.Context, orderID uuid.U
 	return s.repository.FindByOrderID(ctx, orderID)
 }
 
-func (s *OrderServiceImpl) ConfirmOrderByOrderID(ctx context.Context, orderID uuid.UUID) error {
+func (s *OrderServiceImpl) GetOrderByRequestID(ctx context.Context, requestID string) (*model.Order, error) {
+	return s.repository.FindByRequestID(ctx, requestID)
+}
+
+func (s *OrderServiceImpl) ConfirmOrderByOrderID(ctx context.Context, orderID uuid.UUID, change model.OrderStatusChange) error {
 	order, err := s.repository.FindByOrderID(ctx, orderID)
 	if err != nil {
 		return err
 	}
-	if order.Status != model.OrderStatusPending {
+	if !order.Status.CanTransitionTo(model.OrderStatusConfirmed) {
 		return apperrors.ErrInvalidOrderStatus
 	}
-	return s.confirmOrderByID(ctx, order.ID)
+	return s.confirmOrderByID(ctx, order.ID, change)
 }
 
-func (s *OrderServiceImpl) CancelOrderByOrderID(ctx context.Context, orderID uuid.UUID) error {
+func (s *OrderServiceImpl) CancelOrderByOrderID(ctx context.Context, orderID uuid.UUID, change model.OrderStatusChange) error {
 	order, err := s.repository.FindByOrderID(ctx, orderID)
 	if err != nil {
 		return err
 	}
-	if order.Status != model.OrderStatusPending {
+	if !order.Status.CanTransitionTo(model.OrderStatusCancelled) {
 		return apperrors.ErrInvalidOrderStatus
 	}
-	return s.cancelOrderByID(ctx, order.ID)
+	return s.releaseOrderByID(ctx, order.ID, model.OrderStatusCancelled, model.EventTypeOrderCancelled, change)
+}
+
+func (s *OrderServiceImpl) ExpireOrderByOrderID(ctx context.Context, orderID uuid.UUID, change model.OrderStatusChange) error {
+	order, err := s.repository.FindByOrderID(ctx, orderID)
+	if err != nil {
+		return err
+	}
+	if !order.Status.CanTransitionTo(model.OrderStatusExpired) {
+		return apperrors.ErrInvalidOrderStatus
+	}
+	return s.releaseOrderByID(ctx, order.ID, model.OrderStatusExpired, model.EventTypeOrderExpired, change)
+}
+
+func (s *OrderServiceImpl) RefundOrderByOrderID(ctx context.Context, orderID uuid.UUID, change model.OrderStatusChange) error {
+	order, err := s.repository.FindByOrderID(ctx, orderID)
+	if err != nil {
+		return err
+	}
+	if !order.Status.CanTransitionTo(model.OrderStatusRefunded) {
+		return apperrors.ErrInvalidOrderStatus
+	}
+	return s.releaseOrderByID(ctx, order.ID, model.OrderStatusRefunded, model.EventTypeOrderRefunded, change)
 }
 
 func (s *OrderServiceImpl) DeleteOrderByOrderID(ctx context.Context, orderID uuid.UUID) error {
@@ -146,28 +556,40 @@ func (s *OrderServiceImpl) DeleteOrderByOrderID(ctx context.Context, orderID uui
 	return s.repository.Delete(ctx, order.ID)
 }
 
-func (s *OrderServiceImpl) confirmOrderByID(ctx context.Context, id int) error {
+func (s *OrderServiceImpl) GetOrderStatusHistory(ctx context.Context, orderID uuid.UUID) ([]*model.OrderStatusHistory, error) {
+	order, err := s.repository.FindByOrderID(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	return s.repository.ListStatusHistory(ctx, order.ID)
+}
+
+func (s *OrderServiceImpl) confirmOrderByID(ctx context.Context, id int, change model.OrderStatusChange) error {
 	tx, err := s.pool.BeginTx(ctx, pgx.TxOptions{})
 	if err != nil {
 		return err
 	}
 	defer tx.Rollback(ctx)
 
-	_, err = s.repository.UpdateStatusWithLock(ctx, tx, id, model.OrderStatusConfirmed)
+	order, err := s.transitionStatusWithLock(ctx, tx, id, model.OrderStatusConfirmed, change)
 	if err != nil {
 		return err
 	}
+	if err := s.writeOrderEvent(ctx, tx, model.EventTypeOrderConfirmed, order); err != nil {
+		return err
+	}
 	return tx.Commit(ctx)
 }
 
-func (s *OrderServiceImpl) cancelOrderByID(ctx context.Context, id int) error {
+// releaseOrderByID 取消、逾期及退款共用：轉換狀態後歸還庫存 / 座位、優惠碼及存取碼，並寫入對應的領域事件
+func (s *OrderServiceImpl) releaseOrderByID(ctx context.Context, id int, to model.OrderStatus, eventType string, change model.OrderStatusChange) error {
 	tx, err := s.pool.BeginTx(ctx, pgx.TxOptions{})
 	if err != nil {
 		return err
 	}
 	defer tx.Rollback(ctx)
 
-	order, err := s.repository.UpdateStatusWithLock(ctx, tx, id, model.OrderStatusCancelled)
+	order, err := s.transitionStatusWithLock(ctx, tx, id, to, change)
 	if err != nil {
 		return err
 	}
@@ -175,5 +597,87 @@ func (s *OrderServiceImpl) cancelOrderByID(ctx context.Context, id int) error {
 	if err != nil {
 		return err
 	}
-	return tx.Commit(ctx)
+	seatIDs, err := s.seatRepository.ReleaseOrderSeats(ctx, tx, order.ID)
+	if err != nil {
+		return err
+	}
+	if order.PromoCode != nil {
+		if err := s.promoCodeRepository.ReturnRedemption(ctx, tx, order.ID); err != nil {
+			return err
+		}
+	}
+	if err := s.writeOrderEvent(ctx, tx, eventType, order); err != nil {
+		return err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+
+	// 交易提交後才歸還 Redis 的優惠碼、存取碼使用次數及座位 / 庫存（候補名單由 WaitlistPromoter 遞補），
+	// 失敗時由下次開賣預熱以資料庫為準修正
+	s.returnPromoCode(order)
+	s.returnAccessCode(order.TicketID, order.AccessCode)
+	if len(seatIDs) > 0 {
+		if err := s.seatHoldManager.RollbackSeats(context.Background(), order.TicketID, order.UserID, seatIDs); err != nil {
+			logger.WithContext(ctx, logger.Service).Error("failed to release seats in redis", zap.Int("order_id", order.ID), zap.Error(err))
+		}
+		return nil
+	}
+	if err := s.inventoryManager.RollbackStock(context.Background(), order.TicketID, order.Quantity, order.UserID); err != nil {
+		logger.WithContext(ctx, logger.Service).Error("failed to restore stock in redis", zap.Int("order_id", order.ID), zap.Error(err))
+	}
+	return nil
+}
+
+// transitionStatusWithLock 訂單狀態轉換的唯一入口：
+// 1. 鎖定訂單列（SELECT ... FOR UPDATE），避免並發的確認 / 取消互相覆蓋
+// 2. 依狀態機檢查轉換是否合法
+// 3. 更新狀態並寫入 order_status_history
+func (s *OrderServiceImpl) transitionStatusWithLock(
+	ctx context.Context,
+	tx pgx.Tx,
+	id int,
+	to model.OrderStatus,
+	change model.OrderStatusChange,
+) (*model.Order, error) {
+	current, err := s.repository.FindByIDWithLock(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !current.Status.CanTransitionTo(to) {
+		return nil, apperrors.ErrInvalidOrderStatus
+	}
+
+	order, err := s.repository.UpdateStatusWithLock(ctx, tx, id, to)
+	if err != nil {
+		return nil, err
+	}
+
+	actor := change.Actor
+	if actor == "" {
+		actor = model.OrderActorSystem
+	}
+	from := current.Status
+	_, err = s.repository.CreateStatusHistory(ctx, tx, &model.OrderStatusHistory{
+		OrderID:    id,
+		FromStatus: &from,
+		ToStatus:   to,
+		Actor:      actor,
+		Reason:     change.Reason,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return order, nil
+}
+
+// writeOrderEvent 在同一交易內寫入 outbox，確保事件與訂單狀態一起提交或一起回滾
+func (s *OrderServiceImpl) writeOrderEvent(ctx context.Context, tx pgx.Tx, eventType string, order *model.Order) error {
+	event, err := model.NewOrderEvent(eventType, order)
+	if err != nil {
+		return err
+	}
+	_, err = s.outboxRepository.Create(ctx, tx, event)
+	return err
 }
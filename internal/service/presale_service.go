@@ -0,0 +1,137 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"go-gin-high-concurrency/internal/cache"
+	"go-gin-high-concurrency/internal/model"
+	"go-gin-high-concurrency/internal/repository"
+	apperrors "go-gin-high-concurrency/pkg/app_errors"
+
+	"github.com/google/uuid"
+)
+
+// PresaleService 管理預售票種的存取碼及名單；已開賣的票種同步更新 Redis，下一筆預約即生效
+type PresaleService interface {
+	CreateAccessCode(ctx context.Context, ticketID uuid.UUID, req model.CreateAccessCodeRequest) (*model.PresaleAccessCode, error)
+	ListAccessCodes(ctx context.Context, ticketID uuid.UUID) ([]*model.PresaleAccessCode, error)
+	AddAllowlist(ctx context.Context, ticketID uuid.UUID, userIDs []int) error
+	RemoveAllowlist(ctx context.Context, ticketID uuid.UUID, userID int) error
+	ListAllowlist(ctx context.Context, ticketID uuid.UUID) ([]int, error)
+}
+
+type PresaleServiceImpl struct {
+	repo           repository.PresaleRepository
+	ticketRepo     repository.TicketRepository
+	presaleManager cache.RedisPresaleManager
+}
+
+func NewPresaleService(
+	repo repository.PresaleRepository,
+	ticketRepo repository.TicketRepository,
+	presaleManager cache.RedisPresaleManager,
+) PresaleService {
+	return &PresaleServiceImpl{
+		repo:           repo,
+		ticketRepo:     ticketRepo,
+		presaleManager: presaleManager,
+	}
+}
+
+func (s *PresaleServiceImpl) CreateAccessCode(ctx context.Context, ticketID uuid.UUID, req model.CreateAccessCodeRequest) (*model.PresaleAccessCode, error) {
+	ticket, err := s.findPresaleTicket(ctx, ticketID)
+	if err != nil {
+		return nil, err
+	}
+	code := normalizeAccessCode(req.Code)
+	if code == "" {
+		return nil, apperrors.ErrInvalidInput
+	}
+
+	created, err := s.repo.CreateAccessCode(ctx, &model.PresaleAccessCode{
+		TicketID: ticket.ID,
+		Code:     code,
+		MaxUses:  req.MaxUses,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := s.presaleManager.AddAccessCode(ctx, ticket.ID, created); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+func (s *PresaleServiceImpl) ListAccessCodes(ctx context.Context, ticketID uuid.UUID) ([]*model.PresaleAccessCode, error) {
+	ticket, err := s.findPresaleTicket(ctx, ticketID)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.ListAccessCodes(ctx, ticket.ID)
+}
+
+func (s *PresaleServiceImpl) AddAllowlist(ctx context.Context, ticketID uuid.UUID, userIDs []int) error {
+	ticket, err := s.findPresaleTicket(ctx, ticketID)
+	if err != nil {
+		return err
+	}
+	if err := s.repo.AddAllowlistUsers(ctx, ticket.ID, userIDs); err != nil {
+		return err
+	}
+	return s.presaleManager.AddAllowlist(ctx, ticket.ID, userIDs)
+}
+
+// RemoveAllowlist 移除後已成立的訂單不受影響，之後的預約需改帶存取碼
+func (s *PresaleServiceImpl) RemoveAllowlist(ctx context.Context, ticketID uuid.UUID, userID int) error {
+	ticket, err := s.findPresaleTicket(ctx, ticketID)
+	if err != nil {
+		return err
+	}
+	if err := s.repo.RemoveAllowlistUser(ctx, ticket.ID, userID); err != nil {
+		return err
+	}
+	return s.presaleManager.RemoveAllowlist(ctx, ticket.ID, userID)
+}
+
+func (s *PresaleServiceImpl) ListAllowlist(ctx context.Context, ticketID uuid.UUID) ([]int, error) {
+	ticket, err := s.findPresaleTicket(ctx, ticketID)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.ListAllowlistUserIDs(ctx, ticket.ID)
+}
+
+// findPresaleTicket 存取碼及名單只適用於預售票種
+func (s *PresaleServiceImpl) findPresaleTicket(ctx context.Context, ticketID uuid.UUID) (*model.Ticket, error) {
+	ticket, err := s.ticketRepo.FindByTicketID(ctx, ticketID)
+	if err != nil {
+		return nil, err
+	}
+	if !ticket.Presale {
+		return nil, apperrors.ErrInvalidInput
+	}
+	return ticket, nil
+}
+
+// warmUpPresale 開賣時以資料庫的存取碼、名單及未取消訂單的使用次數重建 Redis
+func warmUpPresale(ctx context.Context, repo repository.PresaleRepository, presaleManager cache.RedisPresaleManager, ticketID int) error {
+	accessCodes, err := repo.ListAccessCodes(ctx, ticketID)
+	if err != nil {
+		return err
+	}
+	allowlist, err := repo.ListAllowlistUserIDs(ctx, ticketID)
+	if err != nil {
+		return err
+	}
+	used, err := repo.CountActiveAccessCodeUses(ctx, ticketID)
+	if err != nil {
+		return err
+	}
+	return presaleManager.WarmUp(ctx, ticketID, accessCodes, allowlist, used)
+}
+
+// normalizeAccessCode 存取碼不分大小寫，一律以大寫儲存及比對
+func normalizeAccessCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"go-gin-high-concurrency/internal/cache"
+	"go-gin-high-concurrency/internal/model"
+	"go-gin-high-concurrency/internal/repository"
+	apperrors "go-gin-high-concurrency/pkg/app_errors"
+	"go-gin-high-concurrency/pkg/logger"
+
+	"go.uber.org/zap"
+)
+
+type PromoCodeService interface {
+	Create(ctx context.Context, promo *model.PromoCode) (*model.PromoCode, error)
+	List(ctx context.Context) ([]*model.PromoCode, error)
+	GetByCode(ctx context.Context, code string) (*model.PromoCode, error)
+	// 優惠碼的使用紀錄（含已因取消訂單歸還的紀錄）
+	ListRedemptions(ctx context.Context, code string) ([]*model.PromoRedemption, error)
+}
+
+type PromoCodeServiceImpl struct {
+	repo             repository.PromoCodeRepository
+	eventRepo        repository.EventRepository
+	ticketRepo       repository.TicketRepository
+	promoCodeManager cache.RedisPromoCodeManager
+}
+
+func NewPromoCodeService(
+	repo repository.PromoCodeRepository,
+	eventRepo repository.EventRepository,
+	ticketRepo repository.TicketRepository,
+	promoCodeManager cache.RedisPromoCodeManager,
+) PromoCodeService {
+	return &PromoCodeServiceImpl{
+		repo:             repo,
+		eventRepo:        eventRepo,
+		ticketRepo:       ticketRepo,
+		promoCodeManager: promoCodeManager,
+	}
+}
+
+func (s *PromoCodeServiceImpl) Create(ctx context.Context, promo *model.PromoCode) (*model.PromoCode, error) {
+	promo.Code = normalizePromoCode(promo.Code)
+	if err := validatePromoCode(promo); err != nil {
+		return nil, err
+	}
+
+	// 適用範圍：指定票種時以票種所屬活動為準，同時指定活動需一致
+	if promo.TicketID != nil {
+		ticket, err := s.ticketRepo.FindByID(ctx, *promo.TicketID)
+		if err != nil {
+			return nil, err
+		}
+		if promo.EventID != nil && *promo.EventID != ticket.EventID {
+			return nil, apperrors.ErrInvalidInput
+		}
+	} else if promo.EventID != nil {
+		if _, err := s.eventRepo.FindByID(ctx, *promo.EventID); err != nil {
+			return nil, err
+		}
+	}
+
+	created, err := s.repo.Create(ctx, promo)
+	if err != nil {
+		return nil, err
+	}
+
+	// 預熱失敗不影響建立，下單時會以資料庫重新載入
+	if err := s.promoCodeManager.WarmUp(ctx, created, nil); err != nil {
+		logger.WithContext(ctx, logger.Service).Warn("failed to warm up promo code", zap.String("code", created.Code), zap.Error(err))
+	}
+	return created, nil
+}
+
+func (s *PromoCodeServiceImpl) List(ctx context.Context) ([]*model.PromoCode, error) {
+	return s.repo.List(ctx)
+}
+
+func (s *PromoCodeServiceImpl) GetByCode(ctx context.Context, code string) (*model.PromoCode, error) {
+	return s.repo.FindByCode(ctx, normalizePromoCode(code))
+}
+
+func (s *PromoCodeServiceImpl) ListRedemptions(ctx context.Context, code string) ([]*model.PromoRedemption, error) {
+	promo, err := s.repo.FindByCode(ctx, normalizePromoCode(code))
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.ListRedemptions(ctx, promo.ID)
+}
+
+// normalizePromoCode 優惠碼不分大小寫，一律以大寫儲存及比對
+func normalizePromoCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+func validatePromoCode(promo *model.PromoCode) error {
+	if promo.Code == "" || !promo.DiscountType.IsValid() || promo.DiscountValue <= 0 {
+		return apperrors.ErrInvalidInput
+	}
+	if promo.DiscountType == model.DiscountTypePercentage && promo.DiscountValue > 100 {
+		return apperrors.ErrInvalidInput
+	}
+	if promo.StartsAt != nil && promo.EndsAt != nil && !promo.StartsAt.Before(*promo.EndsAt) {
+		return apperrors.ErrInvalidInput
+	}
+	return nil
+}
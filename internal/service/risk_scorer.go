@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go-gin-high-concurrency/internal/cache"
+	"go-gin-high-concurrency/internal/model"
+)
+
+// RiskScorer 下單前的風險評分 hook：在扣減庫存前評估請求，決定放行、標記或拒絕
+type RiskScorer interface {
+	// 評分：累計本次請求的特徵並回傳評分結果
+	Assess(ctx context.Context, req model.CreateOrderRequest) (*model.RiskAssessment, error)
+	// 庫存不足：記錄使用者因庫存不足下單失敗，作為之後評分的特徵
+	RecordInsufficientStock(ctx context.Context, req model.CreateOrderRequest) error
+	// 執行中調整視窗、門檻與分數，零值的欄位保留原值
+	UpdateConfig(config RiskScorerConfig)
+}
+
+// RiskScorerConfig 可注入的視窗、門檻與分數；nil 或零值時使用預設。
+// 各項計數超過門檻即觸發對應的風險標記，分數為觸發標記權重的總和。
+type RiskScorerConfig struct {
+	Window             time.Duration // 下單次數及庫存不足次數的計數視窗
+	PaymentWindow      time.Duration // 付款識別共用的計數視窗
+	MaxUserAttempts    int           // 視窗內同一使用者的下單次數上限
+	MaxIPAttempts      int           // 視窗內同一 IP 的下單次數上限
+	MaxDeviceAttempts  int           // 視窗內同一裝置指紋的下單次數上限
+	MaxStockMisses     int           // 視窗內同一使用者因庫存不足下單失敗的次數上限
+	MaxPaymentUsers    int           // 共用同一付款識別的使用者數上限
+	VelocityWeight     int           // 使用者 / IP / 裝置下單次數超過上限的分數
+	StockMissWeight    int           // 庫存不足次數超過上限的分數
+	SharedPaymentScore int           // 付款識別共用超過上限的分數
+	FlagScore          int           // 分數達到此值時標記訂單
+	BlockScore         int           // 分數達到此值時拒絕下單
+}
+
+func defaultRiskScorerConfig() RiskScorerConfig {
+	return RiskScorerConfig{
+		Window:             time.Minute,
+		PaymentWindow:      24 * time.Hour,
+		MaxUserAttempts:    10,
+		MaxIPAttempts:      30,
+		MaxDeviceAttempts:  10,
+		MaxStockMisses:     5,
+		MaxPaymentUsers:    3,
+		VelocityWeight:     30,
+		StockMissWeight:    20,
+		SharedPaymentScore: 40,
+		FlagScore:          30,
+		BlockScore:         80,
+	}
+}
+
+type RiskScorerImpl struct {
+	signalManager cache.RedisRiskSignalManager
+	cfgMu         sync.RWMutex
+	cfg           RiskScorerConfig
+}
+
+// NewRiskScorer 建立以 Redis 計數為特徵的風險評分。config 可為 nil，則使用預設門檻與分數。
+func NewRiskScorer(signalManager cache.RedisRiskSignalManager, config *RiskScorerConfig) RiskScorer {
+	cfg := defaultRiskScorerConfig()
+	if config != nil {
+		cfg.override(*config)
+	}
+	return &RiskScorerImpl{
+		signalManager: signalManager,
+		cfg:           cfg,
+	}
+}
+
+func (s *RiskScorerImpl) UpdateConfig(config RiskScorerConfig) {
+	s.cfgMu.Lock()
+	defer s.cfgMu.Unlock()
+	s.cfg.override(config)
+}
+
+// config 目前生效的設定快照
+func (s *RiskScorerImpl) config() RiskScorerConfig {
+	s.cfgMu.RLock()
+	defer s.cfgMu.RUnlock()
+	return s.cfg
+}
+
+func (s *RiskScorerImpl) Assess(ctx context.Context, req model.CreateOrderRequest) (*model.RiskAssessment, error) {
+	cfg := s.config()
+	counters, err := s.signalManager.RecordAttempt(ctx, model.NewRiskSignals(req), cfg.Window, cfg.PaymentWindow)
+	if err != nil {
+		return nil, err
+	}
+
+	assessment := &model.RiskAssessment{Decision: model.RiskDecisionAllow}
+	flag := func(exceeded bool, name string, weight int) {
+		if exceeded {
+			assessment.Flags = append(assessment.Flags, name)
+			assessment.Score += weight
+		}
+	}
+	flag(counters.UserAttempts > cfg.MaxUserAttempts, model.RiskFlagUserVelocity, cfg.VelocityWeight)
+	flag(counters.IPAttempts > cfg.MaxIPAttempts, model.RiskFlagIPVelocity, cfg.VelocityWeight)
+	flag(counters.DeviceAttempts > cfg.MaxDeviceAttempts, model.RiskFlagDeviceVelocity, cfg.VelocityWeight)
+	flag(counters.StockMisses > cfg.MaxStockMisses, model.RiskFlagStockMisses, cfg.StockMissWeight)
+	flag(counters.PaymentUsers > cfg.MaxPaymentUsers, model.RiskFlagSharedPayment, cfg.SharedPaymentScore)
+
+	switch {
+	case assessment.Score >= cfg.BlockScore:
+		assessment.Decision = model.RiskDecisionBlock
+	case assessment.Score >= cfg.FlagScore:
+		assessment.Decision = model.RiskDecisionFlag
+	}
+	return assessment, nil
+}
+
+func (s *RiskScorerImpl) RecordInsufficientStock(ctx context.Context, req model.CreateOrderRequest) error {
+	return s.signalManager.RecordStockMiss(ctx, req.UserID, s.config().Window)
+}
+
+// override 以 config 中大於零的欄位覆蓋目前設定
+func (c *RiskScorerConfig) override(config RiskScorerConfig) {
+	overrideDuration(&c.Window, config.Window)
+	overrideDuration(&c.PaymentWindow, config.PaymentWindow)
+	overrideInt(&c.MaxUserAttempts, config.MaxUserAttempts)
+	overrideInt(&c.MaxIPAttempts, config.MaxIPAttempts)
+	overrideInt(&c.MaxDeviceAttempts, config.MaxDeviceAttempts)
+	overrideInt(&c.MaxStockMisses, config.MaxStockMisses)
+	overrideInt(&c.MaxPaymentUsers, config.MaxPaymentUsers)
+	overrideInt(&c.VelocityWeight, config.VelocityWeight)
+	overrideInt(&c.StockMissWeight, config.StockMissWeight)
+	overrideInt(&c.SharedPaymentScore, config.SharedPaymentScore)
+	overrideInt(&c.FlagScore, config.FlagScore)
+	overrideInt(&c.BlockScore, config.BlockScore)
+}
+
+func overrideDuration(dst *time.Duration, value time.Duration) {
+	if value > 0 {
+		*dst = value
+	}
+}
+
+func overrideInt(dst *int, value int) {
+	if value > 0 {
+		*dst = value
+	}
+}
@@ -0,0 +1,35 @@
+package service
+
+import (
+	"context"
+
+	"go-gin-high-concurrency/internal/model"
+	"go-gin-high-concurrency/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+// RiskService 提供主辦方審核風險評分標記的訂單
+type RiskService interface {
+	ListFlaggedOrders(ctx context.Context, eventID uuid.UUID) ([]*model.Order, error)
+}
+
+type RiskServiceImpl struct {
+	orderRepo repository.OrderRepository
+	eventRepo repository.EventRepository
+}
+
+func NewRiskService(orderRepo repository.OrderRepository, eventRepo repository.EventRepository) RiskService {
+	return &RiskServiceImpl{
+		orderRepo: orderRepo,
+		eventRepo: eventRepo,
+	}
+}
+
+func (s *RiskServiceImpl) ListFlaggedOrders(ctx context.Context, eventID uuid.UUID) ([]*model.Order, error) {
+	event, err := s.eventRepo.FindByEventID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	return s.orderRepo.ListFlaggedByEventID(ctx, event.ID)
+}
@@ -0,0 +1,194 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"go-gin-high-concurrency/internal/cache"
+	"go-gin-high-concurrency/internal/model"
+	"go-gin-high-concurrency/internal/repository"
+	apperrors "go-gin-high-concurrency/pkg/app_errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// seatHoldTTL 座位保留時間，逾時未下單自動釋放
+const seatHoldTTL = 10 * time.Minute
+
+type SeatService interface {
+	// CreateVenue 建立場地及其區域、座位
+	CreateVenue(ctx context.Context, venue *model.Venue) (*model.Venue, error)
+	GetVenue(ctx context.Context, venueID uuid.UUID) (*model.Venue, error)
+	// ListSeatAvailability 對號座票種各座位的即時狀態
+	ListSeatAvailability(ctx context.Context, ticketID uuid.UUID) ([]*model.SeatAvailability, error)
+	// HoldSeats 為使用者保留座位，下單前需先保留；預售票種的使用者不在名單內時需帶入存取碼（下單時才扣除使用次數）
+	HoldSeats(ctx context.Context, ticketID uuid.UUID, userID int, seatIDs []int, accessCode string) (*model.SeatHold, error)
+	ReleaseSeats(ctx context.Context, ticketID uuid.UUID, userID int, seatIDs []int) error
+}
+
+type SeatServiceImpl struct {
+	pool            *pgxpool.Pool
+	repo            repository.SeatRepository
+	ticketRepo      repository.TicketRepository
+	seatHoldManager cache.RedisSeatHoldManager
+}
+
+func NewSeatService(
+	pool *pgxpool.Pool,
+	repo repository.SeatRepository,
+	ticketRepo repository.TicketRepository,
+	seatHoldManager cache.RedisSeatHoldManager,
+) SeatService {
+	return &SeatServiceImpl{
+		pool:            pool,
+		repo:            repo,
+		ticketRepo:      ticketRepo,
+		seatHoldManager: seatHoldManager,
+	}
+}
+
+func (s *SeatServiceImpl) CreateVenue(ctx context.Context, venue *model.Venue) (*model.Venue, error) {
+	if len(venue.Sections) == 0 {
+		return nil, apperrors.ErrInvalidInput
+	}
+	if venue.VenueID == uuid.Nil {
+		venue.VenueID = uuid.New()
+	}
+
+	tx, err := s.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	created, err := s.repo.CreateVenue(ctx, tx, venue)
+	if err != nil {
+		return nil, err
+	}
+	for _, section := range venue.Sections {
+		section.VenueID = created.ID
+		createdSection, err := s.repo.CreateSection(ctx, tx, section)
+		if err != nil {
+			return nil, err
+		}
+		for _, seat := range section.Seats {
+			seat.SectionID = createdSection.ID
+		}
+		if err := s.repo.CreateSeats(ctx, tx, section.Seats); err != nil {
+			return nil, err
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return s.repo.FindVenueByVenueID(ctx, created.VenueID)
+}
+
+func (s *SeatServiceImpl) GetVenue(ctx context.Context, venueID uuid.UUID) (*model.Venue, error) {
+	return s.repo.FindVenueByVenueID(ctx, venueID)
+}
+
+func (s *SeatServiceImpl) ListSeatAvailability(ctx context.Context, ticketID uuid.UUID) ([]*model.SeatAvailability, error) {
+	ticket, seats, err := s.findSeatedTicket(ctx, ticketID)
+	if err != nil {
+		return nil, err
+	}
+	soldSeatIDs, err := s.repo.ListSoldSeatIDs(ctx, ticket.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	seatIDs := make([]int, 0, len(seats))
+	for _, seat := range seats {
+		seatIDs = append(seatIDs, seat.ID)
+	}
+	// Redis 有尚未落庫的售出與保留；資料庫則補上 Redis 重啟後遺失的售出紀錄
+	statuses, err := s.seatHoldManager.GetSeatStatuses(ctx, ticket.ID, seatIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, seatID := range soldSeatIDs {
+		statuses[seatID] = model.SeatStatusSold
+	}
+
+	availability := make([]*model.SeatAvailability, 0, len(seats))
+	for _, seat := range seats {
+		status, ok := statuses[seat.ID]
+		if !ok {
+			status = model.SeatStatusAvailable
+		}
+		availability = append(availability, &model.SeatAvailability{
+			SeatID: seat.ID,
+			Row:    seat.Row,
+			Number: seat.Number,
+			Status: status,
+		})
+	}
+	return availability, nil
+}
+
+func (s *SeatServiceImpl) HoldSeats(ctx context.Context, ticketID uuid.UUID, userID int, seatIDs []int, accessCode string) (*model.SeatHold, error) {
+	ticket, seats, err := s.findSeatedTicket(ctx, ticketID)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateSeatIDs(seats, seatIDs); err != nil {
+		return nil, err
+	}
+
+	if err := s.seatHoldManager.HoldSeats(ctx, ticket.ID, userID, seatIDs, seatHoldTTL, normalizeAccessCode(accessCode)); err != nil {
+		return nil, err
+	}
+	return &model.SeatHold{
+		TicketID:  ticket.TicketID,
+		UserID:    userID,
+		SeatIDs:   seatIDs,
+		ExpiresAt: time.Now().UTC().Add(seatHoldTTL),
+	}, nil
+}
+
+func (s *SeatServiceImpl) ReleaseSeats(ctx context.Context, ticketID uuid.UUID, userID int, seatIDs []int) error {
+	ticket, err := s.ticketRepo.FindByTicketID(ctx, ticketID)
+	if err != nil {
+		return err
+	}
+	return s.seatHoldManager.ReleaseHolds(ctx, ticket.ID, userID, seatIDs)
+}
+
+// findSeatedTicket 取得對號座票種及其區域的所有座位；一般票種回傳 ErrInvalidInput
+func (s *SeatServiceImpl) findSeatedTicket(ctx context.Context, ticketID uuid.UUID) (*model.Ticket, []*model.Seat, error) {
+	ticket, err := s.ticketRepo.FindByTicketID(ctx, ticketID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !ticket.IsSeated() {
+		return nil, nil, apperrors.ErrInvalidInput
+	}
+	seats, err := s.repo.ListSeatsBySectionID(ctx, *ticket.SectionID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return ticket, seats, nil
+}
+
+// validateSeatIDs 座位不可重複，且都必須屬於票種的區域
+func validateSeatIDs(seats []*model.Seat, seatIDs []int) error {
+	if len(seatIDs) == 0 {
+		return apperrors.ErrInvalidInput
+	}
+	inSection := make(map[int]bool, len(seats))
+	for _, seat := range seats {
+		inSection[seat.ID] = true
+	}
+	seen := make(map[int]bool, len(seatIDs))
+	for _, seatID := range seatIDs {
+		if !inSection[seatID] || seen[seatID] {
+			return apperrors.ErrInvalidInput
+		}
+		seen[seatID] = true
+	}
+	return nil
+}
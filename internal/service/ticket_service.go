@@ -2,11 +2,18 @@ package service
 
 import (
 	"context"
+	"errors"
 
+	"go-gin-high-concurrency/internal/cache"
 	"go-gin-high-concurrency/internal/model"
 	"go-gin-high-concurrency/internal/repository"
+	apperrors "go-gin-high-concurrency/pkg/app_errors"
+	"go-gin-high-concurrency/pkg/logger"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
 )
 
 type TicketService interface {
@@ -15,14 +22,25 @@ type TicketService interface {
 	Create(ctx context.Context, ticket *model.Ticket) (*model.Ticket, error)
 	UpdateByTicketID(ctx context.Context, ticketID uuid.UUID, params model.UpdateTicketParams) (*model.Ticket, error)
 	DeleteByTicketID(ctx context.Context, ticketID uuid.UUID) error
+	// GetAvailability 取得票券即時可購買狀態，優先讀取 Redis 庫存
+	GetAvailability(ctx context.Context, ticketID uuid.UUID) (*model.TicketResponse, error)
+	// AdjustStock 販售中加開或收回總庫存，同步更新資料庫與已預熱的 Redis 庫存並留下調整紀錄
+	AdjustStock(ctx context.Context, ticketID uuid.UUID, req model.AdjustStockRequest) (*model.InventoryAdjustment, error)
+	ListStockAdjustments(ctx context.Context, ticketID uuid.UUID) ([]*model.InventoryAdjustment, error)
+	// SetPricePhases 整批取代票種的價格階段，已開賣的票種同步更新 Redis，下一筆預約即套用
+	SetPricePhases(ctx context.Context, ticketID uuid.UUID, req model.SetPricePhasesRequest) ([]*model.TicketPricePhase, error)
+	ListPricePhases(ctx context.Context, ticketID uuid.UUID) ([]*model.TicketPricePhase, error)
 }
 
 type TicketServiceImpl struct {
-	repo repository.TicketRepository
+	pool             *pgxpool.Pool
+	repo             repository.TicketRepository
+	seatRepo         repository.SeatRepository
+	inventoryManager cache.RedisTicketInventoryManager
 }
 
-func NewTicketService(repo repository.TicketRepository) TicketService {
-	return &TicketServiceImpl{repo: repo}
+func NewTicketService(pool *pgxpool.Pool, repo repository.TicketRepository, seatRepo repository.SeatRepository, inventoryManager cache.RedisTicketInventoryManager) TicketService {
+	return &TicketServiceImpl{pool: pool, repo: repo, seatRepo: seatRepo, inventoryManager: inventoryManager}
 }
 
 func (s *TicketServiceImpl) List(ctx context.Context) ([]*model.Ticket, error) {
@@ -35,13 +53,193 @@ func (s *TicketServiceImpl) GetByTicketID(ctx context.Context, ticketID uuid.UUI
 
 func (s *TicketServiceImpl) Create(ctx context.Context, ticket *model.Ticket) (*model.Ticket, error) {
 	ticket.TicketID = uuid.New()
+	// 對號座票種的庫存即為區域內的座位數
+	if ticket.IsSeated() {
+		seats, err := s.seatRepo.ListSeatsBySectionID(ctx, *ticket.SectionID)
+		if err != nil {
+			return nil, err
+		}
+		if len(seats) == 0 {
+			return nil, apperrors.ErrInvalidInput
+		}
+		ticket.TotalStock = len(seats)
+		ticket.RemainingStock = len(seats)
+	}
 	return s.repo.Create(ctx, ticket)
 }
 
+// UpdateByTicketID 售價或每人限購變更時一併同步已預熱的 Redis，避免開賣後仍以舊價格成立訂單；
+// 已建立的保留沿用保留當下的售價
 func (s *TicketServiceImpl) UpdateByTicketID(ctx context.Context, ticketID uuid.UUID, params model.UpdateTicketParams) (*model.Ticket, error) {
-	return s.repo.Update(ctx, ticketID, params)
+	current, err := s.repo.FindByTicketID(ctx, ticketID)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	updated, err := s.repo.Update(ctx, tx, ticketID, params)
+	if err != nil {
+		return nil, err
+	}
+	syncRedis := params.Price != nil || params.MaxPerUser != nil
+	if syncRedis {
+		if err := s.inventoryManager.UpdateInfo(ctx, updated.ID, updated.Price, updated.MaxPerUser); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		// Redis 已更新，提交失敗時還原為原本的售價及限購
+		if syncRedis {
+			if rollbackErr := s.inventoryManager.UpdateInfo(context.Background(), current.ID, current.Price, current.MaxPerUser); rollbackErr != nil {
+				logger.WithContext(ctx, logger.Service).Error("failed to restore ticket info in redis", zap.Int("ticket_id", current.ID), zap.Error(rollbackErr))
+			}
+		}
+		return nil, err
+	}
+	return updated, nil
 }
 
 func (s *TicketServiceImpl) DeleteByTicketID(ctx context.Context, ticketID uuid.UUID) error {
 	return s.repo.Delete(ctx, ticketID)
 }
+
+func (s *TicketServiceImpl) GetAvailability(ctx context.Context, ticketID uuid.UUID) (*model.TicketResponse, error) {
+	ticket, err := s.repo.FindByTicketID(ctx, ticketID)
+	if err != nil {
+		return nil, err
+	}
+	return ticketAvailability(ctx, s.inventoryManager, ticket)
+}
+
+func (s *TicketServiceImpl) AdjustStock(ctx context.Context, ticketID uuid.UUID, req model.AdjustStockRequest) (*model.InventoryAdjustment, error) {
+	if req.Delta == 0 {
+		return nil, apperrors.ErrInvalidInput
+	}
+	ticket, err := s.repo.FindByTicketID(ctx, ticketID)
+	if err != nil {
+		return nil, err
+	}
+	// 對號座票種的庫存由座位數決定，不能直接調整
+	if ticket.IsSeated() {
+		return nil, apperrors.ErrInvalidInput
+	}
+	if req.Actor == "" {
+		req.Actor = model.InventoryActorAdmin
+	}
+
+	tx, err := s.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	// 資料庫的剩餘庫存不含已扣 Redis 但尚未落地的訂單，收回時兩邊都需足夠
+	updated, err := s.repo.AdjustStock(ctx, tx, ticket.ID, req.Delta)
+	if err != nil {
+		return nil, err
+	}
+	adjustment, err := s.repo.CreateInventoryAdjustment(ctx, tx, &model.InventoryAdjustment{
+		TicketID:       ticket.ID,
+		Delta:          req.Delta,
+		TotalStock:     updated.TotalStock,
+		RemainingStock: updated.RemainingStock,
+		Actor:          req.Actor,
+		Reason:         req.Reason,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := s.inventoryManager.AdjustStock(ctx, ticket.ID, req.Delta); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		// Redis 已調整，提交失敗時補償回原本的庫存
+		if rollbackErr := s.inventoryManager.AdjustStock(context.Background(), ticket.ID, -req.Delta); rollbackErr != nil {
+			logger.WithContext(ctx, logger.Service).Error("failed to compensate stock adjustment in redis", zap.Int("ticket_id", ticket.ID), zap.Error(rollbackErr))
+		}
+		return nil, err
+	}
+	return adjustment, nil
+}
+
+func (s *TicketServiceImpl) ListStockAdjustments(ctx context.Context, ticketID uuid.UUID) ([]*model.InventoryAdjustment, error) {
+	ticket, err := s.repo.FindByTicketID(ctx, ticketID)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.ListInventoryAdjustments(ctx, ticket.ID)
+}
+
+func (s *TicketServiceImpl) SetPricePhases(ctx context.Context, ticketID uuid.UUID, req model.SetPricePhasesRequest) ([]*model.TicketPricePhase, error) {
+	ticket, err := s.repo.FindByTicketID(ctx, ticketID)
+	if err != nil {
+		return nil, err
+	}
+	previous, err := s.repo.ListPricePhases(ctx, ticket.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	phases := make([]*model.TicketPricePhase, 0, len(req.Phases))
+	for _, p := range req.Phases {
+		phases = append(phases, &model.TicketPricePhase{
+			TicketID:  ticket.ID,
+			Name:      p.Name,
+			Price:     p.Price,
+			EndsAt:    p.EndsAt,
+			SoldLimit: p.SoldLimit,
+		})
+	}
+
+	tx, err := s.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	saved, err := s.repo.ReplacePricePhases(ctx, tx, ticket.ID, phases)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.inventoryManager.SetPricePhases(ctx, ticket.ID, saved); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		// Redis 已更新，提交失敗時還原為原本的階段
+		if rollbackErr := s.inventoryManager.SetPricePhases(context.Background(), ticket.ID, previous); rollbackErr != nil {
+			logger.WithContext(ctx, logger.Service).Error("failed to restore price phases in redis", zap.Int("ticket_id", ticket.ID), zap.Error(rollbackErr))
+		}
+		return nil, err
+	}
+	return saved, nil
+}
+
+func (s *TicketServiceImpl) ListPricePhases(ctx context.Context, ticketID uuid.UUID) ([]*model.TicketPricePhase, error) {
+	ticket, err := s.repo.FindByTicketID(ctx, ticketID)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.ListPricePhases(ctx, ticket.ID)
+}
+
+// ticketAvailability 以 Redis 的庫存與目前價格階段的售價組出票券響應；尚未開賣（Redis 未預熱）時以資料庫為準
+func ticketAvailability(ctx context.Context, inventoryManager cache.RedisTicketInventoryManager, ticket *model.Ticket) (*model.TicketResponse, error) {
+	info, err := inventoryManager.GetInfo(ctx, ticket.ID)
+	if err != nil {
+		if !errors.Is(err, apperrors.ErrTicketNotFound) {
+			return nil, err
+		}
+		return model.NewTicketResponse(ticket, ticket.RemainingStock, ticket.Price), nil
+	}
+	response := model.NewTicketResponse(ticket, info.Stock, info.Price)
+	response.PricePhase = info.Phase
+	return response, nil
+}
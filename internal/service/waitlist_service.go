@@ -0,0 +1,158 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"go-gin-high-concurrency/internal/cache"
+	"go-gin-high-concurrency/internal/model"
+	"go-gin-high-concurrency/internal/repository"
+	"go-gin-high-concurrency/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+// waitlistHoldTTL 候補遞補的專屬保留時間，逾時未下單由 HoldSweeper 歸還並遞補下一位
+const waitlistHoldTTL = 10 * time.Minute
+
+type WaitlistService interface {
+	// Join 加入票種的候補名單
+	Join(ctx context.Context, ticketID uuid.UUID, req model.JoinWaitlistRequest) (*model.WaitlistEntry, error)
+	Leave(ctx context.Context, ticketID uuid.UUID, userID int) error
+	// GetEntry 查詢候補順位或已遞補的專屬保留
+	GetEntry(ctx context.Context, ticketID uuid.UUID, userID int) (*model.WaitlistEntry, error)
+	// PromoteWaitlisted 將釋出的庫存遞補給各票種的候補者並發出通知，回傳本次遞補的人數；
+	// 通知寫入失敗時回傳錯誤，保留仍有效，下一輪先補發尚未通知的遞補
+	PromoteWaitlisted(ctx context.Context, limit int) (int, error)
+}
+
+type WaitlistServiceImpl struct {
+	pool             *pgxpool.Pool
+	ticketRepository repository.TicketRepository
+	outboxRepository repository.OutboxRepository
+	waitlistManager  cache.RedisWaitlistManager
+}
+
+func NewWaitlistService(
+	pool *pgxpool.Pool,
+	ticketRepository repository.TicketRepository,
+	outboxRepository repository.OutboxRepository,
+	waitlistManager cache.RedisWaitlistManager,
+) WaitlistService {
+	return &WaitlistServiceImpl{
+		pool:             pool,
+		ticketRepository: ticketRepository,
+		outboxRepository: outboxRepository,
+		waitlistManager:  waitlistManager,
+	}
+}
+
+func (s *WaitlistServiceImpl) Join(ctx context.Context, ticketID uuid.UUID, req model.JoinWaitlistRequest) (*model.WaitlistEntry, error) {
+	ticket, err := s.ticketRepository.FindByTicketID(ctx, ticketID)
+	if err != nil {
+		return nil, err
+	}
+	position, err := s.waitlistManager.Join(ctx, ticket.ID, req.UserID, req.Quantity)
+	if err != nil {
+		return nil, err
+	}
+	return &model.WaitlistEntry{
+		TicketID: ticket.TicketID,
+		UserID:   req.UserID,
+		Quantity: req.Quantity,
+		Status:   model.WaitlistStatusWaiting,
+		Position: position,
+	}, nil
+}
+
+func (s *WaitlistServiceImpl) Leave(ctx context.Context, ticketID uuid.UUID, userID int) error {
+	ticket, err := s.ticketRepository.FindByTicketID(ctx, ticketID)
+	if err != nil {
+		return err
+	}
+	return s.waitlistManager.Leave(ctx, ticket.ID, userID)
+}
+
+func (s *WaitlistServiceImpl) GetEntry(ctx context.Context, ticketID uuid.UUID, userID int) (*model.WaitlistEntry, error) {
+	ticket, err := s.ticketRepository.FindByTicketID(ctx, ticketID)
+	if err != nil {
+		return nil, err
+	}
+	entry, err := s.waitlistManager.GetEntry(ctx, ticket.ID, userID)
+	if err != nil {
+		return nil, err
+	}
+	entry.TicketID = ticket.TicketID
+	return entry, nil
+}
+
+func (s *WaitlistServiceImpl) PromoteWaitlisted(ctx context.Context, limit int) (int, error) {
+	// 先補發上一輪通知寫入失敗的遞補
+	pending, err := s.waitlistManager.ListUnnotified(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if err := s.notifyPromoted(ctx, pending); err != nil {
+		return 0, err
+	}
+
+	ticketIDs, err := s.waitlistManager.ListWaitlistedTickets(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	promoted := 0
+	for _, ticketID := range ticketIDs {
+		holds, err := s.waitlistManager.Promote(ctx, ticketID, waitlistHoldTTL, limit)
+		if err != nil {
+			return promoted, err
+		}
+		promoted += len(holds)
+		if err := s.notifyPromoted(ctx, holds); err != nil {
+			return promoted, err
+		}
+	}
+	return promoted, nil
+}
+
+// notifyPromoted 寫入 waitlist.promoted 事件，由 outbox relay 發佈給通知服務；寫入後標記為已通知，
+// 標記失敗時下一輪會再寫入一次（至少一次），事件以 hold id 為 aggregate id 供下游去重
+func (s *WaitlistServiceImpl) notifyPromoted(ctx context.Context, holds []*model.TicketHold) error {
+	if len(holds) == 0 {
+		return nil
+	}
+	if err := s.writePromotedEvents(ctx, holds); err != nil {
+		return err
+	}
+
+	holdIDs := make([]uuid.UUID, 0, len(holds))
+	for _, hold := range holds {
+		holdIDs = append(holdIDs, hold.HoldID)
+	}
+	if err := s.waitlistManager.MarkNotified(ctx, holdIDs); err != nil {
+		logger.WithContext(ctx, logger.Service).Warn("failed to mark waitlist promotions notified", zap.Int("count", len(holdIDs)), zap.Error(err))
+	}
+	return nil
+}
+
+func (s *WaitlistServiceImpl) writePromotedEvents(ctx context.Context, holds []*model.TicketHold) error {
+	tx, err := s.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	for _, hold := range holds {
+		event, err := model.NewHoldEvent(model.EventTypeWaitlistPromoted, hold)
+		if err != nil {
+			return err
+		}
+		if _, err := s.outboxRepository.Create(ctx, tx, event); err != nil {
+			return err
+		}
+	}
+	return tx.Commit(ctx)
+}
@@ -0,0 +1,234 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/url"
+
+	"go-gin-high-concurrency/internal/model"
+	"go-gin-high-concurrency/internal/repository"
+	apperrors "go-gin-high-concurrency/pkg/app_errors"
+	"go-gin-high-concurrency/pkg/logger"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type WebhookService interface {
+	CreateSubscription(ctx context.Context, eventID uuid.UUID, subscription *model.WebhookSubscription) (*model.WebhookSubscription, error)
+	ListSubscriptions(ctx context.Context, eventID uuid.UUID) ([]*model.WebhookSubscription, error)
+	GetSubscription(ctx context.Context, subscriptionID uuid.UUID) (*model.WebhookSubscription, error)
+	UpdateSubscription(ctx context.Context, subscriptionID uuid.UUID, params model.UpdateWebhookSubscriptionParams) (*model.WebhookSubscription, error)
+	DeleteSubscription(ctx context.Context, subscriptionID uuid.UUID) error
+	ListDeliveries(ctx context.Context, subscriptionID uuid.UUID) ([]*model.WebhookDelivery, error)
+	// 手動重送：將投遞重設為待投遞，由 dispatcher 立即重新送出
+	Redeliver(ctx context.Context, subscriptionID uuid.UUID, deliveryID uuid.UUID) (*model.WebhookDelivery, error)
+	// Publish 實作 queue.EventPublisher：為訂閱了該事件的 webhook 建立投遞紀錄
+	Publish(ctx context.Context, event *model.OutboxEvent) error
+}
+
+type WebhookServiceImpl struct {
+	repo       repository.WebhookRepository
+	eventRepo  repository.EventRepository
+	ticketRepo repository.TicketRepository
+}
+
+func NewWebhookService(repo repository.WebhookRepository, eventRepo repository.EventRepository, ticketRepo repository.TicketRepository) WebhookService {
+	return &WebhookServiceImpl{repo: repo, eventRepo: eventRepo, ticketRepo: ticketRepo}
+}
+
+func (s *WebhookServiceImpl) CreateSubscription(ctx context.Context, eventID uuid.UUID, subscription *model.WebhookSubscription) (*model.WebhookSubscription, error) {
+	if err := validateWebhookURL(subscription.URL); err != nil {
+		return nil, err
+	}
+	if err := validateWebhookEventTypes(subscription.EventTypes); err != nil {
+		return nil, err
+	}
+
+	event, err := s.eventRepo.FindByEventID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	if subscription.Secret == "" {
+		secret, err := generateWebhookSecret()
+		if err != nil {
+			return nil, err
+		}
+		subscription.Secret = secret
+	}
+	if subscription.SubscriptionID == uuid.Nil {
+		subscription.SubscriptionID = uuid.New()
+	}
+	subscription.EventID = event.ID
+	subscription.Active = true
+
+	return s.repo.CreateSubscription(ctx, subscription)
+}
+
+func (s *WebhookServiceImpl) ListSubscriptions(ctx context.Context, eventID uuid.UUID) ([]*model.WebhookSubscription, error) {
+	event, err := s.eventRepo.FindByEventID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.ListSubscriptionsByEventID(ctx, event.ID)
+}
+
+func (s *WebhookServiceImpl) GetSubscription(ctx context.Context, subscriptionID uuid.UUID) (*model.WebhookSubscription, error) {
+	return s.repo.FindSubscriptionBySubscriptionID(ctx, subscriptionID)
+}
+
+func (s *WebhookServiceImpl) UpdateSubscription(ctx context.Context, subscriptionID uuid.UUID, params model.UpdateWebhookSubscriptionParams) (*model.WebhookSubscription, error) {
+	if params.URL != nil {
+		if err := validateWebhookURL(*params.URL); err != nil {
+			return nil, err
+		}
+	}
+	if params.EventTypes != nil {
+		if err := validateWebhookEventTypes(params.EventTypes); err != nil {
+			return nil, err
+		}
+	}
+
+	subscription, err := s.repo.FindSubscriptionBySubscriptionID(ctx, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.UpdateSubscription(ctx, subscription.ID, params)
+}
+
+func (s *WebhookServiceImpl) DeleteSubscription(ctx context.Context, subscriptionID uuid.UUID) error {
+	subscription, err := s.repo.FindSubscriptionBySubscriptionID(ctx, subscriptionID)
+	if err != nil {
+		return err
+	}
+	return s.repo.DeleteSubscription(ctx, subscription.ID)
+}
+
+func (s *WebhookServiceImpl) ListDeliveries(ctx context.Context, subscriptionID uuid.UUID) ([]*model.WebhookDelivery, error) {
+	subscription, err := s.repo.FindSubscriptionBySubscriptionID(ctx, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.ListDeliveriesBySubscriptionID(ctx, subscription.ID)
+}
+
+func (s *WebhookServiceImpl) Redeliver(ctx context.Context, subscriptionID uuid.UUID, deliveryID uuid.UUID) (*model.WebhookDelivery, error) {
+	subscription, err := s.repo.FindSubscriptionBySubscriptionID(ctx, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+	delivery, err := s.repo.FindDeliveryByDeliveryID(ctx, subscription.ID, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.ResetDelivery(ctx, delivery.ID)
+}
+
+func (s *WebhookServiceImpl) Publish(ctx context.Context, event *model.OutboxEvent) error {
+	if !model.IsWebhookEventType(event.EventType) {
+		return nil
+	}
+
+	eventID, data, err := s.webhookData(ctx, event)
+	if err != nil {
+		// 票券已刪除等情況找不到所屬活動，略過而非阻塞 relay
+		if errors.Is(err, apperrors.ErrTicketNotFound) {
+			logger.WithContext(ctx, logger.Service).Warn("skip webhook: ticket not found",
+				zap.Int64("outbox_event_id", event.ID), zap.String("event_type", event.EventType))
+			return nil
+		}
+		return err
+	}
+
+	subscriptions, err := s.repo.ListActiveSubscriptionsByEventType(ctx, eventID, event.EventType)
+	if err != nil {
+		return err
+	}
+	if len(subscriptions) == 0 {
+		return nil
+	}
+
+	deliveries := make([]*model.WebhookDelivery, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		deliveryID := uuid.New()
+		payload, err := json.Marshal(model.WebhookPayload{
+			ID:        deliveryID,
+			Type:      event.EventType,
+			CreatedAt: event.CreatedAt,
+			Data:      data,
+		})
+		if err != nil {
+			return err
+		}
+		deliveries = append(deliveries, &model.WebhookDelivery{
+			DeliveryID:     deliveryID,
+			SubscriptionID: subscription.ID,
+			OutboxEventID:  event.ID,
+			EventType:      event.EventType,
+			Payload:        payload,
+		})
+	}
+
+	return s.repo.CreateDeliveries(ctx, deliveries)
+}
+
+// webhookData 依事件的聚合類型找出所屬活動的內部 ID，並取出送給主辦方的內容；
+// 訂單事件改用 WebhookOrder，不外流預售存取碼及風險評分
+func (s *WebhookServiceImpl) webhookData(ctx context.Context, event *model.OutboxEvent) (int, json.RawMessage, error) {
+	switch event.AggregateType {
+	case model.AggregateTypeOrder:
+		var order model.Order
+		if err := json.Unmarshal(event.Payload, &order); err != nil {
+			return 0, nil, err
+		}
+		ticket, err := s.ticketRepo.FindByID(ctx, order.TicketID)
+		if err != nil {
+			return 0, nil, err
+		}
+		data, err := json.Marshal(model.NewWebhookOrder(&order))
+		if err != nil {
+			return 0, nil, err
+		}
+		return ticket.EventID, data, nil
+	case model.AggregateTypeTicket:
+		var ticket model.Ticket
+		if err := json.Unmarshal(event.Payload, &ticket); err != nil {
+			return 0, nil, err
+		}
+		return ticket.EventID, event.Payload, nil
+	default:
+		return 0, nil, apperrors.ErrInvalidInput
+	}
+}
+
+func validateWebhookURL(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return apperrors.ErrInvalidInput
+	}
+	return nil
+}
+
+func validateWebhookEventTypes(eventTypes []string) error {
+	if len(eventTypes) == 0 {
+		return apperrors.ErrInvalidInput
+	}
+	for _, t := range eventTypes {
+		if !model.IsWebhookEventType(t) {
+			return apperrors.ErrInvalidInput
+		}
+	}
+	return nil
+}
+
+func generateWebhookSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,78 @@
+package worker
+
+import (
+	"context"
+	"go-gin-high-concurrency/internal/service"
+	"go-gin-high-concurrency/pkg/logger"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+type HoldSweeper interface {
+	// 定期歸還到期的保留
+	Start(ctx context.Context) error
+}
+
+// HoldSweeperConfig 可注入的批次大小與輪詢間隔；nil 或零值時使用預設。
+type HoldSweeperConfig struct {
+	BatchSize    int           // 每次最多回收的保留數
+	PollInterval time.Duration // 沒有到期保留時的輪詢間隔
+}
+
+func defaultHoldSweeperConfig() HoldSweeperConfig {
+	return HoldSweeperConfig{
+		BatchSize:    200,
+		PollInterval: 1 * time.Second,
+	}
+}
+
+type HoldSweeperImpl struct {
+	holdService service.HoldService
+	cfg         HoldSweeperConfig
+}
+
+// NewHoldSweeper 建立保留回收器。config 可為 nil，則使用預設批次大小與輪詢間隔。
+func NewHoldSweeper(holdService service.HoldService, config *HoldSweeperConfig) HoldSweeper {
+	cfg := defaultHoldSweeperConfig()
+	if config != nil {
+		if config.BatchSize > 0 {
+			cfg.BatchSize = config.BatchSize
+		}
+		if config.PollInterval > 0 {
+			cfg.PollInterval = config.PollInterval
+		}
+	}
+	return &HoldSweeperImpl{
+		holdService: holdService,
+		cfg:         cfg,
+	}
+}
+
+func (s *HoldSweeperImpl) Start(ctx context.Context) error {
+	go func() {
+		ticker := time.NewTicker(s.cfg.PollInterval)
+		defer ticker.Stop()
+
+		for {
+			// 一批回收滿了代表可能還有積壓，直接處理下一批
+			n, err := s.holdService.ReleaseExpiredHolds(ctx, s.cfg.BatchSize)
+			if err != nil && ctx.Err() == nil {
+				logger.Worker.Error("release expired holds failed", zap.Error(err))
+			}
+			if n > 0 {
+				logger.Worker.Info("released expired holds", zap.Int("count", n))
+			}
+			if err == nil && n >= s.cfg.BatchSize {
+				continue
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	return nil
+}
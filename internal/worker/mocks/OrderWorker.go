@@ -6,6 +6,8 @@ package mocks
 
 import (
 	"context"
+	"go-gin-high-concurrency/internal/worker"
+	"time"
 
 	mock "github.com/stretchr/testify/mock"
 )
@@ -37,6 +39,50 @@ func (_m *MockOrderWorker) EXPECT() *MockOrderWorker_Expecter {
 	return &MockOrderWorker_Expecter{mock: &_m.Mock}
 }
 
+// LastHeartbeat provides a mock function for the type MockOrderWorker
+func (_mock *MockOrderWorker) LastHeartbeat() time.Time {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for LastHeartbeat")
+	}
+
+	var r0 time.Time
+	if returnFunc, ok := ret.Get(0).(func() time.Time); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(time.Time)
+	}
+	return r0
+}
+
+// MockOrderWorker_LastHeartbeat_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LastHeartbeat'
+type MockOrderWorker_LastHeartbeat_Call struct {
+	*mock.Call
+}
+
+// LastHeartbeat is a helper method to define mock.On call
+func (_e *MockOrderWorker_Expecter) LastHeartbeat() *MockOrderWorker_LastHeartbeat_Call {
+	return &MockOrderWorker_LastHeartbeat_Call{Call: _e.mock.On("LastHeartbeat")}
+}
+
+func (_c *MockOrderWorker_LastHeartbeat_Call) Run(run func()) *MockOrderWorker_LastHeartbeat_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockOrderWorker_LastHeartbeat_Call) Return(time time.Time) *MockOrderWorker_LastHeartbeat_Call {
+	_c.Call.Return(time)
+	return _c
+}
+
+func (_c *MockOrderWorker_LastHeartbeat_Call) RunAndReturn(run func() time.Time) *MockOrderWorker_LastHeartbeat_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Start provides a mock function for the type MockOrderWorker
 func (_mock *MockOrderWorker) Start(ctx context.Context) error {
 	ret := _mock.Called(ctx)
@@ -87,3 +133,43 @@ func (_c *MockOrderWorker_Start_Call) RunAndReturn(run func(ctx context.Context)
 	_c.Call.Return(run)
 	return _c
 }
+
+// UpdateConfig provides a mock function for the type MockOrderWorker
+func (_mock *MockOrderWorker) UpdateConfig(config worker.OrderWorkerConfig) {
+	_mock.Called(config)
+	return
+}
+
+// MockOrderWorker_UpdateConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateConfig'
+type MockOrderWorker_UpdateConfig_Call struct {
+	*mock.Call
+}
+
+// UpdateConfig is a helper method to define mock.On call
+//   - config worker.OrderWorkerConfig
+func (_e *MockOrderWorker_Expecter) UpdateConfig(config interface{}) *MockOrderWorker_UpdateConfig_Call {
+	return &MockOrderWorker_UpdateConfig_Call{Call: _e.mock.On("UpdateConfig", config)}
+}
+
+func (_c *MockOrderWorker_UpdateConfig_Call) Run(run func(config worker.OrderWorkerConfig)) *MockOrderWorker_UpdateConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 worker.OrderWorkerConfig
+		if args[0] != nil {
+			arg0 = args[0].(worker.OrderWorkerConfig)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOrderWorker_UpdateConfig_Call) Return() *MockOrderWorker_UpdateConfig_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockOrderWorker_UpdateConfig_Call) RunAndReturn(run func(config worker.OrderWorkerConfig)) *MockOrderWorker_UpdateConfig_Call {
+	_c.Run(run)
+	return _c
+}
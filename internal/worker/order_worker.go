@@ -4,43 +4,141 @@ import (
 	"context"
 	"go-gin-high-concurrency/internal/queue"
 	"go-gin-high-concurrency/internal/service"
+	"go-gin-high-concurrency/pkg/logger"
+	"sync"
+	"sync/atomic"
+	"time"
 )
 
 type OrderWorker interface {
 	// 訂閱訂單隊列
 	Start(ctx context.Context) error
+	// 執行中調整同時處理的訂單數，零值時保留原值
+	UpdateConfig(config OrderWorkerConfig)
+	// 消費循環最後一次存活的時間；尚未啟動或消費循環已結束後不再更新，供健康檢查判斷 Worker 是否停擺
+	LastHeartbeat() time.Time
+}
+
+// orderWorkerHeartbeatInterval 沒有訊息時更新心跳的間隔
+const orderWorkerHeartbeatInterval = 5 * time.Second
+
+// OrderWorkerConfig 可注入的並行設定；nil 或零值時使用預設。
+type OrderWorkerConfig struct {
+	Concurrency int // 同時寫入資料庫的訂單數
+}
+
+func defaultOrderWorkerConfig() OrderWorkerConfig {
+	return OrderWorkerConfig{
+		Concurrency: 1,
+	}
 }
 
 type OrderWorkerImpl struct {
 	service service.OrderService
 	queue   queue.OrderQueue
+
+	mu       sync.Mutex
+	slots    *sync.Cond // 處理中的訂單數達到上限時等待
+	cfg      OrderWorkerConfig
+	inFlight int
+
+	heartbeat atomic.Int64 // unix 毫秒
 }
 
-func NewOrderWorker(service service.OrderService, queue queue.OrderQueue) OrderWorker {
-	return &OrderWorkerImpl{
+// NewOrderWorker 建立訂單 Worker。config 可為 nil，則逐筆處理訂單。
+func NewOrderWorker(service service.OrderService, queue queue.OrderQueue, config *OrderWorkerConfig) OrderWorker {
+	cfg := defaultOrderWorkerConfig()
+	if config != nil && config.Concurrency > 0 {
+		cfg.Concurrency = config.Concurrency
+	}
+	w := &OrderWorkerImpl{
 		service: service,
 		queue:   queue,
+		cfg:     cfg,
 	}
+	w.slots = sync.NewCond(&w.mu)
+	return w
 }
 
 func (w *OrderWorkerImpl) Start(ctx context.Context) error {
 	// 1. 從自製的 MemoryQueue 訂閱
 	msgs, _ := w.queue.SubscribeOrders(ctx)
 
+	w.beat()
 	go func() {
-		for msg := range msgs {
-			// Worker 正在努力工作：
-			// 它是那個把「訊息」變成「資料庫成果」的搬運工
-			err := w.service.DispatchOrder(ctx, msg.Data)
-
-			if err != nil {
-				// 如果資料庫暫時連不上，Worker 決定重試
-				msg.Nack(true)
-			} else {
-				// 成功了，Worker 告訴 Queue 可以結案了
-				msg.Ack()
+		ticker := time.NewTicker(orderWorkerHeartbeatInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case msg, ok := <-msgs:
+				if !ok {
+					return
+				}
+				// 處理中的訂單數達到上限時在這裡等待，等待過久心跳會逾時
+				w.acquire()
+				w.beat()
+				go func(msg queue.Delivery) {
+					defer w.release()
+					w.process(ctx, msg)
+				}(msg)
+			case <-ticker.C:
+				w.beat()
 			}
 		}
 	}()
 	return nil
 }
+
+func (w *OrderWorkerImpl) LastHeartbeat() time.Time {
+	ms := w.heartbeat.Load()
+	if ms == 0 {
+		return time.Time{}
+	}
+	return time.UnixMilli(ms)
+}
+
+func (w *OrderWorkerImpl) beat() {
+	w.heartbeat.Store(time.Now().UnixMilli())
+}
+
+func (w *OrderWorkerImpl) UpdateConfig(config OrderWorkerConfig) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if config.Concurrency > 0 {
+		w.cfg.Concurrency = config.Concurrency
+	}
+	// 上限調高時喚醒等待中的訂單；調低時處理中的訂單照常完成，之後才套用新的上限
+	w.slots.Broadcast()
+}
+
+func (w *OrderWorkerImpl) process(ctx context.Context, msg queue.Delivery) {
+	// Worker 正在努力工作：
+	// 它是那個把「訊息」變成「資料庫成果」的搬運工
+	// 沿用下單請求的 request id，Worker 的日誌可與 API 的日誌串接
+	ctx = logger.WithRequestID(ctx, msg.Data.CorrelationID)
+	err := w.service.DispatchOrder(ctx, msg.Data)
+
+	if err != nil {
+		// 如果資料庫暫時連不上，Worker 決定重試
+		msg.Nack(true)
+	} else {
+		// 成功了，Worker 告訴 Queue 可以結案了
+		msg.Ack()
+	}
+}
+
+func (w *OrderWorkerImpl) acquire() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for w.inFlight >= w.cfg.Concurrency {
+		w.slots.Wait()
+	}
+	w.inFlight++
+}
+
+func (w *OrderWorkerImpl) release() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.inFlight--
+	w.slots.Signal()
+}
@@ -0,0 +1,118 @@
+package worker
+
+import (
+	"context"
+	"go-gin-high-concurrency/internal/queue"
+	"go-gin-high-concurrency/internal/repository"
+	"go-gin-high-concurrency/pkg/logger"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+type OutboxRelay interface {
+	// 輪詢 outbox 並發佈未發佈的事件
+	Start(ctx context.Context) error
+}
+
+// OutboxRelayConfig 可注入的批次大小與輪詢間隔；nil 或零值時使用預設。
+type OutboxRelayConfig struct {
+	BatchSize    int           // 每次輪詢最多處理的事件數
+	PollInterval time.Duration // 沒有待發佈事件時的輪詢間隔
+}
+
+func defaultOutboxRelayConfig() OutboxRelayConfig {
+	return OutboxRelayConfig{
+		BatchSize:    100,
+		PollInterval: 500 * time.Millisecond,
+	}
+}
+
+type OutboxRelayImpl struct {
+	pool             *pgxpool.Pool
+	outboxRepository repository.OutboxRepository
+	publisher        queue.EventPublisher
+	cfg              OutboxRelayConfig
+}
+
+// NewOutboxRelay 建立 outbox relay。config 可為 nil，則使用預設批次大小與輪詢間隔。
+func NewOutboxRelay(pool *pgxpool.Pool, outboxRepository repository.OutboxRepository, publisher queue.EventPublisher, config *OutboxRelayConfig) OutboxRelay {
+	cfg := defaultOutboxRelayConfig()
+	if config != nil {
+		if config.BatchSize > 0 {
+			cfg.BatchSize = config.BatchSize
+		}
+		if config.PollInterval > 0 {
+			cfg.PollInterval = config.PollInterval
+		}
+	}
+	return &OutboxRelayImpl{
+		pool:             pool,
+		outboxRepository: outboxRepository,
+		publisher:        publisher,
+		cfg:              cfg,
+	}
+}
+
+func (r *OutboxRelayImpl) Start(ctx context.Context) error {
+	go func() {
+		ticker := time.NewTicker(r.cfg.PollInterval)
+		defer ticker.Stop()
+
+		for {
+			// 一批處理滿了代表可能還有積壓，直接處理下一批
+			n, err := r.relayBatch(ctx)
+			if err != nil && ctx.Err() == nil {
+				logger.Worker.Error("relay outbox batch failed", zap.Error(err))
+			}
+			if err == nil && n >= r.cfg.BatchSize {
+				continue
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	return nil
+}
+
+// relayBatch 在單一交易內鎖定一批事件、依序發佈並標記為已發佈。
+// 發佈失敗時停止處理後續事件以維持順序，已發佈的部分仍會標記；
+// 若 commit 失敗則整批會被重新發佈（at-least-once）。
+func (r *OutboxRelayImpl) relayBatch(ctx context.Context) (int, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	events, err := r.outboxRepository.ListUnpublishedWithLock(ctx, tx, r.cfg.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+	if len(events) == 0 {
+		return 0, nil
+	}
+
+	published := make([]int64, 0, len(events))
+	var publishErr error
+	for _, event := range events {
+		if publishErr = r.publisher.Publish(ctx, event); publishErr != nil {
+			break
+		}
+		published = append(published, event.ID)
+	}
+
+	if err := r.outboxRepository.MarkPublished(ctx, tx, published); err != nil {
+		return 0, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return 0, err
+	}
+
+	return len(published), publishErr
+}
@@ -0,0 +1,74 @@
+package worker
+
+import (
+	"context"
+	"go-gin-high-concurrency/internal/service"
+	"go-gin-high-concurrency/pkg/logger"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+type WaitlistPromoter interface {
+	// 定期將釋出的庫存（取消、保留到期、加開）遞補給候補者
+	Start(ctx context.Context) error
+}
+
+// WaitlistPromoterConfig 可注入的批次大小與輪詢間隔；nil 或零值時使用預設。
+type WaitlistPromoterConfig struct {
+	BatchSize    int           // 每個票種每次最多遞補的人數
+	PollInterval time.Duration // 輪詢間隔
+}
+
+func defaultWaitlistPromoterConfig() WaitlistPromoterConfig {
+	return WaitlistPromoterConfig{
+		BatchSize:    50,
+		PollInterval: 1 * time.Second,
+	}
+}
+
+type WaitlistPromoterImpl struct {
+	waitlistService service.WaitlistService
+	cfg             WaitlistPromoterConfig
+}
+
+// NewWaitlistPromoter 建立候補遞補 worker。config 可為 nil，則使用預設批次大小與輪詢間隔。
+func NewWaitlistPromoter(waitlistService service.WaitlistService, config *WaitlistPromoterConfig) WaitlistPromoter {
+	cfg := defaultWaitlistPromoterConfig()
+	if config != nil {
+		if config.BatchSize > 0 {
+			cfg.BatchSize = config.BatchSize
+		}
+		if config.PollInterval > 0 {
+			cfg.PollInterval = config.PollInterval
+		}
+	}
+	return &WaitlistPromoterImpl{
+		waitlistService: waitlistService,
+		cfg:             cfg,
+	}
+}
+
+func (p *WaitlistPromoterImpl) Start(ctx context.Context) error {
+	go func() {
+		ticker := time.NewTicker(p.cfg.PollInterval)
+		defer ticker.Stop()
+
+		for {
+			n, err := p.waitlistService.PromoteWaitlisted(ctx, p.cfg.BatchSize)
+			if err != nil && ctx.Err() == nil {
+				logger.Worker.Error("promote waitlist failed", zap.Error(err))
+			}
+			if n > 0 {
+				logger.Worker.Info("promoted waitlisted users", zap.Int("count", n))
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	return nil
+}
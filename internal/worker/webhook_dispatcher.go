@@ -0,0 +1,228 @@
+package worker
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"go-gin-high-concurrency/internal/model"
+	"go-gin-high-concurrency/internal/repository"
+	"go-gin-high-concurrency/pkg/logger"
+	"go-gin-high-concurrency/pkg/webhook"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+type WebhookDispatcher interface {
+	// 輪詢到期的投遞並送出
+	Start(ctx context.Context) error
+	// 執行中調整重試次數及退避時間（MaxAttempts、InitialBackoff、MaxBackoff），零值的欄位保留原值
+	UpdateConfig(config WebhookDispatcherConfig)
+}
+
+// WebhookDispatcherConfig 可注入的投遞與重試設定；nil 或零值時使用預設。
+type WebhookDispatcherConfig struct {
+	BatchSize      int           // 每次輪詢最多領取的投遞數
+	PollInterval   time.Duration // 沒有到期投遞時的輪詢間隔
+	RequestTimeout time.Duration // 單次 HTTP 請求逾時
+	MaxAttempts    int           // 超過此次數標記為 failed，不再自動重試
+	InitialBackoff time.Duration // 第一次失敗後的等待時間，之後每次加倍
+	MaxBackoff     time.Duration // 重試等待時間上限
+	HTTPClient     *http.Client
+}
+
+func defaultWebhookDispatcherConfig() WebhookDispatcherConfig {
+	return WebhookDispatcherConfig{
+		BatchSize:      50,
+		PollInterval:   1 * time.Second,
+		RequestTimeout: 10 * time.Second,
+		MaxAttempts:    8,
+		InitialBackoff: 10 * time.Second,
+		MaxBackoff:     1 * time.Hour,
+	}
+}
+
+type WebhookDispatcherImpl struct {
+	repository repository.WebhookRepository
+	client     *http.Client
+	cfgMu      sync.RWMutex // 保護可於執行中調整的重試設定
+	cfg        WebhookDispatcherConfig
+	now        func() time.Time
+}
+
+// NewWebhookDispatcher 建立 webhook dispatcher。config 可為 nil，則使用預設逾時與重試設定。
+func NewWebhookDispatcher(repository repository.WebhookRepository, config *WebhookDispatcherConfig) WebhookDispatcher {
+	cfg := defaultWebhookDispatcherConfig()
+	if config != nil {
+		if config.BatchSize > 0 {
+			cfg.BatchSize = config.BatchSize
+		}
+		if config.PollInterval > 0 {
+			cfg.PollInterval = config.PollInterval
+		}
+		if config.RequestTimeout > 0 {
+			cfg.RequestTimeout = config.RequestTimeout
+		}
+		if config.MaxAttempts > 0 {
+			cfg.MaxAttempts = config.MaxAttempts
+		}
+		if config.InitialBackoff > 0 {
+			cfg.InitialBackoff = config.InitialBackoff
+		}
+		if config.MaxBackoff > 0 {
+			cfg.MaxBackoff = config.MaxBackoff
+		}
+		cfg.HTTPClient = config.HTTPClient
+	}
+	client := cfg.HTTPClient
+	if client == nil {
+		client = &http.Client{Timeout: cfg.RequestTimeout}
+	}
+	return &WebhookDispatcherImpl{
+		repository: repository,
+		client:     client,
+		cfg:        cfg,
+		now:        func() time.Time { return time.Now().UTC() },
+	}
+}
+
+func (d *WebhookDispatcherImpl) Start(ctx context.Context) error {
+	go func() {
+		ticker := time.NewTicker(d.cfg.PollInterval)
+		defer ticker.Stop()
+
+		for {
+			n, err := d.dispatchBatch(ctx)
+			if err != nil && ctx.Err() == nil {
+				logger.Worker.Error("dispatch webhook batch failed", zap.Error(err))
+			}
+			if err == nil && n >= d.cfg.BatchSize {
+				continue
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	return nil
+}
+
+func (d *WebhookDispatcherImpl) dispatchBatch(ctx context.Context) (int, error) {
+	now := d.now()
+	// 租約時間需涵蓋一次請求的逾時，避免請求尚未結束就被其他 dispatcher 重新領取
+	leaseUntil := now.Add(2 * d.cfg.RequestTimeout)
+	tasks, err := d.repository.ClaimDueDeliveries(ctx, now, leaseUntil, d.cfg.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, task := range tasks {
+		attempt := d.deliver(ctx, task)
+		if err := d.repository.RecordDeliveryAttempt(ctx, task.Delivery.ID, attempt); err != nil {
+			logger.Worker.Error("record webhook delivery attempt failed",
+				zap.String("delivery_id", task.Delivery.DeliveryID.String()), zap.Error(err))
+		}
+	}
+	return len(tasks), nil
+}
+
+// deliver 送出一次請求並依結果決定下一次嘗試時間；2xx 視為成功
+func (d *WebhookDispatcherImpl) deliver(ctx context.Context, task *model.WebhookDeliveryTask) model.WebhookDeliveryAttempt {
+	statusCode, err := d.send(ctx, task)
+	if err == nil {
+		return model.WebhookDeliveryAttempt{
+			Status:        model.WebhookDeliveryStatusSucceeded,
+			StatusCode:    &statusCode,
+			NextAttemptAt: d.now(),
+		}
+	}
+
+	attempt := model.WebhookDeliveryAttempt{
+		Status:        model.WebhookDeliveryStatusPending,
+		NextAttemptAt: d.now().Add(d.backoff(task.Delivery.Attempts + 1)),
+	}
+	if statusCode != 0 {
+		attempt.StatusCode = &statusCode
+	}
+	msg := err.Error()
+	attempt.Error = &msg
+	if task.Delivery.Attempts+1 >= d.retryConfig().MaxAttempts {
+		attempt.Status = model.WebhookDeliveryStatusFailed
+	}
+
+	logger.Worker.Warn("webhook delivery failed",
+		zap.String("delivery_id", task.Delivery.DeliveryID.String()),
+		zap.Int("attempts", task.Delivery.Attempts+1),
+		zap.String("status", string(attempt.Status)),
+		zap.Error(err))
+	return attempt
+}
+
+func (d *WebhookDispatcherImpl) send(ctx context.Context, task *model.WebhookDeliveryTask) (int, error) {
+	reqCtx, cancel := context.WithTimeout(ctx, d.cfg.RequestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(reqCtx, http.MethodPost, task.URL, bytes.NewReader(task.Delivery.Payload))
+	if err != nil {
+		return 0, err
+	}
+	timestamp := d.now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(webhook.HeaderEvent, task.Delivery.EventType)
+	req.Header.Set(webhook.HeaderDelivery, task.Delivery.DeliveryID.String())
+	req.Header.Set(webhook.HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(webhook.HeaderSignature, webhook.Sign(task.Secret, timestamp, task.Delivery.Payload))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// backoff 指數退避：InitialBackoff * 2^(attempts-1)，上限 MaxBackoff
+func (d *WebhookDispatcherImpl) backoff(attempts int) time.Duration {
+	cfg := d.retryConfig()
+	wait := cfg.InitialBackoff
+	for i := 1; i < attempts; i++ {
+		wait *= 2
+		if wait >= cfg.MaxBackoff {
+			return cfg.MaxBackoff
+		}
+	}
+	return wait
+}
+
+func (d *WebhookDispatcherImpl) UpdateConfig(config WebhookDispatcherConfig) {
+	d.cfgMu.Lock()
+	defer d.cfgMu.Unlock()
+	if config.MaxAttempts > 0 {
+		d.cfg.MaxAttempts = config.MaxAttempts
+	}
+	if config.InitialBackoff > 0 {
+		d.cfg.InitialBackoff = config.InitialBackoff
+	}
+	if config.MaxBackoff > 0 {
+		d.cfg.MaxBackoff = config.MaxBackoff
+	}
+}
+
+// retryConfig 目前生效的設定快照（含執行中調整的重試設定）
+func (d *WebhookDispatcherImpl) retryConfig() WebhookDispatcherConfig {
+	d.cfgMu.RLock()
+	defer d.cfgMu.RUnlock()
+	return d.cfg
+}
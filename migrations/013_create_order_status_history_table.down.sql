@@ -0,0 +1,9 @@
+-- Drop order_status_history table
+DROP TABLE IF EXISTS order_status_history;
+
+-- Map new statuses back before restoring the original check
+UPDATE orders SET status = 'cancelled' WHERE status IN ('expired', 'refunded');
+
+ALTER TABLE orders DROP CONSTRAINT IF EXISTS orders_status_check;
+ALTER TABLE orders ADD CONSTRAINT orders_status_check
+    CHECK (status IN ('pending', 'confirmed', 'cancelled'));
@@ -0,0 +1,49 @@
+-- Create webhook_subscriptions table
+CREATE TABLE IF NOT EXISTS webhook_subscriptions (
+    id SERIAL PRIMARY KEY,
+    subscription_id UUID NOT NULL DEFAULT gen_random_uuid(),
+    event_id INTEGER NOT NULL,
+    url TEXT NOT NULL,
+    secret VARCHAR(255) NOT NULL,
+    event_types TEXT[] NOT NULL,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL,
+
+    -- Add constraints
+    CONSTRAINT uq_webhook_subscriptions_subscription_id UNIQUE (subscription_id),
+    CONSTRAINT fk_webhook_subscriptions_event_id FOREIGN KEY (event_id) REFERENCES events(id) ON DELETE RESTRICT
+);
+
+-- Add index
+CREATE INDEX IF NOT EXISTS idx_webhook_subscriptions_event_id ON webhook_subscriptions(event_id) WHERE deleted_at IS NULL;
+
+-- Create webhook_deliveries table
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id BIGSERIAL PRIMARY KEY,
+    delivery_id UUID NOT NULL DEFAULT gen_random_uuid(),
+    subscription_id INTEGER NOT NULL,
+    outbox_event_id BIGINT NOT NULL,
+    event_type VARCHAR(100) NOT NULL,
+    payload JSONB NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_status_code INTEGER NULL,
+    last_error TEXT NULL,
+    delivered_at TIMESTAMP NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    -- Add constraints
+    CONSTRAINT uq_webhook_deliveries_delivery_id UNIQUE (delivery_id),
+    -- 同一個 outbox 事件對同一訂閱只投遞一次（relay 重送時不會重複建立）
+    CONSTRAINT uq_webhook_deliveries_subscription_event UNIQUE (subscription_id, outbox_event_id),
+    CONSTRAINT fk_webhook_deliveries_subscription_id FOREIGN KEY (subscription_id) REFERENCES webhook_subscriptions(id) ON DELETE CASCADE,
+    CONSTRAINT webhook_deliveries_status_check CHECK (status IN ('pending', 'succeeded', 'failed'))
+);
+
+-- Add index
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_due ON webhook_deliveries(next_attempt_at) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_subscription_id ON webhook_deliveries(subscription_id);
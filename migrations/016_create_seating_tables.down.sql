@@ -0,0 +1,10 @@
+-- Drop seating tables
+DROP TABLE IF EXISTS order_seats;
+
+DROP INDEX IF EXISTS uq_tickets_event_section;
+ALTER TABLE tickets DROP CONSTRAINT IF EXISTS fk_tickets_section_id;
+ALTER TABLE tickets DROP COLUMN IF EXISTS section_id;
+
+DROP TABLE IF EXISTS seats;
+DROP TABLE IF EXISTS venue_sections;
+DROP TABLE IF EXISTS venues;
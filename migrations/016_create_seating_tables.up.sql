@@ -0,0 +1,67 @@
+-- Create venues table
+CREATE TABLE IF NOT EXISTS venues (
+    id SERIAL PRIMARY KEY,
+    venue_id UUID NOT NULL DEFAULT gen_random_uuid(),
+    name VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    -- Add constraints
+    CONSTRAINT uq_venues_venue_id UNIQUE (venue_id)
+);
+
+-- Create venue_sections table
+CREATE TABLE IF NOT EXISTS venue_sections (
+    id SERIAL PRIMARY KEY,
+    venue_id INTEGER NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    -- Add constraints
+    CONSTRAINT uq_venue_sections_venue_name UNIQUE (venue_id, name),
+    CONSTRAINT fk_venue_sections_venue_id FOREIGN KEY (venue_id) REFERENCES venues(id) ON DELETE CASCADE
+);
+
+-- Create seats table
+CREATE TABLE IF NOT EXISTS seats (
+    id SERIAL PRIMARY KEY,
+    section_id INTEGER NOT NULL,
+    row_label VARCHAR(20) NOT NULL,
+    seat_number INTEGER NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    -- Add constraints
+    CONSTRAINT uq_seats_position UNIQUE (section_id, row_label, seat_number),
+    CONSTRAINT fk_seats_section_id FOREIGN KEY (section_id) REFERENCES venue_sections(id) ON DELETE CASCADE,
+    CONSTRAINT seats_seat_number_check CHECK (seat_number > 0)
+);
+
+-- 對號座票種綁定一個區域；NULL 為一般（自由入座）票種
+ALTER TABLE tickets ADD COLUMN section_id INTEGER NULL;
+
+ALTER TABLE tickets
+ADD CONSTRAINT fk_tickets_section_id
+FOREIGN KEY (section_id) REFERENCES venue_sections(id) ON DELETE RESTRICT;
+
+-- 同一活動的同一區域只能由一個票種販售，避免同一座位被兩個票種重複售出
+CREATE UNIQUE INDEX IF NOT EXISTS uq_tickets_event_section ON tickets(event_id, section_id)
+WHERE section_id IS NOT NULL AND deleted_at IS NULL;
+
+-- Create order_seats table
+CREATE TABLE IF NOT EXISTS order_seats (
+    id SERIAL PRIMARY KEY,
+    order_id INTEGER NOT NULL,
+    ticket_id INTEGER NOT NULL,
+    seat_id INTEGER NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    released_at TIMESTAMP NULL,
+
+    -- Add constraints
+    CONSTRAINT fk_order_seats_order_id FOREIGN KEY (order_id) REFERENCES orders(id) ON DELETE CASCADE,
+    CONSTRAINT fk_order_seats_ticket_id FOREIGN KEY (ticket_id) REFERENCES tickets(id) ON DELETE RESTRICT,
+    CONSTRAINT fk_order_seats_seat_id FOREIGN KEY (seat_id) REFERENCES seats(id) ON DELETE RESTRICT
+);
+
+-- 一個座位在同一票種下同時只能屬於一筆未釋放的訂單
+CREATE UNIQUE INDEX IF NOT EXISTS uq_order_seats_active_seat ON order_seats(ticket_id, seat_id) WHERE released_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_order_seats_order_id ON order_seats(order_id);
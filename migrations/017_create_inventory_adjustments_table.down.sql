@@ -0,0 +1,2 @@
+-- Drop inventory_adjustments table
+DROP TABLE IF EXISTS inventory_adjustments;
@@ -0,0 +1,18 @@
+-- Create inventory_adjustments table
+CREATE TABLE IF NOT EXISTS inventory_adjustments (
+    id SERIAL PRIMARY KEY,
+    ticket_id INTEGER NOT NULL,
+    delta INTEGER NOT NULL,
+    total_stock INTEGER NOT NULL,
+    remaining_stock INTEGER NOT NULL,
+    actor VARCHAR(255) NOT NULL,
+    reason TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    -- Add constraints
+    CONSTRAINT fk_inventory_adjustments_ticket_id FOREIGN KEY (ticket_id) REFERENCES tickets(id) ON DELETE RESTRICT,
+    CONSTRAINT chk_inventory_adjustments_delta CHECK (delta <> 0)
+);
+
+-- Add index
+CREATE INDEX IF NOT EXISTS idx_inventory_adjustments_ticket_id ON inventory_adjustments(ticket_id);
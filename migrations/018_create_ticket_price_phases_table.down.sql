@@ -0,0 +1,4 @@
+-- Drop ticket_price_phases table
+ALTER TABLE orders DROP COLUMN IF EXISTS price_phase;
+
+DROP TABLE IF EXISTS ticket_price_phases;
@@ -0,0 +1,20 @@
+-- Create ticket_price_phases table
+CREATE TABLE IF NOT EXISTS ticket_price_phases (
+    id SERIAL PRIMARY KEY,
+    ticket_id INTEGER NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    price DECIMAL(10, 2) NOT NULL,
+    ends_at TIMESTAMP NULL,
+    sold_limit INTEGER NULL,
+    position INTEGER NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    -- Add constraints
+    CONSTRAINT uq_ticket_price_phases_position UNIQUE (ticket_id, position),
+    CONSTRAINT fk_ticket_price_phases_ticket_id FOREIGN KEY (ticket_id) REFERENCES tickets(id) ON DELETE CASCADE,
+    CONSTRAINT ticket_price_phases_price_check CHECK (price >= 0),
+    CONSTRAINT ticket_price_phases_sold_limit_check CHECK (sold_limit IS NULL OR sold_limit > 0)
+);
+
+-- 訂單成立時套用的價格階段；NULL 為票種原價
+ALTER TABLE orders ADD COLUMN price_phase VARCHAR(100) NULL;
@@ -0,0 +1,50 @@
+-- Create promo_codes table
+CREATE TABLE IF NOT EXISTS promo_codes (
+    id SERIAL PRIMARY KEY,
+    code VARCHAR(50) NOT NULL,
+    discount_type VARCHAR(20) NOT NULL,
+    discount_value DECIMAL(10, 2) NOT NULL,
+    event_id INTEGER NULL,
+    ticket_id INTEGER NULL,
+    max_redemptions INTEGER NULL,
+    max_per_user INTEGER NULL,
+    starts_at TIMESTAMP NULL,
+    ends_at TIMESTAMP NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    -- Add constraints
+    CONSTRAINT uq_promo_codes_code UNIQUE (code),
+    CONSTRAINT fk_promo_codes_event_id FOREIGN KEY (event_id) REFERENCES events(id) ON DELETE CASCADE,
+    CONSTRAINT fk_promo_codes_ticket_id FOREIGN KEY (ticket_id) REFERENCES tickets(id) ON DELETE CASCADE,
+    CONSTRAINT promo_codes_discount_type_check CHECK (discount_type IN ('percentage', 'fixed')),
+    CONSTRAINT promo_codes_discount_value_check CHECK (
+        discount_value > 0 AND (discount_type <> 'percentage' OR discount_value <= 100)
+    ),
+    CONSTRAINT promo_codes_max_redemptions_check CHECK (max_redemptions IS NULL OR max_redemptions > 0),
+    CONSTRAINT promo_codes_max_per_user_check CHECK (max_per_user IS NULL OR max_per_user > 0),
+    CONSTRAINT promo_codes_window_check CHECK (starts_at IS NULL OR ends_at IS NULL OR starts_at < ends_at)
+);
+
+-- Create promo_redemptions table
+CREATE TABLE IF NOT EXISTS promo_redemptions (
+    id SERIAL PRIMARY KEY,
+    promo_code_id INTEGER NOT NULL,
+    order_id INTEGER NOT NULL,
+    user_id INTEGER NOT NULL,
+    discount_amount DECIMAL(10, 2) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    returned_at TIMESTAMP NULL, -- 訂單取消時歸還，不再計入使用次數
+
+    -- Add constraints
+    CONSTRAINT uq_promo_redemptions_order_id UNIQUE (order_id),
+    CONSTRAINT fk_promo_redemptions_promo_code_id FOREIGN KEY (promo_code_id) REFERENCES promo_codes(id) ON DELETE RESTRICT,
+    CONSTRAINT fk_promo_redemptions_order_id FOREIGN KEY (order_id) REFERENCES orders(id) ON DELETE CASCADE
+);
+
+-- Add index
+CREATE INDEX IF NOT EXISTS idx_promo_redemptions_promo_code_id ON promo_redemptions(promo_code_id);
+
+-- 訂單套用的優惠碼與折扣金額；total_price 為折扣後金額
+ALTER TABLE orders ADD COLUMN promo_code VARCHAR(50) NULL;
+ALTER TABLE orders ADD COLUMN discount_amount DECIMAL(10, 2) NOT NULL DEFAULT 0;
@@ -0,0 +1,7 @@
+-- Drop presale tables
+ALTER TABLE orders DROP COLUMN IF EXISTS access_code;
+
+DROP TABLE IF EXISTS presale_allowlist;
+DROP TABLE IF EXISTS presale_access_codes;
+
+ALTER TABLE tickets DROP COLUMN IF EXISTS is_presale;
@@ -0,0 +1,31 @@
+-- 預售票種：僅接受帶有效存取碼的訂單或名單內的使用者
+ALTER TABLE tickets ADD COLUMN is_presale BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Create presale_access_codes table
+CREATE TABLE IF NOT EXISTS presale_access_codes (
+    id SERIAL PRIMARY KEY,
+    ticket_id INTEGER NOT NULL,
+    code VARCHAR(50) NOT NULL,
+    max_uses INTEGER NULL, -- 可成立的訂單數，NULL 為不限
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    -- Add constraints
+    CONSTRAINT uq_presale_access_codes_ticket_code UNIQUE (ticket_id, code),
+    CONSTRAINT fk_presale_access_codes_ticket_id FOREIGN KEY (ticket_id) REFERENCES tickets(id) ON DELETE CASCADE,
+    CONSTRAINT presale_access_codes_max_uses_check CHECK (max_uses IS NULL OR max_uses > 0)
+);
+
+-- Create presale_allowlist table
+CREATE TABLE IF NOT EXISTS presale_allowlist (
+    ticket_id INTEGER NOT NULL,
+    user_id INTEGER NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    -- Add constraints
+    CONSTRAINT pk_presale_allowlist PRIMARY KEY (ticket_id, user_id),
+    CONSTRAINT fk_presale_allowlist_ticket_id FOREIGN KEY (ticket_id) REFERENCES tickets(id) ON DELETE CASCADE,
+    CONSTRAINT fk_presale_allowlist_user_id FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- 訂單使用的預售存取碼；名單內的使用者不消耗存取碼，為 NULL
+ALTER TABLE orders ADD COLUMN access_code VARCHAR(50) NULL;
@@ -0,0 +1,5 @@
+-- Drop constraints
+ALTER TABLE events DROP CONSTRAINT IF EXISTS events_max_per_user_check;
+
+-- Drop max_per_user column
+ALTER TABLE events DROP COLUMN IF EXISTS max_per_user;
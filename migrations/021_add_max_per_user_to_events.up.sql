@@ -0,0 +1,6 @@
+-- Update events table: per-event cap on tickets per user across all ticket types (NULL = no cap)
+ALTER TABLE events ADD COLUMN max_per_user INTEGER NULL;
+
+-- Add constraints
+ALTER TABLE events ADD CONSTRAINT events_max_per_user_check
+ CHECK (max_per_user IS NULL OR max_per_user > 0);
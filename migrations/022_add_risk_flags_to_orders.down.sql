@@ -0,0 +1,6 @@
+-- Drop index
+DROP INDEX IF EXISTS idx_orders_flagged;
+
+-- Drop risk columns
+ALTER TABLE orders DROP COLUMN IF EXISTS risk_flags;
+ALTER TABLE orders DROP COLUMN IF EXISTS risk_score;
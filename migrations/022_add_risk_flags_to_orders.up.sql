@@ -0,0 +1,6 @@
+-- Update orders table: risk score and flags from the pre-reservation risk check
+ALTER TABLE orders ADD COLUMN risk_score INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE orders ADD COLUMN risk_flags TEXT[] NOT NULL DEFAULT '{}';
+
+-- Add index for the flagged order review listing
+CREATE INDEX IF NOT EXISTS idx_orders_flagged ON orders(ticket_id, created_at DESC) WHERE risk_score > 0;
@@ -0,0 +1,9 @@
+// Package migrations 以 embed 內嵌資料庫 migration 的 SQL 檔，供內建的 migrate 子命令及啟動時自動 migrate 使用
+package migrations
+
+import "embed"
+
+// FS 所有 migration 檔（<版本>_<名稱>.up.sql / .down.sql，與 golang-migrate 的命名相同）
+//
+//go:embed *.sql
+var FS embed.FS
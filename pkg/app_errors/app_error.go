@@ -0,0 +1,176 @@
+package app_errors
+
+import (
+	"errors"
+	"net/http"
+)
+
+// 對外回應的錯誤碼，供呼叫端以程式判斷錯誤種類；訊息可能調整，錯誤碼不會
+const (
+	CodeInternal         = "INTERNAL"
+	CodeNotFound         = "NOT_FOUND"
+	CodeAlreadyExists    = "ALREADY_EXISTS"
+	CodeInvalidInput     = "INVALID_INPUT"
+	CodeValidationFailed = "VALIDATION_FAILED"
+	CodeInvalidConfig    = "INVALID_CONFIG"
+	CodeMethodNotAllowed = "METHOD_NOT_ALLOWED"
+
+	CodeTicketNotFound    = "TICKET_NOT_FOUND"
+	CodeInsufficientStock = "INSUFFICIENT_STOCK"
+	CodeInvalidTicketData = "INVALID_TICKET_DATA"
+
+	CodeOrderNotFound      = "ORDER_NOT_FOUND"
+	CodeInvalidOrderStatus = "INVALID_ORDER_STATUS"
+	CodeOrderBlocked       = "ORDER_BLOCKED"
+	CodeExceedsMaxPerUser  = "EXCEEDS_MAX_PER_USER"
+	CodeExceedsEventLimit  = "EXCEEDS_EVENT_LIMIT"
+	CodePriceChanged       = "PRICE_CHANGED"
+
+	CodeUserNotFound   = "USER_NOT_FOUND"
+	CodeDuplicateEmail = "DUPLICATE_EMAIL"
+
+	CodeEventNotFound = "EVENT_NOT_FOUND"
+
+	CodeWebhookNotFound         = "WEBHOOK_NOT_FOUND"
+	CodeWebhookDeliveryNotFound = "WEBHOOK_DELIVERY_NOT_FOUND"
+
+	CodeVenueNotFound         = "VENUE_NOT_FOUND"
+	CodeSeatUnavailable       = "SEAT_UNAVAILABLE"
+	CodeSeatHoldExpired       = "SEAT_HOLD_EXPIRED"
+	CodeSeatSelectionRequired = "SEAT_SELECTION_REQUIRED"
+
+	CodeHoldExpired = "HOLD_EXPIRED"
+
+	CodeWaitlistEntryNotFound = "WAITLIST_ENTRY_NOT_FOUND"
+	CodeAlreadyWaitlisted     = "ALREADY_WAITLISTED"
+	CodeTicketNotSoldOut      = "TICKET_NOT_SOLD_OUT"
+
+	CodePromoCodeNotFound      = "PROMO_CODE_NOT_FOUND"
+	CodePromoCodeInactive      = "PROMO_CODE_INACTIVE"
+	CodePromoCodeNotApplicable = "PROMO_CODE_NOT_APPLICABLE"
+	CodePromoCodeExhausted     = "PROMO_CODE_EXHAUSTED"
+
+	CodePresaleAccessDenied = "PRESALE_ACCESS_DENIED"
+	CodeAccessCodeExhausted = "ACCESS_CODE_EXHAUSTED"
+
+	CodeQueueFull = "QUEUE_FULL"
+)
+
+// AppError 對外回應的錯誤：HTTP 狀態碼、錯誤碼、訊息及欄位驗證錯誤。
+// Err 為原始錯誤，只供 errors.Is 及日誌使用，不會回應給呼叫端
+type AppError struct {
+	Status  int          `json:"-"`
+	Code    string       `json:"code"`
+	Message string       `json:"message"`
+	Details []FieldError `json:"details,omitempty"`
+	Err     error        `json:"-"`
+}
+
+// FieldError 單一欄位的驗證錯誤；Field 為 JSON / query 欄位名稱，Rule 為未通過的驗證規則（例如 required、min）
+type FieldError struct {
+	Field   string `json:"field"`
+	Rule    string `json:"rule,omitempty"`
+	Message string `json:"message"`
+}
+
+// ErrorResponse 所有 API 錯誤回應的格式
+type ErrorResponse struct {
+	Error     *AppError `json:"error"`
+	RequestID string    `json:"request_id,omitempty"`
+}
+
+func New(status int, code string, message string) *AppError {
+	return &AppError{Status: status, Code: code, Message: message}
+}
+
+func (e *AppError) Error() string {
+	if e.Err != nil {
+		return e.Message + ": " + e.Err.Error()
+	}
+	return e.Message
+}
+
+func (e *AppError) Unwrap() error {
+	return e.Err
+}
+
+// WithDetails 回傳附帶欄位驗證錯誤的副本，不修改原本的錯誤
+func (e *AppError) WithDetails(details ...FieldError) *AppError {
+	clone := *e
+	clone.Details = append(append([]FieldError{}, e.Details...), details...)
+	return &clone
+}
+
+// Wrap 回傳以 err 為原始錯誤的副本，不修改原本的錯誤
+func (e *AppError) Wrap(err error) *AppError {
+	clone := *e
+	clone.Err = err
+	return &clone
+}
+
+// sentinelMappings 各 sentinel error 對外的狀態碼、錯誤碼及訊息；依序以 errors.Is 比對，較明確的錯誤放前面
+var sentinelMappings = []struct {
+	err    error
+	status int
+	code   string
+	msg    string
+}{
+	{ErrTicketNotFound, http.StatusNotFound, CodeTicketNotFound, "Ticket not found"},
+	{ErrInsufficientStock, http.StatusConflict, CodeInsufficientStock, "Insufficient stock"},
+	{ErrInvalidTicketData, http.StatusBadRequest, CodeInvalidTicketData, "Invalid ticket data"},
+
+	{ErrOrderNotFound, http.StatusNotFound, CodeOrderNotFound, "Order not found"},
+	{ErrInvalidOrderStatus, http.StatusBadRequest, CodeInvalidOrderStatus, "Invalid order status"},
+	{ErrOrderBlocked, http.StatusForbidden, CodeOrderBlocked, "Order rejected by risk check"},
+	{ErrExceedsMaxPerUser, http.StatusBadRequest, CodeExceedsMaxPerUser, "Exceeds max per user"},
+	{ErrExceedsEventLimit, http.StatusBadRequest, CodeExceedsEventLimit, "Exceeds max per user for this event"},
+	{ErrPriceChanged, http.StatusConflict, CodePriceChanged, "Ticket price changed"},
+
+	{ErrUserNotFound, http.StatusNotFound, CodeUserNotFound, "User not found"},
+	{ErrDuplicateEmail, http.StatusConflict, CodeDuplicateEmail, "Email already exists"},
+
+	{ErrEventNotFound, http.StatusNotFound, CodeEventNotFound, "Event not found"},
+
+	{ErrWebhookNotFound, http.StatusNotFound, CodeWebhookNotFound, "Webhook not found"},
+	{ErrWebhookDeliveryNotFound, http.StatusNotFound, CodeWebhookDeliveryNotFound, "Webhook delivery not found"},
+
+	{ErrVenueNotFound, http.StatusNotFound, CodeVenueNotFound, "Venue not found"},
+	{ErrSeatUnavailable, http.StatusConflict, CodeSeatUnavailable, "Seat unavailable"},
+	{ErrSeatHoldExpired, http.StatusConflict, CodeSeatHoldExpired, "Seat hold not found or expired"},
+	{ErrSeatSelectionRequired, http.StatusBadRequest, CodeSeatSelectionRequired, "Seat selection required"},
+
+	{ErrHoldExpired, http.StatusNotFound, CodeHoldExpired, "Hold not found or expired"},
+
+	{ErrWaitlistEntryNotFound, http.StatusNotFound, CodeWaitlistEntryNotFound, "Waitlist entry not found"},
+	{ErrAlreadyWaitlisted, http.StatusConflict, CodeAlreadyWaitlisted, "Already on waitlist"},
+	{ErrTicketNotSoldOut, http.StatusConflict, CodeTicketNotSoldOut, "Ticket is not sold out"},
+
+	{ErrPromoCodeNotFound, http.StatusNotFound, CodePromoCodeNotFound, "Promo code not found"},
+	{ErrPromoCodeInactive, http.StatusBadRequest, CodePromoCodeInactive, "Promo code not yet valid or expired"},
+	{ErrPromoCodeNotApplicable, http.StatusBadRequest, CodePromoCodeNotApplicable, "Promo code not applicable to ticket"},
+	{ErrPromoCodeExhausted, http.StatusConflict, CodePromoCodeExhausted, "Promo code redemption limit reached"},
+
+	{ErrPresaleAccessDenied, http.StatusForbidden, CodePresaleAccessDenied, "Presale requires a valid access code or allow-listed user"},
+	{ErrAccessCodeExhausted, http.StatusConflict, CodeAccessCodeExhausted, "Access code usage limit reached"},
+
+	{ErrQueueFull, http.StatusServiceUnavailable, CodeQueueFull, "Order queue is full, please retry later"},
+
+	{ErrNotFound, http.StatusNotFound, CodeNotFound, "Resource not found"},
+	{ErrAlreadyExists, http.StatusConflict, CodeAlreadyExists, "Resource already exists"},
+	{ErrInvalidInput, http.StatusBadRequest, CodeInvalidInput, "Invalid input"},
+}
+
+// From 將 err 轉為對外的 AppError：錯誤鏈中已有 AppError 時直接沿用，
+// 否則依 sentinel error 對應；無法對應的錯誤一律視為 500，不對外暴露內部訊息
+func From(err error) *AppError {
+	var appErr *AppError
+	if errors.As(err, &appErr) {
+		return appErr
+	}
+	for _, m := range sentinelMappings {
+		if errors.Is(err, m.err) {
+			return &AppError{Status: m.status, Code: m.code, Message: m.msg, Err: err}
+		}
+	}
+	return &AppError{Status: http.StatusInternalServerError, Code: CodeInternal, Message: "Internal server error", Err: err}
+}
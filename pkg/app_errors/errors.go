@@ -17,7 +17,10 @@ var (
 	// Order related errors
 	ErrOrderNotFound      = errors.New("order not found")
 	ErrInvalidOrderStatus = errors.New("invalid order status")
+	ErrOrderBlocked       = errors.New("order blocked by risk check")
 	ErrExceedsMaxPerUser  = errors.New("exceeds maximum tickets per user")
+	ErrExceedsEventLimit  = errors.New("exceeds maximum tickets per user for this event")
+	ErrPriceChanged       = errors.New("ticket price changed")
 
 	// User related errors
 	ErrUserNotFound   = errors.New("user not found")
@@ -25,4 +28,40 @@ var (
 
 	// Event related errors
 	ErrEventNotFound = errors.New("event not found")
+
+	// Webhook related errors
+	ErrWebhookNotFound         = errors.New("webhook subscription not found")
+	ErrWebhookDeliveryNotFound = errors.New("webhook delivery not found")
+
+	// Seating related errors
+	ErrVenueNotFound         = errors.New("venue not found")
+	ErrSeatUnavailable       = errors.New("seat unavailable")
+	ErrSeatHoldExpired       = errors.New("seat hold not found or expired")
+	ErrSeatSelectionRequired = errors.New("seat selection required for seated ticket")
+
+	// Hold related errors
+	ErrHoldExpired = errors.New("hold not found or expired")
+
+	// Waitlist related errors
+	ErrWaitlistEntryNotFound = errors.New("waitlist entry not found")
+	ErrAlreadyWaitlisted     = errors.New("user already on waitlist")
+	ErrTicketNotSoldOut      = errors.New("ticket is not sold out")
+
+	// Promo code related errors
+	ErrPromoCodeNotFound      = errors.New("promo code not found")
+	ErrPromoCodeInactive      = errors.New("promo code not yet valid or expired")
+	ErrPromoCodeNotApplicable = errors.New("promo code not applicable to ticket")
+	ErrPromoCodeExhausted     = errors.New("promo code redemption limit reached")
+
+	// Presale related errors
+	ErrPresaleAccessDenied = errors.New("presale requires a valid access code or allow-listed user")
+	ErrAccessCodeExhausted = errors.New("presale access code usage limit reached")
+
+	// Queue related errors
+	ErrConsumerGroupNotFound = errors.New("consumer group not found")
+	ErrQueueFull             = errors.New("order queue is full")
+
+	// Migration related errors
+	ErrMigrationDirty    = errors.New("database is dirty: fix the failed migration and force a version")
+	ErrMigrationNotFound = errors.New("migration version not found")
 )
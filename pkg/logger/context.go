@@ -0,0 +1,58 @@
+package logger
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+)
+
+// RequestIDKey gin.Context 中存放 request id 的 key；gin.Context 未啟用 ContextWithFallback 時只查得到字串 key
+const RequestIDKey = "request_id"
+
+// maxRequestIDLength 沿用呼叫端 request id 的長度上限，超過或含不可見字元時應改為產生新的
+const maxRequestIDLength = 128
+
+type requestIDContextKey struct{}
+
+// ValidRequestID 檢查呼叫端帶入的 request id 是否可沿用：非空、不超過 128 字元且只含可見的 ASCII 字元
+func ValidRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] < 0x21 || id[i] > 0x7e {
+			return false
+		}
+	}
+	return true
+}
+
+// WithRequestID 回傳帶有 request id 的 context，供之後的日誌關聯同一個請求
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	if requestID == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, requestIDContextKey{}, requestID)
+}
+
+// RequestID 取出 context 中的 request id，沒有時回傳空字串
+func RequestID(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	if id, ok := ctx.Value(requestIDContextKey{}).(string); ok {
+		return id
+	}
+	if id, ok := ctx.Value(RequestIDKey).(string); ok {
+		return id
+	}
+	return ""
+}
+
+// WithContext 回傳帶有 context 中 request id 的 logger，沒有 request id 時回傳原本的 logger
+func WithContext(ctx context.Context, l *zap.Logger) *zap.Logger {
+	if id := RequestID(ctx); id != "" {
+		return l.With(zap.String("request_id", id))
+	}
+	return l
+}
@@ -7,29 +7,67 @@ import (
 
 var L *zap.Logger
 
+// level 所有 logger 共用的等級，SetLevel 可於執行中調整
+var level = zap.NewAtomicLevelAt(zapcore.InfoLevel)
+
 // Pre-built component loggers — use these instead of calling WithComponent on every log line.
 var (
 	MQ      *zap.Logger
 	Handler *zap.Logger
 	Service *zap.Logger
 	Worker  *zap.Logger
+	HTTP    *zap.Logger // 存取日誌
+	GRPC    *zap.Logger // gRPC 存取日誌
 )
 
 func init() {
+	if err := Configure("info", "json"); err != nil {
+		panic(err)
+	}
+}
+
+// Configure 依設定的等級 (debug / info / warn / error) 及格式 (json / console) 重建 logger，
+// 啟動時載入設定後呼叫；失敗時保留原本的 logger
+func Configure(levelText string, format string) error {
+	lvl, err := zapcore.ParseLevel(levelText)
+	if err != nil {
+		return err
+	}
+
 	config := zap.NewProductionConfig()
+	config.Encoding = format
 	config.EncoderConfig.TimeKey = "ts"
 	config.EncoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder
-	config.Level = zap.NewAtomicLevelAt(zapcore.InfoLevel)
-	var err error
-	L, err = config.Build(zap.AddCallerSkip(1))
+	config.Level = level
+	built, err := config.Build(zap.AddCallerSkip(1))
 	if err != nil {
-		panic(err)
+		return err
 	}
+	level.SetLevel(lvl)
 
+	L = built
 	MQ = L.With(zap.String("component", "mq"))
 	Handler = L.With(zap.String("component", "handler"))
 	Service = L.With(zap.String("component", "service"))
 	Worker = L.With(zap.String("component", "worker"))
+	HTTP = L.With(zap.String("component", "http"))
+	GRPC = L.With(zap.String("component", "grpc"))
+	return nil
+}
+
+// SetLevel 於執行中調整所有 logger 的等級，不需重建 logger
+func SetLevel(levelText string) error {
+	lvl, err := zapcore.ParseLevel(levelText)
+	if err != nil {
+		return err
+	}
+	level.SetLevel(lvl)
+	return nil
+}
+
+// Level 目前生效的日誌等級
+func Level() string {
+	return level.Level().String()
 }
 
 // WithComponent 回傳帶有 component 欄位的 logger，供 MQ、handler、service 等使用
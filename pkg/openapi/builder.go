@@ -0,0 +1,205 @@
+package openapi
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const contentTypeJSON = "application/json"
+
+// Route 一個 API 的描述；Path 使用 gin 的路由格式（例如 /api/v1/orders/:uuid），路徑參數由 Path 推導
+type Route struct {
+	Method      string
+	Path        string
+	Tag         string
+	Summary     string
+	Description string
+
+	Query   any               // query 參數，以 struct 的 form tag 描述
+	Headers map[string]string // 選填的 request header 及說明
+
+	Request         any  // JSON request body 的型別，nil 為沒有 body
+	RequestOptional bool // body 可省略
+
+	Replies []Reply
+}
+
+// Reply 一種成功回應；Body 為 nil 時沒有內容
+type Reply struct {
+	Status      int
+	Description string
+	Body        any
+	ContentType string // 預設 application/json
+}
+
+// Builder 由 Route 組出 OpenAPI 文件
+type Builder struct {
+	info      Info
+	errorBody any
+	routes    []Route
+}
+
+// NewBuilder 建立 Builder；errorBody 不為 nil 時，每個操作都加上以它為內容的 default 錯誤回應
+func NewBuilder(info Info, errorBody any) *Builder {
+	return &Builder{info: info, errorBody: errorBody}
+}
+
+func (b *Builder) Add(routes ...Route) *Builder {
+	b.routes = append(b.routes, routes...)
+	return b
+}
+
+// Build 產生文件；同一路徑及方法重複宣告、或方法不支援時回傳錯誤
+func (b *Builder) Build() (*Document, error) {
+	registry := newSchemaRegistry()
+	doc := &Document{
+		OpenAPI: Version,
+		Info:    b.info,
+		Paths:   make(map[string]*PathItem),
+	}
+
+	var errorSchema *Schema
+	if b.errorBody != nil {
+		errorSchema = registry.schemaOf(b.errorBody)
+	}
+
+	for _, route := range b.routes {
+		path := Path(route.Path)
+		item, ok := doc.Paths[path]
+		if !ok {
+			item = &PathItem{}
+			doc.Paths[path] = item
+		}
+		slot := item.slot(route.Method)
+		if slot == nil {
+			return nil, fmt.Errorf("openapi: unsupported method %s %s", route.Method, route.Path)
+		}
+		if *slot != nil {
+			return nil, fmt.Errorf("openapi: duplicate route %s %s", route.Method, route.Path)
+		}
+		*slot = b.operation(registry, route, errorSchema)
+	}
+
+	doc.Components.Schemas = registry.schemas
+	return doc, nil
+}
+
+func (b *Builder) operation(registry *schemaRegistry, route Route, errorSchema *Schema) *Operation {
+	op := &Operation{
+		Summary:     route.Summary,
+		Description: route.Description,
+		Responses:   make(map[string]*Response),
+	}
+	if route.Tag != "" {
+		op.Tags = []string{route.Tag}
+	}
+
+	op.Parameters = append(op.Parameters, pathParameters(route.Path)...)
+	op.Parameters = append(op.Parameters, queryParameters(registry, route.Query)...)
+	op.Parameters = append(op.Parameters, headerParameters(route.Headers)...)
+
+	if route.Request != nil {
+		op.RequestBody = &RequestBody{
+			Required: !route.RequestOptional,
+			Content:  map[string]*MediaType{contentTypeJSON: {Schema: registry.schemaOf(route.Request)}},
+		}
+	}
+
+	for _, reply := range route.Replies {
+		response := &Response{Description: reply.Description}
+		if response.Description == "" {
+			response.Description = http.StatusText(reply.Status)
+		}
+		if reply.Body != nil {
+			contentType := reply.ContentType
+			if contentType == "" {
+				contentType = contentTypeJSON
+			}
+			response.Content = map[string]*MediaType{contentType: {Schema: registry.schemaOf(reply.Body)}}
+		}
+		op.Responses[strconv.Itoa(reply.Status)] = response
+	}
+	if errorSchema != nil {
+		op.Responses["default"] = &Response{
+			Description: "Error",
+			Content:     map[string]*MediaType{contentTypeJSON: {Schema: errorSchema}},
+		}
+	}
+	return op
+}
+
+// Path 將 gin 的路由格式轉為 OpenAPI 格式，例如 /orders/:uuid → /orders/{uuid}
+func Path(ginPath string) string {
+	segments := strings.Split(ginPath, "/")
+	for i, segment := range segments {
+		if strings.HasPrefix(segment, ":") || strings.HasPrefix(segment, "*") {
+			segments[i] = "{" + segment[1:] + "}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// pathParameters 由路徑推導參數：名稱含 uuid 的為 UUID，以 _id 結尾的為整數，其餘為字串
+func pathParameters(ginPath string) []*Parameter {
+	var params []*Parameter
+	for _, segment := range strings.Split(ginPath, "/") {
+		if !strings.HasPrefix(segment, ":") && !strings.HasPrefix(segment, "*") {
+			continue
+		}
+		name := segment[1:]
+		schema := &Schema{Type: "string"}
+		switch {
+		case strings.Contains(name, "uuid"):
+			schema.Format = "uuid"
+		case strings.HasSuffix(name, "_id"):
+			schema.Type = "integer"
+		}
+		params = append(params, &Parameter{Name: name, In: "path", Required: true, Schema: schema})
+	}
+	return params
+}
+
+// queryParameters 將 struct 的每個欄位轉為 query 參數，名稱取自 form tag（與 gin 的 ShouldBindQuery 相同）
+func queryParameters(registry *schemaRegistry, query any) []*Parameter {
+	if query == nil {
+		return nil
+	}
+	t := reflect.TypeOf(query)
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	var params []*Parameter
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("form"), ",")
+		if name == "-" || !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		schema := registry.schema(field.Type)
+		required := applyBinding(schema, field.Tag.Get("binding"))
+		params = append(params, &Parameter{Name: name, In: "query", Required: required, Schema: schema})
+	}
+	return params
+}
+
+func headerParameters(headers map[string]string) []*Parameter {
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	params := make([]*Parameter, 0, len(names))
+	for _, name := range names {
+		params = append(params, &Parameter{Name: name, In: "header", Description: headers[name], Schema: &Schema{Type: "string"}})
+	}
+	return params
+}
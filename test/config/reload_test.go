@@ -0,0 +1,72 @@
+package config
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"go-gin-high-concurrency/config"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReloader(t *testing.T) {
+	t.Setenv(config.ConfigFileEnv, "")
+
+	newReloader := func(t *testing.T) (config.Reloader, string) {
+		path := writeConfigFile(t, "config.yaml", "log:\n  level: info\n")
+		args := []string{"-config", path}
+		initial, err := config.Load(args)
+		require.NoError(t, err)
+		return config.NewReloader(func() (*config.Config, error) { return config.Load(args) }, initial), path
+	}
+
+	t.Run("Success - applies reloaded settings", func(t *testing.T) {
+		reloader, path := newReloader(t)
+		var applied []config.RuntimeSettings
+		reloader.OnReload(func(s config.RuntimeSettings) { applied = append(applied, s) })
+		require.NoError(t, os.WriteFile(path, []byte(`
+log:
+  level: debug
+worker:
+  order_worker:
+    concurrency: 8
+queue:
+  claim_min_idle_time: 30s
+  nats:
+    redelivery_delay: 2s
+risk:
+  max_ip_attempts: 100
+`), 0o600))
+
+		settings, err := reloader.Reload()
+
+		require.NoError(t, err)
+		assert.Equal(t, "debug", settings.LogLevel)
+		assert.Equal(t, 8, settings.OrderWorkerConcurrency)
+		assert.Equal(t, 30*time.Second, settings.QueueClaimMinIdleTime.Std())
+		assert.Equal(t, 2*time.Second, settings.QueueNATSRedeliveryDelay.Std())
+		assert.Equal(t, 100, settings.RiskMaxIPAttempts)
+		// 未列出的欄位保留預設值
+		assert.Equal(t, 5, settings.QueueMaxRetryCount)
+		assert.Equal(t, []config.RuntimeSettings{settings}, applied)
+		assert.Equal(t, settings, reloader.Current())
+	})
+
+	t.Run("Error - invalid config keeps current settings", func(t *testing.T) {
+		reloader, path := newReloader(t)
+		applied := false
+		reloader.OnReload(func(config.RuntimeSettings) { applied = true })
+		before := reloader.Current()
+		require.NoError(t, os.WriteFile(path, []byte("worker:\n  order_worker:\n    concurrency: 0\n"), 0o600))
+
+		settings, err := reloader.Reload()
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "worker.order_worker.concurrency")
+		assert.False(t, applied)
+		assert.Equal(t, before, settings)
+		assert.Equal(t, before, reloader.Current())
+	})
+}
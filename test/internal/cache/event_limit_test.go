@@ -0,0 +1,156 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"go-gin-high-concurrency/internal/cache"
+	"go-gin-high-concurrency/pkg/app_errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupEventLimitTickets 活動 1 底下的票種 1、2：各庫存 10、每人限購 4，活動每人上限 5
+func setupEventLimitTickets(t *testing.T, ctx context.Context) cache.RedisTicketInventoryManager {
+	t.Helper()
+	inventory := cache.NewRedisTicketInventoryManager(getTestRdb())
+	require.NoError(t, inventory.WarmUpInventory(ctx, 1, 1, 10, 100, 4))
+	require.NoError(t, inventory.WarmUpInventory(ctx, 2, 1, 10, 200, 4))
+	require.NoError(t, inventory.SetEventLimit(ctx, 1, 5))
+	return inventory
+}
+
+func verifyEventBought(t *testing.T, ctx context.Context, eventID int, userID int, expectedBought int) {
+	t.Helper()
+	bought, err := getTestRdb().HGet(ctx, fmt.Sprintf("event:%d:users", eventID), strconv.Itoa(userID)).Int()
+	if expectedBought == 0 && err != nil {
+		return
+	}
+	require.NoError(t, err)
+	assert.Equal(t, expectedBought, bought)
+}
+
+func TestEventLimit_DecreStock(t *testing.T) {
+	ctx := context.Background()
+	clearRedis(ctx)
+	t.Cleanup(func() {
+		clearRedis(ctx)
+	})
+
+	t.Run("Failed - cap shared across ticket types", func(t *testing.T) {
+		defer clearRedis(ctx)
+		inventory := setupEventLimitTickets(t, ctx)
+
+		_, _, err := inventory.DecreStock(ctx, 1, 4, 100, "")
+		require.NoError(t, err)
+
+		_, _, err = inventory.DecreStock(ctx, 2, 2, 100, "")
+		assert.ErrorIs(t, err, app_errors.ErrExceedsEventLimit)
+		verifyStock(t, ctx, inventory, 2, 10)
+
+		_, _, err = inventory.DecreStock(ctx, 2, 1, 100, "")
+		require.NoError(t, err)
+		verifyEventBought(t, ctx, 1, 100, 5)
+
+		// 其他使用者不受影響
+		_, _, err = inventory.DecreStock(ctx, 2, 4, 200, "")
+		assert.NoError(t, err)
+	})
+
+	t.Run("Success - rollback frees event quota", func(t *testing.T) {
+		defer clearRedis(ctx)
+		inventory := setupEventLimitTickets(t, ctx)
+
+		_, _, err := inventory.DecreStock(ctx, 1, 4, 100, "")
+		require.NoError(t, err)
+		require.NoError(t, inventory.RollbackStock(ctx, 1, 2, 100))
+		verifyEventBought(t, ctx, 1, 100, 2)
+
+		_, _, err = inventory.DecreStock(ctx, 2, 3, 100, "")
+		assert.NoError(t, err)
+	})
+
+	t.Run("Success - cap removed or raised during sale", func(t *testing.T) {
+		defer clearRedis(ctx)
+		inventory := setupEventLimitTickets(t, ctx)
+
+		_, _, err := inventory.DecreStock(ctx, 1, 4, 100, "")
+		require.NoError(t, err)
+		_, _, err = inventory.DecreStock(ctx, 2, 2, 100, "")
+		require.ErrorIs(t, err, app_errors.ErrExceedsEventLimit)
+
+		require.NoError(t, inventory.SetEventLimit(ctx, 1, 0))
+		_, _, err = inventory.DecreStock(ctx, 2, 4, 100, "")
+		assert.NoError(t, err)
+		verifyEventBought(t, ctx, 1, 100, 8)
+	})
+}
+
+func TestEventLimit_HoldsSeatsAndWaitlist(t *testing.T) {
+	ctx := context.Background()
+	clearRedis(ctx)
+	t.Cleanup(func() {
+		clearRedis(ctx)
+	})
+
+	t.Run("Success - released hold returns event quota", func(t *testing.T) {
+		defer clearRedis(ctx)
+		inventory := setupEventLimitTickets(t, ctx)
+		holds := cache.NewRedisTicketHoldManager(getTestRdb())
+
+		hold, err := holds.CreateHold(ctx, 1, 100, 4, time.Minute)
+		require.NoError(t, err)
+		_, err = holds.CreateHold(ctx, 2, 100, 2, time.Minute)
+		assert.ErrorIs(t, err, app_errors.ErrExceedsEventLimit)
+
+		require.NoError(t, holds.ReleaseHold(ctx, hold.HoldID, 100))
+		verifyEventBought(t, ctx, 1, 100, 0)
+		_, _, err = inventory.DecreStock(ctx, 2, 4, 100, "")
+		assert.NoError(t, err)
+	})
+
+	t.Run("Failed - seats count toward event cap", func(t *testing.T) {
+		defer clearRedis(ctx)
+		inventory, seats := setupSeatedTicket(t, ctx, nil)
+		require.NoError(t, inventory.WarmUpInventory(ctx, 2, 1, 10, 200, 4))
+		require.NoError(t, inventory.SetEventLimit(ctx, 1, 3))
+
+		_, _, err := inventory.DecreStock(ctx, 2, 2, 100, "")
+		require.NoError(t, err)
+		err = seats.HoldSeats(ctx, 1, 100, []int{1, 2}, time.Minute, "")
+		assert.ErrorIs(t, err, app_errors.ErrExceedsEventLimit)
+
+		require.NoError(t, seats.HoldSeats(ctx, 1, 100, []int{1}, time.Minute, ""))
+		// 保留座位後才在其他票種購買，售出時再檢查一次
+		require.NoError(t, inventory.SetEventLimit(ctx, 1, 2))
+		_, err = seats.CommitSeats(ctx, 1, 100, []int{1}, "")
+		assert.ErrorIs(t, err, app_errors.ErrExceedsEventLimit)
+
+		require.NoError(t, inventory.SetEventLimit(ctx, 1, 3))
+		_, err = seats.CommitSeats(ctx, 1, 100, []int{1}, "")
+		require.NoError(t, err)
+		verifyEventBought(t, ctx, 1, 100, 3)
+
+		require.NoError(t, seats.RollbackSeats(ctx, 1, 100, []int{1}))
+		verifyEventBought(t, ctx, 1, 100, 2)
+	})
+
+	t.Run("Failed - waitlist join over event cap", func(t *testing.T) {
+		defer clearRedis(ctx)
+		inventory := setupEventLimitTickets(t, ctx)
+		waitlist := cache.NewRedisWaitlistManager(getTestRdb())
+
+		_, _, err := inventory.DecreStock(ctx, 1, 4, 100, "")
+		require.NoError(t, err)
+		require.NoError(t, inventory.AdjustStock(ctx, 2, -10))
+
+		_, err = waitlist.Join(ctx, 2, 100, 2)
+		assert.ErrorIs(t, err, app_errors.ErrExceedsEventLimit)
+		position, err := waitlist.Join(ctx, 2, 100, 1)
+		require.NoError(t, err)
+		assert.Equal(t, 1, position)
+	})
+}
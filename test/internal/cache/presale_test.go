@@ -0,0 +1,226 @@
+package cache
+
+import (
+	"context"
+	"go-gin-high-concurrency/internal/cache"
+	"go-gin-high-concurrency/internal/model"
+	"go-gin-high-concurrency/pkg/app_errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupPresaleTicket 預熱預售票種 1：庫存 10、單價 100、每人限購 4，
+// 名單內為使用者 100，存取碼 FANCLUB 限用 2 次、VIP 不限次數
+func setupPresaleTicket(t *testing.T, ctx context.Context) (cache.RedisTicketInventoryManager, cache.RedisPresaleManager) {
+	t.Helper()
+	inventory := cache.NewRedisTicketInventoryManager(getTestRdb())
+	presale := cache.NewRedisPresaleManager(getTestRdb())
+	require.NoError(t, inventory.WarmUpInventory(ctx, 1, 1, 10, 100, 4))
+	require.NoError(t, presale.WarmUp(ctx, 1, []*model.PresaleAccessCode{
+		{Code: "FANCLUB", MaxUses: intPtr(2)},
+		{Code: "VIP"},
+	}, []int{100}, nil))
+	return inventory, presale
+}
+
+func TestPresale_DecreStock(t *testing.T) {
+	ctx := context.Background()
+	clearRedis(ctx)
+	t.Cleanup(func() {
+		clearRedis(ctx)
+	})
+
+	t.Run("Success - allow-listed user does not consume access code", func(t *testing.T) {
+		defer clearRedis(ctx)
+		inventory, _ := setupPresaleTicket(t, ctx)
+
+		ok, quote, err := inventory.DecreStock(ctx, 1, 1, 100, "FANCLUB")
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.Empty(t, quote.AccessCode)
+
+		used, err := getTestRdb().HGet(ctx, "ticket:1:presale:used", "FANCLUB").Result()
+		assert.Error(t, err)
+		assert.Empty(t, used)
+	})
+
+	t.Run("Success - access code consumed until exhausted", func(t *testing.T) {
+		defer clearRedis(ctx)
+		inventory, _ := setupPresaleTicket(t, ctx)
+
+		for userID := 200; userID < 202; userID++ {
+			_, quote, err := inventory.DecreStock(ctx, 1, 1, userID, "FANCLUB")
+			require.NoError(t, err)
+			assert.Equal(t, "FANCLUB", quote.AccessCode)
+		}
+
+		_, _, err := inventory.DecreStock(ctx, 1, 1, 202, "FANCLUB")
+		assert.ErrorIs(t, err, app_errors.ErrAccessCodeExhausted)
+		verifyStock(t, ctx, inventory, 1, 8)
+
+		// 不限次數的存取碼
+		_, quote, err := inventory.DecreStock(ctx, 1, 1, 202, "VIP")
+		require.NoError(t, err)
+		assert.Equal(t, "VIP", quote.AccessCode)
+	})
+
+	t.Run("Failed - missing or unknown access code", func(t *testing.T) {
+		defer clearRedis(ctx)
+		inventory, _ := setupPresaleTicket(t, ctx)
+
+		_, _, err := inventory.DecreStock(ctx, 1, 1, 200, "")
+		assert.ErrorIs(t, err, app_errors.ErrPresaleAccessDenied)
+		_, _, err = inventory.DecreStock(ctx, 1, 1, 200, "UNKNOWN")
+		assert.ErrorIs(t, err, app_errors.ErrPresaleAccessDenied)
+		verifyStock(t, ctx, inventory, 1, 10)
+	})
+
+	t.Run("Failed - other checks do not consume access code", func(t *testing.T) {
+		defer clearRedis(ctx)
+		inventory, _ := setupPresaleTicket(t, ctx)
+
+		_, _, err := inventory.DecreStock(ctx, 1, 5, 200, "FANCLUB")
+		assert.ErrorIs(t, err, app_errors.ErrExceedsMaxPerUser)
+
+		for userID := 200; userID < 202; userID++ {
+			_, _, err := inventory.DecreStock(ctx, 1, 1, userID, "FANCLUB")
+			require.NoError(t, err)
+		}
+	})
+
+	t.Run("Success - general ticket ignores access code", func(t *testing.T) {
+		defer clearRedis(ctx)
+		inventory := cache.NewRedisTicketInventoryManager(getTestRdb())
+		require.NoError(t, inventory.WarmUpInventory(ctx, 1, 1, 10, 100, 4))
+
+		_, quote, err := inventory.DecreStock(ctx, 1, 1, 200, "FANCLUB")
+		require.NoError(t, err)
+		assert.Empty(t, quote.AccessCode)
+	})
+}
+
+func TestPresale_ReturnAccessCode(t *testing.T) {
+	ctx := context.Background()
+	clearRedis(ctx)
+	t.Cleanup(func() {
+		clearRedis(ctx)
+	})
+
+	t.Run("Success - returned use can be reused", func(t *testing.T) {
+		defer clearRedis(ctx)
+		inventory, presale := setupPresaleTicket(t, ctx)
+		require.NoError(t, presale.WarmUp(ctx, 1, []*model.PresaleAccessCode{{Code: "FANCLUB", MaxUses: intPtr(2)}}, nil, map[string]int{"FANCLUB": 2}))
+
+		_, _, err := inventory.DecreStock(ctx, 1, 1, 200, "FANCLUB")
+		assert.ErrorIs(t, err, app_errors.ErrAccessCodeExhausted)
+
+		require.NoError(t, presale.ReturnAccessCode(ctx, 1, "FANCLUB"))
+
+		_, _, err = inventory.DecreStock(ctx, 1, 1, 200, "FANCLUB")
+		assert.NoError(t, err)
+	})
+
+	t.Run("Success - unused code is a no-op", func(t *testing.T) {
+		defer clearRedis(ctx)
+		_, presale := setupPresaleTicket(t, ctx)
+
+		require.NoError(t, presale.ReturnAccessCode(ctx, 1, "VIP"))
+		exists, err := getTestRdb().HExists(ctx, "ticket:1:presale:used", "VIP").Result()
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
+}
+
+func TestPresale_Allowlist(t *testing.T) {
+	ctx := context.Background()
+	clearRedis(ctx)
+	t.Cleanup(func() {
+		clearRedis(ctx)
+	})
+
+	t.Run("Success - add and remove during sale", func(t *testing.T) {
+		defer clearRedis(ctx)
+		inventory, presale := setupPresaleTicket(t, ctx)
+
+		require.NoError(t, presale.AddAllowlist(ctx, 1, []int{200}))
+		_, _, err := inventory.DecreStock(ctx, 1, 1, 200, "")
+		require.NoError(t, err)
+
+		require.NoError(t, presale.RemoveAllowlist(ctx, 1, 200))
+		_, _, err = inventory.DecreStock(ctx, 1, 1, 200, "")
+		assert.ErrorIs(t, err, app_errors.ErrPresaleAccessDenied)
+	})
+
+	t.Run("Success - access code added during sale", func(t *testing.T) {
+		defer clearRedis(ctx)
+		inventory, presale := setupPresaleTicket(t, ctx)
+
+		require.NoError(t, presale.AddAccessCode(ctx, 1, &model.PresaleAccessCode{Code: "LATE", MaxUses: intPtr(1)}))
+		_, _, err := inventory.DecreStock(ctx, 1, 1, 200, "LATE")
+		require.NoError(t, err)
+		_, _, err = inventory.DecreStock(ctx, 1, 1, 201, "LATE")
+		assert.ErrorIs(t, err, app_errors.ErrAccessCodeExhausted)
+	})
+
+	t.Run("Success - not warmed up is a no-op", func(t *testing.T) {
+		defer clearRedis(ctx)
+		presale := cache.NewRedisPresaleManager(getTestRdb())
+
+		require.NoError(t, presale.WarmUp(ctx, 1, nil, []int{100}, nil))
+		require.NoError(t, presale.AddAllowlist(ctx, 1, []int{200}))
+		exists, err := getTestRdb().Exists(ctx, "ticket:1:info", "ticket:1:presale:allowlist").Result()
+		require.NoError(t, err)
+		assert.Zero(t, exists)
+	})
+}
+
+func TestPresale_HoldsAndWaitlist(t *testing.T) {
+	ctx := context.Background()
+	clearRedis(ctx)
+	t.Cleanup(func() {
+		clearRedis(ctx)
+	})
+
+	t.Run("Success - holds limited to allow-listed users", func(t *testing.T) {
+		defer clearRedis(ctx)
+		setupPresaleTicket(t, ctx)
+		holds := cache.NewRedisTicketHoldManager(getTestRdb())
+
+		_, err := holds.CreateHold(ctx, 1, 100, 1, time.Minute)
+		require.NoError(t, err)
+		_, err = holds.CreateHold(ctx, 1, 200, 1, time.Minute)
+		assert.ErrorIs(t, err, app_errors.ErrPresaleAccessDenied)
+	})
+
+	t.Run("Failed - waitlist limited to allow-listed users", func(t *testing.T) {
+		defer clearRedis(ctx)
+		setupPresaleTicket(t, ctx)
+		waitlist := cache.NewRedisWaitlistManager(getTestRdb())
+
+		_, err := waitlist.Join(ctx, 1, 200, 1)
+		assert.ErrorIs(t, err, app_errors.ErrPresaleAccessDenied)
+	})
+
+	t.Run("Success - seated presale checked on hold and commit", func(t *testing.T) {
+		defer clearRedis(ctx)
+		inventory, seats := setupSeatedTicket(t, ctx, nil)
+		presale := cache.NewRedisPresaleManager(getTestRdb())
+		require.NoError(t, presale.WarmUp(ctx, 1, []*model.PresaleAccessCode{{Code: "FANCLUB"}}, nil, nil))
+
+		// 不在名單內又沒有存取碼時無法保留座位
+		err := seats.HoldSeats(ctx, 1, 200, []int{1}, time.Minute, "")
+		assert.ErrorIs(t, err, app_errors.ErrPresaleAccessDenied)
+		require.NoError(t, seats.HoldSeats(ctx, 1, 200, []int{1}, time.Minute, "FANCLUB"))
+
+		_, err = seats.CommitSeats(ctx, 1, 200, []int{1}, "")
+		assert.ErrorIs(t, err, app_errors.ErrPresaleAccessDenied)
+
+		quote, err := seats.CommitSeats(ctx, 1, 200, []int{1}, "FANCLUB")
+		require.NoError(t, err)
+		assert.Equal(t, "FANCLUB", quote.AccessCode)
+		verifyStock(t, ctx, inventory, 1, 2)
+	})
+}
@@ -0,0 +1,204 @@
+package cache
+
+import (
+	"context"
+	"go-gin-high-concurrency/internal/cache"
+	"go-gin-high-concurrency/internal/model"
+	"go-gin-high-concurrency/pkg/app_errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupPromoTickets 預熱活動 1 的票種 1、活動 2 的票種 2
+func setupPromoTickets(t *testing.T, ctx context.Context) cache.RedisPromoCodeManager {
+	t.Helper()
+	inventory := cache.NewRedisTicketInventoryManager(getTestRdb())
+	require.NoError(t, inventory.WarmUpInventory(ctx, 1, 1, 10, 100, 4))
+	require.NoError(t, inventory.WarmUpInventory(ctx, 2, 2, 10, 100, 4))
+	return cache.NewRedisPromoCodeManager(getTestRdb())
+}
+
+func intPtr(v int) *int {
+	return &v
+}
+
+func TestPromoCode_Redeem(t *testing.T) {
+	ctx := context.Background()
+	clearRedis(ctx)
+	t.Cleanup(func() {
+		clearRedis(ctx)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		defer clearRedis(ctx)
+		promos := setupPromoTickets(t, ctx)
+
+		require.NoError(t, promos.WarmUp(ctx, &model.PromoCode{
+			ID: 7, Code: "SAVE10", DiscountType: model.DiscountTypePercentage, DiscountValue: 10,
+		}, nil))
+
+		promo, err := promos.Redeem(ctx, "SAVE10", 1, 100)
+		require.NoError(t, err)
+		assert.Equal(t, 7, promo.ID)
+		assert.Equal(t, model.DiscountTypePercentage, promo.DiscountType)
+		assert.Equal(t, 10.0, promo.DiscountValue)
+		assert.Equal(t, 20.0, promo.Discount(200))
+	})
+
+	t.Run("Failed - not loaded", func(t *testing.T) {
+		defer clearRedis(ctx)
+		promos := setupPromoTickets(t, ctx)
+
+		_, err := promos.Redeem(ctx, "MISSING", 1, 100)
+		assert.ErrorIs(t, err, app_errors.ErrPromoCodeNotFound)
+	})
+
+	t.Run("Success - missing mark cleared by warm up", func(t *testing.T) {
+		defer clearRedis(ctx)
+		promos := setupPromoTickets(t, ctx)
+
+		require.NoError(t, promos.MarkMissing(ctx, "LATE", time.Minute))
+		missing, err := promos.IsMissing(ctx, "LATE")
+		require.NoError(t, err)
+		assert.True(t, missing)
+
+		// 記錄不存在後才建立的優惠碼，預熱時清除紀錄
+		require.NoError(t, promos.WarmUp(ctx, &model.PromoCode{
+			ID: 8, Code: "LATE", DiscountType: model.DiscountTypeFixed, DiscountValue: 10,
+		}, nil))
+		missing, err = promos.IsMissing(ctx, "LATE")
+		require.NoError(t, err)
+		assert.False(t, missing)
+		_, err = promos.Redeem(ctx, "LATE", 1, 100)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Failed - outside validity window", func(t *testing.T) {
+		defer clearRedis(ctx)
+		promos := setupPromoTickets(t, ctx)
+
+		startsAt := time.Now().Add(time.Hour)
+		endedAt := time.Now().Add(-time.Hour)
+		require.NoError(t, promos.WarmUp(ctx, &model.PromoCode{
+			ID: 1, Code: "LATER", DiscountType: model.DiscountTypeFixed, DiscountValue: 50, StartsAt: &startsAt,
+		}, nil))
+		require.NoError(t, promos.WarmUp(ctx, &model.PromoCode{
+			ID: 2, Code: "ENDED", DiscountType: model.DiscountTypeFixed, DiscountValue: 50, EndsAt: &endedAt,
+		}, nil))
+
+		_, err := promos.Redeem(ctx, "LATER", 1, 100)
+		assert.ErrorIs(t, err, app_errors.ErrPromoCodeInactive)
+		_, err = promos.Redeem(ctx, "ENDED", 1, 100)
+		assert.ErrorIs(t, err, app_errors.ErrPromoCodeInactive)
+	})
+
+	t.Run("Failed - outside ticket and event scope", func(t *testing.T) {
+		defer clearRedis(ctx)
+		promos := setupPromoTickets(t, ctx)
+
+		require.NoError(t, promos.WarmUp(ctx, &model.PromoCode{
+			ID: 1, Code: "TICKET1", DiscountType: model.DiscountTypeFixed, DiscountValue: 50, TicketID: intPtr(1),
+		}, nil))
+		require.NoError(t, promos.WarmUp(ctx, &model.PromoCode{
+			ID: 2, Code: "EVENT2", DiscountType: model.DiscountTypeFixed, DiscountValue: 50, EventID: intPtr(2),
+		}, nil))
+
+		_, err := promos.Redeem(ctx, "TICKET1", 2, 100)
+		assert.ErrorIs(t, err, app_errors.ErrPromoCodeNotApplicable)
+		_, err = promos.Redeem(ctx, "EVENT2", 1, 100)
+		assert.ErrorIs(t, err, app_errors.ErrPromoCodeNotApplicable)
+
+		_, err = promos.Redeem(ctx, "TICKET1", 1, 100)
+		assert.NoError(t, err)
+		_, err = promos.Redeem(ctx, "EVENT2", 2, 100)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Failed - global and per-user limits", func(t *testing.T) {
+		defer clearRedis(ctx)
+		promos := setupPromoTickets(t, ctx)
+
+		require.NoError(t, promos.WarmUp(ctx, &model.PromoCode{
+			ID: 1, Code: "LIMITED", DiscountType: model.DiscountTypeFixed, DiscountValue: 50,
+			MaxRedemptions: intPtr(3), MaxPerUser: intPtr(1),
+		}, map[int]int{100: 1}))
+
+		// 使用者 100 已用過一次
+		_, err := promos.Redeem(ctx, "LIMITED", 1, 100)
+		assert.ErrorIs(t, err, app_errors.ErrPromoCodeExhausted)
+
+		_, err = promos.Redeem(ctx, "LIMITED", 1, 200)
+		require.NoError(t, err)
+		_, err = promos.Redeem(ctx, "LIMITED", 1, 300)
+		require.NoError(t, err)
+
+		// 全域 3 次已用完
+		_, err = promos.Redeem(ctx, "LIMITED", 1, 400)
+		assert.ErrorIs(t, err, app_errors.ErrPromoCodeExhausted)
+	})
+}
+
+func TestPromoCode_Return(t *testing.T) {
+	ctx := context.Background()
+	clearRedis(ctx)
+	t.Cleanup(func() {
+		clearRedis(ctx)
+	})
+
+	t.Run("Success - returned redemption can be reused", func(t *testing.T) {
+		defer clearRedis(ctx)
+		promos := setupPromoTickets(t, ctx)
+
+		require.NoError(t, promos.WarmUp(ctx, &model.PromoCode{
+			ID: 1, Code: "ONCE", DiscountType: model.DiscountTypeFixed, DiscountValue: 50, MaxRedemptions: intPtr(1),
+		}, nil))
+
+		_, err := promos.Redeem(ctx, "ONCE", 1, 100)
+		require.NoError(t, err)
+		_, err = promos.Redeem(ctx, "ONCE", 1, 200)
+		assert.ErrorIs(t, err, app_errors.ErrPromoCodeExhausted)
+
+		require.NoError(t, promos.Return(ctx, "ONCE", 100))
+
+		_, err = promos.Redeem(ctx, "ONCE", 1, 200)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Success - not loaded is a no-op", func(t *testing.T) {
+		defer clearRedis(ctx)
+		promos := setupPromoTickets(t, ctx)
+
+		require.NoError(t, promos.Return(ctx, "MISSING", 100))
+		exists, err := getTestRdb().Exists(ctx, "promo:MISSING:info").Result()
+		require.NoError(t, err)
+		assert.Zero(t, exists)
+	})
+}
+
+func TestPromoCode_WarmUp(t *testing.T) {
+	ctx := context.Background()
+	clearRedis(ctx)
+	t.Cleanup(func() {
+		clearRedis(ctx)
+	})
+
+	t.Run("Success - does not overwrite loaded usage", func(t *testing.T) {
+		defer clearRedis(ctx)
+		promos := setupPromoTickets(t, ctx)
+
+		promo := &model.PromoCode{ID: 1, Code: "TWICE", DiscountType: model.DiscountTypeFixed, DiscountValue: 50, MaxRedemptions: intPtr(2)}
+		require.NoError(t, promos.WarmUp(ctx, promo, nil))
+		_, err := promos.Redeem(ctx, "TWICE", 1, 100)
+		require.NoError(t, err)
+
+		// 併發的重建不應把使用次數重設為 0
+		require.NoError(t, promos.WarmUp(ctx, promo, nil))
+		_, err = promos.Redeem(ctx, "TWICE", 1, 200)
+		require.NoError(t, err)
+		_, err = promos.Redeem(ctx, "TWICE", 1, 300)
+		assert.ErrorIs(t, err, app_errors.ErrPromoCodeExhausted)
+	})
+}
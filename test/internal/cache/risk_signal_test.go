@@ -0,0 +1,77 @@
+package cache
+
+import (
+	"context"
+	"go-gin-high-concurrency/internal/cache"
+	"go-gin-high-concurrency/internal/model"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRiskSignal_RecordAttempt(t *testing.T) {
+	ctx := context.Background()
+	clearRedis(ctx)
+	t.Cleanup(func() {
+		clearRedis(ctx)
+	})
+
+	t.Run("Success - counts per user, ip and device", func(t *testing.T) {
+		defer clearRedis(ctx)
+		manager := cache.NewRedisRiskSignalManager(getTestRdb())
+		signals := model.RiskSignals{UserID: 1, TicketID: 1, Quantity: 1, ClientIP: "10.0.0.1", DeviceFingerprint: "device-a"}
+
+		var counters *model.RiskCounters
+		var err error
+		for i := 0; i < 3; i++ {
+			counters, err = manager.RecordAttempt(ctx, signals, time.Minute, time.Hour)
+			require.NoError(t, err)
+		}
+		assert.Equal(t, 3, counters.UserAttempts)
+		assert.Equal(t, 3, counters.IPAttempts)
+		assert.Equal(t, 3, counters.DeviceAttempts)
+
+		// 同一 IP 的其他使用者
+		counters, err = manager.RecordAttempt(ctx, model.RiskSignals{UserID: 2, ClientIP: "10.0.0.1"}, time.Minute, time.Hour)
+		require.NoError(t, err)
+		assert.Equal(t, 1, counters.UserAttempts)
+		assert.Equal(t, 4, counters.IPAttempts)
+		assert.Zero(t, counters.DeviceAttempts)
+
+		ttl, err := getTestRdb().PTTL(ctx, "risk:user:1:attempts").Result()
+		require.NoError(t, err)
+		assert.Greater(t, ttl, time.Duration(0))
+	})
+
+	t.Run("Success - shared payment counts distinct users", func(t *testing.T) {
+		defer clearRedis(ctx)
+		manager := cache.NewRedisRiskSignalManager(getTestRdb())
+
+		var counters *model.RiskCounters
+		var err error
+		for _, userID := range []int{1, 2, 2, 3} {
+			counters, err = manager.RecordAttempt(ctx, model.RiskSignals{UserID: userID, PaymentFingerprint: "card-1"}, time.Minute, time.Hour)
+			require.NoError(t, err)
+		}
+		assert.Equal(t, 3, counters.PaymentUsers)
+	})
+
+	t.Run("Success - stock misses reported on next attempt", func(t *testing.T) {
+		defer clearRedis(ctx)
+		manager := cache.NewRedisRiskSignalManager(getTestRdb())
+
+		require.NoError(t, manager.RecordStockMiss(ctx, 1, time.Minute))
+		require.NoError(t, manager.RecordStockMiss(ctx, 1, time.Minute))
+
+		counters, err := manager.RecordAttempt(ctx, model.RiskSignals{UserID: 1}, time.Minute, time.Hour)
+		require.NoError(t, err)
+		assert.Equal(t, 2, counters.StockMisses)
+		assert.Zero(t, counters.PaymentUsers)
+
+		counters, err = manager.RecordAttempt(ctx, model.RiskSignals{UserID: 2}, time.Minute, time.Hour)
+		require.NoError(t, err)
+		assert.Zero(t, counters.StockMisses)
+	})
+}
@@ -0,0 +1,179 @@
+package cache
+
+import (
+	"context"
+	"go-gin-high-concurrency/internal/cache"
+	"go-gin-high-concurrency/internal/model"
+	"go-gin-high-concurrency/pkg/app_errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupSeatedTicket 預熱一個對號座票種：庫存 3、單價 80、每人限購 2
+func setupSeatedTicket(t *testing.T, ctx context.Context, soldSeatIDs []int) (cache.RedisTicketInventoryManager, cache.RedisSeatHoldManager) {
+	t.Helper()
+	inventory := cache.NewRedisTicketInventoryManager(getTestRdb())
+	seats := cache.NewRedisSeatHoldManager(getTestRdb())
+	require.NoError(t, inventory.WarmUpInventory(ctx, 1, 1, 3, 80, 2))
+	require.NoError(t, seats.WarmUpSeats(ctx, 1, soldSeatIDs))
+	return inventory, seats
+}
+
+func TestSeatHold_HoldSeats(t *testing.T) {
+	ctx := context.Background()
+	clearRedis(ctx)
+	t.Cleanup(func() {
+		clearRedis(ctx)
+	})
+
+	t.Run("Success - all or nothing", func(t *testing.T) {
+		defer clearRedis(ctx)
+		_, seats := setupSeatedTicket(t, ctx, nil)
+
+		require.NoError(t, seats.HoldSeats(ctx, 1, 100, []int{1, 2}, time.Minute, ""))
+
+		// 座位 2 已被 100 保留，其他人保留 2、3 時全部失敗，座位 3 不會被保留
+		err := seats.HoldSeats(ctx, 1, 200, []int{3, 2}, time.Minute, "")
+		assert.ErrorIs(t, err, app_errors.ErrSeatUnavailable)
+
+		statuses, err := seats.GetSeatStatuses(ctx, 1, []int{1, 2, 3})
+		require.NoError(t, err)
+		assert.Equal(t, map[int]model.SeatStatus{1: model.SeatStatusHeld, 2: model.SeatStatusHeld}, statuses)
+
+		// 同一使用者可以重新保留（延長 TTL）
+		assert.NoError(t, seats.HoldSeats(ctx, 1, 100, []int{1, 2}, time.Minute, ""))
+	})
+
+	t.Run("Failed - sold seat", func(t *testing.T) {
+		defer clearRedis(ctx)
+		_, seats := setupSeatedTicket(t, ctx, []int{3})
+
+		err := seats.HoldSeats(ctx, 1, 100, []int{3}, time.Minute, "")
+		assert.ErrorIs(t, err, app_errors.ErrSeatUnavailable)
+	})
+
+	t.Run("Failed - exceeds max per user", func(t *testing.T) {
+		defer clearRedis(ctx)
+		_, seats := setupSeatedTicket(t, ctx, nil)
+
+		err := seats.HoldSeats(ctx, 1, 100, []int{1, 2, 3}, time.Minute, "")
+		assert.ErrorIs(t, err, app_errors.ErrExceedsMaxPerUser)
+	})
+
+	t.Run("Failed - held seats count toward max per user", func(t *testing.T) {
+		defer clearRedis(ctx)
+		_, seats := setupSeatedTicket(t, ctx, nil)
+
+		require.NoError(t, seats.HoldSeats(ctx, 1, 100, []int{1, 2}, time.Minute, ""))
+		err := seats.HoldSeats(ctx, 1, 100, []int{3}, time.Minute, "")
+		assert.ErrorIs(t, err, app_errors.ErrExceedsMaxPerUser)
+
+		// 釋放後保留數量跟著減少
+		require.NoError(t, seats.ReleaseHolds(ctx, 1, 100, []int{2}))
+		assert.NoError(t, seats.HoldSeats(ctx, 1, 100, []int{3}, time.Minute, ""))
+	})
+
+	t.Run("Success - expired holds no longer count", func(t *testing.T) {
+		defer clearRedis(ctx)
+		_, seats := setupSeatedTicket(t, ctx, nil)
+
+		require.NoError(t, seats.HoldSeats(ctx, 1, 100, []int{1, 2}, 50*time.Millisecond, ""))
+		time.Sleep(100 * time.Millisecond)
+
+		assert.NoError(t, seats.HoldSeats(ctx, 1, 100, []int{3}, time.Minute, ""))
+	})
+
+	t.Run("Success - committed holds move to bought", func(t *testing.T) {
+		defer clearRedis(ctx)
+		_, seats := setupSeatedTicket(t, ctx, nil)
+
+		require.NoError(t, seats.HoldSeats(ctx, 1, 100, []int{1}, time.Minute, ""))
+		_, err := seats.CommitSeats(ctx, 1, 100, []int{1}, "")
+		require.NoError(t, err)
+
+		// 已購買 1 張、保留數量已歸零，仍可再保留 1 張
+		require.NoError(t, seats.HoldSeats(ctx, 1, 100, []int{2}, time.Minute, ""))
+		err = seats.HoldSeats(ctx, 1, 100, []int{3}, time.Minute, "")
+		assert.ErrorIs(t, err, app_errors.ErrExceedsMaxPerUser)
+	})
+
+	t.Run("Failed - general admission ticket", func(t *testing.T) {
+		defer clearRedis(ctx)
+		inventory := cache.NewRedisTicketInventoryManager(getTestRdb())
+		seats := cache.NewRedisSeatHoldManager(getTestRdb())
+		require.NoError(t, inventory.WarmUpInventory(ctx, 1, 1, 3, 80, 2))
+
+		err := seats.HoldSeats(ctx, 1, 100, []int{1}, time.Minute, "")
+		assert.ErrorIs(t, err, app_errors.ErrTicketNotFound)
+	})
+}
+
+func TestSeatHold_CommitAndRollbackSeats(t *testing.T) {
+	ctx := context.Background()
+	clearRedis(ctx)
+	t.Cleanup(func() {
+		clearRedis(ctx)
+	})
+
+	t.Run("Success - commit held seats then rollback", func(t *testing.T) {
+		defer clearRedis(ctx)
+		inventory, seats := setupSeatedTicket(t, ctx, nil)
+		require.NoError(t, seats.HoldSeats(ctx, 1, 100, []int{1, 2}, time.Minute, ""))
+
+		quote, err := seats.CommitSeats(ctx, 1, 100, []int{1, 2}, "")
+		require.NoError(t, err)
+		assert.Equal(t, 80.0, quote.Price)
+		verifyStock(t, ctx, inventory, 1, 1)
+		verifyUserBought(t, ctx, getTestRdb(), 1, 100, 2)
+
+		statuses, err := seats.GetSeatStatuses(ctx, 1, []int{1, 2, 3})
+		require.NoError(t, err)
+		assert.Equal(t, map[int]model.SeatStatus{1: model.SeatStatusSold, 2: model.SeatStatusSold}, statuses)
+
+		require.NoError(t, seats.RollbackSeats(ctx, 1, 100, []int{1, 2}))
+		verifyStock(t, ctx, inventory, 1, 3)
+		verifyUserBought(t, ctx, getTestRdb(), 1, 100, 0)
+
+		// 重複回滾不會多補庫存
+		require.NoError(t, seats.RollbackSeats(ctx, 1, 100, []int{1, 2}))
+		verifyStock(t, ctx, inventory, 1, 3)
+	})
+
+	t.Run("Failed - hold owned by another user", func(t *testing.T) {
+		defer clearRedis(ctx)
+		inventory, seats := setupSeatedTicket(t, ctx, nil)
+		require.NoError(t, seats.HoldSeats(ctx, 1, 100, []int{1}, time.Minute, ""))
+
+		_, err := seats.CommitSeats(ctx, 1, 200, []int{1}, "")
+		assert.ErrorIs(t, err, app_errors.ErrSeatHoldExpired)
+		verifyStock(t, ctx, inventory, 1, 3)
+	})
+
+	t.Run("Failed - hold released", func(t *testing.T) {
+		defer clearRedis(ctx)
+		_, seats := setupSeatedTicket(t, ctx, nil)
+		require.NoError(t, seats.HoldSeats(ctx, 1, 100, []int{1}, time.Minute, ""))
+		require.NoError(t, seats.ReleaseHolds(ctx, 1, 100, []int{1}))
+
+		_, err := seats.CommitSeats(ctx, 1, 100, []int{1}, "")
+		assert.ErrorIs(t, err, app_errors.ErrSeatHoldExpired)
+	})
+}
+
+func TestSeatHold_DecreStockRequiresSeatSelection(t *testing.T) {
+	ctx := context.Background()
+	clearRedis(ctx)
+	t.Cleanup(func() {
+		clearRedis(ctx)
+	})
+
+	inventory, _ := setupSeatedTicket(t, ctx, nil)
+
+	ok, _, err := inventory.DecreStock(ctx, 1, 1, 100, "")
+	assert.False(t, ok)
+	assert.ErrorIs(t, err, app_errors.ErrSeatSelectionRequired)
+	verifyStock(t, ctx, inventory, 1, 3)
+}
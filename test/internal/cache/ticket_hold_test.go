@@ -0,0 +1,228 @@
+package cache
+
+import (
+	"context"
+	"go-gin-high-concurrency/internal/cache"
+	"go-gin-high-concurrency/internal/model"
+	"go-gin-high-concurrency/pkg/app_errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupHoldTicket 預熱一個一般票種：庫存 10、單價 100、每人限購 4
+func setupHoldTicket(t *testing.T, ctx context.Context) (cache.RedisTicketInventoryManager, cache.RedisTicketHoldManager) {
+	t.Helper()
+	inventory := cache.NewRedisTicketInventoryManager(getTestRdb())
+	holds := cache.NewRedisTicketHoldManager(getTestRdb())
+	require.NoError(t, inventory.WarmUpInventory(ctx, 1, 1, 10, 100, 4))
+	return inventory, holds
+}
+
+func TestTicketHold_CreateHold(t *testing.T) {
+	ctx := context.Background()
+	clearRedis(ctx)
+	t.Cleanup(func() {
+		clearRedis(ctx)
+	})
+
+	t.Run("Success - decrements stock", func(t *testing.T) {
+		defer clearRedis(ctx)
+		inventory, holds := setupHoldTicket(t, ctx)
+
+		hold, err := holds.CreateHold(ctx, 1, 100, 3, time.Minute)
+		require.NoError(t, err)
+		assert.Equal(t, 100.0, hold.Price)
+		assert.WithinDuration(t, time.Now().Add(time.Minute), hold.ExpiresAt, 5*time.Second)
+
+		stock, err := inventory.GetStock(ctx, 1)
+		require.NoError(t, err)
+		assert.Equal(t, 7, stock)
+
+		// 保留也計入個人限購
+		_, err = holds.CreateHold(ctx, 1, 100, 2, time.Minute)
+		assert.ErrorIs(t, err, app_errors.ErrExceedsMaxPerUser)
+	})
+
+	t.Run("Failed - insufficient stock", func(t *testing.T) {
+		defer clearRedis(ctx)
+		_, holds := setupHoldTicket(t, ctx)
+
+		for userID := 1; userID <= 5; userID++ {
+			_, err := holds.CreateHold(ctx, 1, userID, 2, time.Minute)
+			require.NoError(t, err)
+		}
+		_, err := holds.CreateHold(ctx, 1, 6, 1, time.Minute)
+		assert.ErrorIs(t, err, app_errors.ErrInsufficientStock)
+	})
+
+	t.Run("Failed - ticket not warmed up", func(t *testing.T) {
+		defer clearRedis(ctx)
+		holds := cache.NewRedisTicketHoldManager(getTestRdb())
+
+		_, err := holds.CreateHold(ctx, 99, 100, 1, time.Minute)
+		assert.ErrorIs(t, err, app_errors.ErrTicketNotFound)
+	})
+}
+
+func TestTicketHold_ConvertHold(t *testing.T) {
+	ctx := context.Background()
+	clearRedis(ctx)
+	t.Cleanup(func() {
+		clearRedis(ctx)
+	})
+
+	t.Run("Success - converted hold is not swept", func(t *testing.T) {
+		defer clearRedis(ctx)
+		inventory, holds := setupHoldTicket(t, ctx)
+
+		hold, err := holds.CreateHold(ctx, 1, 100, 2, time.Minute)
+		require.NoError(t, err)
+
+		converted, err := holds.ConvertHold(ctx, hold.HoldID, 100, 1, 2)
+		require.NoError(t, err)
+		assert.Equal(t, 100.0, converted.Price)
+
+		// 轉換後不可重複使用，也不會被 sweeper 歸還
+		_, err = holds.ConvertHold(ctx, hold.HoldID, 100, 1, 2)
+		assert.ErrorIs(t, err, app_errors.ErrHoldExpired)
+
+		released, err := holds.ReleaseExpired(ctx, time.Now().Add(time.Hour), 100)
+		require.NoError(t, err)
+		assert.Equal(t, 0, released)
+
+		stock, err := inventory.GetStock(ctx, 1)
+		require.NoError(t, err)
+		assert.Equal(t, 8, stock)
+	})
+
+	t.Run("Success - keeps price phase from hold time", func(t *testing.T) {
+		defer clearRedis(ctx)
+		inventory, holds := setupHoldTicket(t, ctx)
+		endsAt := time.Now().Add(time.Hour)
+		require.NoError(t, inventory.SetPricePhases(ctx, 1, []*model.TicketPricePhase{{Name: "Early Bird", Price: 70, EndsAt: &endsAt}}))
+
+		hold, err := holds.CreateHold(ctx, 1, 100, 2, time.Minute)
+		require.NoError(t, err)
+		assert.Equal(t, 70.0, hold.Price)
+		assert.Equal(t, "Early Bird", hold.PricePhase)
+
+		// 保留後早鳥結束，轉為訂單時仍以保留當下的價格計算
+		require.NoError(t, inventory.SetPricePhases(ctx, 1, nil))
+		converted, err := holds.ConvertHold(ctx, hold.HoldID, 100, 1, 2)
+		require.NoError(t, err)
+		assert.Equal(t, 70.0, converted.Price)
+		assert.Equal(t, "Early Bird", converted.PricePhase)
+	})
+
+	t.Run("Failed - mismatched ticket or quantity", func(t *testing.T) {
+		defer clearRedis(ctx)
+		_, holds := setupHoldTicket(t, ctx)
+
+		hold, err := holds.CreateHold(ctx, 1, 100, 2, time.Minute)
+		require.NoError(t, err)
+
+		_, err = holds.ConvertHold(ctx, hold.HoldID, 100, 1, 3)
+		assert.ErrorIs(t, err, app_errors.ErrInvalidInput)
+
+		// 其他使用者無法使用
+		_, err = holds.ConvertHold(ctx, hold.HoldID, 200, 1, 2)
+		assert.ErrorIs(t, err, app_errors.ErrHoldExpired)
+	})
+
+	t.Run("Failed - expired hold", func(t *testing.T) {
+		defer clearRedis(ctx)
+		_, holds := setupHoldTicket(t, ctx)
+
+		hold, err := holds.CreateHold(ctx, 1, 100, 2, 50*time.Millisecond)
+		require.NoError(t, err)
+		time.Sleep(100 * time.Millisecond)
+
+		_, err = holds.ConvertHold(ctx, hold.HoldID, 100, 1, 2)
+		assert.ErrorIs(t, err, app_errors.ErrHoldExpired)
+	})
+}
+
+func TestTicketHold_Release(t *testing.T) {
+	ctx := context.Background()
+	clearRedis(ctx)
+	t.Cleanup(func() {
+		clearRedis(ctx)
+	})
+
+	t.Run("ReleaseHold - restores stock and quota", func(t *testing.T) {
+		defer clearRedis(ctx)
+		inventory, holds := setupHoldTicket(t, ctx)
+
+		hold, err := holds.CreateHold(ctx, 1, 100, 4, time.Minute)
+		require.NoError(t, err)
+
+		// 只有保留者可以釋放
+		assert.ErrorIs(t, holds.ReleaseHold(ctx, hold.HoldID, 200), app_errors.ErrHoldExpired)
+		require.NoError(t, holds.ReleaseHold(ctx, hold.HoldID, 100))
+		assert.ErrorIs(t, holds.ReleaseHold(ctx, hold.HoldID, 100), app_errors.ErrHoldExpired)
+
+		stock, err := inventory.GetStock(ctx, 1)
+		require.NoError(t, err)
+		assert.Equal(t, 10, stock)
+
+		// 額度已歸還，可以再買滿限購數量
+		_, err = holds.CreateHold(ctx, 1, 100, 4, time.Minute)
+		assert.NoError(t, err)
+	})
+
+	t.Run("ReleaseExpired - only expired holds within limit", func(t *testing.T) {
+		defer clearRedis(ctx)
+		inventory, holds := setupHoldTicket(t, ctx)
+
+		_, err := holds.CreateHold(ctx, 1, 100, 1, time.Minute)
+		require.NoError(t, err)
+		_, err = holds.CreateHold(ctx, 1, 200, 2, time.Minute)
+		require.NoError(t, err)
+		active, err := holds.CreateHold(ctx, 1, 300, 3, time.Hour)
+		require.NoError(t, err)
+
+		released, err := holds.ReleaseExpired(ctx, time.Now().Add(2*time.Minute), 1)
+		require.NoError(t, err)
+		assert.Equal(t, 1, released)
+
+		released, err = holds.ReleaseExpired(ctx, time.Now().Add(2*time.Minute), 100)
+		require.NoError(t, err)
+		assert.Equal(t, 1, released)
+
+		stock, err := inventory.GetStock(ctx, 1)
+		require.NoError(t, err)
+		assert.Equal(t, 7, stock)
+
+		// 未到期的保留仍可轉換
+		_, err = holds.ConvertHold(ctx, active.HoldID, 300, 1, 3)
+		assert.NoError(t, err)
+	})
+
+	t.Run("ReleaseExpired - does not recreate removed inventory", func(t *testing.T) {
+		defer clearRedis(ctx)
+		_, holds := setupHoldTicket(t, ctx)
+
+		_, err := holds.CreateHold(ctx, 1, 100, 1, time.Minute)
+		require.NoError(t, err)
+		require.NoError(t, getTestRdb().Del(ctx, "ticket:1:info").Err())
+
+		released, err := holds.ReleaseExpired(ctx, time.Now().Add(2*time.Minute), 100)
+		require.NoError(t, err)
+		assert.Equal(t, 1, released)
+
+		exists, err := getTestRdb().Exists(ctx, "ticket:1:info").Result()
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), exists)
+	})
+
+	t.Run("ReleaseHold - unknown hold", func(t *testing.T) {
+		defer clearRedis(ctx)
+		_, holds := setupHoldTicket(t, ctx)
+
+		assert.ErrorIs(t, holds.ReleaseHold(ctx, uuid.New(), 100), app_errors.ErrHoldExpired)
+	})
+}
@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 	"go-gin-high-concurrency/internal/cache"
+	"go-gin-high-concurrency/internal/model"
 	"go-gin-high-concurrency/pkg/app_errors"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/redis/go-redis/v9"
 	"github.com/stretchr/testify/assert"
@@ -43,7 +45,7 @@ func TestTicketInventory_WarmUpInventory(t *testing.T) {
 
 	t.Run("Success", func(t *testing.T) {
 		defer clearRedis(ctx)
-		err := inventory.WarmUpInventory(ctx, 1, 100, 100.5, 2)
+		err := inventory.WarmUpInventory(ctx, 1, 1, 100, 100.5, 2)
 		assert.NoError(t, err)
 		info, err := inventory.GetInfo(ctx, 1)
 		assert.NoError(t, err)
@@ -64,7 +66,7 @@ func TestTicketInventory_GetStock(t *testing.T) {
 
 	t.Run("Success", func(t *testing.T) {
 		defer clearRedis(ctx)
-		err := inventory.WarmUpInventory(ctx, 1, 100, 100.5, 2)
+		err := inventory.WarmUpInventory(ctx, 1, 1, 100, 100.5, 2)
 		assert.NoError(t, err)
 		stock, err := inventory.GetStock(ctx, 1)
 		assert.NoError(t, err)
@@ -90,7 +92,7 @@ func TestTicketInventory_GetInfo(t *testing.T) {
 
 	t.Run("Success", func(t *testing.T) {
 		defer clearRedis(ctx)
-		err := inventory.WarmUpInventory(ctx, 1, 100, 100.5, 2)
+		err := inventory.WarmUpInventory(ctx, 1, 1, 100, 100.5, 2)
 		assert.NoError(t, err)
 		info, err := inventory.GetInfo(ctx, 1)
 		assert.NoError(t, err)
@@ -118,12 +120,12 @@ func TestTicketInventory_DecreStock(t *testing.T) {
 
 	t.Run("Success", func(t *testing.T) {
 		defer clearRedis(ctx)
-		err := inventory.WarmUpInventory(ctx, 1, 100, 100.5, 2)
+		err := inventory.WarmUpInventory(ctx, 1, 1, 100, 100.5, 2)
 		assert.NoError(t, err)
-		result, price, err := inventory.DecreStock(ctx, 1, 2, 1)
+		result, quote, err := inventory.DecreStock(ctx, 1, 2, 1, "")
 		assert.NoError(t, err)
 		assert.True(t, result)
-		assert.Equal(t, 100.5, price)
+		assert.Equal(t, 100.5, quote.Price)
 
 		// 驗證庫存
 		verifyStock(t, ctx, inventory, 1, 98)
@@ -134,12 +136,12 @@ func TestTicketInventory_DecreStock(t *testing.T) {
 
 	t.Run("Failed - InsufficientStock", func(t *testing.T) {
 		defer clearRedis(ctx)
-		err := inventory.WarmUpInventory(ctx, 1, 1, 100.5, 2)
+		err := inventory.WarmUpInventory(ctx, 1, 1, 1, 100.5, 2)
 		assert.NoError(t, err)
-		result, price, err := inventory.DecreStock(ctx, 1, 2, 1)
+		result, quote, err := inventory.DecreStock(ctx, 1, 2, 1, "")
 		assert.Equal(t, app_errors.ErrInsufficientStock, err)
 		assert.False(t, result)
-		assert.Equal(t, 0.0, price)
+		assert.Equal(t, 0.0, quote.Price)
 
 		// 驗證庫存
 		verifyStock(t, ctx, inventory, 1, 1)
@@ -150,12 +152,12 @@ func TestTicketInventory_DecreStock(t *testing.T) {
 
 	t.Run("Failed - ExceedsMaxPerUser", func(t *testing.T) {
 		defer clearRedis(ctx)
-		err := inventory.WarmUpInventory(ctx, 1, 100, 100.5, 2)
+		err := inventory.WarmUpInventory(ctx, 1, 1, 100, 100.5, 2)
 		assert.NoError(t, err)
-		result, price, err := inventory.DecreStock(ctx, 1, 3, 1)
+		result, quote, err := inventory.DecreStock(ctx, 1, 3, 1, "")
 		assert.Equal(t, app_errors.ErrExceedsMaxPerUser, err)
 		assert.False(t, result)
-		assert.Equal(t, 0.0, price)
+		assert.Equal(t, 0.0, quote.Price)
 
 		// 驗證庫存
 		verifyStock(t, ctx, inventory, 1, 100)
@@ -166,24 +168,24 @@ func TestTicketInventory_DecreStock(t *testing.T) {
 
 	t.Run("Failed - ExceedsMaxPerUser - AlreadyBought", func(t *testing.T) {
 		defer clearRedis(ctx)
-		err := inventory.WarmUpInventory(ctx, 1, 100, 100.5, 2)
+		err := inventory.WarmUpInventory(ctx, 1, 1, 100, 100.5, 2)
 		assert.NoError(t, err)
 
 		// 第一次購買 1 張
-		result, price, err := inventory.DecreStock(ctx, 1, 1, 1)
+		result, quote, err := inventory.DecreStock(ctx, 1, 1, 1, "")
 		assert.NoError(t, err)
 		assert.True(t, result)
-		assert.Equal(t, 100.5, price)
+		assert.Equal(t, 100.5, quote.Price)
 
 		// 驗證購買
 		verifyStock(t, ctx, inventory, 1, 99)
 		verifyUserBought(t, ctx, redis, 1, 1, 1)
 
 		// 第二次購買 2 張，超過個人購買限制
-		result, price, err = inventory.DecreStock(ctx, 1, 2, 1)
+		result, quote, err = inventory.DecreStock(ctx, 1, 2, 1, "")
 		assert.Equal(t, app_errors.ErrExceedsMaxPerUser, err)
 		assert.False(t, result)
-		assert.Equal(t, 0.0, price)
+		assert.Equal(t, 0.0, quote.Price)
 
 		// 驗證第二次購買失敗
 		verifyStock(t, ctx, inventory, 1, 99)
@@ -192,10 +194,10 @@ func TestTicketInventory_DecreStock(t *testing.T) {
 
 	t.Run("Failed - TicketNotFound", func(t *testing.T) {
 		defer clearRedis(ctx)
-		result, price, err := inventory.DecreStock(ctx, 1, 1, 1)
+		result, quote, err := inventory.DecreStock(ctx, 1, 1, 1, "")
 		assert.Equal(t, app_errors.ErrTicketNotFound, err)
 		assert.False(t, result)
-		assert.Equal(t, 0.0, price)
+		assert.Equal(t, 0.0, quote.Price)
 
 		// 驗證使用者購買紀錄
 		verifyUserBought(t, ctx, redis, 1, 1, 0)
@@ -213,11 +215,11 @@ func TestTicketInventory_RollbackStock(t *testing.T) {
 
 	t.Run("Success", func(t *testing.T) {
 		defer clearRedis(ctx)
-		err := inventory.WarmUpInventory(ctx, 1, 100, 100.5, 2)
+		err := inventory.WarmUpInventory(ctx, 1, 1, 100, 100.5, 2)
 		assert.NoError(t, err)
 
 		// 購買 2 張
-		result, _, err := inventory.DecreStock(ctx, 1, 2, 1)
+		result, _, err := inventory.DecreStock(ctx, 1, 2, 1, "")
 		assert.NoError(t, err)
 		assert.True(t, result)
 
@@ -234,3 +236,246 @@ func TestTicketInventory_RollbackStock(t *testing.T) {
 		verifyUserBought(t, ctx, redis, 1, 1, 0)
 	})
 }
+
+func TestTicketInventory_UpdateInfo(t *testing.T) {
+	ctx := context.Background()
+	redis := getTestRdb()
+	inventory := cache.NewRedisTicketInventoryManager(redis)
+	clearRedis(ctx)
+	t.Cleanup(func() {
+		clearRedis(ctx)
+	})
+
+	t.Run("Success - new price applies to next purchase", func(t *testing.T) {
+		defer clearRedis(ctx)
+		assert.NoError(t, inventory.WarmUpInventory(ctx, 1, 1, 10, 100.5, 2))
+
+		assert.NoError(t, inventory.UpdateInfo(ctx, 1, 120.25, 4))
+
+		info, err := inventory.GetInfo(ctx, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, cache.RedisTicketInfo{Stock: 10, Price: 120.25, Limit: 4}, info)
+
+		_, quote, err := inventory.DecreStock(ctx, 1, 3, 1, "")
+		assert.NoError(t, err)
+		assert.Equal(t, 120.25, quote.Price)
+	})
+
+	t.Run("Skips ticket not warmed up", func(t *testing.T) {
+		defer clearRedis(ctx)
+		assert.NoError(t, inventory.UpdateInfo(ctx, 1, 120.25, 4))
+
+		_, err := inventory.GetInfo(ctx, 1)
+		assert.Equal(t, app_errors.ErrTicketNotFound, err)
+	})
+}
+
+func TestTicketInventory_PricePhases(t *testing.T) {
+	ctx := context.Background()
+	redis := getTestRdb()
+	inventory := cache.NewRedisTicketInventoryManager(redis)
+	clearRedis(ctx)
+	t.Cleanup(func() {
+		clearRedis(ctx)
+	})
+
+	soldLimit := 3
+	future := time.Now().Add(time.Hour)
+	phases := []*model.TicketPricePhase{
+		{Name: "Early Bird", Price: 60, SoldLimit: &soldLimit},
+		{Name: "Regular", Price: 80.5, EndsAt: &future},
+	}
+
+	t.Run("Success - early bird until sold limit, then regular", func(t *testing.T) {
+		defer clearRedis(ctx)
+		assert.NoError(t, inventory.WarmUpInventory(ctx, 1, 1, 10, 100, 10))
+		assert.NoError(t, inventory.SetPricePhases(ctx, 1, phases))
+
+		_, quote, err := inventory.DecreStock(ctx, 1, 2, 1, "")
+		assert.NoError(t, err)
+		assert.Equal(t, cache.PriceQuote{Price: 60, Phase: "Early Bird"}, quote)
+
+		// 已售出 2 張，再買 2 張會超過早鳥的 3 張，整筆以下一個階段計價
+		_, quote, err = inventory.DecreStock(ctx, 1, 2, 2, "")
+		assert.NoError(t, err)
+		assert.Equal(t, cache.PriceQuote{Price: 80.5, Phase: "Regular"}, quote)
+
+		// 回滾後售出數量減少，早鳥名額再次可用
+		assert.NoError(t, inventory.RollbackStock(ctx, 1, 2, 2))
+		_, quote, err = inventory.DecreStock(ctx, 1, 1, 3, "")
+		assert.NoError(t, err)
+		assert.Equal(t, "Early Bird", quote.Phase)
+	})
+
+	t.Run("Success - expired phases fall back to ticket price", func(t *testing.T) {
+		defer clearRedis(ctx)
+		past := time.Now().Add(-time.Minute)
+		assert.NoError(t, inventory.WarmUpInventory(ctx, 1, 1, 10, 100, 10))
+		assert.NoError(t, inventory.SetPricePhases(ctx, 1, []*model.TicketPricePhase{{Name: "Early Bird", Price: 60, EndsAt: &past}}))
+
+		_, quote, err := inventory.DecreStock(ctx, 1, 1, 1, "")
+		assert.NoError(t, err)
+		assert.Equal(t, cache.PriceQuote{Price: 100}, quote)
+	})
+
+	t.Run("Success - GetInfo reports current phase", func(t *testing.T) {
+		defer clearRedis(ctx)
+		assert.NoError(t, inventory.WarmUpInventory(ctx, 1, 1, 10, 100, 10))
+		assert.NoError(t, inventory.SetPricePhases(ctx, 1, phases))
+
+		info, err := inventory.GetInfo(ctx, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, cache.RedisTicketInfo{Stock: 10, Price: 60, Limit: 10, Phase: "Early Bird"}, info)
+
+		// 清除階段後回到票種原價
+		assert.NoError(t, inventory.SetPricePhases(ctx, 1, nil))
+		info, err = inventory.GetInfo(ctx, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, cache.RedisTicketInfo{Stock: 10, Price: 100, Limit: 10}, info)
+	})
+
+	t.Run("Success - added stock does not count as sold", func(t *testing.T) {
+		defer clearRedis(ctx)
+		assert.NoError(t, inventory.WarmUpInventory(ctx, 1, 1, 2, 100, 10))
+		assert.NoError(t, inventory.SetPricePhases(ctx, 1, phases))
+		assert.NoError(t, inventory.AdjustStock(ctx, 1, 10))
+
+		_, quote, err := inventory.DecreStock(ctx, 1, 3, 1, "")
+		assert.NoError(t, err)
+		assert.Equal(t, "Early Bird", quote.Phase)
+	})
+
+	t.Run("Skips ticket not warmed up", func(t *testing.T) {
+		defer clearRedis(ctx)
+		assert.NoError(t, inventory.SetPricePhases(ctx, 1, phases))
+
+		exists, err := redis.Exists(ctx, "ticket:1:phases").Result()
+		assert.NoError(t, err)
+		assert.Zero(t, exists)
+	})
+}
+
+func TestTicketInventory_AdjustStock(t *testing.T) {
+	ctx := context.Background()
+	redis := getTestRdb()
+	inventory := cache.NewRedisTicketInventoryManager(redis)
+	clearRedis(ctx)
+	t.Cleanup(func() {
+		clearRedis(ctx)
+	})
+
+	t.Run("Success - increase and decrease", func(t *testing.T) {
+		defer clearRedis(ctx)
+		assert.NoError(t, inventory.WarmUpInventory(ctx, 1, 1, 10, 100.5, 2))
+
+		assert.NoError(t, inventory.AdjustStock(ctx, 1, 5))
+		verifyStock(t, ctx, inventory, 1, 15)
+
+		assert.NoError(t, inventory.AdjustStock(ctx, 1, -15))
+		verifyStock(t, ctx, inventory, 1, 0)
+	})
+
+	t.Run("Failed - decrease below zero", func(t *testing.T) {
+		defer clearRedis(ctx)
+		assert.NoError(t, inventory.WarmUpInventory(ctx, 1, 1, 10, 100.5, 2))
+
+		err := inventory.AdjustStock(ctx, 1, -11)
+		assert.Equal(t, app_errors.ErrInsufficientStock, err)
+		verifyStock(t, ctx, inventory, 1, 10)
+	})
+
+	t.Run("Failed - zero delta", func(t *testing.T) {
+		err := inventory.AdjustStock(ctx, 1, 0)
+		assert.Equal(t, app_errors.ErrInvalidInput, err)
+	})
+
+	t.Run("Skips ticket not warmed up", func(t *testing.T) {
+		defer clearRedis(ctx)
+		assert.NoError(t, inventory.AdjustStock(ctx, 1, 5))
+
+		exists, err := redis.Exists(ctx, "ticket:1:info").Result()
+		assert.NoError(t, err)
+		assert.Zero(t, exists)
+	})
+}
+
+func TestTicketInventory_SubscribeStock(t *testing.T) {
+	ctx := context.Background()
+	redis := getTestRdb()
+	inventory := cache.NewRedisTicketInventoryManager(redis)
+	clearRedis(ctx)
+	t.Cleanup(func() {
+		clearRedis(ctx)
+	})
+
+	t.Run("Success - publishes on DecreStock and RollbackStock", func(t *testing.T) {
+		defer clearRedis(ctx)
+		subCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		assert.NoError(t, inventory.WarmUpInventory(ctx, 1, 1, 3, 100.5, 4))
+		assert.NoError(t, inventory.WarmUpInventory(ctx, 2, 1, 10, 50, 4))
+
+		updates, err := inventory.SubscribeStock(subCtx, []int{1, 2})
+		assert.NoError(t, err)
+
+		_, _, err = inventory.DecreStock(ctx, 1, 3, 1, "")
+		assert.NoError(t, err)
+		err = inventory.RollbackStock(ctx, 1, 1, 1)
+		assert.NoError(t, err)
+		_, _, err = inventory.DecreStock(ctx, 2, 1, 1, "")
+		assert.NoError(t, err)
+
+		expected := []cache.StockUpdate{
+			{TicketID: 1, Stock: 0},
+			{TicketID: 1, Stock: 1},
+			{TicketID: 2, Stock: 9},
+		}
+		for _, want := range expected {
+			select {
+			case got := <-updates:
+				assert.Equal(t, want, got)
+			case <-time.After(2 * time.Second):
+				t.Fatalf("timeout waiting for %+v", want)
+			}
+		}
+	})
+
+	t.Run("Failed DecreStock does not publish", func(t *testing.T) {
+		defer clearRedis(ctx)
+		subCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		assert.NoError(t, inventory.WarmUpInventory(ctx, 1, 1, 1, 100.5, 4))
+		updates, err := inventory.SubscribeStock(subCtx, []int{1})
+		assert.NoError(t, err)
+
+		_, _, err = inventory.DecreStock(ctx, 1, 2, 1, "")
+		assert.Equal(t, app_errors.ErrInsufficientStock, err)
+
+		select {
+		case got := <-updates:
+			t.Fatalf("unexpected update %+v", got)
+		case <-time.After(200 * time.Millisecond):
+		}
+	})
+
+	t.Run("Channel closes when context is cancelled", func(t *testing.T) {
+		subCtx, cancel := context.WithCancel(ctx)
+		updates, err := inventory.SubscribeStock(subCtx, []int{1})
+		assert.NoError(t, err)
+
+		cancel()
+		select {
+		case _, ok := <-updates:
+			assert.False(t, ok)
+		case <-time.After(2 * time.Second):
+			t.Fatal("channel was not closed")
+		}
+	})
+
+	t.Run("Failed - no tickets", func(t *testing.T) {
+		_, err := inventory.SubscribeStock(ctx, nil)
+		assert.Equal(t, app_errors.ErrInvalidInput, err)
+	})
+}
@@ -0,0 +1,297 @@
+package cache
+
+import (
+	"context"
+	"go-gin-high-concurrency/internal/cache"
+	"go-gin-high-concurrency/internal/model"
+	"go-gin-high-concurrency/pkg/app_errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupSoldOutTicket 預熱一個已售完的一般票種：庫存 0、單價 100、每人限購 4
+func setupSoldOutTicket(t *testing.T, ctx context.Context) (cache.RedisTicketInventoryManager, cache.RedisTicketHoldManager, cache.RedisWaitlistManager) {
+	t.Helper()
+	inventory := cache.NewRedisTicketInventoryManager(getTestRdb())
+	holds := cache.NewRedisTicketHoldManager(getTestRdb())
+	waitlist := cache.NewRedisWaitlistManager(getTestRdb())
+	require.NoError(t, inventory.WarmUpInventory(ctx, 1, 1, 0, 100, 4))
+	return inventory, holds, waitlist
+}
+
+func TestWaitlist_JoinAndLeave(t *testing.T) {
+	ctx := context.Background()
+	clearRedis(ctx)
+	t.Cleanup(func() {
+		clearRedis(ctx)
+	})
+
+	t.Run("Success - FIFO positions", func(t *testing.T) {
+		defer clearRedis(ctx)
+		_, _, waitlist := setupSoldOutTicket(t, ctx)
+
+		for i, userID := range []int{100, 200, 300} {
+			position, err := waitlist.Join(ctx, 1, userID, 1)
+			require.NoError(t, err)
+			assert.Equal(t, i+1, position)
+		}
+
+		_, err := waitlist.Join(ctx, 1, 200, 1)
+		assert.ErrorIs(t, err, app_errors.ErrAlreadyWaitlisted)
+
+		require.NoError(t, waitlist.Leave(ctx, 1, 100))
+		assert.ErrorIs(t, waitlist.Leave(ctx, 1, 100), app_errors.ErrWaitlistEntryNotFound)
+
+		entry, err := waitlist.GetEntry(ctx, 1, 300)
+		require.NoError(t, err)
+		assert.Equal(t, model.WaitlistStatusWaiting, entry.Status)
+		assert.Equal(t, 2, entry.Position)
+
+		_, err = waitlist.GetEntry(ctx, 1, 100)
+		assert.ErrorIs(t, err, app_errors.ErrWaitlistEntryNotFound)
+
+		tickets, err := waitlist.ListWaitlistedTickets(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, []int{1}, tickets)
+	})
+
+	t.Run("Failed - ticket not sold out", func(t *testing.T) {
+		defer clearRedis(ctx)
+		inventory := cache.NewRedisTicketInventoryManager(getTestRdb())
+		waitlist := cache.NewRedisWaitlistManager(getTestRdb())
+		require.NoError(t, inventory.WarmUpInventory(ctx, 1, 1, 3, 100, 4))
+
+		_, err := waitlist.Join(ctx, 1, 100, 2)
+		assert.ErrorIs(t, err, app_errors.ErrTicketNotSoldOut)
+
+		// 剩餘庫存不足需求數量時可以候補
+		_, err = waitlist.Join(ctx, 1, 100, 4)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Failed - exceeds max per user", func(t *testing.T) {
+		defer clearRedis(ctx)
+		_, _, waitlist := setupSoldOutTicket(t, ctx)
+
+		_, err := waitlist.Join(ctx, 1, 100, 5)
+		assert.ErrorIs(t, err, app_errors.ErrExceedsMaxPerUser)
+	})
+
+	t.Run("Leaving last entry removes ticket from promotion set", func(t *testing.T) {
+		defer clearRedis(ctx)
+		inventory, _, waitlist := setupSoldOutTicket(t, ctx)
+
+		_, err := waitlist.Join(ctx, 1, 100, 1)
+		require.NoError(t, err)
+		require.NoError(t, waitlist.Leave(ctx, 1, 100))
+
+		tickets, err := waitlist.ListWaitlistedTickets(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, tickets)
+
+		// 離開後不再為其保留庫存
+		require.NoError(t, inventory.RollbackStock(ctx, 1, 1, 999))
+		ok, _, err := inventory.DecreStock(ctx, 1, 1, 300, "")
+		require.NoError(t, err)
+		assert.True(t, ok)
+	})
+}
+
+func TestWaitlist_Promote(t *testing.T) {
+	ctx := context.Background()
+	clearRedis(ctx)
+	t.Cleanup(func() {
+		clearRedis(ctx)
+	})
+
+	t.Run("Released stock goes to waitlist before public buyers", func(t *testing.T) {
+		defer clearRedis(ctx)
+		inventory, holds, waitlist := setupSoldOutTicket(t, ctx)
+
+		_, err := waitlist.Join(ctx, 1, 100, 2)
+		require.NoError(t, err)
+		_, err = waitlist.Join(ctx, 1, 200, 1)
+		require.NoError(t, err)
+
+		// 取消訂單歸還 3 張，但一般購買仍被擋下
+		require.NoError(t, inventory.RollbackStock(ctx, 1, 3, 999))
+		_, _, err = inventory.DecreStock(ctx, 1, 1, 300, "")
+		assert.ErrorIs(t, err, app_errors.ErrInsufficientStock)
+		_, err = holds.CreateHold(ctx, 1, 300, 1, time.Minute)
+		assert.ErrorIs(t, err, app_errors.ErrInsufficientStock)
+
+		promoted, err := waitlist.Promote(ctx, 1, time.Minute, 10)
+		require.NoError(t, err)
+		require.Len(t, promoted, 2)
+		assert.Equal(t, 100, promoted[0].UserID)
+		assert.Equal(t, 2, promoted[0].Quantity)
+		assert.Equal(t, 100.0, promoted[0].Price)
+		assert.Equal(t, 200, promoted[1].UserID)
+
+		stock, err := inventory.GetStock(ctx, 1)
+		require.NoError(t, err)
+		assert.Equal(t, 0, stock)
+
+		entry, err := waitlist.GetEntry(ctx, 1, 100)
+		require.NoError(t, err)
+		assert.Equal(t, model.WaitlistStatusPromoted, entry.Status)
+		assert.Equal(t, promoted[0].HoldID, *entry.HoldID)
+
+		// 遞補的保留可直接轉為訂單，轉換後不再顯示為已遞補
+		_, err = holds.ConvertHold(ctx, promoted[0].HoldID, 100, 1, 2)
+		require.NoError(t, err)
+		_, err = waitlist.GetEntry(ctx, 1, 100)
+		assert.ErrorIs(t, err, app_errors.ErrWaitlistEntryNotFound)
+
+		tickets, err := waitlist.ListWaitlistedTickets(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, tickets)
+	})
+
+	t.Run("Head needing more than released stock is skipped", func(t *testing.T) {
+		defer clearRedis(ctx)
+		inventory, _, waitlist := setupSoldOutTicket(t, ctx)
+
+		_, err := waitlist.Join(ctx, 1, 100, 3)
+		require.NoError(t, err)
+		_, err = waitlist.Join(ctx, 1, 200, 1)
+		require.NoError(t, err)
+		require.NoError(t, inventory.RollbackStock(ctx, 1, 2, 999))
+
+		// 排第一位的需求 3 張超過可用庫存，由後面的候補者先遞補
+		promoted, err := waitlist.Promote(ctx, 1, time.Minute, 10)
+		require.NoError(t, err)
+		require.Len(t, promoted, 1)
+		assert.Equal(t, 200, promoted[0].UserID)
+
+		entry, err := waitlist.GetEntry(ctx, 1, 100)
+		require.NoError(t, err)
+		assert.Equal(t, model.WaitlistStatusWaiting, entry.Status)
+		assert.Equal(t, 1, entry.Position)
+
+		// 剩餘 1 張保留給候補需求，一般購買仍被擋下
+		_, _, err = inventory.DecreStock(ctx, 1, 1, 300, "")
+		assert.ErrorIs(t, err, app_errors.ErrInsufficientStock)
+
+		// 超出候補需求的庫存開放一般購買
+		require.NoError(t, inventory.RollbackStock(ctx, 1, 4, 999))
+		ok, _, err := inventory.DecreStock(ctx, 1, 2, 300, "")
+		require.NoError(t, err)
+		assert.True(t, ok)
+		_, _, err = inventory.DecreStock(ctx, 1, 1, 400, "")
+		assert.ErrorIs(t, err, app_errors.ErrInsufficientStock)
+
+		promoted, err = waitlist.Promote(ctx, 1, time.Minute, 10)
+		require.NoError(t, err)
+		require.Len(t, promoted, 1)
+		assert.Equal(t, 100, promoted[0].UserID)
+		assert.Equal(t, 3, promoted[0].Quantity)
+
+		stock, err := inventory.GetStock(ctx, 1)
+		require.NoError(t, err)
+		assert.Equal(t, 0, stock)
+	})
+
+	t.Run("No deliverable demand promotes nobody", func(t *testing.T) {
+		defer clearRedis(ctx)
+		inventory, _, waitlist := setupSoldOutTicket(t, ctx)
+
+		_, err := waitlist.Join(ctx, 1, 100, 3)
+		require.NoError(t, err)
+
+		// 售完時及釋出的庫存不足任何候補數量時都不遞補
+		promoted, err := waitlist.Promote(ctx, 1, time.Minute, 10)
+		require.NoError(t, err)
+		assert.Empty(t, promoted)
+		require.NoError(t, inventory.RollbackStock(ctx, 1, 2, 999))
+		promoted, err = waitlist.Promote(ctx, 1, time.Minute, 10)
+		require.NoError(t, err)
+		assert.Empty(t, promoted)
+
+		keys, err := getTestRdb().Keys(ctx, "hold:*").Result()
+		require.NoError(t, err)
+		assert.Empty(t, keys)
+	})
+
+	t.Run("Promotions stay unnotified until marked", func(t *testing.T) {
+		defer clearRedis(ctx)
+		inventory, holds, waitlist := setupSoldOutTicket(t, ctx)
+
+		_, err := waitlist.Join(ctx, 1, 100, 1)
+		require.NoError(t, err)
+		_, err = waitlist.Join(ctx, 1, 200, 1)
+		require.NoError(t, err)
+		require.NoError(t, inventory.RollbackStock(ctx, 1, 2, 999))
+
+		promoted, err := waitlist.Promote(ctx, 1, time.Minute, 10)
+		require.NoError(t, err)
+		require.Len(t, promoted, 2)
+
+		pending, err := waitlist.ListUnnotified(ctx)
+		require.NoError(t, err)
+		require.Len(t, pending, 2)
+		for _, hold := range pending {
+			assert.Equal(t, 1, hold.TicketID)
+			assert.Equal(t, 1, hold.Quantity)
+			assert.WithinDuration(t, promoted[0].ExpiresAt, hold.ExpiresAt, time.Second)
+		}
+
+		require.NoError(t, waitlist.MarkNotified(ctx, []uuid.UUID{promoted[0].HoldID}))
+		pending, err = waitlist.ListUnnotified(ctx)
+		require.NoError(t, err)
+		require.Len(t, pending, 1)
+		assert.Equal(t, promoted[1].HoldID, pending[0].HoldID)
+
+		// 已釋放的保留不再通知
+		require.NoError(t, holds.ReleaseHold(ctx, promoted[1].HoldID, promoted[1].UserID))
+		pending, err = waitlist.ListUnnotified(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, pending)
+	})
+
+	t.Run("Expired promotion returns stock to the next in line", func(t *testing.T) {
+		defer clearRedis(ctx)
+		inventory, holds, waitlist := setupSoldOutTicket(t, ctx)
+
+		_, err := waitlist.Join(ctx, 1, 100, 1)
+		require.NoError(t, err)
+		_, err = waitlist.Join(ctx, 1, 200, 1)
+		require.NoError(t, err)
+		require.NoError(t, inventory.RollbackStock(ctx, 1, 1, 999))
+
+		promoted, err := waitlist.Promote(ctx, 1, time.Minute, 10)
+		require.NoError(t, err)
+		require.Len(t, promoted, 1)
+
+		// 遞補的保留未到期前不可重新候補
+		_, err = waitlist.Join(ctx, 1, 100, 1)
+		assert.ErrorIs(t, err, app_errors.ErrAlreadyWaitlisted)
+
+		released, err := holds.ReleaseExpired(ctx, time.Now().Add(2*time.Minute), 10)
+		require.NoError(t, err)
+		assert.Equal(t, 1, released)
+		_, err = waitlist.GetEntry(ctx, 1, 100)
+		assert.ErrorIs(t, err, app_errors.ErrWaitlistEntryNotFound)
+
+		promoted, err = waitlist.Promote(ctx, 1, time.Minute, 10)
+		require.NoError(t, err)
+		require.Len(t, promoted, 1)
+		assert.Equal(t, 200, promoted[0].UserID)
+	})
+}
+
+func TestTicketInventory_RollbackStockSkipsMissingTicket(t *testing.T) {
+	ctx := context.Background()
+	clearRedis(ctx)
+	defer clearRedis(ctx)
+
+	inventory := cache.NewRedisTicketInventoryManager(getTestRdb())
+	require.NoError(t, inventory.RollbackStock(ctx, 1, 2, 100))
+
+	_, err := inventory.GetInfo(ctx, 1)
+	assert.ErrorIs(t, err, app_errors.ErrTicketNotFound)
+}
@@ -0,0 +1,167 @@
+package database
+
+import (
+	"context"
+	"testing"
+	"testing/fstest"
+
+	"go-gin-high-concurrency/config"
+	"go-gin-high-concurrency/internal/database"
+	"go-gin-high-concurrency/migrations"
+	"go-gin-high-concurrency/pkg/app_errors"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testMigrationsTable = "migrator_test_schema_migrations"
+
+func testMigrationFS() fstest.MapFS {
+	return fstest.MapFS{
+		"001_create_items.up.sql":    {Data: []byte("CREATE TABLE migrator_test_items (id SERIAL PRIMARY KEY);")},
+		"001_create_items.down.sql":  {Data: []byte("DROP TABLE migrator_test_items;")},
+		"002_add_item_name.up.sql":   {Data: []byte("ALTER TABLE migrator_test_items ADD COLUMN name TEXT;\nCREATE INDEX migrator_test_items_name ON migrator_test_items(name);")},
+		"002_add_item_name.down.sql": {Data: []byte("ALTER TABLE migrator_test_items DROP COLUMN name;")},
+		"003_broken.up.sql":          {Data: []byte("ALTER TABLE migrator_test_missing ADD COLUMN name TEXT;")},
+		"003_broken.down.sql":        {Data: []byte("SELECT 1;")},
+		"README.md":                  {Data: []byte("ignored")},
+	}
+}
+
+func TestLoadMigrations(t *testing.T) {
+	t.Run("Success - sorted by version", func(t *testing.T) {
+		got, err := database.LoadMigrations(testMigrationFS())
+
+		require.NoError(t, err)
+		require.Len(t, got, 3)
+		assert.Equal(t, 1, got[0].Version)
+		assert.Equal(t, "create_items", got[0].Name)
+		assert.Contains(t, got[1].Up, "CREATE INDEX")
+		assert.Equal(t, "DROP TABLE migrator_test_items;", got[0].Down)
+	})
+
+	t.Run("Success - embedded migrations", func(t *testing.T) {
+		got, err := database.LoadMigrations(migrations.FS)
+
+		require.NoError(t, err)
+		require.NotEmpty(t, got)
+		for i, m := range got {
+			assert.NotEmpty(t, m.Up, "migration %d", m.Version)
+			assert.NotEmpty(t, m.Down, "migration %d", m.Version)
+			if i > 0 {
+				assert.Greater(t, m.Version, got[i-1].Version)
+			}
+		}
+	})
+
+	t.Run("Failed - missing up file", func(t *testing.T) {
+		_, err := database.LoadMigrations(fstest.MapFS{
+			"001_create_items.down.sql": {Data: []byte("DROP TABLE migrator_test_items;")},
+		})
+
+		assert.Error(t, err)
+	})
+
+	t.Run("Failed - duplicate version", func(t *testing.T) {
+		_, err := database.LoadMigrations(fstest.MapFS{
+			"001_create_items.up.sql": {Data: []byte("SELECT 1;")},
+			"001_create_users.up.sql": {Data: []byte("SELECT 1;")},
+		})
+
+		assert.Error(t, err)
+	})
+}
+
+// getTestPool 連接測試資料庫，無法連線時略過（LoadMigrations 的測試不需要資料庫）
+func getTestPool(t *testing.T) *pgxpool.Pool {
+	t.Helper()
+	cfg := config.LoadTestConfig()
+	pool, err := database.InitDatabase(&cfg.Database)
+	if err != nil {
+		t.Skipf("test database unavailable: %v", err)
+	}
+	t.Cleanup(func() {
+		ctx := context.Background()
+		_, _ = pool.Exec(ctx, "DROP TABLE IF EXISTS migrator_test_items")
+		_, _ = pool.Exec(ctx, "DROP TABLE IF EXISTS "+testMigrationsTable)
+		pool.Close()
+	})
+	return pool
+}
+
+func TestMigrator(t *testing.T) {
+	ctx := context.Background()
+	pool := getTestPool(t)
+	fsys := testMigrationFS()
+	delete(fsys, "003_broken.up.sql")
+	delete(fsys, "003_broken.down.sql")
+	migrator, err := database.NewMigrator(pool, fsys, &database.MigratorConfig{TableName: testMigrationsTable})
+	require.NoError(t, err)
+
+	status, err := migrator.Status(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, database.NilVersion, status.Version)
+
+	applied, err := migrator.Up(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, applied)
+
+	// 再次執行不重複套用
+	applied, err = migrator.Up(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, applied)
+
+	status, err = migrator.Status(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, status.Version)
+	assert.False(t, status.Dirty)
+	assert.True(t, status.Migrations[1].Applied)
+
+	reverted, err := migrator.Down(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 1, reverted)
+	status, err = migrator.Status(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, status.Version)
+	assert.False(t, status.Migrations[1].Applied)
+
+	reverted, err = migrator.Down(ctx, 5)
+	require.NoError(t, err)
+	assert.Equal(t, 1, reverted)
+	status, err = migrator.Status(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, database.NilVersion, status.Version)
+}
+
+func TestMigrator_DirtyAfterFailure(t *testing.T) {
+	ctx := context.Background()
+	pool := getTestPool(t)
+	migrator, err := database.NewMigrator(pool, testMigrationFS(), &database.MigratorConfig{TableName: testMigrationsTable})
+	require.NoError(t, err)
+
+	applied, err := migrator.Up(ctx)
+	require.Error(t, err)
+	assert.Equal(t, 2, applied)
+
+	status, err := migrator.Status(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 3, status.Version)
+	assert.True(t, status.Dirty)
+	assert.False(t, status.Migrations[2].Applied)
+
+	// dirty 時拒絕執行，需先手動修復再 force
+	_, err = migrator.Up(ctx)
+	assert.ErrorIs(t, err, app_errors.ErrMigrationDirty)
+
+	require.NoError(t, migrator.Force(ctx, 2))
+	status, err = migrator.Status(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, status.Version)
+	assert.False(t, status.Dirty)
+
+	assert.ErrorIs(t, migrator.Force(ctx, 9), app_errors.ErrMigrationNotFound)
+
+	_, err = migrator.Down(ctx, 2)
+	require.NoError(t, err)
+}
@@ -0,0 +1,324 @@
+package grpcserver
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	ticketingv1 "go-gin-high-concurrency/api/ticketing/v1"
+	"go-gin-high-concurrency/internal/grpcserver"
+	"go-gin-high-concurrency/internal/model"
+	"go-gin-high-concurrency/internal/service/mocks"
+	apperrors "go-gin-high-concurrency/pkg/app_errors"
+	"go-gin-high-concurrency/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+type testServices struct {
+	events  *mocks.MockEventService
+	tickets *mocks.MockTicketService
+	orders  *mocks.MockOrderService
+}
+
+// setupTicketingClient 以 bufconn 啟動 gRPC Server，回傳連到該 Server 的 client
+func setupTicketingClient(t *testing.T) (ticketingv1.TicketingServiceClient, testServices) {
+	services := testServices{
+		events:  mocks.NewMockEventService(t),
+		tickets: mocks.NewMockTicketService(t),
+		orders:  mocks.NewMockOrderService(t),
+	}
+	ticketing := grpcserver.NewTicketingServer(services.events, services.tickets, services.orders, &grpcserver.Config{
+		WatchInterval: 10 * time.Millisecond,
+	})
+	srv := grpcserver.NewServer(ticketing)
+
+	lis := bufconn.Listen(1024 * 1024)
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return ticketingv1.NewTicketingServiceClient(conn), services
+}
+
+// errorReason 取出 status details 中 ErrorInfo 的錯誤碼
+func errorReason(t *testing.T, err error) string {
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok {
+			return info.Reason
+		}
+	}
+	return ""
+}
+
+func TestTicketingServer_GetEvent(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		client, services := setupTicketingClient(t)
+		eventID := uuid.New()
+		maxPerUser := 4
+		services.events.EXPECT().GetByEventID(mock.Anything, eventID).Return(&model.Event{
+			ID: 1, EventID: eventID, Name: "Concert", MaxPerUser: &maxPerUser, CreatedAt: time.Now(),
+		}, nil).Once()
+
+		event, err := client.GetEvent(context.Background(), &ticketingv1.GetEventRequest{EventId: eventID.String()})
+
+		require.NoError(t, err)
+		assert.Equal(t, eventID.String(), event.EventId)
+		assert.Equal(t, "Concert", event.Name)
+		assert.Equal(t, int32(4), event.GetMaxPerUser())
+		assert.NotNil(t, event.CreatedAt)
+	})
+
+	t.Run("Failed - not found", func(t *testing.T) {
+		client, services := setupTicketingClient(t)
+		eventID := uuid.New()
+		services.events.EXPECT().GetByEventID(mock.Anything, eventID).Return(nil, apperrors.ErrEventNotFound).Once()
+
+		_, err := client.GetEvent(context.Background(), &ticketingv1.GetEventRequest{EventId: eventID.String()})
+
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		assert.Equal(t, apperrors.CodeEventNotFound, errorReason(t, err))
+	})
+
+	t.Run("Failed - invalid uuid", func(t *testing.T) {
+		client, _ := setupTicketingClient(t)
+
+		_, err := client.GetEvent(context.Background(), &ticketingv1.GetEventRequest{EventId: "not-a-uuid"})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Equal(t, apperrors.CodeInvalidInput, errorReason(t, err))
+	})
+}
+
+func TestTicketingServer_GetTicketAvailability(t *testing.T) {
+	client, services := setupTicketingClient(t)
+	ticketID := uuid.New()
+	services.tickets.EXPECT().GetAvailability(mock.Anything, ticketID).Return(&model.TicketResponse{
+		ID: 3, TicketID: ticketID, Name: "VIP", Price: 800, PricePhase: "early_bird",
+		TotalStock: 100, RemainingStock: 5, Available: true, Status: model.StockStatusLowStock,
+	}, nil).Once()
+
+	availability, err := client.GetTicketAvailability(context.Background(), &ticketingv1.GetTicketAvailabilityRequest{TicketId: ticketID.String()})
+
+	require.NoError(t, err)
+	assert.Equal(t, int32(5), availability.RemainingStock)
+	assert.Equal(t, "early_bird", availability.PricePhase)
+	assert.Equal(t, ticketingv1.StockStatus_STOCK_STATUS_LOW_STOCK, availability.Status)
+}
+
+func TestTicketingServer_CreateOrder(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		client, services := setupTicketingClient(t)
+		var captured model.CreateOrderRequest
+		services.orders.EXPECT().PrepareOrder(mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, req model.CreateOrderRequest) (*model.Order, error) {
+				captured = req
+				return &model.Order{
+					RequestID:     "req-1",
+					UserID:        req.UserID,
+					TicketID:      req.TicketID,
+					Quantity:      req.Quantity,
+					TotalPrice:    200,
+					Status:        model.OrderStatusPending,
+					CorrelationID: logger.RequestID(ctx),
+				}, nil
+			}).Once()
+
+		price := 100.0
+		fingerprint := "device-1"
+		ctx := metadata.AppendToOutgoingContext(context.Background(), grpcserver.RequestIDMetadataKey, "partner-trace-1")
+		var header metadata.MD
+		order, err := client.CreateOrder(ctx, &ticketingv1.CreateOrderRequest{
+			UserId: 1, TicketId: 2, Quantity: 2, ExpectedPrice: &price, DeviceFingerprint: &fingerprint,
+		}, grpc.Header(&header))
+
+		require.NoError(t, err)
+		assert.Equal(t, "req-1", order.RequestId)
+		assert.Empty(t, order.OrderId)
+		assert.Nil(t, order.CreatedAt)
+		assert.Equal(t, ticketingv1.OrderStatus_ORDER_STATUS_PENDING, order.Status)
+		assert.Equal(t, 2, captured.Quantity)
+		assert.Equal(t, &price, captured.ExpectedPrice)
+		assert.Equal(t, "device-1", captured.DeviceFingerprint)
+		assert.NotEmpty(t, captured.ClientIP)
+		assert.Equal(t, []string{"partner-trace-1"}, header.Get(grpcserver.RequestIDMetadataKey))
+	})
+
+	t.Run("Failed - validation reports every field", func(t *testing.T) {
+		client, _ := setupTicketingClient(t)
+		holdID := "bad"
+
+		_, err := client.CreateOrder(context.Background(), &ticketingv1.CreateOrderRequest{HoldId: &holdID})
+
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Equal(t, apperrors.CodeValidationFailed, errorReason(t, err))
+		var fields []string
+		for _, detail := range status.Convert(err).Details() {
+			if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+				for _, violation := range badRequest.FieldViolations {
+					fields = append(fields, violation.Field)
+				}
+			}
+		}
+		assert.ElementsMatch(t, []string{"user_id", "ticket_id", "quantity", "hold_id"}, fields)
+	})
+
+	t.Run("Failed - insufficient stock", func(t *testing.T) {
+		client, services := setupTicketingClient(t)
+		services.orders.EXPECT().PrepareOrder(mock.Anything, mock.Anything).Return(nil, apperrors.ErrInsufficientStock).Once()
+
+		_, err := client.CreateOrder(context.Background(), &ticketingv1.CreateOrderRequest{UserId: 1, TicketId: 2, Quantity: 1})
+
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		assert.Equal(t, apperrors.CodeInsufficientStock, errorReason(t, err))
+	})
+}
+
+func TestTicketingServer_GetOrder(t *testing.T) {
+	t.Run("Success - by request id", func(t *testing.T) {
+		client, services := setupTicketingClient(t)
+		orderID := uuid.New()
+		services.orders.EXPECT().GetOrderByRequestID(mock.Anything, "req-1").Return(&model.Order{
+			OrderID: orderID, RequestID: "req-1", Status: model.OrderStatusConfirmed, SeatIDs: []int{7, 8},
+		}, nil).Once()
+
+		order, err := client.GetOrder(context.Background(), &ticketingv1.GetOrderRequest{
+			Lookup: &ticketingv1.GetOrderRequest_RequestId{RequestId: "req-1"},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, orderID.String(), order.OrderId)
+		assert.Equal(t, []int32{7, 8}, order.SeatIds)
+		assert.Equal(t, ticketingv1.OrderStatus_ORDER_STATUS_CONFIRMED, order.Status)
+	})
+
+	t.Run("Failed - internal error is not exposed", func(t *testing.T) {
+		client, services := setupTicketingClient(t)
+		orderID := uuid.New()
+		services.orders.EXPECT().GetOrderByOrderID(mock.Anything, orderID).Return(nil, assert.AnError).Once()
+
+		_, err := client.GetOrder(context.Background(), &ticketingv1.GetOrderRequest{
+			Lookup: &ticketingv1.GetOrderRequest_OrderId{OrderId: orderID.String()},
+		})
+
+		assert.Equal(t, codes.Internal, status.Code(err))
+		assert.NotContains(t, status.Convert(err).Message(), assert.AnError.Error())
+	})
+}
+
+func TestTicketingServer_CancelOrder(t *testing.T) {
+	client, services := setupTicketingClient(t)
+	orderID := uuid.New()
+	reason := "partner refund"
+	services.orders.EXPECT().CancelOrderByOrderID(mock.Anything, orderID, model.OrderStatusChange{Actor: model.OrderActorUser, Reason: &reason}).Return(nil).Once()
+	services.orders.EXPECT().GetOrderByOrderID(mock.Anything, orderID).Return(&model.Order{
+		OrderID: orderID, Status: model.OrderStatusCancelled,
+	}, nil).Once()
+
+	order, err := client.CancelOrder(context.Background(), &ticketingv1.CancelOrderRequest{OrderId: orderID.String(), Reason: reason})
+
+	require.NoError(t, err)
+	assert.Equal(t, ticketingv1.OrderStatus_ORDER_STATUS_CANCELLED, order.Status)
+}
+
+func TestTicketingServer_WatchOrder(t *testing.T) {
+	t.Run("Success - waits for persistence and ends on terminal status", func(t *testing.T) {
+		client, services := setupTicketingClient(t)
+		orderID := uuid.New()
+		pending := &model.Order{OrderID: orderID, RequestID: "req-1", Status: model.OrderStatusPending}
+		confirmed := &model.Order{OrderID: orderID, RequestID: "req-1", Status: model.OrderStatusConfirmed}
+		refunded := &model.Order{OrderID: orderID, RequestID: "req-1", Status: model.OrderStatusRefunded}
+		services.orders.EXPECT().GetOrderByRequestID(mock.Anything, "req-1").Return(nil, apperrors.ErrOrderNotFound).Twice()
+		services.orders.EXPECT().GetOrderByRequestID(mock.Anything, "req-1").Return(pending, nil).Twice()
+		services.orders.EXPECT().GetOrderByRequestID(mock.Anything, "req-1").Return(confirmed, nil).Once()
+		services.orders.EXPECT().GetOrderByRequestID(mock.Anything, "req-1").Return(refunded, nil).Once()
+
+		stream, err := client.WatchOrder(context.Background(), &ticketingv1.WatchOrderRequest{
+			Lookup: &ticketingv1.WatchOrderRequest_RequestId{RequestId: "req-1"},
+		})
+		require.NoError(t, err)
+
+		var statuses []ticketingv1.OrderStatus
+		for {
+			order, err := stream.Recv()
+			if err != nil {
+				require.ErrorIs(t, err, io.EOF)
+				break
+			}
+			statuses = append(statuses, order.Status)
+		}
+		assert.Equal(t, []ticketingv1.OrderStatus{
+			ticketingv1.OrderStatus_ORDER_STATUS_PENDING,
+			ticketingv1.OrderStatus_ORDER_STATUS_CONFIRMED,
+			ticketingv1.OrderStatus_ORDER_STATUS_REFUNDED,
+		}, statuses)
+	})
+
+	t.Run("Failed - unknown order id", func(t *testing.T) {
+		client, services := setupTicketingClient(t)
+		orderID := uuid.New()
+		services.orders.EXPECT().GetOrderByOrderID(mock.Anything, orderID).Return(nil, apperrors.ErrOrderNotFound).Once()
+
+		stream, err := client.WatchOrder(context.Background(), &ticketingv1.WatchOrderRequest{
+			Lookup: &ticketingv1.WatchOrderRequest_OrderId{OrderId: orderID.String()},
+		})
+		require.NoError(t, err)
+
+		_, err = stream.Recv()
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		assert.Equal(t, apperrors.CodeOrderNotFound, errorReason(t, err))
+	})
+
+	t.Run("Success - client cancel stops polling", func(t *testing.T) {
+		client, services := setupTicketingClient(t)
+		orderID := uuid.New()
+		services.orders.EXPECT().GetOrderByOrderID(mock.Anything, orderID).Return(&model.Order{
+			OrderID: orderID, Status: model.OrderStatusPending,
+		}, nil)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		stream, err := client.WatchOrder(ctx, &ticketingv1.WatchOrderRequest{
+			Lookup: &ticketingv1.WatchOrderRequest_OrderId{OrderId: orderID.String()},
+		})
+		require.NoError(t, err)
+
+		order, err := stream.Recv()
+		require.NoError(t, err)
+		assert.Equal(t, ticketingv1.OrderStatus_ORDER_STATUS_PENDING, order.Status)
+
+		cancel()
+		_, err = stream.Recv()
+		assert.Equal(t, codes.Canceled, status.Code(err))
+	})
+}
+
+func TestTicketingServer_Recovery(t *testing.T) {
+	client, services := setupTicketingClient(t)
+	services.tickets.EXPECT().List(mock.Anything).RunAndReturn(func(context.Context) ([]*model.Ticket, error) {
+		panic("boom")
+	}).Once()
+
+	_, err := client.ListTickets(context.Background(), &ticketingv1.ListTicketsRequest{})
+
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
@@ -0,0 +1,189 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go-gin-high-concurrency/internal/handler"
+	"go-gin-high-concurrency/internal/middleware"
+	"go-gin-high-concurrency/internal/model"
+	"go-gin-high-concurrency/internal/service/mocks"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	apperrors "go-gin-high-concurrency/pkg/app_errors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// setupErrorTestRouter 與正式環境相同，帶 request id 並註冊 fallback 路由
+func setupErrorTestRouter(mockService *mocks.MockOrderService) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(middleware.RequestID())
+
+	handler.NewOrderHandler(mockService).RegisterRoutes(router)
+	handler.RegisterFallbackRoutes(router)
+	return router
+}
+
+func decodeErrorResponse(t *testing.T, w *httptest.ResponseRecorder) apperrors.ErrorResponse {
+	t.Helper()
+	var got apperrors.ErrorResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &got), w.Body.String())
+	require.NotNil(t, got.Error, w.Body.String())
+	return got
+}
+
+func TestErrorResponse(t *testing.T) {
+	t.Run("Failed - service error envelope", func(t *testing.T) {
+		mockService := mocks.NewMockOrderService(t)
+		router := setupErrorTestRouter(mockService)
+
+		// 包裝過的 sentinel error 仍以 errors.Is 對應
+		mockService.EXPECT().PrepareOrder(mock.Anything, mock.Anything).
+			Return(nil, fmt.Errorf("prepare order: %w", apperrors.ErrInsufficientStock)).Once()
+
+		req := createJSONHTTPRequest("POST", "/api/v1/orders", model.CreateOrderRequest{UserID: 1, TicketID: 1, Quantity: 1})
+		req.Header.Set(middleware.RequestIDHeader, "req-123")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+		got := decodeErrorResponse(t, w)
+		assert.Equal(t, apperrors.CodeInsufficientStock, got.Error.Code)
+		assert.Equal(t, "Insufficient stock", got.Error.Message)
+		assert.Empty(t, got.Error.Details)
+		assert.Equal(t, "req-123", got.RequestID)
+	})
+
+	t.Run("Failed - unexpected error hides internal message", func(t *testing.T) {
+		mockService := mocks.NewMockOrderService(t)
+		router := setupErrorTestRouter(mockService)
+
+		mockService.EXPECT().PrepareOrder(mock.Anything, mock.Anything).
+			Return(nil, errors.New("dial tcp 10.0.0.1:5432: connection refused")).Once()
+
+		req := createJSONHTTPRequest("POST", "/api/v1/orders", model.CreateOrderRequest{UserID: 1, TicketID: 1, Quantity: 1})
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		got := decodeErrorResponse(t, w)
+		assert.Equal(t, apperrors.CodeInternal, got.Error.Code)
+		assert.NotContains(t, w.Body.String(), "10.0.0.1")
+	})
+
+	t.Run("Failed - validation details", func(t *testing.T) {
+		mockService := mocks.NewMockOrderService(t)
+		router := setupErrorTestRouter(mockService)
+
+		req := createJSONHTTPRequest("POST", "/api/v1/orders", map[string]interface{}{"user_id": 1, "quantity": 0})
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		got := decodeErrorResponse(t, w)
+		assert.Equal(t, apperrors.CodeValidationFailed, got.Error.Code)
+		assert.ElementsMatch(t, []apperrors.FieldError{
+			{Field: "ticket_id", Rule: "required", Message: "is required"},
+			{Field: "quantity", Rule: "required", Message: "is required"},
+		}, got.Error.Details)
+	})
+
+	t.Run("Failed - type mismatch", func(t *testing.T) {
+		mockService := mocks.NewMockOrderService(t)
+		router := setupErrorTestRouter(mockService)
+
+		req := createJSONHTTPRequest("POST", "/api/v1/orders", map[string]interface{}{"user_id": 1, "ticket_id": "abc", "quantity": 1})
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		got := decodeErrorResponse(t, w)
+		assert.Equal(t, apperrors.CodeValidationFailed, got.Error.Code)
+		require.Len(t, got.Error.Details, 1)
+		assert.Equal(t, "ticket_id", got.Error.Details[0].Field)
+		assert.Equal(t, "type", got.Error.Details[0].Rule)
+	})
+
+	t.Run("Failed - malformed JSON", func(t *testing.T) {
+		mockService := mocks.NewMockOrderService(t)
+		router := setupErrorTestRouter(mockService)
+
+		req, _ := http.NewRequest("POST", "/api/v1/orders", bytes.NewBufferString(InvalidJSON))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		got := decodeErrorResponse(t, w)
+		assert.Equal(t, apperrors.CodeValidationFailed, got.Error.Code)
+		assert.Equal(t, "Malformed JSON body", got.Error.Message)
+	})
+
+	t.Run("Failed - invalid path parameter", func(t *testing.T) {
+		mockService := mocks.NewMockOrderService(t)
+		router := setupErrorTestRouter(mockService)
+
+		req, _ := http.NewRequest("GET", "/api/v1/orders/not-a-uuid", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		got := decodeErrorResponse(t, w)
+		assert.Equal(t, apperrors.CodeInvalidInput, got.Error.Code)
+		assert.Equal(t, "Invalid order uuid", got.Error.Message)
+	})
+
+	t.Run("Failed - unknown route and method", func(t *testing.T) {
+		mockService := mocks.NewMockOrderService(t)
+		router := setupErrorTestRouter(mockService)
+
+		req, _ := http.NewRequest("GET", "/api/v1/unknown", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Equal(t, apperrors.CodeNotFound, decodeErrorResponse(t, w).Error.Code)
+
+		req, _ = http.NewRequest("DELETE", "/api/v1/orders", nil)
+		w = httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+		assert.Equal(t, apperrors.CodeMethodNotAllowed, decodeErrorResponse(t, w).Error.Code)
+	})
+}
+
+func TestAppErrorFrom(t *testing.T) {
+	t.Run("Success - sentinel errors keep their status", func(t *testing.T) {
+		cases := []struct {
+			err    error
+			status int
+			code   string
+		}{
+			{apperrors.ErrTicketNotFound, http.StatusNotFound, apperrors.CodeTicketNotFound},
+			{apperrors.ErrOrderBlocked, http.StatusForbidden, apperrors.CodeOrderBlocked},
+			{apperrors.ErrHoldExpired, http.StatusNotFound, apperrors.CodeHoldExpired},
+			{apperrors.ErrAlreadyExists, http.StatusConflict, apperrors.CodeAlreadyExists},
+			{apperrors.ErrQueueFull, http.StatusServiceUnavailable, apperrors.CodeQueueFull},
+			{fmt.Errorf("wrap: %w", apperrors.ErrInvalidInput), http.StatusBadRequest, apperrors.CodeInvalidInput},
+		}
+		for _, tc := range cases {
+			got := apperrors.From(tc.err)
+			assert.Equal(t, tc.status, got.Status, tc.err.Error())
+			assert.Equal(t, tc.code, got.Code, tc.err.Error())
+			assert.ErrorIs(t, got, tc.err)
+		}
+	})
+
+	t.Run("Success - existing AppError is kept", func(t *testing.T) {
+		appErr := apperrors.New(http.StatusTeapot, "TEAPOT", "I'm a teapot")
+		got := apperrors.From(fmt.Errorf("wrap: %w", appErr))
+		assert.Same(t, appErr, got)
+	})
+}
@@ -0,0 +1,180 @@
+package handler
+
+import (
+	"encoding/json"
+	"go-gin-high-concurrency/internal/handler"
+	"go-gin-high-concurrency/internal/model"
+	"go-gin-high-concurrency/internal/service/mocks"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	apperrors "go-gin-high-concurrency/pkg/app_errors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func setupEventTestRouter(mockService *mocks.MockEventService) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	eventHandler := handler.NewEventHandler(mockService)
+	eventHandler.RegisterRoutes(router)
+
+	return router
+}
+
+func TestStreamStock(t *testing.T) {
+	eventID := uuid.New()
+
+	t.Run("Success - stock, low_stock and sold_out events", func(t *testing.T) {
+		mockService := mocks.NewMockEventService(t)
+		router := setupEventTestRouter(mockService)
+
+		ticketID := uuid.New()
+		stocks := make(chan *model.TicketStock, 4)
+		stocks <- &model.TicketStock{TicketID: ticketID, Name: "A", Stock: 20, Status: model.StockStatusAvailable}
+		stocks <- &model.TicketStock{TicketID: ticketID, Name: "A", Stock: 5, Status: model.StockStatusLowStock}
+		stocks <- &model.TicketStock{TicketID: ticketID, Name: "A", Stock: 4, Status: model.StockStatusLowStock}
+		stocks <- &model.TicketStock{TicketID: ticketID, Name: "A", Stock: 0, Status: model.StockStatusSoldOut}
+		close(stocks)
+
+		mockService.EXPECT().SubscribeStock(mock.Anything, eventID).Return((<-chan *model.TicketStock)(stocks), nil).Once()
+
+		req, _ := http.NewRequest("GET", "/api/v1/events/"+eventID.String()+"/stock/stream", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Header().Get("Content-Type"), "text/event-stream")
+
+		body := w.Body.String()
+		assert.Equal(t, 4, strings.Count(body, "event:stock\n"))
+		// 狀態轉換時才送出，連續兩次 low_stock 只會送一次
+		assert.Equal(t, 1, strings.Count(body, "event:low_stock\n"))
+		assert.Equal(t, 1, strings.Count(body, "event:sold_out\n"))
+		assert.Contains(t, body, `"stock":0`)
+	})
+
+	t.Run("Failed - Invalid UUID", func(t *testing.T) {
+		mockService := mocks.NewMockEventService(t)
+		router := setupEventTestRouter(mockService)
+
+		req, _ := http.NewRequest("GET", "/api/v1/events/invalid-uuid/stock/stream", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertNotCalled(t, "SubscribeStock")
+	})
+
+	t.Run("Failed - ErrEventNotFound", func(t *testing.T) {
+		mockService := mocks.NewMockEventService(t)
+		router := setupEventTestRouter(mockService)
+
+		mockService.EXPECT().SubscribeStock(mock.Anything, eventID).Return(nil, apperrors.ErrEventNotFound).Once()
+
+		req, _ := http.NewRequest("GET", "/api/v1/events/"+eventID.String()+"/stock/stream", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestListEventAvailability(t *testing.T) {
+	eventID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		mockService := mocks.NewMockEventService(t)
+		router := setupEventTestRouter(mockService)
+
+		mockService.EXPECT().ListAvailability(mock.Anything, eventID).Return([]*model.TicketResponse{
+			{ID: 1, TicketID: uuid.New(), RemainingStock: 20, Available: true, Status: model.StockStatusAvailable},
+			{ID: 2, TicketID: uuid.New(), RemainingStock: 0, Available: false, Status: model.StockStatusSoldOut},
+		}, nil).Once()
+
+		req, _ := http.NewRequest("GET", "/api/v1/events/"+eventID.String()+"/availability", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.NotEmpty(t, w.Header().Get("ETag"))
+		assert.NotEmpty(t, w.Header().Get("Cache-Control"))
+
+		var body []model.TicketResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+		require.Len(t, body, 2)
+		assert.False(t, body[1].Available)
+	})
+
+	t.Run("Failed - ErrEventNotFound", func(t *testing.T) {
+		mockService := mocks.NewMockEventService(t)
+		router := setupEventTestRouter(mockService)
+
+		mockService.EXPECT().ListAvailability(mock.Anything, eventID).Return(nil, apperrors.ErrEventNotFound).Once()
+
+		req, _ := http.NewRequest("GET", "/api/v1/events/"+eventID.String()+"/availability", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestEventMaxPerUser(t *testing.T) {
+	eventID := uuid.New()
+
+	t.Run("Success - create with cap", func(t *testing.T) {
+		mockService := mocks.NewMockEventService(t)
+		router := setupEventTestRouter(mockService)
+
+		maxPerUser := 6
+		mockService.EXPECT().Create(mock.Anything, mock.MatchedBy(func(e *model.Event) bool {
+			return e.MaxPerUser != nil && *e.MaxPerUser == 6
+		})).Return(&model.Event{ID: 1, EventID: eventID, Name: "Concert", MaxPerUser: &maxPerUser}, nil).Once()
+
+		req := createJSONHTTPRequest("POST", "/api/v1/events", handler.CreateEventRequest{Name: "Concert", MaxPerUser: &maxPerUser})
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		var body model.Event
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+		require.NotNil(t, body.MaxPerUser)
+		assert.Equal(t, 6, *body.MaxPerUser)
+	})
+
+	t.Run("Failed - create with zero cap", func(t *testing.T) {
+		mockService := mocks.NewMockEventService(t)
+		router := setupEventTestRouter(mockService)
+
+		req := createJSONHTTPRequest("POST", "/api/v1/events", map[string]interface{}{"name": "Concert", "max_per_user": 0})
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertNotCalled(t, "Create")
+	})
+
+	t.Run("Success - update cap only", func(t *testing.T) {
+		mockService := mocks.NewMockEventService(t)
+		router := setupEventTestRouter(mockService)
+
+		noCap := 0
+		mockService.EXPECT().UpdateByEventID(mock.Anything, eventID, model.UpdateEventParams{MaxPerUser: &noCap}).
+			Return(&model.Event{ID: 1, EventID: eventID, Name: "Concert"}, nil).Once()
+
+		req := createJSONHTTPRequest("PUT", "/api/v1/events/"+eventID.String(), handler.UpdateEventRequest{MaxPerUser: &noCap})
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.NotContains(t, w.Body.String(), "max_per_user")
+	})
+}
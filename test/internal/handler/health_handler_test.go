@@ -0,0 +1,84 @@
+package handler
+
+import (
+	"encoding/json"
+	"go-gin-high-concurrency/internal/handler"
+	"go-gin-high-concurrency/internal/model"
+	"go-gin-high-concurrency/internal/service/mocks"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func setupHealthTestRouter(mockService *mocks.MockHealthService) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	healthHandler := handler.NewHealthHandler(mockService)
+	healthHandler.RegisterRoutes(router)
+
+	return router
+}
+
+func TestHealth(t *testing.T) {
+	t.Run("Success - liveness", func(t *testing.T) {
+		mockService := mocks.NewMockHealthService(t)
+		router := setupHealthTestRouter(mockService)
+
+		mockService.EXPECT().Liveness(mock.Anything).Return(&model.HealthReport{
+			Status: model.HealthStatusOK,
+			Checks: map[string]model.HealthCheckResult{"order_worker": {Status: model.HealthStatusOK}},
+		}).Once()
+
+		req, _ := http.NewRequest("GET", "/healthz", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"status":"ok","checks":{"order_worker":{"status":"ok","duration_ms":0}}}`, w.Body.String())
+	})
+
+	t.Run("Success - ready", func(t *testing.T) {
+		mockService := mocks.NewMockHealthService(t)
+		router := setupHealthTestRouter(mockService)
+
+		mockService.EXPECT().Readiness(mock.Anything).Return(&model.HealthReport{
+			Status: model.HealthStatusOK,
+			Checks: map[string]model.HealthCheckResult{"postgres": {Status: model.HealthStatusOK, DurationMs: 1}},
+		}).Once()
+
+		req, _ := http.NewRequest("GET", "/readyz", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("Failed - not ready", func(t *testing.T) {
+		mockService := mocks.NewMockHealthService(t)
+		router := setupHealthTestRouter(mockService)
+
+		mockService.EXPECT().Readiness(mock.Anything).Return(&model.HealthReport{
+			Status: model.HealthStatusFail,
+			Checks: map[string]model.HealthCheckResult{
+				"postgres": {Status: model.HealthStatusOK},
+				"shutdown": {Status: model.HealthStatusFail, Error: "server is shutting down"},
+			},
+		}).Once()
+
+		req, _ := http.NewRequest("GET", "/readyz", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+		var got model.HealthReport
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &got))
+		assert.Equal(t, model.HealthStatusFail, got.Status)
+		assert.Equal(t, "server is shutting down", got.Checks["shutdown"].Error)
+	})
+}
@@ -0,0 +1,108 @@
+package handler
+
+import (
+	"encoding/json"
+	"go-gin-high-concurrency/internal/handler"
+	"go-gin-high-concurrency/internal/model"
+	"go-gin-high-concurrency/internal/service/mocks"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	apperrors "go-gin-high-concurrency/pkg/app_errors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func setupHoldTestRouter(mockService *mocks.MockHoldService) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	holdHandler := handler.NewHoldHandler(mockService)
+	holdHandler.RegisterRoutes(router)
+
+	return router
+}
+
+func TestCreateHold(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		mockService := mocks.NewMockHoldService(t)
+		router := setupHoldTestRouter(mockService)
+
+		req := model.CreateHoldRequest{UserID: 1, TicketID: 10, Quantity: 2}
+		hold := &model.TicketHold{HoldID: uuid.New(), TicketID: 10, UserID: 1, Quantity: 2, Price: 100, ExpiresAt: time.Now().Add(5 * time.Minute)}
+		mockService.EXPECT().CreateHold(mock.Anything, req).Return(hold, nil).Once()
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, createJSONHTTPRequest("POST", "/api/v1/holds", req))
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		var got model.TicketHold
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &got))
+		assert.Equal(t, hold.HoldID, got.HoldID)
+	})
+
+	t.Run("Failed - Invalid quantity", func(t *testing.T) {
+		mockService := mocks.NewMockHoldService(t)
+		router := setupHoldTestRouter(mockService)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, createJSONHTTPRequest("POST", "/api/v1/holds", model.CreateHoldRequest{UserID: 1, TicketID: 10}))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("Failed - ErrInsufficientStock", func(t *testing.T) {
+		mockService := mocks.NewMockHoldService(t)
+		router := setupHoldTestRouter(mockService)
+
+		mockService.EXPECT().CreateHold(mock.Anything, mock.Anything).Return(nil, apperrors.ErrInsufficientStock).Once()
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, createJSONHTTPRequest("POST", "/api/v1/holds", model.CreateHoldRequest{UserID: 1, TicketID: 10, Quantity: 2}))
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+}
+
+func TestReleaseHold(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		mockService := mocks.NewMockHoldService(t)
+		router := setupHoldTestRouter(mockService)
+
+		holdID := uuid.New()
+		mockService.EXPECT().ReleaseHold(mock.Anything, holdID, 1).Return(nil).Once()
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, createJSONHTTPRequest("POST", "/api/v1/holds/"+holdID.String()+"/release", model.ReleaseHoldRequest{UserID: 1}))
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("Failed - ErrHoldExpired", func(t *testing.T) {
+		mockService := mocks.NewMockHoldService(t)
+		router := setupHoldTestRouter(mockService)
+
+		mockService.EXPECT().ReleaseHold(mock.Anything, mock.Anything, 1).Return(apperrors.ErrHoldExpired).Once()
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, createJSONHTTPRequest("POST", "/api/v1/holds/"+uuid.New().String()+"/release", model.ReleaseHoldRequest{UserID: 1}))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("Failed - Invalid uuid", func(t *testing.T) {
+		mockService := mocks.NewMockHoldService(t)
+		router := setupHoldTestRouter(mockService)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, createJSONHTTPRequest("POST", "/api/v1/holds/not-a-uuid/release", model.ReleaseHoldRequest{UserID: 1}))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -0,0 +1,167 @@
+package handler
+
+import (
+	"encoding/json"
+	"go-gin-high-concurrency/internal/handler"
+	"go-gin-high-concurrency/pkg/openapi"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// registeredRoutes 以所有 handler 的 RegisterRoutes 註冊路由後，列出實際的路由（OpenAPI 路徑格式）
+func registeredRoutes() []string {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	handler.NewOrderHandler(nil).RegisterRoutes(router)
+	handler.NewEventHandler(nil).RegisterRoutes(router)
+	handler.NewTicketHandler(nil).RegisterRoutes(router)
+	handler.NewWebhookHandler(nil).RegisterRoutes(router)
+	handler.NewSeatHandler(nil).RegisterRoutes(router)
+	handler.NewHoldHandler(nil).RegisterRoutes(router)
+	handler.NewWaitlistHandler(nil).RegisterRoutes(router)
+	handler.NewPromoCodeHandler(nil).RegisterRoutes(router)
+	handler.NewPresaleHandler(nil).RegisterRoutes(router)
+	handler.NewRiskHandler(nil).RegisterRoutes(router)
+	handler.NewHealthHandler(nil).RegisterRoutes(router)
+	handler.NewSettingsHandler(nil).RegisterRoutes(router)
+
+	var routes []string
+	for _, route := range router.Routes() {
+		routes = append(routes, route.Method+" "+openapi.Path(route.Path))
+	}
+	sort.Strings(routes)
+	return routes
+}
+
+func documentedRoutes(doc *openapi.Document) []string {
+	var routes []string
+	for path, item := range doc.Paths {
+		for _, method := range []string{http.MethodGet, http.MethodPut, http.MethodPost, http.MethodDelete, http.MethodPatch} {
+			if item.Operation(method) != nil {
+				routes = append(routes, method+" "+path)
+			}
+		}
+	}
+	sort.Strings(routes)
+	return routes
+}
+
+// collectRefs 列出 JSON 文件中所有的 $ref
+func collectRefs(node interface{}, refs map[string]bool) {
+	switch v := node.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if ref, ok := value.(string); ok && key == "$ref" {
+				refs[ref] = true
+			}
+			collectRefs(value, refs)
+		}
+	case []interface{}:
+		for _, value := range v {
+			collectRefs(value, refs)
+		}
+	}
+}
+
+func TestOpenAPIDocument(t *testing.T) {
+	doc, err := handler.OpenAPIDocument()
+	require.NoError(t, err)
+
+	t.Run("Success - routes and spec match", func(t *testing.T) {
+		// 新增或調整路由時須同步更新 handler.OpenAPIRoutes
+		assert.Equal(t, registeredRoutes(), documentedRoutes(doc))
+	})
+
+	t.Run("Success - every operation has a success response", func(t *testing.T) {
+		for _, route := range documentedRoutes(doc) {
+			method, path, _ := strings.Cut(route, " ")
+			op := doc.Paths[path].Operation(method)
+			assert.Greater(t, len(op.Responses), 1, route)
+			assert.Contains(t, op.Responses, "default", route)
+		}
+	})
+
+	t.Run("Success - schema references resolve", func(t *testing.T) {
+		body, err := json.Marshal(doc)
+		require.NoError(t, err)
+		var raw interface{}
+		require.NoError(t, json.Unmarshal(body, &raw))
+
+		refs := make(map[string]bool)
+		collectRefs(raw, refs)
+		require.NotEmpty(t, refs)
+		for ref := range refs {
+			name := strings.TrimPrefix(ref, "#/components/schemas/")
+			assert.Contains(t, doc.Components.Schemas, name, ref)
+		}
+	})
+
+	t.Run("Success - request schema from binding tags", func(t *testing.T) {
+		op := doc.Paths["/api/v1/orders"].Operation(http.MethodPost)
+		require.NotNil(t, op.RequestBody)
+		assert.True(t, op.RequestBody.Required)
+		assert.Equal(t, "#/components/schemas/CreateOrderRequest", op.RequestBody.Content["application/json"].Schema.Ref)
+
+		schema := doc.Components.Schemas["CreateOrderRequest"]
+		require.NotNil(t, schema)
+		assert.ElementsMatch(t, []string{"user_id", "ticket_id", "quantity"}, schema.Required)
+		require.NotNil(t, schema.Properties["quantity"].Minimum)
+		assert.Equal(t, 1.0, *schema.Properties["quantity"].Minimum)
+		assert.Equal(t, "uuid", schema.Properties["hold_id"].Format)
+		assert.NotContains(t, schema.Properties, "ClientIP")
+	})
+
+	t.Run("Success - path and query parameters", func(t *testing.T) {
+		op := doc.Paths["/api/v1/tickets/{uuid}/waitlist"].Operation(http.MethodGet)
+		require.NotNil(t, op)
+		params := make(map[string]*openapi.Parameter)
+		for _, p := range op.Parameters {
+			params[p.In+":"+p.Name] = p
+		}
+		require.Contains(t, params, "path:uuid")
+		assert.Equal(t, "uuid", params["path:uuid"].Schema.Format)
+		require.Contains(t, params, "query:user_id")
+		assert.True(t, params["query:user_id"].Required)
+		assert.Equal(t, "integer", params["query:user_id"].Schema.Type)
+	})
+}
+
+func TestOpenAPIHandler(t *testing.T) {
+	doc, err := handler.OpenAPIDocument()
+	require.NoError(t, err)
+	openAPIHandler, err := handler.NewOpenAPIHandler(doc)
+	require.NoError(t, err)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	openAPIHandler.RegisterRoutes(router)
+
+	t.Run("Success - serve spec", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/openapi.json", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var got map[string]interface{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &got))
+		assert.Equal(t, openapi.Version, got["openapi"])
+		assert.Contains(t, got["paths"], "/api/v1/orders/{uuid}")
+	})
+
+	t.Run("Success - serve Swagger UI", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/docs", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Header().Get("Content-Type"), "text/html")
+		assert.Contains(t, w.Body.String(), "/openapi.json")
+	})
+}
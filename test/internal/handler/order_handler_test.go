@@ -27,6 +27,9 @@ func setupOrderTestRouter(mockService *mocks.MockOrderService) *gin.Engine {
 	router.POST("/api/v1/orders", orderHandler.CreateOrder)
 	router.PUT("/api/v1/orders/:uuid/confirm", orderHandler.ConfirmOrder)
 	router.PUT("/api/v1/orders/:uuid/cancel", orderHandler.CancelOrder)
+	router.PUT("/api/v1/orders/:uuid/expire", orderHandler.ExpireOrder)
+	router.PUT("/api/v1/orders/:uuid/refund", orderHandler.RefundOrder)
+	router.GET("/api/v1/orders/:uuid/history", orderHandler.GetOrderHistory)
 
 	return router
 }
@@ -83,6 +86,132 @@ func TestCreateOrder(t *testing.T) {
 		mockService.AssertExpectations(t)
 	})
 
+	t.Run("Failed - ErrPriceChanged", func(t *testing.T) {
+		mockService := mocks.NewMockOrderService(t)
+		router := setupOrderTestRouter(mockService)
+
+		expectedPrice := 100.0
+		mockService.EXPECT().PrepareOrder(mock.Anything, mock.MatchedBy(func(req model.CreateOrderRequest) bool {
+			return req.ExpectedPrice != nil && *req.ExpectedPrice == expectedPrice
+		})).Return(nil, apperrors.ErrPriceChanged).Once()
+
+		createOrderRequest := model.CreateOrderRequest{
+			UserID:        1,
+			TicketID:      1,
+			Quantity:      1,
+			ExpectedPrice: &expectedPrice,
+		}
+
+		// request
+		req := createJSONHTTPRequest("POST", "/api/v1/orders", createOrderRequest)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		// assert
+		assert.Equal(t, http.StatusConflict, w.Code)
+		assert.Contains(t, w.Body.String(), "Ticket price changed")
+	})
+
+	t.Run("Failed - promo code errors", func(t *testing.T) {
+		cases := []struct {
+			err    error
+			status int
+		}{
+			{apperrors.ErrPromoCodeNotFound, http.StatusNotFound},
+			{apperrors.ErrPromoCodeInactive, http.StatusBadRequest},
+			{apperrors.ErrPromoCodeNotApplicable, http.StatusBadRequest},
+			{apperrors.ErrPromoCodeExhausted, http.StatusConflict},
+		}
+		for _, tc := range cases {
+			mockService := mocks.NewMockOrderService(t)
+			router := setupOrderTestRouter(mockService)
+
+			promoCode := "SAVE10"
+			mockService.EXPECT().PrepareOrder(mock.Anything, mock.MatchedBy(func(req model.CreateOrderRequest) bool {
+				return req.PromoCode != nil && *req.PromoCode == promoCode
+			})).Return(nil, tc.err).Once()
+
+			createOrderRequest := model.CreateOrderRequest{
+				UserID:    1,
+				TicketID:  1,
+				Quantity:  1,
+				PromoCode: &promoCode,
+			}
+
+			req := createJSONHTTPRequest("POST", "/api/v1/orders", createOrderRequest)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tc.status, w.Code, tc.err.Error())
+		}
+	})
+
+	t.Run("Failed - presale access errors", func(t *testing.T) {
+		cases := []struct {
+			err    error
+			status int
+		}{
+			{apperrors.ErrPresaleAccessDenied, http.StatusForbidden},
+			{apperrors.ErrAccessCodeExhausted, http.StatusConflict},
+		}
+		for _, tc := range cases {
+			mockService := mocks.NewMockOrderService(t)
+			router := setupOrderTestRouter(mockService)
+
+			accessCode := "FANCLUB"
+			mockService.EXPECT().PrepareOrder(mock.Anything, mock.MatchedBy(func(req model.CreateOrderRequest) bool {
+				return req.AccessCode != nil && *req.AccessCode == accessCode
+			})).Return(nil, tc.err).Once()
+
+			createOrderRequest := model.CreateOrderRequest{
+				UserID:     1,
+				TicketID:   1,
+				Quantity:   1,
+				AccessCode: &accessCode,
+			}
+
+			req := createJSONHTTPRequest("POST", "/api/v1/orders", createOrderRequest)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tc.status, w.Code, tc.err.Error())
+		}
+	})
+
+	t.Run("Success - passes risk signals from request", func(t *testing.T) {
+		mockService := mocks.NewMockOrderService(t)
+		router := setupOrderTestRouter(mockService)
+
+		mockService.EXPECT().PrepareOrder(mock.Anything, mock.MatchedBy(func(req model.CreateOrderRequest) bool {
+			return req.ClientIP == "192.0.2.1" && req.DeviceFingerprint == "device-a" &&
+				req.PaymentFingerprint != nil && *req.PaymentFingerprint == "card-1"
+		})).Return(&model.Order{ID: 1, Status: model.OrderStatusPending}, nil).Once()
+
+		// client_ip 不接受由 body 帶入
+		req := createJSONHTTPRequest("POST", "/api/v1/orders", map[string]interface{}{
+			"user_id": 1, "ticket_id": 1, "quantity": 1, "payment_fingerprint": "card-1", "client_ip": "10.0.0.1",
+		})
+		req.RemoteAddr = "192.0.2.1:12345"
+		req.Header.Set(handler.DeviceFingerprintHeader, "device-a")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+	})
+
+	t.Run("Failed - ErrOrderBlocked", func(t *testing.T) {
+		mockService := mocks.NewMockOrderService(t)
+		router := setupOrderTestRouter(mockService)
+
+		mockService.EXPECT().PrepareOrder(mock.Anything, mock.Anything).Return(nil, apperrors.ErrOrderBlocked).Once()
+
+		req := createJSONHTTPRequest("POST", "/api/v1/orders", model.CreateOrderRequest{UserID: 1, TicketID: 1, Quantity: 1})
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
 	t.Run("Failed - ErrInternalServerError", func(t *testing.T) {
 		mockService := mocks.NewMockOrderService(t)
 		router := setupOrderTestRouter(mockService)
@@ -208,7 +337,7 @@ func TestConfirmOrder(t *testing.T) {
 		mockService := mocks.NewMockOrderService(t)
 		router := setupOrderTestRouter(mockService)
 
-		mockService.EXPECT().ConfirmOrderByOrderID(mock.Anything, mock.Anything).Return(nil).Once()
+		mockService.EXPECT().ConfirmOrderByOrderID(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
 
 		req := httptest.NewRequest("PUT", "/api/v1/orders/"+validUUID+"/confirm", nil)
 		w := httptest.NewRecorder()
@@ -223,7 +352,7 @@ func TestConfirmOrder(t *testing.T) {
 		router := setupOrderTestRouter(mockService)
 
 		notFoundUUID := "550e8400-e29b-41d4-a716-446655440099"
-		mockService.EXPECT().ConfirmOrderByOrderID(mock.Anything, mock.Anything).Return(apperrors.ErrOrderNotFound).Once()
+		mockService.EXPECT().ConfirmOrderByOrderID(mock.Anything, mock.Anything, mock.Anything).Return(apperrors.ErrOrderNotFound).Once()
 
 		req := httptest.NewRequest("PUT", "/api/v1/orders/"+notFoundUUID+"/confirm", nil)
 		w := httptest.NewRecorder()
@@ -250,7 +379,7 @@ func TestConfirmOrder(t *testing.T) {
 		router := setupOrderTestRouter(mockService)
 
 		validUUID := "550e8400-e29b-41d4-a716-44665544001a"
-		mockService.EXPECT().ConfirmOrderByOrderID(mock.Anything, mock.Anything).Return(apperrors.ErrInvalidOrderStatus).Once()
+		mockService.EXPECT().ConfirmOrderByOrderID(mock.Anything, mock.Anything, mock.Anything).Return(apperrors.ErrInvalidOrderStatus).Once()
 
 		req := httptest.NewRequest("PUT", "/api/v1/orders/"+validUUID+"/confirm", nil)
 		w := httptest.NewRecorder()
@@ -267,7 +396,7 @@ func TestCancelOrder(t *testing.T) {
 		mockService := mocks.NewMockOrderService(t)
 		router := setupOrderTestRouter(mockService)
 
-		mockService.EXPECT().CancelOrderByOrderID(mock.Anything, mock.Anything).Return(nil).Once()
+		mockService.EXPECT().CancelOrderByOrderID(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
 
 		req := httptest.NewRequest("PUT", "/api/v1/orders/"+validUUID+"/cancel", nil)
 		w := httptest.NewRecorder()
@@ -282,7 +411,7 @@ func TestCancelOrder(t *testing.T) {
 		router := setupOrderTestRouter(mockService)
 
 		notFoundUUID := "550e8400-e29b-41d4-a716-446655440099"
-		mockService.EXPECT().CancelOrderByOrderID(mock.Anything, mock.Anything).Return(apperrors.ErrOrderNotFound).Once()
+		mockService.EXPECT().CancelOrderByOrderID(mock.Anything, mock.Anything, mock.Anything).Return(apperrors.ErrOrderNotFound).Once()
 
 		req := httptest.NewRequest("PUT", "/api/v1/orders/"+notFoundUUID+"/cancel", nil)
 		w := httptest.NewRecorder()
@@ -309,7 +438,7 @@ func TestCancelOrder(t *testing.T) {
 		router := setupOrderTestRouter(mockService)
 
 		validUUID := "550e8400-e29b-41d4-a716-44665544003a"
-		mockService.EXPECT().CancelOrderByOrderID(mock.Anything, mock.Anything).Return(apperrors.ErrInvalidOrderStatus).Once()
+		mockService.EXPECT().CancelOrderByOrderID(mock.Anything, mock.Anything, mock.Anything).Return(apperrors.ErrInvalidOrderStatus).Once()
 
 		req := httptest.NewRequest("PUT", "/api/v1/orders/"+validUUID+"/cancel", nil)
 		w := httptest.NewRecorder()
@@ -319,3 +448,144 @@ func TestCancelOrder(t *testing.T) {
 		mockService.AssertExpectations(t)
 	})
 }
+
+func TestCancelOrder_WithReason(t *testing.T) {
+	validUUID := "550e8400-e29b-41d4-a716-446655440021"
+	mockService := mocks.NewMockOrderService(t)
+	router := setupOrderTestRouter(mockService)
+
+	reason := "changed my mind"
+	mockService.EXPECT().CancelOrderByOrderID(mock.Anything, mock.Anything, model.OrderStatusChange{Actor: model.OrderActorUser, Reason: &reason}).Return(nil).Once()
+
+	req := createJSONHTTPRequest("PUT", "/api/v1/orders/"+validUUID+"/cancel", model.UpdateOrderStatusRequest{Reason: &reason})
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestCancelOrder_IgnoresActorInBody(t *testing.T) {
+	validUUID := "550e8400-e29b-41d4-a716-446655440024"
+	mockService := mocks.NewMockOrderService(t)
+	router := setupOrderTestRouter(mockService)
+
+	// 請求無法指定執行者，一律記錄為使用者
+	mockService.EXPECT().CancelOrderByOrderID(mock.Anything, mock.Anything, model.OrderStatusChange{Actor: model.OrderActorUser}).Return(nil).Once()
+
+	req := createJSONHTTPRequest("PUT", "/api/v1/orders/"+validUUID+"/cancel", map[string]string{"actor": model.OrderActorAdmin})
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestExpireOrder(t *testing.T) {
+	validUUID := "550e8400-e29b-41d4-a716-446655440022"
+	t.Run("Success - records system actor", func(t *testing.T) {
+		mockService := mocks.NewMockOrderService(t)
+		router := setupOrderTestRouter(mockService)
+
+		mockService.EXPECT().ExpireOrderByOrderID(mock.Anything, mock.Anything, model.OrderStatusChange{Actor: model.OrderActorSystem}).Return(nil).Once()
+
+		req := httptest.NewRequest("PUT", "/api/v1/orders/"+validUUID+"/expire", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("InvalidOrderStatus", func(t *testing.T) {
+		mockService := mocks.NewMockOrderService(t)
+		router := setupOrderTestRouter(mockService)
+
+		mockService.EXPECT().ExpireOrderByOrderID(mock.Anything, mock.Anything, mock.Anything).Return(apperrors.ErrInvalidOrderStatus).Once()
+
+		req := httptest.NewRequest("PUT", "/api/v1/orders/"+validUUID+"/expire", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertExpectations(t)
+	})
+}
+
+func TestRefundOrder(t *testing.T) {
+	validUUID := "550e8400-e29b-41d4-a716-446655440023"
+	t.Run("Success", func(t *testing.T) {
+		mockService := mocks.NewMockOrderService(t)
+		router := setupOrderTestRouter(mockService)
+
+		reason := "event postponed"
+		mockService.EXPECT().RefundOrderByOrderID(mock.Anything, mock.Anything, model.OrderStatusChange{Actor: model.OrderActorAdmin, Reason: &reason}).Return(nil).Once()
+
+		req := createJSONHTTPRequest("PUT", "/api/v1/orders/"+validUUID+"/refund", model.UpdateOrderStatusRequest{Reason: &reason})
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("InvalidUUID", func(t *testing.T) {
+		mockService := mocks.NewMockOrderService(t)
+		router := setupOrderTestRouter(mockService)
+
+		req := httptest.NewRequest("PUT", "/api/v1/orders/invalid/refund", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertNotCalled(t, "RefundOrderByOrderID")
+	})
+}
+
+func TestGetOrderHistory(t *testing.T) {
+	validUUID := "550e8400-e29b-41d4-a716-446655440030"
+	t.Run("Success", func(t *testing.T) {
+		mockService := mocks.NewMockOrderService(t)
+		router := setupOrderTestRouter(mockService)
+
+		pending := model.OrderStatusPending
+		mockService.EXPECT().GetOrderStatusHistory(mock.Anything, mock.Anything).Return([]*model.OrderStatusHistory{
+			{ToStatus: model.OrderStatusPending, Actor: model.OrderActorSystem},
+			{FromStatus: &pending, ToStatus: model.OrderStatusCancelled, Actor: model.OrderActorUser},
+		}, nil).Once()
+
+		req := httptest.NewRequest("GET", "/api/v1/orders/"+validUUID+"/history", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"to_status":"cancelled"`)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("OrderNotFound", func(t *testing.T) {
+		mockService := mocks.NewMockOrderService(t)
+		router := setupOrderTestRouter(mockService)
+
+		mockService.EXPECT().GetOrderStatusHistory(mock.Anything, mock.Anything).Return(nil, apperrors.ErrOrderNotFound).Once()
+
+		req := httptest.NewRequest("GET", "/api/v1/orders/"+validUUID+"/history", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("InvalidUUID", func(t *testing.T) {
+		mockService := mocks.NewMockOrderService(t)
+		router := setupOrderTestRouter(mockService)
+
+		req := httptest.NewRequest("GET", "/api/v1/orders/invalid/history", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertNotCalled(t, "GetOrderStatusHistory")
+	})
+}
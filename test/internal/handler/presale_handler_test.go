@@ -0,0 +1,160 @@
+package handler
+
+import (
+	"encoding/json"
+	"go-gin-high-concurrency/internal/handler"
+	"go-gin-high-concurrency/internal/model"
+	"go-gin-high-concurrency/internal/service/mocks"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	apperrors "go-gin-high-concurrency/pkg/app_errors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func setupPresaleTestRouter(mockService *mocks.MockPresaleService) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	presaleHandler := handler.NewPresaleHandler(mockService)
+	presaleHandler.RegisterRoutes(router)
+
+	return router
+}
+
+func TestCreateAccessCode(t *testing.T) {
+	ticketID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440000")
+	path := "/api/v1/tickets/" + ticketID.String() + "/presale/access-codes"
+
+	t.Run("Success", func(t *testing.T) {
+		mockService := mocks.NewMockPresaleService(t)
+		router := setupPresaleTestRouter(mockService)
+
+		maxUses := 100
+		mockService.EXPECT().CreateAccessCode(mock.Anything, ticketID, mock.MatchedBy(func(req model.CreateAccessCodeRequest) bool {
+			return req.Code == "FANCLUB" && req.MaxUses != nil && *req.MaxUses == 100
+		})).Return(&model.PresaleAccessCode{Code: "FANCLUB", MaxUses: &maxUses}, nil).Once()
+
+		req := createJSONHTTPRequest("POST", path, model.CreateAccessCodeRequest{Code: "FANCLUB", MaxUses: &maxUses})
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		var got model.PresaleAccessCode
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &got))
+		assert.Equal(t, "FANCLUB", got.Code)
+	})
+
+	t.Run("Failed - invalid max uses", func(t *testing.T) {
+		mockService := mocks.NewMockPresaleService(t)
+		router := setupPresaleTestRouter(mockService)
+
+		req := createJSONHTTPRequest("POST", path, map[string]interface{}{"code": "FANCLUB", "max_uses": 0})
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertNotCalled(t, "CreateAccessCode")
+	})
+
+	t.Run("Failed - service errors", func(t *testing.T) {
+		cases := []struct {
+			err    error
+			status int
+		}{
+			{apperrors.ErrTicketNotFound, http.StatusNotFound},
+			{apperrors.ErrInvalidInput, http.StatusBadRequest},
+			{apperrors.ErrAlreadyExists, http.StatusConflict},
+		}
+		for _, tc := range cases {
+			mockService := mocks.NewMockPresaleService(t)
+			router := setupPresaleTestRouter(mockService)
+
+			mockService.EXPECT().CreateAccessCode(mock.Anything, ticketID, mock.Anything).Return(nil, tc.err).Once()
+
+			req := createJSONHTTPRequest("POST", path, model.CreateAccessCodeRequest{Code: "FANCLUB"})
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tc.status, w.Code, tc.err.Error())
+		}
+	})
+}
+
+func TestPresaleAllowlist(t *testing.T) {
+	ticketID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440000")
+	path := "/api/v1/tickets/" + ticketID.String() + "/presale/allowlist"
+
+	t.Run("Success - add", func(t *testing.T) {
+		mockService := mocks.NewMockPresaleService(t)
+		router := setupPresaleTestRouter(mockService)
+
+		mockService.EXPECT().AddAllowlist(mock.Anything, ticketID, []int{1, 2}).Return(nil).Once()
+
+		req := createJSONHTTPRequest("POST", path, model.PresaleAllowlistRequest{UserIDs: []int{1, 2}})
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("Failed - add unknown user", func(t *testing.T) {
+		mockService := mocks.NewMockPresaleService(t)
+		router := setupPresaleTestRouter(mockService)
+
+		mockService.EXPECT().AddAllowlist(mock.Anything, ticketID, []int{999}).Return(apperrors.ErrUserNotFound).Once()
+
+		req := createJSONHTTPRequest("POST", path, model.PresaleAllowlistRequest{UserIDs: []int{999}})
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("Success - list", func(t *testing.T) {
+		mockService := mocks.NewMockPresaleService(t)
+		router := setupPresaleTestRouter(mockService)
+
+		mockService.EXPECT().ListAllowlist(mock.Anything, ticketID).Return([]int{1, 2}, nil).Once()
+
+		req := createJSONHTTPRequest("GET", path, nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var got map[string][]int
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &got))
+		assert.Equal(t, []int{1, 2}, got["user_ids"])
+	})
+
+	t.Run("Success - remove", func(t *testing.T) {
+		mockService := mocks.NewMockPresaleService(t)
+		router := setupPresaleTestRouter(mockService)
+
+		mockService.EXPECT().RemoveAllowlist(mock.Anything, ticketID, 2).Return(nil).Once()
+
+		req := createJSONHTTPRequest("DELETE", path+"/2", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("Failed - remove invalid user_id", func(t *testing.T) {
+		mockService := mocks.NewMockPresaleService(t)
+		router := setupPresaleTestRouter(mockService)
+
+		req := createJSONHTTPRequest("DELETE", path+"/abc", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertNotCalled(t, "RemoveAllowlist")
+	})
+}
@@ -0,0 +1,133 @@
+package handler
+
+import (
+	"encoding/json"
+	"go-gin-high-concurrency/internal/handler"
+	"go-gin-high-concurrency/internal/model"
+	"go-gin-high-concurrency/internal/service/mocks"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	apperrors "go-gin-high-concurrency/pkg/app_errors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func setupPromoCodeTestRouter(mockService *mocks.MockPromoCodeService) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	promoCodeHandler := handler.NewPromoCodeHandler(mockService)
+	promoCodeHandler.RegisterRoutes(router)
+
+	return router
+}
+
+func TestCreatePromoCode(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		mockService := mocks.NewMockPromoCodeService(t)
+		router := setupPromoCodeTestRouter(mockService)
+
+		maxPerUser := 1
+		mockService.EXPECT().Create(mock.Anything, mock.MatchedBy(func(p *model.PromoCode) bool {
+			return p.Code == "SAVE10" && p.DiscountType == model.DiscountTypePercentage &&
+				p.DiscountValue == 10 && p.MaxPerUser != nil && *p.MaxPerUser == 1
+		})).Return(&model.PromoCode{Code: "SAVE10", DiscountType: model.DiscountTypePercentage, DiscountValue: 10, MaxPerUser: &maxPerUser}, nil).Once()
+
+		req := createJSONHTTPRequest("POST", "/api/v1/promo-codes", handler.CreatePromoCodeRequest{
+			Code:          "SAVE10",
+			DiscountType:  model.DiscountTypePercentage,
+			DiscountValue: 10,
+			MaxPerUser:    &maxPerUser,
+		})
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		var got model.PromoCode
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &got))
+		assert.Equal(t, "SAVE10", got.Code)
+	})
+
+	t.Run("Failed - invalid discount type", func(t *testing.T) {
+		mockService := mocks.NewMockPromoCodeService(t)
+		router := setupPromoCodeTestRouter(mockService)
+
+		req := createJSONHTTPRequest("POST", "/api/v1/promo-codes", map[string]interface{}{
+			"code": "SAVE10", "discount_type": "bogo", "discount_value": 10,
+		})
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertNotCalled(t, "Create")
+	})
+
+	t.Run("Failed - ErrAlreadyExists", func(t *testing.T) {
+		mockService := mocks.NewMockPromoCodeService(t)
+		router := setupPromoCodeTestRouter(mockService)
+
+		mockService.EXPECT().Create(mock.Anything, mock.Anything).Return(nil, apperrors.ErrAlreadyExists).Once()
+
+		req := createJSONHTTPRequest("POST", "/api/v1/promo-codes", handler.CreatePromoCodeRequest{
+			Code: "SAVE10", DiscountType: model.DiscountTypeFixed, DiscountValue: 50,
+		})
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+}
+
+func TestGetPromoCode(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		mockService := mocks.NewMockPromoCodeService(t)
+		router := setupPromoCodeTestRouter(mockService)
+
+		mockService.EXPECT().GetByCode(mock.Anything, "SAVE10").
+			Return(&model.PromoCode{Code: "SAVE10", DiscountType: model.DiscountTypeFixed, DiscountValue: 50}, nil).Once()
+
+		req, _ := http.NewRequest("GET", "/api/v1/promo-codes/SAVE10", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("Failed - ErrPromoCodeNotFound", func(t *testing.T) {
+		mockService := mocks.NewMockPromoCodeService(t)
+		router := setupPromoCodeTestRouter(mockService)
+
+		mockService.EXPECT().GetByCode(mock.Anything, "MISSING").Return(nil, apperrors.ErrPromoCodeNotFound).Once()
+
+		req, _ := http.NewRequest("GET", "/api/v1/promo-codes/MISSING", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestListPromoCodeRedemptions(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		mockService := mocks.NewMockPromoCodeService(t)
+		router := setupPromoCodeTestRouter(mockService)
+
+		mockService.EXPECT().ListRedemptions(mock.Anything, "SAVE10").
+			Return([]*model.PromoRedemption{{UserID: 1, DiscountAmount: 20}, {UserID: 2, DiscountAmount: 10}}, nil).Once()
+
+		req, _ := http.NewRequest("GET", "/api/v1/promo-codes/SAVE10/redemptions", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var got []model.PromoRedemption
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &got))
+		require.Len(t, got, 2)
+		assert.Equal(t, 10.0, got[1].DiscountAmount)
+	})
+}
@@ -0,0 +1,79 @@
+package handler
+
+import (
+	"encoding/json"
+	"go-gin-high-concurrency/internal/handler"
+	"go-gin-high-concurrency/internal/model"
+	"go-gin-high-concurrency/internal/service/mocks"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	apperrors "go-gin-high-concurrency/pkg/app_errors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func setupRiskTestRouter(mockService *mocks.MockRiskService) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	riskHandler := handler.NewRiskHandler(mockService)
+	riskHandler.RegisterRoutes(router)
+
+	return router
+}
+
+func TestListFlaggedOrders(t *testing.T) {
+	eventID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440000")
+	path := "/api/v1/events/" + eventID.String() + "/orders/flagged"
+
+	t.Run("Success", func(t *testing.T) {
+		mockService := mocks.NewMockRiskService(t)
+		router := setupRiskTestRouter(mockService)
+
+		mockService.EXPECT().ListFlaggedOrders(mock.Anything, eventID).Return([]*model.Order{
+			{ID: 1, RiskScore: 40, RiskFlags: []string{model.RiskFlagSharedPayment}},
+		}, nil).Once()
+
+		req, _ := http.NewRequest("GET", path, nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var got []model.Order
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &got))
+		require.Len(t, got, 1)
+		assert.Equal(t, 40, got[0].RiskScore)
+		assert.Equal(t, []string{model.RiskFlagSharedPayment}, got[0].RiskFlags)
+	})
+
+	t.Run("Failed - invalid uuid", func(t *testing.T) {
+		mockService := mocks.NewMockRiskService(t)
+		router := setupRiskTestRouter(mockService)
+
+		req, _ := http.NewRequest("GET", "/api/v1/events/not-a-uuid/orders/flagged", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertNotCalled(t, "ListFlaggedOrders")
+	})
+
+	t.Run("Failed - event not found", func(t *testing.T) {
+		mockService := mocks.NewMockRiskService(t)
+		router := setupRiskTestRouter(mockService)
+
+		mockService.EXPECT().ListFlaggedOrders(mock.Anything, eventID).Return(nil, apperrors.ErrEventNotFound).Once()
+
+		req, _ := http.NewRequest("GET", path, nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
@@ -0,0 +1,173 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"go-gin-high-concurrency/internal/handler"
+	"go-gin-high-concurrency/internal/model"
+	"go-gin-high-concurrency/internal/service/mocks"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	apperrors "go-gin-high-concurrency/pkg/app_errors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func setupSeatTestRouter(mockService *mocks.MockSeatService) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	seatHandler := handler.NewSeatHandler(mockService)
+	seatHandler.RegisterRoutes(router)
+
+	return router
+}
+
+func TestCreateVenue(t *testing.T) {
+	t.Run("Success - expands rows into seats", func(t *testing.T) {
+		mockService := mocks.NewMockSeatService(t)
+		router := setupSeatTestRouter(mockService)
+
+		mockService.EXPECT().CreateVenue(mock.Anything, mock.MatchedBy(func(v *model.Venue) bool {
+			return v.Name == "Arena" &&
+				len(v.Sections) == 1 &&
+				len(v.Sections[0].Seats) == 5 &&
+				v.Sections[0].Seats[0].Row == "A" && v.Sections[0].Seats[0].Number == 1 &&
+				v.Sections[0].Seats[4].Row == "B" && v.Sections[0].Seats[4].Number == 2
+		})).RunAndReturn(func(_ context.Context, v *model.Venue) (*model.Venue, error) {
+			v.VenueID = uuid.New()
+			return v, nil
+		}).Once()
+
+		req := createJSONHTTPRequest("POST", "/api/v1/venues", handler.CreateVenueRequest{
+			Name: "Arena",
+			Sections: []handler.CreateVenueSectionRequest{
+				{Name: "Floor", Rows: []handler.CreateVenueRowRequest{{Label: "A", Seats: 3}, {Label: "B", Seats: 2}}},
+			},
+		})
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+	})
+
+	t.Run("Failed - no sections", func(t *testing.T) {
+		mockService := mocks.NewMockSeatService(t)
+		router := setupSeatTestRouter(mockService)
+
+		req := createJSONHTTPRequest("POST", "/api/v1/venues", map[string]interface{}{"name": "Arena", "sections": []interface{}{}})
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertNotCalled(t, "CreateVenue")
+	})
+}
+
+func TestListSeatAvailability(t *testing.T) {
+	ticketID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		mockService := mocks.NewMockSeatService(t)
+		router := setupSeatTestRouter(mockService)
+
+		mockService.EXPECT().ListSeatAvailability(mock.Anything, ticketID).Return([]*model.SeatAvailability{
+			{SeatID: 1, Row: "A", Number: 1, Status: model.SeatStatusSold},
+			{SeatID: 2, Row: "A", Number: 2, Status: model.SeatStatusAvailable},
+		}, nil).Once()
+
+		req, _ := http.NewRequest("GET", "/api/v1/tickets/"+ticketID.String()+"/seats", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.NotEmpty(t, w.Header().Get("ETag"))
+		var body []model.SeatAvailability
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+		require.Len(t, body, 2)
+		assert.Equal(t, model.SeatStatusSold, body[0].Status)
+	})
+
+	t.Run("Failed - general admission ticket", func(t *testing.T) {
+		mockService := mocks.NewMockSeatService(t)
+		router := setupSeatTestRouter(mockService)
+
+		mockService.EXPECT().ListSeatAvailability(mock.Anything, ticketID).Return(nil, apperrors.ErrInvalidInput).Once()
+
+		req, _ := http.NewRequest("GET", "/api/v1/tickets/"+ticketID.String()+"/seats", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestHoldSeats(t *testing.T) {
+	ticketID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		mockService := mocks.NewMockSeatService(t)
+		router := setupSeatTestRouter(mockService)
+
+		mockService.EXPECT().HoldSeats(mock.Anything, ticketID, 7, []int{1, 2}, "").Return(&model.SeatHold{
+			TicketID:  ticketID,
+			UserID:    7,
+			SeatIDs:   []int{1, 2},
+			ExpiresAt: time.Now().Add(10 * time.Minute),
+		}, nil).Once()
+
+		req := createJSONHTTPRequest("POST", "/api/v1/tickets/"+ticketID.String()+"/seats/hold", handler.SeatHoldRequest{UserID: 7, SeatIDs: []int{1, 2}})
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("Failed - ErrSeatUnavailable", func(t *testing.T) {
+		mockService := mocks.NewMockSeatService(t)
+		router := setupSeatTestRouter(mockService)
+
+		mockService.EXPECT().HoldSeats(mock.Anything, ticketID, 7, []int{1}, "").Return(nil, apperrors.ErrSeatUnavailable).Once()
+
+		req := createJSONHTTPRequest("POST", "/api/v1/tickets/"+ticketID.String()+"/seats/hold", handler.SeatHoldRequest{UserID: 7, SeatIDs: []int{1}})
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("Failed - Missing seat_ids", func(t *testing.T) {
+		mockService := mocks.NewMockSeatService(t)
+		router := setupSeatTestRouter(mockService)
+
+		req := createJSONHTTPRequest("POST", "/api/v1/tickets/"+ticketID.String()+"/seats/hold", map[string]interface{}{"user_id": 7})
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertNotCalled(t, "HoldSeats")
+	})
+}
+
+func TestReleaseSeats(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		mockService := mocks.NewMockSeatService(t)
+		router := setupSeatTestRouter(mockService)
+
+		ticketID := uuid.New()
+		mockService.EXPECT().ReleaseSeats(mock.Anything, ticketID, 7, []int{1}).Return(nil).Once()
+
+		req := createJSONHTTPRequest("POST", "/api/v1/tickets/"+ticketID.String()+"/seats/release", handler.SeatHoldRequest{UserID: 7, SeatIDs: []int{1}})
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+}
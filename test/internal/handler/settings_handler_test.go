@@ -0,0 +1,74 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"go-gin-high-concurrency/config"
+	"go-gin-high-concurrency/internal/handler"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupSettingsTestRouter(reloader config.Reloader) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	settingsHandler := handler.NewSettingsHandler(reloader)
+	settingsHandler.RegisterRoutes(router)
+
+	return router
+}
+
+func TestSettings(t *testing.T) {
+	t.Run("Success - get current settings", func(t *testing.T) {
+		reloader := config.NewReloader(func() (*config.Config, error) { return config.Default(), nil }, config.Default())
+		router := setupSettingsTestRouter(reloader)
+
+		req, _ := http.NewRequest("GET", "/api/v1/admin/settings", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var got map[string]interface{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &got))
+		assert.Equal(t, "info", got["log_level"])
+		assert.Equal(t, "5s", got["queue_claim_min_idle_time"])
+	})
+
+	t.Run("Success - reload", func(t *testing.T) {
+		reloaded := config.Default()
+		reloaded.Worker.OrderWorker.Concurrency = 4
+		reloader := config.NewReloader(func() (*config.Config, error) { return reloaded, nil }, config.Default())
+		router := setupSettingsTestRouter(reloader)
+
+		req, _ := http.NewRequest("POST", "/api/v1/admin/settings/reload", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var got config.RuntimeSettings
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &got))
+		assert.Equal(t, 4, got.OrderWorkerConcurrency)
+		assert.Equal(t, 4, reloader.Current().OrderWorkerConcurrency)
+	})
+
+	t.Run("Failed - invalid config", func(t *testing.T) {
+		reloader := config.NewReloader(func() (*config.Config, error) {
+			return nil, errors.New("invalid config:\nlog.level: unsupported level \"loud\"")
+		}, config.Default())
+		router := setupSettingsTestRouter(reloader)
+
+		req, _ := http.NewRequest("POST", "/api/v1/admin/settings/reload", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+		assert.Contains(t, w.Body.String(), "log.level")
+		assert.Equal(t, "info", reloader.Current().LogLevel)
+	})
+}
@@ -0,0 +1,299 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"go-gin-high-concurrency/internal/handler"
+	"go-gin-high-concurrency/internal/model"
+	"go-gin-high-concurrency/internal/service/mocks"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	apperrors "go-gin-high-concurrency/pkg/app_errors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func setupTicketTestRouter(mockService *mocks.MockTicketService) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	ticketHandler := handler.NewTicketHandler(mockService)
+	ticketHandler.RegisterRoutes(router)
+
+	return router
+}
+
+func TestGetTicketAvailability(t *testing.T) {
+	ticketID := uuid.New()
+	availability := &model.TicketResponse{
+		ID:             1,
+		TicketID:       ticketID,
+		Name:           "VIP",
+		Price:          100,
+		TotalStock:     100,
+		RemainingStock: 5,
+		Available:      true,
+		Status:         model.StockStatusLowStock,
+	}
+
+	t.Run("Success - cacheable with ETag", func(t *testing.T) {
+		mockService := mocks.NewMockTicketService(t)
+		router := setupTicketTestRouter(mockService)
+
+		mockService.EXPECT().GetAvailability(mock.Anything, ticketID).Return(availability, nil).Once()
+
+		req, _ := http.NewRequest("GET", "/api/v1/tickets/"+ticketID.String()+"/availability", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "public, max-age=2", w.Header().Get("Cache-Control"))
+		assert.Regexp(t, `^W/"[0-9a-f]{16}"$`, w.Header().Get("ETag"))
+
+		var body model.TicketResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+		assert.Equal(t, 5, body.RemainingStock)
+		assert.True(t, body.Available)
+		assert.Equal(t, model.StockStatusLowStock, body.Status)
+	})
+
+	t.Run("Success - If-None-Match returns 304", func(t *testing.T) {
+		mockService := mocks.NewMockTicketService(t)
+		router := setupTicketTestRouter(mockService)
+
+		mockService.EXPECT().GetAvailability(mock.Anything, ticketID).Return(availability, nil).Twice()
+
+		req, _ := http.NewRequest("GET", "/api/v1/tickets/"+ticketID.String()+"/availability", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		etag := w.Header().Get("ETag")
+		require.NotEmpty(t, etag)
+
+		req, _ = http.NewRequest("GET", "/api/v1/tickets/"+ticketID.String()+"/availability", nil)
+		req.Header.Set("If-None-Match", `"other", `+etag)
+		w = httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotModified, w.Code)
+		assert.Empty(t, w.Body.String())
+		assert.Equal(t, etag, w.Header().Get("ETag"))
+	})
+
+	t.Run("Success - stale ETag returns body", func(t *testing.T) {
+		mockService := mocks.NewMockTicketService(t)
+		router := setupTicketTestRouter(mockService)
+
+		mockService.EXPECT().GetAvailability(mock.Anything, ticketID).Return(availability, nil).Once()
+
+		req, _ := http.NewRequest("GET", "/api/v1/tickets/"+ticketID.String()+"/availability", nil)
+		req.Header.Set("If-None-Match", `W/"0000000000000000"`)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.NotEmpty(t, w.Body.String())
+	})
+
+	t.Run("Failed - Invalid UUID", func(t *testing.T) {
+		mockService := mocks.NewMockTicketService(t)
+		router := setupTicketTestRouter(mockService)
+
+		req, _ := http.NewRequest("GET", "/api/v1/tickets/invalid-uuid/availability", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertNotCalled(t, "GetAvailability")
+	})
+
+	t.Run("Failed - ErrTicketNotFound", func(t *testing.T) {
+		mockService := mocks.NewMockTicketService(t)
+		router := setupTicketTestRouter(mockService)
+
+		mockService.EXPECT().GetAvailability(mock.Anything, ticketID).Return(nil, apperrors.ErrTicketNotFound).Once()
+
+		req, _ := http.NewRequest("GET", "/api/v1/tickets/"+ticketID.String()+"/availability", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Empty(t, w.Header().Get("ETag"))
+	})
+}
+
+func TestAdjustTicketStock(t *testing.T) {
+	ticketID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		mockService := mocks.NewMockTicketService(t)
+		router := setupTicketTestRouter(mockService)
+
+		reason := "加開"
+		mockService.EXPECT().AdjustStock(mock.Anything, ticketID, model.AdjustStockRequest{Delta: 50, Reason: &reason}).
+			Return(&model.InventoryAdjustment{Delta: 50, TotalStock: 150, RemainingStock: 130, Actor: model.InventoryActorAdmin, Reason: &reason}, nil).Once()
+
+		body, _ := json.Marshal(map[string]interface{}{"delta": 50, "reason": reason})
+		req, _ := http.NewRequest("POST", "/api/v1/tickets/"+ticketID.String()+"/stock", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var got model.InventoryAdjustment
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &got))
+		assert.Equal(t, 150, got.TotalStock)
+		assert.Equal(t, 130, got.RemainingStock)
+		assert.Equal(t, model.InventoryActorAdmin, got.Actor)
+	})
+
+	t.Run("Failed - missing delta", func(t *testing.T) {
+		mockService := mocks.NewMockTicketService(t)
+		router := setupTicketTestRouter(mockService)
+
+		req, _ := http.NewRequest("POST", "/api/v1/tickets/"+ticketID.String()+"/stock", bytes.NewBufferString(`{"delta": 0}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertNotCalled(t, "AdjustStock")
+	})
+
+	t.Run("Failed - decrease below sold quantity", func(t *testing.T) {
+		mockService := mocks.NewMockTicketService(t)
+		router := setupTicketTestRouter(mockService)
+
+		mockService.EXPECT().AdjustStock(mock.Anything, ticketID, model.AdjustStockRequest{Delta: -100}).
+			Return(nil, apperrors.ErrInsufficientStock).Once()
+
+		req, _ := http.NewRequest("POST", "/api/v1/tickets/"+ticketID.String()+"/stock", bytes.NewBufferString(`{"delta": -100}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("Failed - ErrTicketNotFound", func(t *testing.T) {
+		mockService := mocks.NewMockTicketService(t)
+		router := setupTicketTestRouter(mockService)
+
+		mockService.EXPECT().AdjustStock(mock.Anything, ticketID, model.AdjustStockRequest{Delta: 10}).
+			Return(nil, apperrors.ErrTicketNotFound).Once()
+
+		req, _ := http.NewRequest("POST", "/api/v1/tickets/"+ticketID.String()+"/stock", bytes.NewBufferString(`{"delta": 10}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestListTicketStockAdjustments(t *testing.T) {
+	ticketID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		mockService := mocks.NewMockTicketService(t)
+		router := setupTicketTestRouter(mockService)
+
+		mockService.EXPECT().ListStockAdjustments(mock.Anything, ticketID).
+			Return([]*model.InventoryAdjustment{{Delta: 50}, {Delta: -20}}, nil).Once()
+
+		req, _ := http.NewRequest("GET", "/api/v1/tickets/"+ticketID.String()+"/stock/adjustments", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var got []model.InventoryAdjustment
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &got))
+		require.Len(t, got, 2)
+		assert.Equal(t, -20, got[1].Delta)
+	})
+}
+
+func TestSetTicketPricePhases(t *testing.T) {
+	ticketID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		mockService := mocks.NewMockTicketService(t)
+		router := setupTicketTestRouter(mockService)
+
+		soldLimit := 100
+		mockService.EXPECT().SetPricePhases(mock.Anything, ticketID, model.SetPricePhasesRequest{
+			Phases: []model.PricePhaseRequest{{Name: "Early Bird", Price: 800, SoldLimit: &soldLimit}, {Name: "Regular", Price: 1000}},
+		}).Return([]*model.TicketPricePhase{
+			{Name: "Early Bird", Price: 800, SoldLimit: &soldLimit, Position: 1},
+			{Name: "Regular", Price: 1000, Position: 2},
+		}, nil).Once()
+
+		body := `{"phases": [{"name": "Early Bird", "price": 800, "sold_limit": 100}, {"name": "Regular", "price": 1000}]}`
+		req, _ := http.NewRequest("PUT", "/api/v1/tickets/"+ticketID.String()+"/price-phases", bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var got []model.TicketPricePhase
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &got))
+		require.Len(t, got, 2)
+		assert.Equal(t, "Early Bird", got[0].Name)
+		assert.Equal(t, 2, got[1].Position)
+	})
+
+	t.Run("Failed - phase without name", func(t *testing.T) {
+		mockService := mocks.NewMockTicketService(t)
+		router := setupTicketTestRouter(mockService)
+
+		req, _ := http.NewRequest("PUT", "/api/v1/tickets/"+ticketID.String()+"/price-phases", bytes.NewBufferString(`{"phases": [{"price": 800}]}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertNotCalled(t, "SetPricePhases")
+	})
+
+	t.Run("Failed - ErrTicketNotFound", func(t *testing.T) {
+		mockService := mocks.NewMockTicketService(t)
+		router := setupTicketTestRouter(mockService)
+
+		mockService.EXPECT().SetPricePhases(mock.Anything, ticketID, model.SetPricePhasesRequest{Phases: []model.PricePhaseRequest{}}).
+			Return(nil, apperrors.ErrTicketNotFound).Once()
+
+		req, _ := http.NewRequest("PUT", "/api/v1/tickets/"+ticketID.String()+"/price-phases", bytes.NewBufferString(`{"phases": []}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestListTicketPricePhases(t *testing.T) {
+	ticketID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		mockService := mocks.NewMockTicketService(t)
+		router := setupTicketTestRouter(mockService)
+
+		mockService.EXPECT().ListPricePhases(mock.Anything, ticketID).
+			Return([]*model.TicketPricePhase{{Name: "Early Bird", Price: 800, Position: 1}}, nil).Once()
+
+		req, _ := http.NewRequest("GET", "/api/v1/tickets/"+ticketID.String()+"/price-phases", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var got []model.TicketPricePhase
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &got))
+		require.Len(t, got, 1)
+		assert.Equal(t, 800.0, got[0].Price)
+	})
+}
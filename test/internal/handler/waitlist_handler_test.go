@@ -0,0 +1,135 @@
+package handler
+
+import (
+	"encoding/json"
+	"go-gin-high-concurrency/internal/handler"
+	"go-gin-high-concurrency/internal/model"
+	"go-gin-high-concurrency/internal/service/mocks"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	apperrors "go-gin-high-concurrency/pkg/app_errors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func setupWaitlistTestRouter(mockService *mocks.MockWaitlistService) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	waitlistHandler := handler.NewWaitlistHandler(mockService)
+	waitlistHandler.RegisterRoutes(router)
+
+	return router
+}
+
+func TestJoinWaitlist(t *testing.T) {
+	ticketID := uuid.New()
+	url := "/api/v1/tickets/" + ticketID.String() + "/waitlist"
+
+	t.Run("Success", func(t *testing.T) {
+		mockService := mocks.NewMockWaitlistService(t)
+		router := setupWaitlistTestRouter(mockService)
+
+		req := model.JoinWaitlistRequest{UserID: 1, Quantity: 2}
+		mockService.EXPECT().Join(mock.Anything, ticketID, req).
+			Return(&model.WaitlistEntry{TicketID: ticketID, UserID: 1, Quantity: 2, Status: model.WaitlistStatusWaiting, Position: 4}, nil).Once()
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, createJSONHTTPRequest("POST", url, req))
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		var entry model.WaitlistEntry
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &entry))
+		assert.Equal(t, 4, entry.Position)
+	})
+
+	t.Run("Failed - ErrTicketNotSoldOut", func(t *testing.T) {
+		mockService := mocks.NewMockWaitlistService(t)
+		router := setupWaitlistTestRouter(mockService)
+
+		mockService.EXPECT().Join(mock.Anything, ticketID, mock.Anything).Return(nil, apperrors.ErrTicketNotSoldOut).Once()
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, createJSONHTTPRequest("POST", url, model.JoinWaitlistRequest{UserID: 1, Quantity: 2}))
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("Failed - ErrAlreadyWaitlisted", func(t *testing.T) {
+		mockService := mocks.NewMockWaitlistService(t)
+		router := setupWaitlistTestRouter(mockService)
+
+		mockService.EXPECT().Join(mock.Anything, ticketID, mock.Anything).Return(nil, apperrors.ErrAlreadyWaitlisted).Once()
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, createJSONHTTPRequest("POST", url, model.JoinWaitlistRequest{UserID: 1, Quantity: 2}))
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+}
+
+func TestGetWaitlistEntry(t *testing.T) {
+	ticketID := uuid.New()
+	url := "/api/v1/tickets/" + ticketID.String() + "/waitlist"
+
+	t.Run("Success - promoted", func(t *testing.T) {
+		mockService := mocks.NewMockWaitlistService(t)
+		router := setupWaitlistTestRouter(mockService)
+
+		holdID := uuid.New()
+		mockService.EXPECT().GetEntry(mock.Anything, ticketID, 1).
+			Return(&model.WaitlistEntry{TicketID: ticketID, UserID: 1, Quantity: 2, Status: model.WaitlistStatusPromoted, HoldID: &holdID}, nil).Once()
+
+		req, _ := http.NewRequest("GET", url+"?user_id=1", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var entry model.WaitlistEntry
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &entry))
+		assert.Equal(t, holdID, *entry.HoldID)
+	})
+
+	t.Run("Failed - Missing user_id", func(t *testing.T) {
+		mockService := mocks.NewMockWaitlistService(t)
+		router := setupWaitlistTestRouter(mockService)
+
+		req, _ := http.NewRequest("GET", url, nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("Failed - ErrWaitlistEntryNotFound", func(t *testing.T) {
+		mockService := mocks.NewMockWaitlistService(t)
+		router := setupWaitlistTestRouter(mockService)
+
+		mockService.EXPECT().GetEntry(mock.Anything, ticketID, 1).Return(nil, apperrors.ErrWaitlistEntryNotFound).Once()
+
+		req, _ := http.NewRequest("GET", url+"?user_id=1", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestLeaveWaitlist(t *testing.T) {
+	ticketID := uuid.New()
+	mockService := mocks.NewMockWaitlistService(t)
+	router := setupWaitlistTestRouter(mockService)
+
+	mockService.EXPECT().Leave(mock.Anything, ticketID, 1).Return(nil).Once()
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, createJSONHTTPRequest("POST", "/api/v1/tickets/"+ticketID.String()+"/waitlist/leave", model.LeaveWaitlistRequest{UserID: 1}))
+
+	assert.Equal(t, http.StatusNoContent, w.Code)
+}
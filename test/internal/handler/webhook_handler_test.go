@@ -0,0 +1,256 @@
+package handler
+
+import (
+	"encoding/json"
+	"go-gin-high-concurrency/internal/handler"
+	"go-gin-high-concurrency/internal/model"
+	"go-gin-high-concurrency/internal/service/mocks"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	apperrors "go-gin-high-concurrency/pkg/app_errors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func setupWebhookTestRouter(mockService *mocks.MockWebhookService) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	webhookHandler := handler.NewWebhookHandler(mockService)
+	webhookHandler.RegisterRoutes(router)
+
+	return router
+}
+
+func TestCreateWebhook(t *testing.T) {
+	eventID := uuid.New()
+
+	t.Run("Success - returns secret once", func(t *testing.T) {
+		mockService := mocks.NewMockWebhookService(t)
+		router := setupWebhookTestRouter(mockService)
+
+		mockService.EXPECT().CreateSubscription(mock.Anything, eventID, mock.MatchedBy(func(s *model.WebhookSubscription) bool {
+			return s.URL == "https://organiser.example.com/hooks" && len(s.EventTypes) == 1
+		})).Return(&model.WebhookSubscription{
+			SubscriptionID: uuid.New(),
+			URL:            "https://organiser.example.com/hooks",
+			Secret:         "s3cret",
+			EventTypes:     []string{model.EventTypeOrderCreated},
+			Active:         true,
+		}, nil).Once()
+
+		req := createJSONHTTPRequest("POST", "/api/v1/events/"+eventID.String()+"/webhooks", handler.CreateWebhookRequest{
+			URL:        "https://organiser.example.com/hooks",
+			EventTypes: []string{model.EventTypeOrderCreated},
+		})
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		var body map[string]interface{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+		assert.Equal(t, "s3cret", body["secret"])
+		assert.Equal(t, true, body["active"])
+	})
+
+	t.Run("Failed - ErrInvalidInput", func(t *testing.T) {
+		mockService := mocks.NewMockWebhookService(t)
+		router := setupWebhookTestRouter(mockService)
+
+		mockService.EXPECT().CreateSubscription(mock.Anything, eventID, mock.Anything).Return(nil, apperrors.ErrInvalidInput).Once()
+
+		req := createJSONHTTPRequest("POST", "/api/v1/events/"+eventID.String()+"/webhooks", handler.CreateWebhookRequest{
+			URL:        "not-a-url",
+			EventTypes: []string{model.EventTypeOrderCreated},
+		})
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("Failed - ErrEventNotFound", func(t *testing.T) {
+		mockService := mocks.NewMockWebhookService(t)
+		router := setupWebhookTestRouter(mockService)
+
+		mockService.EXPECT().CreateSubscription(mock.Anything, eventID, mock.Anything).Return(nil, apperrors.ErrEventNotFound).Once()
+
+		req := createJSONHTTPRequest("POST", "/api/v1/events/"+eventID.String()+"/webhooks", handler.CreateWebhookRequest{
+			URL:        "https://organiser.example.com/hooks",
+			EventTypes: []string{model.EventTypeOrderCreated},
+		})
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("Failed - Invalid JSON", func(t *testing.T) {
+		mockService := mocks.NewMockWebhookService(t)
+		router := setupWebhookTestRouter(mockService)
+
+		req, _ := http.NewRequest("POST", "/api/v1/events/"+eventID.String()+"/webhooks", strings.NewReader(InvalidJSON))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertNotCalled(t, "CreateSubscription")
+	})
+}
+
+func TestGetWebhook(t *testing.T) {
+	t.Run("Success - secret is not exposed", func(t *testing.T) {
+		mockService := mocks.NewMockWebhookService(t)
+		router := setupWebhookTestRouter(mockService)
+
+		subscriptionID := uuid.New()
+		mockService.EXPECT().GetSubscription(mock.Anything, subscriptionID).Return(&model.WebhookSubscription{
+			SubscriptionID: subscriptionID,
+			Secret:         "s3cret",
+		}, nil).Once()
+
+		req, _ := http.NewRequest("GET", "/api/v1/webhooks/"+subscriptionID.String(), nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.NotContains(t, w.Body.String(), "s3cret")
+	})
+
+	t.Run("Failed - Invalid UUID", func(t *testing.T) {
+		mockService := mocks.NewMockWebhookService(t)
+		router := setupWebhookTestRouter(mockService)
+
+		req, _ := http.NewRequest("GET", "/api/v1/webhooks/invalid-uuid", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("Failed - ErrWebhookNotFound", func(t *testing.T) {
+		mockService := mocks.NewMockWebhookService(t)
+		router := setupWebhookTestRouter(mockService)
+
+		subscriptionID := uuid.New()
+		mockService.EXPECT().GetSubscription(mock.Anything, subscriptionID).Return(nil, apperrors.ErrWebhookNotFound).Once()
+
+		req, _ := http.NewRequest("GET", "/api/v1/webhooks/"+subscriptionID.String(), nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestUpdateWebhook(t *testing.T) {
+	t.Run("Success - deactivate", func(t *testing.T) {
+		mockService := mocks.NewMockWebhookService(t)
+		router := setupWebhookTestRouter(mockService)
+
+		subscriptionID := uuid.New()
+		mockService.EXPECT().UpdateSubscription(mock.Anything, subscriptionID, mock.MatchedBy(func(p model.UpdateWebhookSubscriptionParams) bool {
+			return p.Active != nil && !*p.Active && p.URL == nil && p.EventTypes == nil
+		})).Return(&model.WebhookSubscription{SubscriptionID: subscriptionID, Active: false}, nil).Once()
+
+		req := createJSONHTTPRequest("PUT", "/api/v1/webhooks/"+subscriptionID.String(), map[string]interface{}{"active": false})
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("Failed - Empty body", func(t *testing.T) {
+		mockService := mocks.NewMockWebhookService(t)
+		router := setupWebhookTestRouter(mockService)
+
+		req := createJSONHTTPRequest("PUT", "/api/v1/webhooks/"+uuid.New().String(), map[string]interface{}{})
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertNotCalled(t, "UpdateSubscription")
+	})
+}
+
+func TestDeleteWebhook(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		mockService := mocks.NewMockWebhookService(t)
+		router := setupWebhookTestRouter(mockService)
+
+		subscriptionID := uuid.New()
+		mockService.EXPECT().DeleteSubscription(mock.Anything, subscriptionID).Return(nil).Once()
+
+		req, _ := http.NewRequest("DELETE", "/api/v1/webhooks/"+subscriptionID.String(), nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+}
+
+func TestListWebhookDeliveries(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		mockService := mocks.NewMockWebhookService(t)
+		router := setupWebhookTestRouter(mockService)
+
+		subscriptionID := uuid.New()
+		mockService.EXPECT().ListDeliveries(mock.Anything, subscriptionID).Return([]*model.WebhookDelivery{
+			{DeliveryID: uuid.New(), EventType: model.EventTypeOrderCreated, Status: model.WebhookDeliveryStatusSucceeded, Payload: json.RawMessage(`{}`)},
+			{DeliveryID: uuid.New(), EventType: model.EventTypeOrderCancelled, Status: model.WebhookDeliveryStatusFailed, Payload: json.RawMessage(`{}`)},
+		}, nil).Once()
+
+		req, _ := http.NewRequest("GET", "/api/v1/webhooks/"+subscriptionID.String()+"/deliveries", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var deliveries []model.WebhookDelivery
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &deliveries))
+		assert.Len(t, deliveries, 2)
+	})
+}
+
+func TestRedeliverWebhook(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		mockService := mocks.NewMockWebhookService(t)
+		router := setupWebhookTestRouter(mockService)
+
+		subscriptionID := uuid.New()
+		deliveryID := uuid.New()
+		mockService.EXPECT().Redeliver(mock.Anything, subscriptionID, deliveryID).Return(&model.WebhookDelivery{
+			DeliveryID: deliveryID,
+			Status:     model.WebhookDeliveryStatusPending,
+			Payload:    json.RawMessage(`{}`),
+		}, nil).Once()
+
+		req, _ := http.NewRequest("POST", "/api/v1/webhooks/"+subscriptionID.String()+"/deliveries/"+deliveryID.String()+"/redeliver", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusAccepted, w.Code)
+	})
+
+	t.Run("Failed - ErrWebhookDeliveryNotFound", func(t *testing.T) {
+		mockService := mocks.NewMockWebhookService(t)
+		router := setupWebhookTestRouter(mockService)
+
+		subscriptionID := uuid.New()
+		deliveryID := uuid.New()
+		mockService.EXPECT().Redeliver(mock.Anything, subscriptionID, deliveryID).Return(nil, apperrors.ErrWebhookDeliveryNotFound).Once()
+
+		req, _ := http.NewRequest("POST", "/api/v1/webhooks/"+subscriptionID.String()+"/deliveries/"+deliveryID.String()+"/redeliver", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
@@ -69,7 +69,22 @@ func setupIntegrationTest(t *testing.T, useFailingQueue bool) (*gin.Engine, func
 	// 初始化所有真實組件
 	orderRepo := repository.NewOrderRepository(testDB)
 	ticketRepo := repository.NewTicketRepository(testDB)
+	outboxRepo := repository.NewOutboxRepository(testDB)
+	seatRepo := repository.NewSeatRepository(testDB)
+	promoCodeRepo := repository.NewPromoCodeRepository(testDB)
+	presaleRepo := repository.NewPresaleRepository(testDB)
 	inventoryManager := cache.NewRedisTicketInventoryManager(testRdb)
+	seatHoldManager := cache.NewRedisSeatHoldManager(testRdb)
+	holdManager := cache.NewRedisTicketHoldManager(testRdb)
+	promoCodeManager := cache.NewRedisPromoCodeManager(testRdb)
+	presaleManager := cache.NewRedisPresaleManager(testRdb)
+	// 整合測試由同一 IP 大量下單，放寬門檻避免觸發風險評分
+	riskScorer := service.NewRiskScorer(cache.NewRedisRiskSignalManager(testRdb), &service.RiskScorerConfig{
+		MaxUserAttempts:   1 << 20,
+		MaxIPAttempts:     1 << 20,
+		MaxDeviceAttempts: 1 << 20,
+		MaxStockMisses:    1 << 20,
+	})
 
 	// 初始化
 	var orderService service.OrderService
@@ -78,7 +93,7 @@ func setupIntegrationTest(t *testing.T, useFailingQueue bool) (*gin.Engine, func
 
 	if useFailingQueue {
 		orderQueue = &failingQueue{}
-		orderService = service.NewOrderService(testDB, orderRepo, ticketRepo, inventoryManager, orderQueue)
+		orderService = service.NewOrderService(testDB, orderRepo, ticketRepo, seatRepo, outboxRepo, promoCodeRepo, inventoryManager, seatHoldManager, holdManager, promoCodeManager, presaleManager, riskScorer, orderQueue)
 	} else {
 		// 使用 Redis Stream 版 Queue
 		cfg := &queue.RedisStreamOrderQueueConfig{
@@ -91,12 +106,12 @@ func setupIntegrationTest(t *testing.T, useFailingQueue bool) (*gin.Engine, func
 		if err != nil {
 			t.Fatalf("Failed to create Redis stream order queue: %v", err)
 		}
-		orderService = service.NewOrderService(testDB, orderRepo, ticketRepo, inventoryManager, orderQueue)
+		orderService = service.NewOrderService(testDB, orderRepo, ticketRepo, seatRepo, outboxRepo, promoCodeRepo, inventoryManager, seatHoldManager, holdManager, promoCodeManager, presaleManager, riskScorer, orderQueue)
 
 		// 初始化 Worker
 		workerCtx, cancel := context.WithCancel(context.Background())
 		workerCancel = cancel
-		orderWorker := worker.NewOrderWorker(orderService, orderQueue)
+		orderWorker := worker.NewOrderWorker(orderService, orderQueue, nil)
 		if err := orderWorker.Start(workerCtx); err != nil {
 			t.Fatalf("Failed to start worker: %v", err)
 		}
@@ -104,9 +119,9 @@ func setupIntegrationTest(t *testing.T, useFailingQueue bool) (*gin.Engine, func
 
 	// 初始化 Handler 和 Router（含 Event / Ticket API，供 createTestEventViaAPI / createTestTicketViaAPI 使用）
 	eventRepo := repository.NewEventRepository(testDB)
-	eventService := service.NewEventService(eventRepo, ticketRepo, inventoryManager)
+	eventService := service.NewEventService(eventRepo, ticketRepo, seatRepo, presaleRepo, inventoryManager, seatHoldManager, presaleManager)
 	eventHandler := handler.NewEventHandler(eventService)
-	ticketService := service.NewTicketService(ticketRepo)
+	ticketService := service.NewTicketService(testDB, ticketRepo, seatRepo, inventoryManager)
 	ticketHandler := handler.NewTicketHandler(ticketService)
 
 	orderHandler := handler.NewOrderHandler(orderService)
@@ -130,7 +145,7 @@ func setupIntegrationTest(t *testing.T, useFailingQueue bool) (*gin.Engine, func
 
 func cleanupDB(ctx context.Context, t *testing.T) {
 	t.Helper()
-	_, err := testDB.Exec(ctx, "TRUNCATE tickets, orders, users, events RESTART IDENTITY CASCADE")
+	_, err := testDB.Exec(ctx, "TRUNCATE tickets, orders, users, events, outbox, venues, order_seats, inventory_adjustments, ticket_price_phases, promo_codes, promo_redemptions, presale_access_codes, presale_allowlist RESTART IDENTITY CASCADE")
 	if err != nil {
 		t.Logf("Warning: failed to truncate tables: %v", err)
 	}
@@ -196,7 +211,10 @@ func createTestTicket(t *testing.T, router *gin.Engine, eventName string, price
 func warmUpInventory(t *testing.T, inventoryManager cache.RedisTicketInventoryManager, ticketID int, stock int, price float64, limit int) {
 	t.Helper()
 	ctx := context.Background()
-	err := inventoryManager.WarmUpInventory(ctx, ticketID, stock, price, limit)
+	var eventID int
+	err := testDB.QueryRow(ctx, "SELECT event_id FROM tickets WHERE id = $1", ticketID).Scan(&eventID)
+	require.NoError(t, err)
+	err = inventoryManager.WarmUpInventory(ctx, ticketID, eventID, stock, price, limit)
 	require.NoError(t, err)
 }
 
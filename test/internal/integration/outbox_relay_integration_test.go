@@ -0,0 +1,81 @@
+package integration
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"go-gin-high-concurrency/internal/cache"
+	"go-gin-high-concurrency/internal/model"
+	"go-gin-high-concurrency/internal/queue"
+	"go-gin-high-concurrency/internal/repository"
+	"go-gin-high-concurrency/internal/worker"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestOutboxRelay_Integration_PublishesOrderEvents 測試訂單建立與確認後，relay 依序將事件發佈到 Redis Stream
+func TestOutboxRelay_Integration_PublishesOrderEvents(t *testing.T) {
+	router, cleanup := setupIntegrationTest(t, false)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	// 1. 準備測試資料
+	userID := createTestUser(t, "Test User", "test@example.com")
+	ticketID := createTestTicket(t, router, "Test Event", 100.0, 100, 2)
+	inventoryManager := cache.NewRedisTicketInventoryManager(testRdb)
+	warmUpInventory(t, inventoryManager, ticketID, 100, 100.0, 2)
+	time.Sleep(200 * time.Millisecond)
+
+	// 2. 建立訂單並等待 Worker 寫入資料庫
+	w := postCreateOrder(t, router, model.CreateOrderRequest{UserID: userID, TicketID: ticketID, Quantity: 1})
+	require.Equal(t, http.StatusCreated, w.Code)
+
+	var orderResponse model.Order
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &orderResponse))
+
+	orderRepo := repository.NewOrderRepository(testDB)
+	require.Eventually(t, func() bool {
+		_, err := orderRepo.FindByOrderID(ctx, orderResponse.OrderID)
+		return err == nil
+	}, 2*time.Second, 100*time.Millisecond)
+
+	// 3. 確認訂單
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, createHTTPRequest(http.MethodPut, "/api/v1/orders/"+orderResponse.OrderID.String()+"/confirm", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+
+	// 4. 啟動 relay
+	relayCtx, relayCancel := context.WithCancel(ctx)
+	defer relayCancel()
+	publisher := queue.NewRedisStreamEventPublisher(testRdb, queue.EventStreamKey)
+	relay := worker.NewOutboxRelay(testDB, repository.NewOutboxRepository(testDB), publisher, &worker.OutboxRelayConfig{
+		PollInterval: 50 * time.Millisecond,
+	})
+	require.NoError(t, relay.Start(relayCtx))
+
+	// 5. 驗證事件依序發佈
+	require.Eventually(t, func() bool {
+		n, err := testRdb.XLen(ctx, queue.EventStreamKey).Result()
+		return err == nil && n == 2
+	}, 2*time.Second, 50*time.Millisecond)
+
+	msgs, err := testRdb.XRange(ctx, queue.EventStreamKey, "-", "+").Result()
+	require.NoError(t, err)
+	require.Len(t, msgs, 2)
+	assert.Equal(t, model.EventTypeOrderCreated, msgs[0].Values["event_type"])
+	assert.Equal(t, model.EventTypeOrderConfirmed, msgs[1].Values["event_type"])
+	assert.Equal(t, orderResponse.OrderID.String(), msgs[1].Values["aggregate_id"])
+
+	// 6. 驗證 outbox 已全部標記為已發佈
+	require.Eventually(t, func() bool {
+		var unpublished int
+		err := testDB.QueryRow(ctx, "SELECT COUNT(*) FROM outbox WHERE published_at IS NULL").Scan(&unpublished)
+		return err == nil && unpublished == 0
+	}, 2*time.Second, 50*time.Millisecond)
+}
@@ -0,0 +1,90 @@
+package middleware
+
+import (
+	"go-gin-high-concurrency/internal/middleware"
+	"go-gin-high-concurrency/pkg/logger"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+// observeHTTPLogs 將存取日誌改寫到 observer，測試結束後還原
+func observeHTTPLogs(t *testing.T) *observer.ObservedLogs {
+	t.Helper()
+	core, logs := observer.New(zapcore.DebugLevel)
+	original := logger.HTTP
+	logger.HTTP = zap.New(core)
+	t.Cleanup(func() { logger.HTTP = original })
+	return logs
+}
+
+func setupAccessLogTestRouter() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(middleware.RequestID(), middleware.AccessLog("/healthz"), middleware.Recovery())
+	router.GET("/healthz", func(c *gin.Context) { c.Status(http.StatusOK) })
+	router.GET("/api/v1/orders/:uuid", func(c *gin.Context) {
+		middleware.SetUserID(c, 42)
+		c.JSON(http.StatusNotFound, gin.H{"error": "Order not found"})
+	})
+	router.GET("/panic", func(c *gin.Context) { panic("boom") })
+	return router
+}
+
+func TestAccessLog(t *testing.T) {
+	t.Run("Success - log route, status and user", func(t *testing.T) {
+		logs := observeHTTPLogs(t)
+		router := setupAccessLogTestRouter()
+
+		req, _ := http.NewRequest("GET", "/api/v1/orders/abc", nil)
+		req.Header.Set(middleware.RequestIDHeader, "req-123")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		require.Equal(t, 1, logs.Len())
+		entry := logs.All()[0]
+		assert.Equal(t, zapcore.WarnLevel, entry.Level)
+		fields := entry.ContextMap()
+		assert.Equal(t, "req-123", fields["request_id"])
+		assert.Equal(t, "/api/v1/orders/:uuid", fields["route"])
+		assert.Equal(t, "/api/v1/orders/abc", fields["path"])
+		assert.EqualValues(t, http.StatusNotFound, fields["status"])
+		assert.EqualValues(t, 42, fields["user_id"])
+		assert.Contains(t, fields, "latency")
+	})
+
+	t.Run("Success - skip quiet path", func(t *testing.T) {
+		logs := observeHTTPLogs(t)
+		router := setupAccessLogTestRouter()
+
+		req, _ := http.NewRequest("GET", "/healthz", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, 0, logs.Len())
+	})
+
+	t.Run("Failed - panic recovered", func(t *testing.T) {
+		logs := observeHTTPLogs(t)
+		router := setupAccessLogTestRouter()
+
+		req, _ := http.NewRequest("GET", "/panic", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		assert.NotEmpty(t, w.Header().Get(middleware.RequestIDHeader))
+		require.Equal(t, 2, logs.Len())
+		assert.Equal(t, "panic recovered", logs.All()[0].Message)
+		assert.Equal(t, zapcore.ErrorLevel, logs.All()[1].Level)
+		assert.EqualValues(t, http.StatusInternalServerError, logs.All()[1].ContextMap()["status"])
+	})
+}
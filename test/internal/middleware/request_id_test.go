@@ -0,0 +1,78 @@
+package middleware
+
+import (
+	"go-gin-high-concurrency/internal/middleware"
+	"go-gin-high-concurrency/pkg/logger"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// setupRequestIDTestRouter 回應 handler 看到的 request id（gin.Context 及 request context 各一）
+func setupRequestIDTestRouter() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(middleware.RequestID())
+	router.GET("/ping", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{
+			"gin":     logger.RequestID(c),
+			"request": logger.RequestID(c.Request.Context()),
+		})
+	})
+	return router
+}
+
+func TestRequestID(t *testing.T) {
+	t.Run("Success - reuse caller request id", func(t *testing.T) {
+		router := setupRequestIDTestRouter()
+
+		req, _ := http.NewRequest("GET", "/ping", nil)
+		req.Header.Set(middleware.RequestIDHeader, "req-123")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "req-123", w.Header().Get(middleware.RequestIDHeader))
+		assert.JSONEq(t, `{"gin":"req-123","request":"req-123"}`, w.Body.String())
+	})
+
+	t.Run("Success - generate when missing", func(t *testing.T) {
+		router := setupRequestIDTestRouter()
+
+		req, _ := http.NewRequest("GET", "/ping", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		id := w.Header().Get(middleware.RequestIDHeader)
+		_, err := uuid.Parse(id)
+		assert.NoError(t, err)
+		assert.Contains(t, w.Body.String(), id)
+	})
+
+	t.Run("Success - generate when invalid", func(t *testing.T) {
+		for name, id := range map[string]string{
+			"contains space": "req 123",
+			"too long":       strings.Repeat("a", 129),
+			"non ascii":      "訂單",
+		} {
+			t.Run(name, func(t *testing.T) {
+				router := setupRequestIDTestRouter()
+
+				req, _ := http.NewRequest("GET", "/ping", nil)
+				req.Header.Set(middleware.RequestIDHeader, id)
+				w := httptest.NewRecorder()
+				router.ServeHTTP(w, req)
+
+				got := w.Header().Get(middleware.RequestIDHeader)
+				assert.NotEqual(t, id, got)
+				_, err := uuid.Parse(got)
+				assert.NoError(t, err)
+			})
+		}
+	})
+}
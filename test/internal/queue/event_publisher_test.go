@@ -0,0 +1,44 @@
+package queue_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"go-gin-high-concurrency/internal/model"
+	"go-gin-high-concurrency/internal/queue"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedisStreamEventPublisher_Publish(t *testing.T) {
+	ctx := context.Background()
+	streamKey := "events:stream:test"
+	_ = testRdb.Del(ctx, streamKey).Err()
+	defer testRdb.Del(ctx, streamKey)
+
+	publisher := queue.NewRedisStreamEventPublisher(testRdb, streamKey)
+
+	aggregateID := uuid.New()
+	events := []*model.OutboxEvent{
+		{ID: 1, AggregateType: model.AggregateTypeOrder, AggregateID: aggregateID, EventType: model.EventTypeOrderCreated, Payload: json.RawMessage(`{"status":"pending"}`), CreatedAt: time.Now()},
+		{ID: 2, AggregateType: model.AggregateTypeOrder, AggregateID: aggregateID, EventType: model.EventTypeOrderConfirmed, Payload: json.RawMessage(`{"status":"confirmed"}`), CreatedAt: time.Now()},
+	}
+	for _, e := range events {
+		require.NoError(t, publisher.Publish(ctx, e))
+	}
+
+	// 驗證順序與欄位
+	msgs, err := testRdb.XRange(ctx, streamKey, "-", "+").Result()
+	require.NoError(t, err)
+	require.Len(t, msgs, 2)
+	assert.Equal(t, "1", msgs[0].Values["event_id"])
+	assert.Equal(t, model.EventTypeOrderCreated, msgs[0].Values["event_type"])
+	assert.Equal(t, aggregateID.String(), msgs[0].Values["aggregate_id"])
+	assert.JSONEq(t, `{"status":"pending"}`, msgs[0].Values["payload"].(string))
+	assert.Equal(t, "2", msgs[1].Values["event_id"])
+	assert.Equal(t, model.EventTypeOrderConfirmed, msgs[1].Values["event_type"])
+}
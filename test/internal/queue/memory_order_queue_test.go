@@ -0,0 +1,193 @@
+package queue_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go-gin-high-concurrency/internal/model"
+	"go-gin-high-concurrency/internal/queue"
+	"go-gin-high-concurrency/pkg/app_errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func receiveDelivery(t *testing.T, ch <-chan queue.Delivery, timeout time.Duration) queue.Delivery {
+	t.Helper()
+	select {
+	case d, ok := <-ch:
+		require.True(t, ok, "channel 不應關閉")
+		require.NotNil(t, d.Data)
+		return d
+	case <-time.After(timeout):
+		t.Fatal("timeout 未收到訊息")
+		return queue.Delivery{}
+	}
+}
+
+func assertNoDelivery(t *testing.T, ch <-chan queue.Delivery, wait time.Duration) {
+	t.Helper()
+	select {
+	case d, ok := <-ch:
+		if ok {
+			t.Fatalf("不應再收到訊息: RequestID=%s", d.Data.RequestID)
+		}
+	case <-time.After(wait):
+	}
+}
+
+func TestMemoryOrderQueue_PublishAndAck(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	q := queue.NewMemoryOrderQueue(nil)
+	order := &model.Order{UserID: 1, TicketID: 2, RequestID: "req-mem-1", Quantity: 2, SeatIDs: []int{3, 4}, Status: model.OrderStatusPending}
+	require.NoError(t, q.PublishOrder(ctx, order))
+	// 發送後修改原訂單不影響佇列中的內容
+	order.SeatIDs[0] = 99
+
+	delCh, err := q.SubscribeOrders(ctx)
+	require.NoError(t, err)
+
+	d := receiveDelivery(t, delCh, time.Second)
+	assert.Equal(t, "req-mem-1", d.Data.RequestID)
+	assert.Equal(t, []int{3, 4}, d.Data.SeatIDs)
+	d.Ack()
+
+	stats, err := q.(queue.InspectableOrderQueue).Stats(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, &queue.QueueStats{Pending: 0, Lag: 0}, stats)
+	assertNoDelivery(t, delCh, 100*time.Millisecond)
+}
+
+func TestMemoryOrderQueue_Publish_full(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	q := queue.NewMemoryOrderQueue(&queue.MemoryOrderQueueConfig{Capacity: 2})
+	require.NoError(t, q.PublishOrder(ctx, &model.Order{RequestID: "req-full-1"}))
+	require.NoError(t, q.PublishOrder(ctx, &model.Order{RequestID: "req-full-2"}))
+	assert.ErrorIs(t, q.PublishOrder(ctx, &model.Order{RequestID: "req-full-3"}), app_errors.ErrQueueFull)
+
+	// 處理中的訊息仍計入容量，Ack 後才釋出空位
+	delCh, err := q.SubscribeOrders(ctx)
+	require.NoError(t, err)
+	d := receiveDelivery(t, delCh, time.Second)
+	assert.ErrorIs(t, q.PublishOrder(ctx, &model.Order{RequestID: "req-full-3"}), app_errors.ErrQueueFull)
+
+	d.Ack()
+	assert.NoError(t, q.PublishOrder(ctx, &model.Order{RequestID: "req-full-3"}))
+}
+
+func TestMemoryOrderQueue_NackRequeue_redeliversAfterDelay(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	q := queue.NewMemoryOrderQueue(&queue.MemoryOrderQueueConfig{RedeliveryDelay: 200 * time.Millisecond})
+	require.NoError(t, q.PublishOrder(ctx, &model.Order{RequestID: "req-requeue"}))
+
+	delCh, err := q.SubscribeOrders(ctx)
+	require.NoError(t, err)
+
+	d := receiveDelivery(t, delCh, time.Second)
+	start := time.Now()
+	d.Nack(true)
+
+	stats, err := q.(queue.InspectableOrderQueue).Stats(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), stats.Pending, "等待重新投遞時計入 Pending")
+
+	redelivered := receiveDelivery(t, delCh, time.Second)
+	assert.Equal(t, "req-requeue", redelivered.Data.RequestID)
+	assert.GreaterOrEqual(t, time.Since(start), 200*time.Millisecond)
+	redelivered.Ack()
+}
+
+func TestMemoryOrderQueue_NackDiscard_preventsRedelivery(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	q := queue.NewMemoryOrderQueue(&queue.MemoryOrderQueueConfig{RedeliveryDelay: 10 * time.Millisecond})
+	require.NoError(t, q.PublishOrder(ctx, &model.Order{RequestID: "req-discard"}))
+
+	delCh, err := q.SubscribeOrders(ctx)
+	require.NoError(t, err)
+
+	d := receiveDelivery(t, delCh, time.Second)
+	d.Nack(false)
+	// 重複呼叫無效
+	d.Nack(true)
+
+	assertNoDelivery(t, delCh, 100*time.Millisecond)
+	stats, err := q.(queue.InspectableOrderQueue).Stats(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, &queue.QueueStats{Pending: 0, Lag: 0}, stats)
+}
+
+func TestMemoryOrderQueue_PoisonMessage_discardedAfterMaxRetry(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	q := queue.NewMemoryOrderQueue(&queue.MemoryOrderQueueConfig{RedeliveryDelay: 10 * time.Millisecond, MaxRetryCount: 3})
+	require.NoError(t, q.PublishOrder(ctx, &model.Order{RequestID: "req-poison"}))
+
+	delCh, err := q.SubscribeOrders(ctx)
+	require.NoError(t, err)
+
+	for range 3 {
+		d := receiveDelivery(t, delCh, time.Second)
+		d.Nack(true)
+	}
+
+	assertNoDelivery(t, delCh, 100*time.Millisecond)
+	stats, err := q.(queue.InspectableOrderQueue).Stats(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, &queue.QueueStats{Pending: 0, Lag: 0}, stats)
+}
+
+func TestMemoryOrderQueue_UpdateConfig_appliesRetrySettings(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	q := queue.NewMemoryOrderQueue(&queue.MemoryOrderQueueConfig{RedeliveryDelay: time.Minute, MaxRetryCount: 5})
+	q.(queue.ConfigurableMemoryOrderQueue).UpdateConfig(queue.MemoryOrderQueueConfig{RedeliveryDelay: 10 * time.Millisecond, MaxRetryCount: 2})
+	require.NoError(t, q.PublishOrder(ctx, &model.Order{RequestID: "req-reload"}))
+
+	delCh, err := q.SubscribeOrders(ctx)
+	require.NoError(t, err)
+
+	// 新的延遲與重試次數立即生效：第 2 次 Nack 後丟棄
+	for range 2 {
+		d := receiveDelivery(t, delCh, time.Second)
+		d.Nack(true)
+	}
+
+	assertNoDelivery(t, delCh, 100*time.Millisecond)
+}
+
+func TestMemoryOrderQueue_Subscribe_cancelKeepsMessage(t *testing.T) {
+	ctx := context.Background()
+	q := queue.NewMemoryOrderQueue(nil)
+	require.NoError(t, q.PublishOrder(ctx, &model.Order{RequestID: "req-cancel"}))
+
+	// 訂閱後未讀取即取消，訊息應留在佇列中
+	subCtx, cancel := context.WithCancel(ctx)
+	_, err := q.SubscribeOrders(subCtx)
+	require.NoError(t, err)
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+
+	require.Eventually(t, func() bool {
+		stats, err := q.(queue.InspectableOrderQueue).Stats(ctx)
+		return err == nil && stats.Lag == 1 && stats.Pending == 0
+	}, time.Second, 10*time.Millisecond)
+
+	nextCtx, nextCancel := context.WithCancel(ctx)
+	defer nextCancel()
+	delCh, err := q.SubscribeOrders(nextCtx)
+	require.NoError(t, err)
+	d := receiveDelivery(t, delCh, time.Second)
+	assert.Equal(t, "req-cancel", d.Data.RequestID)
+	d.Ack()
+}
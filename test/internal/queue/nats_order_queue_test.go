@@ -0,0 +1,156 @@
+package queue_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go-gin-high-concurrency/internal/model"
+	"go-gin-high-concurrency/internal/queue"
+
+	"github.com/nats-io/nats-server/v2/server"
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// startNATS 啟動內嵌的 JetStream 伺服器，測試結束時關閉
+func startNATS(t *testing.T) *nats.Conn {
+	t.Helper()
+	srv, err := server.NewServer(&server.Options{Host: "127.0.0.1", Port: -1, JetStream: true, StoreDir: t.TempDir(), NoLog: true, NoSigs: true})
+	require.NoError(t, err)
+	go srv.Start()
+	if !srv.ReadyForConnections(5 * time.Second) {
+		t.Fatal("nats server not ready")
+	}
+	t.Cleanup(srv.Shutdown)
+
+	nc, err := nats.Connect(srv.ClientURL())
+	require.NoError(t, err)
+	t.Cleanup(nc.Close)
+	return nc
+}
+
+func TestNATSOrderQueue_PublishAndAck(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	q, err := queue.NewNATSOrderQueue(startNATS(t), nil)
+	require.NoError(t, err)
+
+	order := &model.Order{UserID: 10, TicketID: 20, RequestID: "req-nats-1", Quantity: 1, TotalPrice: 50.0, Status: model.OrderStatusPending}
+	require.NoError(t, q.PublishOrder(ctx, order))
+
+	stats, err := q.(queue.InspectableOrderQueue).Stats(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, &queue.QueueStats{Pending: 0, Lag: 1}, stats)
+
+	delCh, err := q.SubscribeOrders(ctx)
+	require.NoError(t, err)
+
+	d := receiveDelivery(t, delCh, 5*time.Second)
+	assert.Equal(t, order.UserID, d.Data.UserID)
+	assert.Equal(t, order.TicketID, d.Data.TicketID)
+	assert.Equal(t, order.RequestID, d.Data.RequestID)
+	assert.Equal(t, order.TotalPrice, d.Data.TotalPrice)
+	d.Ack()
+
+	assertNoDelivery(t, delCh, 200*time.Millisecond)
+	require.Eventually(t, func() bool {
+		stats, err := q.(queue.InspectableOrderQueue).Stats(ctx)
+		return err == nil && stats.Pending == 0 && stats.Lag == 0
+	}, 2*time.Second, 20*time.Millisecond)
+}
+
+func TestNATSOrderQueue_Publish_deduplicatesRequestID(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	q, err := queue.NewNATSOrderQueue(startNATS(t), nil)
+	require.NoError(t, err)
+
+	order := &model.Order{RequestID: "req-nats-dup"}
+	require.NoError(t, q.PublishOrder(ctx, order))
+	require.NoError(t, q.PublishOrder(ctx, order))
+
+	stats, err := q.(queue.InspectableOrderQueue).Stats(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), stats.Lag, "相同 request_id 只保留一筆")
+}
+
+func TestNATSOrderQueue_NackRequeue_redeliversAfterDelay(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	q, err := queue.NewNATSOrderQueue(startNATS(t), &queue.NATSOrderQueueConfig{RedeliveryDelay: 200 * time.Millisecond})
+	require.NoError(t, err)
+	require.NoError(t, q.PublishOrder(ctx, &model.Order{RequestID: "req-nats-requeue"}))
+
+	delCh, err := q.SubscribeOrders(ctx)
+	require.NoError(t, err)
+
+	d := receiveDelivery(t, delCh, 5*time.Second)
+	start := time.Now()
+	d.Nack(true)
+
+	redelivered := receiveDelivery(t, delCh, 5*time.Second)
+	assert.Equal(t, "req-nats-requeue", redelivered.Data.RequestID)
+	assert.GreaterOrEqual(t, time.Since(start), 150*time.Millisecond)
+	redelivered.Ack()
+}
+
+func TestNATSOrderQueue_NackDiscard_preventsRedelivery(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	q, err := queue.NewNATSOrderQueue(startNATS(t), &queue.NATSOrderQueueConfig{RedeliveryDelay: 10 * time.Millisecond})
+	require.NoError(t, err)
+	require.NoError(t, q.PublishOrder(ctx, &model.Order{RequestID: "req-nats-discard"}))
+
+	delCh, err := q.SubscribeOrders(ctx)
+	require.NoError(t, err)
+
+	d := receiveDelivery(t, delCh, 5*time.Second)
+	d.Nack(false)
+
+	assertNoDelivery(t, delCh, 300*time.Millisecond)
+}
+
+func TestNATSOrderQueue_PoisonMessage_discardedAfterMaxRetry(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	q, err := queue.NewNATSOrderQueue(startNATS(t), &queue.NATSOrderQueueConfig{RedeliveryDelay: 10 * time.Millisecond, MaxRetryCount: 2})
+	require.NoError(t, err)
+	require.NoError(t, q.PublishOrder(ctx, &model.Order{RequestID: "req-nats-poison"}))
+
+	delCh, err := q.SubscribeOrders(ctx)
+	require.NoError(t, err)
+
+	// 投遞 2 次後，第 3 次由 Queue 直接丟棄
+	for range 2 {
+		d := receiveDelivery(t, delCh, 5*time.Second)
+		d.Nack(true)
+	}
+
+	assertNoDelivery(t, delCh, 300*time.Millisecond)
+}
+
+func TestNATSOrderQueue_UpdateConfig_appliesRetrySettings(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	q, err := queue.NewNATSOrderQueue(startNATS(t), &queue.NATSOrderQueueConfig{RedeliveryDelay: time.Minute, MaxRetryCount: 5})
+	require.NoError(t, err)
+	q.(queue.ConfigurableNATSOrderQueue).UpdateConfig(queue.NATSOrderQueueConfig{RedeliveryDelay: 10 * time.Millisecond, MaxRetryCount: 1})
+	require.NoError(t, q.PublishOrder(ctx, &model.Order{RequestID: "req-nats-reload"}))
+
+	delCh, err := q.SubscribeOrders(ctx)
+	require.NoError(t, err)
+
+	// 新的延遲立即生效，投遞 1 次後第 2 次由 Queue 直接丟棄
+	d := receiveDelivery(t, delCh, 5*time.Second)
+	d.Nack(true)
+
+	assertNoDelivery(t, delCh, 300*time.Millisecond)
+}
@@ -7,6 +7,7 @@ import (
 
 	"go-gin-high-concurrency/internal/model"
 	"go-gin-high-concurrency/internal/queue"
+	"go-gin-high-concurrency/pkg/app_errors"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -314,3 +315,33 @@ func TestRedisStreamOrderQueue_Subscribe_ctxCancel_closesChannel(t *testing.T) {
 		t.Fatal("channel 未在時限內關閉")
 	}
 }
+
+// --- 健康檢查：consumer group 積壓 ---
+
+func TestRedisStreamOrderQueue_Stats(t *testing.T) {
+	ctx := context.Background()
+	cleanupStream(ctx, t)
+	t.Cleanup(func() { cleanupStream(ctx, t) })
+
+	q, err := queue.NewRedisStreamOrderQueue(testRdb, "test-consumer", nil)
+	require.NoError(t, err)
+	inspectable, ok := q.(queue.InspectableOrderQueue)
+	require.True(t, ok)
+
+	stats, err := inspectable.Stats(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), stats.Pending)
+	assert.Equal(t, int64(0), stats.Lag)
+
+	for i := 1; i <= 3; i++ {
+		require.NoError(t, q.PublishOrder(ctx, &model.Order{ID: i, Status: model.OrderStatusPending}))
+	}
+	stats, err = inspectable.Stats(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), stats.Lag)
+
+	// stream 被刪除後 consumer group 也不存在
+	cleanupStream(ctx, t)
+	_, err = inspectable.Stats(ctx)
+	assert.ErrorIs(t, err, app_errors.ErrConsumerGroupNotFound)
+}
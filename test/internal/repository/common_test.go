@@ -47,7 +47,7 @@ func setupTestWithTruncate(t *testing.T) func() {
 	ctx := context.Background()
 
 	// 清空所有測試資料，保留 schema（子表先清：tickets, orders；再清 users, events）
-	_, err := testDB.Exec(ctx, "TRUNCATE tickets, orders, users, events RESTART IDENTITY CASCADE")
+	_, err := testDB.Exec(ctx, "TRUNCATE tickets, orders, users, events, outbox, venues, order_seats, inventory_adjustments, ticket_price_phases, promo_codes, promo_redemptions, presale_access_codes, presale_allowlist RESTART IDENTITY CASCADE")
 	if err != nil {
 		t.Fatalf("Failed to truncate tables: %v", err)
 	}
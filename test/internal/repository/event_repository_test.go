@@ -193,6 +193,26 @@ func TestEventRepository_Update(t *testing.T) {
 		assert.Equal(t, "New Desc", *updated.Description)
 	})
 
+	t.Run("Success_UpdateMaxPerUser", func(t *testing.T) {
+		cleanup := setupTestWithTruncate(t)
+		defer cleanup()
+
+		eventID := createTestEvent(t, "Event")
+		maxPerUser := 6
+		updated, err := repo.Update(ctx, eventID, model.UpdateEventParams{MaxPerUser: &maxPerUser})
+
+		require.NoError(t, err)
+		require.NotNil(t, updated.MaxPerUser)
+		assert.Equal(t, 6, *updated.MaxPerUser)
+
+		// 0 為取消上限
+		noCap := 0
+		updated, err = repo.Update(ctx, eventID, model.UpdateEventParams{MaxPerUser: &noCap})
+
+		require.NoError(t, err)
+		assert.Nil(t, updated.MaxPerUser)
+	})
+
 	t.Run("NotFound", func(t *testing.T) {
 		cleanup := setupTestWithTruncate(t)
 		defer cleanup()
@@ -47,6 +47,36 @@ func TestOrderRepository_Create(t *testing.T) {
 		assert.Equal(t, model.OrderStatusPending, createdOrder.Status)
 		assert.NotZero(t, createdOrder.CreatedAt)
 		assert.NotZero(t, createdOrder.UpdatedAt)
+		assert.Zero(t, createdOrder.RiskScore)
+		assert.Empty(t, createdOrder.RiskFlags)
+	})
+
+	t.Run("Success_WithRiskFlags", func(t *testing.T) {
+		cleanup := setupTestWithTruncate(t)
+		defer cleanup()
+
+		userID := createTestUser(t, "Test User", "test@example.com")
+		eventID := createTestEvent(t, "Test Event")
+		ticketID := createTestTicket(t, eventID, "Test Event", 100)
+
+		order := &model.Order{
+			UserID:     userID,
+			TicketID:   ticketID,
+			Quantity:   1,
+			TotalPrice: 100.0,
+			RiskScore:  40,
+			RiskFlags:  []string{model.RiskFlagSharedPayment},
+			Status:     model.OrderStatusPending,
+		}
+
+		tx, txCleanup := setupTestWithTransaction(t)
+		defer txCleanup()
+
+		createdOrder, err := repo.Create(ctx, tx, order)
+
+		require.NoError(t, err)
+		assert.Equal(t, 40, createdOrder.RiskScore)
+		assert.Equal(t, []string{model.RiskFlagSharedPayment}, createdOrder.RiskFlags)
 	})
 }
 
@@ -117,6 +147,39 @@ func TestOrderRepository_FindByOrderID(t *testing.T) {
 	})
 }
 
+func TestOrderRepository_FindByRequestID(t *testing.T) {
+	repo := repository.NewOrderRepository(getTestDB())
+	ctx := context.Background()
+
+	t.Run("Success", func(t *testing.T) {
+		cleanup := setupTestWithTruncate(t)
+		defer cleanup()
+
+		userID := createTestUser(t, "Test User", "test@example.com")
+		eventID := createTestEvent(t, "Test Event")
+		ticketID := createTestTicket(t, eventID, "Test Event", 50)
+		orderID := createTestOrder(t, userID, ticketID, 1, 100.0, model.OrderStatusPending)
+		order, err := repo.FindByID(ctx, orderID)
+		require.NoError(t, err)
+
+		found, err := repo.FindByRequestID(ctx, order.RequestID)
+
+		require.NoError(t, err)
+		assert.Equal(t, orderID, found.ID)
+		assert.Equal(t, order.OrderID, found.OrderID)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		cleanup := setupTestWithTruncate(t)
+		defer cleanup()
+
+		_, err := repo.FindByRequestID(ctx, "unknown-request")
+
+		require.Error(t, err)
+		assert.Equal(t, apperrors.ErrOrderNotFound, err)
+	})
+}
+
 func TestOrderRepository_FindByUserID(t *testing.T) {
 	repo := repository.NewOrderRepository(getTestDB())
 	ctx := context.Background()
@@ -152,6 +215,49 @@ func TestOrderRepository_FindByUserID(t *testing.T) {
 	})
 }
 
+func TestOrderRepository_ListFlaggedByEventID(t *testing.T) {
+	repo := repository.NewOrderRepository(getTestDB())
+	ctx := context.Background()
+
+	t.Run("Success", func(t *testing.T) {
+		cleanup := setupTestWithTruncate(t)
+		defer cleanup()
+
+		userID := createTestUser(t, "Test User", "test@example.com")
+		eventID := createTestEvent(t, "Concert")
+		otherEventID := createTestEvent(t, "Other")
+		ticketID := createTestTicket(t, eventID, "Concert", 100)
+		otherTicketID := createTestTicket(t, otherEventID, "Other", 100)
+
+		flaggedID := createTestOrder(t, userID, ticketID, 1, 100.0, model.OrderStatusPending)
+		createTestOrder(t, userID, ticketID, 1, 100.0, model.OrderStatusPending)
+		otherFlaggedID := createTestOrder(t, userID, otherTicketID, 1, 100.0, model.OrderStatusPending)
+		for _, id := range []int{flaggedID, otherFlaggedID} {
+			_, err := testDB.Exec(ctx, `UPDATE orders SET risk_score = 30, risk_flags = $2 WHERE id = $1`, id, []string{model.RiskFlagIPVelocity})
+			require.NoError(t, err)
+		}
+
+		orders, err := repo.ListFlaggedByEventID(ctx, eventID)
+
+		require.NoError(t, err)
+		require.Len(t, orders, 1)
+		assert.Equal(t, flaggedID, orders[0].ID)
+		assert.Equal(t, 30, orders[0].RiskScore)
+		assert.Equal(t, []string{model.RiskFlagIPVelocity}, orders[0].RiskFlags)
+	})
+
+	t.Run("EmptyList", func(t *testing.T) {
+		cleanup := setupTestWithTruncate(t)
+		defer cleanup()
+
+		eventID := createTestEvent(t, "Concert")
+		orders, err := repo.ListFlaggedByEventID(ctx, eventID)
+
+		require.NoError(t, err)
+		assert.Empty(t, orders)
+	})
+}
+
 func TestOrderRepository_List(t *testing.T) {
 	repo := repository.NewOrderRepository(getTestDB())
 	ctx := context.Background()
@@ -278,6 +384,103 @@ func TestOrderRepository_UpdateStatusWithLock(t *testing.T) {
 	})
 }
 
+func TestOrderRepository_FindByIDWithLock(t *testing.T) {
+	repo := repository.NewOrderRepository(getTestDB())
+	ctx := context.Background()
+
+	t.Run("Success", func(t *testing.T) {
+		cleanup := setupTestWithTruncate(t)
+		defer cleanup()
+
+		userID := createTestUser(t, "Test User", "test@example.com")
+		eventID := createTestEvent(t, "Concert A")
+		ticketID := createTestTicket(t, eventID, "Concert A", 100)
+		orderID := createTestOrder(t, userID, ticketID, 1, 100.0, model.OrderStatusPending)
+
+		tx, txCleanup := setupTestWithTransaction(t)
+		defer txCleanup()
+
+		found, err := repo.FindByIDWithLock(ctx, tx, orderID)
+
+		require.NoError(t, err)
+		assert.Equal(t, orderID, found.ID)
+		assert.Equal(t, model.OrderStatusPending, found.Status)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		cleanup := setupTestWithTruncate(t)
+		defer cleanup()
+
+		tx, txCleanup := setupTestWithTransaction(t)
+		defer txCleanup()
+
+		_, err := repo.FindByIDWithLock(ctx, tx, 99999)
+
+		require.Error(t, err)
+		assert.Equal(t, apperrors.ErrOrderNotFound, err)
+	})
+}
+
+func TestOrderRepository_StatusHistory(t *testing.T) {
+	repo := repository.NewOrderRepository(getTestDB())
+	ctx := context.Background()
+
+	t.Run("CreateAndList", func(t *testing.T) {
+		cleanup := setupTestWithTruncate(t)
+		defer cleanup()
+
+		userID := createTestUser(t, "Test User", "test@example.com")
+		eventID := createTestEvent(t, "Concert A")
+		ticketID := createTestTicket(t, eventID, "Concert A", 100)
+		orderID := createTestOrder(t, userID, ticketID, 1, 100.0, model.OrderStatusPending)
+
+		tx, err := getTestDB().Begin(ctx)
+		require.NoError(t, err)
+
+		created, err := repo.CreateStatusHistory(ctx, tx, &model.OrderStatusHistory{
+			OrderID:  orderID,
+			ToStatus: model.OrderStatusPending,
+			Actor:    model.OrderActorSystem,
+		})
+		require.NoError(t, err)
+		assert.NotZero(t, created.ID)
+		assert.Nil(t, created.FromStatus)
+		assert.NotZero(t, created.CreatedAt)
+
+		pending := model.OrderStatusPending
+		reason := "paid"
+		_, err = repo.CreateStatusHistory(ctx, tx, &model.OrderStatusHistory{
+			OrderID:    orderID,
+			FromStatus: &pending,
+			ToStatus:   model.OrderStatusConfirmed,
+			Actor:      model.OrderActorUser,
+			Reason:     &reason,
+		})
+		require.NoError(t, err)
+		require.NoError(t, tx.Commit(ctx))
+
+		history, err := repo.ListStatusHistory(ctx, orderID)
+
+		require.NoError(t, err)
+		require.Len(t, history, 2)
+		assert.Equal(t, model.OrderStatusPending, history[0].ToStatus)
+		assert.Equal(t, model.OrderStatusPending, *history[1].FromStatus)
+		assert.Equal(t, model.OrderStatusConfirmed, history[1].ToStatus)
+		assert.Equal(t, model.OrderActorUser, history[1].Actor)
+		assert.Equal(t, reason, *history[1].Reason)
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		cleanup := setupTestWithTruncate(t)
+		defer cleanup()
+
+		history, err := repo.ListStatusHistory(ctx, 99999)
+
+		require.NoError(t, err)
+		assert.Empty(t, history)
+	})
+}
+
 func TestOrderRepository_GetUserTicketOrderCount(t *testing.T) {
 	repo := repository.NewOrderRepository(getTestDB())
 	ctx := context.Background()
@@ -327,6 +530,7 @@ func TestOrderRepository_GetUserTicketOrderCount(t *testing.T) {
 
 		createTestOrder(t, userID, ticketID, 2, 2000.0, model.OrderStatusPending)
 		createTestOrder(t, userID, ticketID, 3, 3000.0, model.OrderStatusCancelled)
+		createTestOrder(t, userID, ticketID, 1, 1000.0, model.OrderStatusExpired)
 
 		tx, txCleanup := setupTestWithTransaction(t)
 		defer txCleanup()
@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"go-gin-high-concurrency/internal/model"
+	"go-gin-high-concurrency/internal/repository"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestOutboxEvent(eventType string) *model.OutboxEvent {
+	return &model.OutboxEvent{
+		AggregateType: model.AggregateTypeOrder,
+		AggregateID:   uuid.New(),
+		EventType:     eventType,
+		Payload:       json.RawMessage(`{"status":"pending"}`),
+	}
+}
+
+func TestOutboxRepository_Create(t *testing.T) {
+	repo := repository.NewOutboxRepository(getTestDB())
+	ctx := context.Background()
+
+	t.Run("Success", func(t *testing.T) {
+		cleanup := setupTestWithTruncate(t)
+		defer cleanup()
+
+		tx, txCleanup := setupTestWithTransaction(t)
+		defer txCleanup()
+
+		event := newTestOutboxEvent(model.EventTypeOrderCreated)
+		created, err := repo.Create(ctx, tx, event)
+
+		require.NoError(t, err)
+		assert.NotZero(t, created.ID)
+		assert.Equal(t, model.EventTypeOrderCreated, created.EventType)
+		assert.JSONEq(t, `{"status":"pending"}`, string(created.Payload))
+		assert.NotZero(t, created.CreatedAt)
+		assert.Nil(t, created.PublishedAt)
+	})
+}
+
+func TestOutboxRepository_ListUnpublishedWithLock(t *testing.T) {
+	repo := repository.NewOutboxRepository(getTestDB())
+	ctx := context.Background()
+
+	t.Run("Returns unpublished events in insertion order", func(t *testing.T) {
+		cleanup := setupTestWithTruncate(t)
+		defer cleanup()
+
+		tx, txCleanup := setupTestWithTransaction(t)
+		defer txCleanup()
+
+		first, err := repo.Create(ctx, tx, newTestOutboxEvent(model.EventTypeOrderCreated))
+		require.NoError(t, err)
+		second, err := repo.Create(ctx, tx, newTestOutboxEvent(model.EventTypeOrderConfirmed))
+		require.NoError(t, err)
+		third, err := repo.Create(ctx, tx, newTestOutboxEvent(model.EventTypeOrderCancelled))
+		require.NoError(t, err)
+
+		require.NoError(t, repo.MarkPublished(ctx, tx, []int64{first.ID}))
+
+		events, err := repo.ListUnpublishedWithLock(ctx, tx, 10)
+		require.NoError(t, err)
+		require.Len(t, events, 2)
+		assert.Equal(t, second.ID, events[0].ID)
+		assert.Equal(t, third.ID, events[1].ID)
+	})
+
+	t.Run("Respects limit", func(t *testing.T) {
+		cleanup := setupTestWithTruncate(t)
+		defer cleanup()
+
+		tx, txCleanup := setupTestWithTransaction(t)
+		defer txCleanup()
+
+		for i := 0; i < 3; i++ {
+			_, err := repo.Create(ctx, tx, newTestOutboxEvent(model.EventTypeOrderCreated))
+			require.NoError(t, err)
+		}
+
+		events, err := repo.ListUnpublishedWithLock(ctx, tx, 2)
+		require.NoError(t, err)
+		assert.Len(t, events, 2)
+	})
+}
+
+func TestOutboxRepository_MarkPublished(t *testing.T) {
+	repo := repository.NewOutboxRepository(getTestDB())
+	ctx := context.Background()
+
+	t.Run("Empty ids is a no-op", func(t *testing.T) {
+		tx, txCleanup := setupTestWithTransaction(t)
+		defer txCleanup()
+
+		err := repo.MarkPublished(ctx, tx, nil)
+		assert.NoError(t, err)
+	})
+}
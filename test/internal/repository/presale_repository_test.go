@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"go-gin-high-concurrency/internal/model"
+	"go-gin-high-concurrency/internal/repository"
+	apperrors "go-gin-high-concurrency/pkg/app_errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPresaleRepository_AccessCodes(t *testing.T) {
+	repo := repository.NewPresaleRepository(getTestDB())
+	ctx := context.Background()
+
+	t.Run("Success - create and list", func(t *testing.T) {
+		cleanup := setupTestWithTruncate(t)
+		defer cleanup()
+
+		eventID := createTestEvent(t, "Test Event")
+		ticketID := createTestTicket(t, eventID, "Test Event", 100)
+		maxUses := 50
+		created, err := repo.CreateAccessCode(ctx, &model.PresaleAccessCode{TicketID: ticketID, Code: "FANCLUB", MaxUses: &maxUses})
+		require.NoError(t, err)
+		assert.NotZero(t, created.ID)
+
+		_, err = repo.CreateAccessCode(ctx, &model.PresaleAccessCode{TicketID: ticketID, Code: "VIP"})
+		require.NoError(t, err)
+
+		accessCodes, err := repo.ListAccessCodes(ctx, ticketID)
+		require.NoError(t, err)
+		require.Len(t, accessCodes, 2)
+		assert.Equal(t, "FANCLUB", accessCodes[0].Code)
+		require.NotNil(t, accessCodes[0].MaxUses)
+		assert.Equal(t, 50, *accessCodes[0].MaxUses)
+		assert.Nil(t, accessCodes[1].MaxUses)
+	})
+
+	t.Run("Failed - ErrAlreadyExists", func(t *testing.T) {
+		cleanup := setupTestWithTruncate(t)
+		defer cleanup()
+
+		eventID := createTestEvent(t, "Test Event")
+		ticketID := createTestTicket(t, eventID, "Test Event", 100)
+		_, err := repo.CreateAccessCode(ctx, &model.PresaleAccessCode{TicketID: ticketID, Code: "FANCLUB"})
+		require.NoError(t, err)
+
+		_, err = repo.CreateAccessCode(ctx, &model.PresaleAccessCode{TicketID: ticketID, Code: "FANCLUB"})
+		assert.ErrorIs(t, err, apperrors.ErrAlreadyExists)
+	})
+
+	t.Run("Success - cancelled orders are excluded from active uses", func(t *testing.T) {
+		cleanup := setupTestWithTruncate(t)
+		defer cleanup()
+
+		userID := createTestUser(t, "Test User", "test@example.com")
+		eventID := createTestEvent(t, "Test Event")
+		ticketID := createTestTicket(t, eventID, "Test Event", 100)
+		confirmedID := createTestOrder(t, userID, ticketID, 1, 100, model.OrderStatusConfirmed)
+		cancelledID := createTestOrder(t, userID, ticketID, 1, 100, model.OrderStatusCancelled)
+		_, err := getTestDB().Exec(ctx, "UPDATE orders SET access_code = 'FANCLUB' WHERE id = ANY($1)", []int{confirmedID, cancelledID})
+		require.NoError(t, err)
+
+		used, err := repo.CountActiveAccessCodeUses(ctx, ticketID)
+
+		require.NoError(t, err)
+		assert.Equal(t, map[string]int{"FANCLUB": 1}, used)
+	})
+}
+
+func TestPresaleRepository_Allowlist(t *testing.T) {
+	repo := repository.NewPresaleRepository(getTestDB())
+	ctx := context.Background()
+
+	t.Run("Success - add is idempotent and remove", func(t *testing.T) {
+		cleanup := setupTestWithTruncate(t)
+		defer cleanup()
+
+		firstUserID := createTestUser(t, "First User", "first@example.com")
+		secondUserID := createTestUser(t, "Second User", "second@example.com")
+		eventID := createTestEvent(t, "Test Event")
+		ticketID := createTestTicket(t, eventID, "Test Event", 100)
+
+		require.NoError(t, repo.AddAllowlistUsers(ctx, ticketID, []int{firstUserID, secondUserID}))
+		require.NoError(t, repo.AddAllowlistUsers(ctx, ticketID, []int{firstUserID}))
+		assertRowCount(t, "presale_allowlist", 2)
+
+		require.NoError(t, repo.RemoveAllowlistUser(ctx, ticketID, firstUserID))
+
+		userIDs, err := repo.ListAllowlistUserIDs(ctx, ticketID)
+		require.NoError(t, err)
+		assert.Equal(t, []int{secondUserID}, userIDs)
+	})
+
+	t.Run("Failed - ErrUserNotFound", func(t *testing.T) {
+		cleanup := setupTestWithTruncate(t)
+		defer cleanup()
+
+		eventID := createTestEvent(t, "Test Event")
+		ticketID := createTestTicket(t, eventID, "Test Event", 100)
+
+		err := repo.AddAllowlistUsers(ctx, ticketID, []int{999999})
+		assert.ErrorIs(t, err, apperrors.ErrUserNotFound)
+	})
+}
@@ -0,0 +1,135 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"go-gin-high-concurrency/internal/model"
+	"go-gin-high-concurrency/internal/repository"
+	apperrors "go-gin-high-concurrency/pkg/app_errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPromoCodeRepository_Create(t *testing.T) {
+	repo := repository.NewPromoCodeRepository(getTestDB())
+	ctx := context.Background()
+
+	t.Run("Success", func(t *testing.T) {
+		cleanup := setupTestWithTruncate(t)
+		defer cleanup()
+
+		eventID := createTestEvent(t, "Test Event")
+		maxRedemptions := 100
+		created, err := repo.Create(ctx, &model.PromoCode{
+			Code:           "SAVE10",
+			DiscountType:   model.DiscountTypePercentage,
+			DiscountValue:  10,
+			EventID:        &eventID,
+			MaxRedemptions: &maxRedemptions,
+		})
+
+		require.NoError(t, err)
+		assert.NotZero(t, created.ID)
+		assert.Equal(t, "SAVE10", created.Code)
+		assert.Equal(t, model.DiscountTypePercentage, created.DiscountType)
+		require.NotNil(t, created.EventID)
+		assert.Equal(t, eventID, *created.EventID)
+		assert.Nil(t, created.TicketID)
+		assert.Nil(t, created.MaxPerUser)
+	})
+
+	t.Run("Failed - ErrAlreadyExists", func(t *testing.T) {
+		cleanup := setupTestWithTruncate(t)
+		defer cleanup()
+
+		promo := &model.PromoCode{Code: "DUP", DiscountType: model.DiscountTypeFixed, DiscountValue: 50}
+		_, err := repo.Create(ctx, promo)
+		require.NoError(t, err)
+
+		_, err = repo.Create(ctx, &model.PromoCode{Code: "DUP", DiscountType: model.DiscountTypeFixed, DiscountValue: 30})
+		assert.ErrorIs(t, err, apperrors.ErrAlreadyExists)
+	})
+}
+
+func TestPromoCodeRepository_FindByCode(t *testing.T) {
+	repo := repository.NewPromoCodeRepository(getTestDB())
+	ctx := context.Background()
+
+	t.Run("Success", func(t *testing.T) {
+		cleanup := setupTestWithTruncate(t)
+		defer cleanup()
+
+		_, err := repo.Create(ctx, &model.PromoCode{Code: "FLAT50", DiscountType: model.DiscountTypeFixed, DiscountValue: 50})
+		require.NoError(t, err)
+
+		promo, err := repo.FindByCode(ctx, "FLAT50")
+
+		require.NoError(t, err)
+		assert.Equal(t, 50.0, promo.DiscountValue)
+	})
+
+	t.Run("Failed - ErrPromoCodeNotFound", func(t *testing.T) {
+		cleanup := setupTestWithTruncate(t)
+		defer cleanup()
+
+		_, err := repo.FindByCode(ctx, "MISSING")
+
+		assert.ErrorIs(t, err, apperrors.ErrPromoCodeNotFound)
+	})
+}
+
+func TestPromoCodeRepository_Redemptions(t *testing.T) {
+	repo := repository.NewPromoCodeRepository(getTestDB())
+	ctx := context.Background()
+
+	t.Run("Success - returned redemptions are excluded from active counts", func(t *testing.T) {
+		cleanup := setupTestWithTruncate(t)
+		defer cleanup()
+
+		userID := createTestUser(t, "Test User", "test@example.com")
+		eventID := createTestEvent(t, "Test Event")
+		ticketID := createTestTicket(t, eventID, "Test Event", 100)
+		firstOrderID := createTestOrder(t, userID, ticketID, 1, 90, model.OrderStatusConfirmed)
+		secondOrderID := createTestOrder(t, userID, ticketID, 1, 90, model.OrderStatusConfirmed)
+
+		promo, err := repo.Create(ctx, &model.PromoCode{Code: "SAVE10", DiscountType: model.DiscountTypeFixed, DiscountValue: 10})
+		require.NoError(t, err)
+
+		tx, err := getTestDB().Begin(ctx)
+		require.NoError(t, err)
+		for _, orderID := range []int{firstOrderID, secondOrderID} {
+			_, err = repo.CreateRedemption(ctx, tx, &model.PromoRedemption{
+				PromoCodeID: promo.ID, OrderID: orderID, UserID: userID, DiscountAmount: 10,
+			})
+			require.NoError(t, err)
+		}
+		require.NoError(t, tx.Commit(ctx))
+
+		counts, err := repo.CountActiveRedemptionsByUser(ctx, promo.ID)
+		require.NoError(t, err)
+		assert.Equal(t, map[int]int{userID: 2}, counts)
+
+		tx, err = getTestDB().Begin(ctx)
+		require.NoError(t, err)
+		require.NoError(t, repo.ReturnRedemption(ctx, tx, firstOrderID))
+		require.NoError(t, tx.Commit(ctx))
+
+		counts, err = repo.CountActiveRedemptionsByUser(ctx, promo.ID)
+		require.NoError(t, err)
+		assert.Equal(t, map[int]int{userID: 1}, counts)
+
+		redemptions, err := repo.ListRedemptions(ctx, promo.ID)
+		require.NoError(t, err)
+		require.Len(t, redemptions, 2)
+		returned := 0
+		for _, redemption := range redemptions {
+			if redemption.ReturnedAt != nil {
+				returned++
+				assert.Equal(t, firstOrderID, redemption.OrderID)
+			}
+		}
+		assert.Equal(t, 1, returned)
+	})
+}
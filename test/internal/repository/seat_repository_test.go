@@ -0,0 +1,131 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"go-gin-high-concurrency/internal/model"
+	"go-gin-high-concurrency/internal/repository"
+	apperrors "go-gin-high-concurrency/pkg/app_errors"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// createTestVenue 建立一個場地，含一個區域與 rowA 的 seats 個座位，回傳場地與區域 ID
+func createTestVenue(t *testing.T, repo repository.SeatRepository, seats int) (*model.Venue, int) {
+	t.Helper()
+	ctx := context.Background()
+
+	tx, err := getTestDB().Begin(ctx)
+	require.NoError(t, err)
+	defer tx.Rollback(ctx)
+
+	venue, err := repo.CreateVenue(ctx, tx, &model.Venue{VenueID: uuid.New(), Name: "Arena"})
+	require.NoError(t, err)
+	section, err := repo.CreateSection(ctx, tx, &model.VenueSection{VenueID: venue.ID, Name: "Floor"})
+	require.NoError(t, err)
+
+	rows := make([]*model.Seat, 0, seats)
+	for i := 1; i <= seats; i++ {
+		rows = append(rows, &model.Seat{SectionID: section.ID, Row: "A", Number: i})
+	}
+	require.NoError(t, repo.CreateSeats(ctx, tx, rows))
+	require.NoError(t, tx.Commit(ctx))
+	return venue, section.ID
+}
+
+func TestSeatRepository_Venue(t *testing.T) {
+	repo := repository.NewSeatRepository(getTestDB())
+	ctx := context.Background()
+
+	t.Run("Create and find with sections and seats", func(t *testing.T) {
+		cleanup := setupTestWithTruncate(t)
+		defer cleanup()
+
+		venue, sectionID := createTestVenue(t, repo, 3)
+
+		found, err := repo.FindVenueByVenueID(ctx, venue.VenueID)
+		require.NoError(t, err)
+		assert.Equal(t, "Arena", found.Name)
+		require.Len(t, found.Sections, 1)
+		assert.Equal(t, sectionID, found.Sections[0].ID)
+		require.Len(t, found.Sections[0].Seats, 3)
+		assert.Equal(t, 1, found.Sections[0].Seats[0].Number)
+	})
+
+	t.Run("FindVenueByVenueID - ErrVenueNotFound", func(t *testing.T) {
+		cleanup := setupTestWithTruncate(t)
+		defer cleanup()
+
+		_, err := repo.FindVenueByVenueID(ctx, uuid.New())
+		assert.Equal(t, apperrors.ErrVenueNotFound, err)
+	})
+}
+
+func TestSeatRepository_OrderSeats(t *testing.T) {
+	repo := repository.NewSeatRepository(getTestDB())
+	ctx := context.Background()
+
+	t.Run("Create, list sold and release", func(t *testing.T) {
+		cleanup := setupTestWithTruncate(t)
+		defer cleanup()
+
+		_, sectionID := createTestVenue(t, repo, 3)
+		seats, err := repo.ListSeatsBySectionID(ctx, sectionID)
+		require.NoError(t, err)
+
+		eventID := createTestEvent(t, "Test Event")
+		ticketID := createTestTicket(t, eventID, "Test Event", 3)
+		userID := createTestUser(t, "Seat User", "seat@example.com")
+		orderID := createTestOrder(t, userID, ticketID, 2, 200, model.OrderStatusPending)
+
+		tx, txCleanup := setupTestWithTransaction(t)
+		defer txCleanup()
+
+		err = repo.CreateOrderSeats(ctx, tx, []*model.OrderSeat{
+			{OrderID: orderID, TicketID: ticketID, SeatID: seats[0].ID},
+			{OrderID: orderID, TicketID: ticketID, SeatID: seats[1].ID},
+		})
+		require.NoError(t, err)
+
+		released, err := repo.ReleaseOrderSeats(ctx, tx, orderID)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []int{seats[0].ID, seats[1].ID}, released)
+
+		// 釋放後同一座位可以再次售出
+		err = repo.CreateOrderSeats(ctx, tx, []*model.OrderSeat{{OrderID: orderID, TicketID: ticketID, SeatID: seats[0].ID}})
+		require.NoError(t, err)
+	})
+
+	t.Run("CreateOrderSeats - ErrSeatUnavailable", func(t *testing.T) {
+		cleanup := setupTestWithTruncate(t)
+		defer cleanup()
+
+		_, sectionID := createTestVenue(t, repo, 1)
+		seats, err := repo.ListSeatsBySectionID(ctx, sectionID)
+		require.NoError(t, err)
+
+		eventID := createTestEvent(t, "Test Event")
+		ticketID := createTestTicket(t, eventID, "Test Event", 1)
+		userID := createTestUser(t, "Seat User", "seat@example.com")
+		firstOrderID := createTestOrder(t, userID, ticketID, 1, 100, model.OrderStatusPending)
+		secondOrderID := createTestOrder(t, userID, ticketID, 1, 100, model.OrderStatusPending)
+
+		tx, err := getTestDB().Begin(ctx)
+		require.NoError(t, err)
+		require.NoError(t, repo.CreateOrderSeats(ctx, tx, []*model.OrderSeat{{OrderID: firstOrderID, TicketID: ticketID, SeatID: seats[0].ID}}))
+		require.NoError(t, tx.Commit(ctx))
+
+		sold, err := repo.ListSoldSeatIDs(ctx, ticketID)
+		require.NoError(t, err)
+		assert.Equal(t, []int{seats[0].ID}, sold)
+
+		tx, txCleanup := setupTestWithTransaction(t)
+		defer txCleanup()
+
+		err = repo.CreateOrderSeats(ctx, tx, []*model.OrderSeat{{OrderID: secondOrderID, TicketID: ticketID, SeatID: seats[0].ID}})
+		assert.Equal(t, apperrors.ErrSeatUnavailable, err)
+	})
+}
@@ -183,7 +183,10 @@ func TestTicketRepository_Update(t *testing.T) {
 			MaxPerUser: &maxPerUser,
 		}
 
-		updated, err := repo.Update(ctx, ticket.TicketID, updates)
+		tx, txCleanup := setupTestWithTransaction(t)
+		defer txCleanup()
+
+		updated, err := repo.Update(ctx, tx, ticket.TicketID, updates)
 
 		require.NoError(t, err)
 		assert.Equal(t, "Updated Concert", updated.Name)
@@ -201,7 +204,10 @@ func TestTicketRepository_Update(t *testing.T) {
 			Name: &eventName,
 		}
 
-		_, err := repo.Update(ctx, uuid.New(), updates)
+		tx, txCleanup := setupTestWithTransaction(t)
+		defer txCleanup()
+
+		_, err := repo.Update(ctx, tx, uuid.New(), updates)
 
 		require.Error(t, err)
 		assert.Equal(t, apperrors.ErrTicketNotFound, err)
@@ -217,7 +223,10 @@ func TestTicketRepository_Update(t *testing.T) {
 		require.NoError(t, err)
 		updates := model.UpdateTicketParams{}
 
-		_, err = repo.Update(ctx, ticket.TicketID, updates)
+		tx, txCleanup := setupTestWithTransaction(t)
+		defer txCleanup()
+
+		_, err = repo.Update(ctx, tx, ticket.TicketID, updates)
 
 		require.Error(t, err)
 		assert.Equal(t, apperrors.ErrInvalidInput, err)
@@ -324,8 +333,9 @@ func TestTicketRepository_DecrementStock(t *testing.T) {
 		tx, txCleanup := setupTestWithTransaction(t)
 		defer txCleanup()
 
-		err := repo.DecrementStock(ctx, tx, ticketID, 30)
+		updated, err := repo.DecrementStock(ctx, tx, ticketID, 30)
 		require.NoError(t, err)
+		assert.Equal(t, 70, updated.RemainingStock)
 
 		ticket, err := repo.FindByIDWithLock(ctx, tx, ticketID)
 		require.NoError(t, err)
@@ -343,7 +353,7 @@ func TestTicketRepository_DecrementStock(t *testing.T) {
 		tx, txCleanup := setupTestWithTransaction(t)
 		defer txCleanup()
 
-		err := repo.DecrementStock(ctx, tx, ticketID, 10)
+		_, err := repo.DecrementStock(ctx, tx, ticketID, 10)
 
 		require.Error(t, err)
 		assert.Equal(t, apperrors.ErrInsufficientStock, err)
@@ -360,8 +370,9 @@ func TestTicketRepository_DecrementStock(t *testing.T) {
 		tx, txCleanup := setupTestWithTransaction(t)
 		defer txCleanup()
 
-		err := repo.DecrementStock(ctx, tx, ticketID, 50)
+		updated, err := repo.DecrementStock(ctx, tx, ticketID, 50)
 		require.NoError(t, err)
+		assert.Equal(t, 0, updated.RemainingStock)
 
 		ticket, err := repo.FindByIDWithLock(ctx, tx, ticketID)
 		require.NoError(t, err)
@@ -375,7 +386,7 @@ func TestTicketRepository_DecrementStock(t *testing.T) {
 		tx, txCleanup := setupTestWithTransaction(t)
 		defer txCleanup()
 
-		err := repo.DecrementStock(ctx, tx, 99999, 10)
+		_, err := repo.DecrementStock(ctx, tx, 99999, 10)
 
 		require.Error(t, err)
 		assert.Equal(t, apperrors.ErrInsufficientStock, err)
@@ -455,7 +466,7 @@ func TestTicketRepository_IncrementStock(t *testing.T) {
 	})
 }
 
-func TestTicketRepository_AddStock(t *testing.T) {
+func TestTicketRepository_AdjustStock(t *testing.T) {
 	repo := repository.NewTicketRepository(getTestDB())
 	ctx := context.Background()
 
@@ -471,10 +482,7 @@ func TestTicketRepository_AddStock(t *testing.T) {
 		defer txCleanup()
 
 		// 追加 50 张票
-		err := repo.AddStock(ctx, tx, ticketID, 50)
-		require.NoError(t, err)
-
-		ticket, err := repo.FindByIDWithLock(ctx, tx, ticketID)
+		ticket, err := repo.AdjustStock(ctx, tx, ticketID, 50)
 		require.NoError(t, err)
 		assert.Equal(t, 150, ticket.TotalStock)     // 100 + 50
 		assert.Equal(t, 130, ticket.RemainingStock) // 80 + 50
@@ -492,32 +500,51 @@ func TestTicketRepository_AddStock(t *testing.T) {
 		defer txCleanup()
 
 		// 追加 30 张票
-		err := repo.AddStock(ctx, tx, ticketID, 30)
-		require.NoError(t, err)
-
-		ticket, err := repo.FindByIDWithLock(ctx, tx, ticketID)
+		ticket, err := repo.AdjustStock(ctx, tx, ticketID, 30)
 		require.NoError(t, err)
 		assert.Equal(t, 130, ticket.TotalStock)    // 100 + 30
 		assert.Equal(t, 30, ticket.RemainingStock) // 0 + 30
 	})
 
-	t.Run("InvalidQuantity_Zero", func(t *testing.T) {
+	t.Run("Decrease", func(t *testing.T) {
 		cleanup := setupTestWithTruncate(t)
 		defer cleanup()
 
 		eventID := createTestEvent(t, "Concert")
-		ticketID := createTestTicket(t, eventID, "Concert", 100)
+		ticketID := createTestTicketWithStock(t, eventID, "Concert", 100, 80)
+
+		tx, txCleanup := setupTestWithTransaction(t)
+		defer txCleanup()
+
+		// 收回全部未售出的 80 张票
+		ticket, err := repo.AdjustStock(ctx, tx, ticketID, -80)
+		require.NoError(t, err)
+		assert.Equal(t, 20, ticket.TotalStock)
+		assert.Equal(t, 0, ticket.RemainingStock)
+	})
+
+	t.Run("DecreaseBelowSold", func(t *testing.T) {
+		cleanup := setupTestWithTruncate(t)
+		defer cleanup()
+
+		eventID := createTestEvent(t, "Concert")
+		ticketID := createTestTicketWithStock(t, eventID, "Concert", 100, 80)
 
 		tx, txCleanup := setupTestWithTransaction(t)
 		defer txCleanup()
 
-		err := repo.AddStock(ctx, tx, ticketID, 0)
+		_, err := repo.AdjustStock(ctx, tx, ticketID, -81)
 
 		require.Error(t, err)
-		assert.Equal(t, apperrors.ErrInvalidInput, err)
+		assert.Equal(t, apperrors.ErrInsufficientStock, err)
+
+		ticket, err := repo.FindByIDWithLock(ctx, tx, ticketID)
+		require.NoError(t, err)
+		assert.Equal(t, 100, ticket.TotalStock)
+		assert.Equal(t, 80, ticket.RemainingStock)
 	})
 
-	t.Run("InvalidQuantity_Negative", func(t *testing.T) {
+	t.Run("InvalidQuantity_Zero", func(t *testing.T) {
 		cleanup := setupTestWithTruncate(t)
 		defer cleanup()
 
@@ -527,7 +554,7 @@ func TestTicketRepository_AddStock(t *testing.T) {
 		tx, txCleanup := setupTestWithTransaction(t)
 		defer txCleanup()
 
-		err := repo.AddStock(ctx, tx, ticketID, -10)
+		_, err := repo.AdjustStock(ctx, tx, ticketID, 0)
 
 		require.Error(t, err)
 		assert.Equal(t, apperrors.ErrInvalidInput, err)
@@ -540,13 +567,112 @@ func TestTicketRepository_AddStock(t *testing.T) {
 		tx, txCleanup := setupTestWithTransaction(t)
 		defer txCleanup()
 
-		err := repo.AddStock(ctx, tx, 99999, 50)
+		_, err := repo.AdjustStock(ctx, tx, 99999, 50)
 
 		require.Error(t, err)
 		assert.Equal(t, apperrors.ErrTicketNotFound, err)
 	})
 }
 
+func TestTicketRepository_InventoryAdjustments(t *testing.T) {
+	repo := repository.NewTicketRepository(getTestDB())
+	ctx := context.Background()
+
+	t.Run("Create and list", func(t *testing.T) {
+		cleanup := setupTestWithTruncate(t)
+		defer cleanup()
+
+		eventID := createTestEvent(t, "Concert")
+		ticketID := createTestTicketWithStock(t, eventID, "Concert", 100, 80)
+
+		tx, err := getTestDB().Begin(ctx)
+		require.NoError(t, err)
+		reason := "加開第二批"
+		created, err := repo.CreateInventoryAdjustment(ctx, tx, &model.InventoryAdjustment{
+			TicketID: ticketID, Delta: 50, TotalStock: 150, RemainingStock: 130, Actor: model.InventoryActorAdmin, Reason: &reason,
+		})
+		require.NoError(t, err)
+		_, err = repo.CreateInventoryAdjustment(ctx, tx, &model.InventoryAdjustment{
+			TicketID: ticketID, Delta: -30, TotalStock: 120, RemainingStock: 100, Actor: model.InventoryActorAdmin,
+		})
+		require.NoError(t, err)
+		require.NoError(t, tx.Commit(ctx))
+
+		assert.NotZero(t, created.ID)
+		assert.False(t, created.CreatedAt.IsZero())
+
+		adjustments, err := repo.ListInventoryAdjustments(ctx, ticketID)
+		require.NoError(t, err)
+		require.Len(t, adjustments, 2)
+		assert.Equal(t, 50, adjustments[0].Delta)
+		assert.Equal(t, reason, *adjustments[0].Reason)
+		assert.Equal(t, -30, adjustments[1].Delta)
+		assert.Nil(t, adjustments[1].Reason)
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		cleanup := setupTestWithTruncate(t)
+		defer cleanup()
+
+		adjustments, err := repo.ListInventoryAdjustments(ctx, 99999)
+		require.NoError(t, err)
+		assert.Empty(t, adjustments)
+	})
+}
+
+func TestTicketRepository_PricePhases(t *testing.T) {
+	repo := repository.NewTicketRepository(getTestDB())
+	ctx := context.Background()
+
+	t.Run("Replace and list in order", func(t *testing.T) {
+		cleanup := setupTestWithTruncate(t)
+		defer cleanup()
+
+		eventID := createTestEvent(t, "Concert")
+		ticketID := createTestTicket(t, eventID, "Concert", 100)
+
+		soldLimit := 50
+		tx, err := getTestDB().Begin(ctx)
+		require.NoError(t, err)
+		_, err = repo.ReplacePricePhases(ctx, tx, ticketID, []*model.TicketPricePhase{
+			{Name: "Early Bird", Price: 800, SoldLimit: &soldLimit},
+			{Name: "Regular", Price: 1000},
+		})
+		require.NoError(t, err)
+		require.NoError(t, tx.Commit(ctx))
+
+		// 再次取代時舊的階段全部移除
+		tx, err = getTestDB().Begin(ctx)
+		require.NoError(t, err)
+		replaced, err := repo.ReplacePricePhases(ctx, tx, ticketID, []*model.TicketPricePhase{
+			{Name: "Regular", Price: 1000},
+			{Name: "Door", Price: 1200},
+		})
+		require.NoError(t, err)
+		require.NoError(t, tx.Commit(ctx))
+		require.Len(t, replaced, 2)
+		assert.NotZero(t, replaced[0].ID)
+
+		phases, err := repo.ListPricePhases(ctx, ticketID)
+		require.NoError(t, err)
+		require.Len(t, phases, 2)
+		assert.Equal(t, "Regular", phases[0].Name)
+		assert.Equal(t, 1, phases[0].Position)
+		assert.Nil(t, phases[0].SoldLimit)
+		assert.Equal(t, "Door", phases[1].Name)
+		assert.Equal(t, 2, phases[1].Position)
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		cleanup := setupTestWithTruncate(t)
+		defer cleanup()
+
+		phases, err := repo.ListPricePhases(ctx, 99999)
+		require.NoError(t, err)
+		assert.Empty(t, phases)
+	})
+}
+
 /* 輔助函數 */
 
 // createTestTicket 創建測試用 ticket
@@ -0,0 +1,181 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"go-gin-high-concurrency/internal/model"
+	"go-gin-high-concurrency/internal/repository"
+	apperrors "go-gin-high-concurrency/pkg/app_errors"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestWebhookSubscription(t *testing.T, repo repository.WebhookRepository, eventID int, eventTypes ...string) *model.WebhookSubscription {
+	t.Helper()
+	subscription, err := repo.CreateSubscription(context.Background(), &model.WebhookSubscription{
+		SubscriptionID: uuid.New(),
+		EventID:        eventID,
+		URL:            "https://organiser.example.com/hooks",
+		Secret:         "secret",
+		EventTypes:     eventTypes,
+		Active:         true,
+	})
+	require.NoError(t, err)
+	return subscription
+}
+
+func newTestWebhookDelivery(subscriptionID int, outboxEventID int64) *model.WebhookDelivery {
+	return &model.WebhookDelivery{
+		DeliveryID:     uuid.New(),
+		SubscriptionID: subscriptionID,
+		OutboxEventID:  outboxEventID,
+		EventType:      model.EventTypeOrderCreated,
+		Payload:        json.RawMessage(`{"type":"order.created"}`),
+	}
+}
+
+func TestWebhookRepository_Subscriptions(t *testing.T) {
+	repo := repository.NewWebhookRepository(getTestDB())
+	ctx := context.Background()
+
+	t.Run("Create and find", func(t *testing.T) {
+		cleanup := setupTestWithTruncate(t)
+		defer cleanup()
+
+		eventID := createTestEvent(t, "Test Event")
+		created := createTestWebhookSubscription(t, repo, eventID, model.EventTypeOrderCreated)
+
+		assert.NotZero(t, created.ID)
+		assert.Equal(t, []string{model.EventTypeOrderCreated}, created.EventTypes)
+		assert.True(t, created.Active)
+
+		found, err := repo.FindSubscriptionBySubscriptionID(ctx, created.SubscriptionID)
+		require.NoError(t, err)
+		assert.Equal(t, created.ID, found.ID)
+		assert.Equal(t, "secret", found.Secret)
+	})
+
+	t.Run("ListActiveSubscriptionsByEventType filters type, active and deleted", func(t *testing.T) {
+		cleanup := setupTestWithTruncate(t)
+		defer cleanup()
+
+		eventID := createTestEvent(t, "Test Event")
+		matching := createTestWebhookSubscription(t, repo, eventID, model.EventTypeOrderCreated, model.EventTypeTicketSoldOut)
+		createTestWebhookSubscription(t, repo, eventID, model.EventTypeOrderCancelled)
+		inactive := createTestWebhookSubscription(t, repo, eventID, model.EventTypeOrderCreated)
+		deleted := createTestWebhookSubscription(t, repo, eventID, model.EventTypeOrderCreated)
+
+		active := false
+		_, err := repo.UpdateSubscription(ctx, inactive.ID, model.UpdateWebhookSubscriptionParams{Active: &active})
+		require.NoError(t, err)
+		require.NoError(t, repo.DeleteSubscription(ctx, deleted.ID))
+
+		subscriptions, err := repo.ListActiveSubscriptionsByEventType(ctx, eventID, model.EventTypeOrderCreated)
+		require.NoError(t, err)
+		require.Len(t, subscriptions, 1)
+		assert.Equal(t, matching.ID, subscriptions[0].ID)
+
+		all, err := repo.ListSubscriptionsByEventID(ctx, eventID)
+		require.NoError(t, err)
+		assert.Len(t, all, 3)
+	})
+
+	t.Run("Delete - ErrWebhookNotFound", func(t *testing.T) {
+		cleanup := setupTestWithTruncate(t)
+		defer cleanup()
+
+		err := repo.DeleteSubscription(ctx, 99999)
+		assert.Equal(t, apperrors.ErrWebhookNotFound, err)
+	})
+}
+
+func TestWebhookRepository_Deliveries(t *testing.T) {
+	repo := repository.NewWebhookRepository(getTestDB())
+	ctx := context.Background()
+
+	t.Run("CreateDeliveries is idempotent per outbox event", func(t *testing.T) {
+		cleanup := setupTestWithTruncate(t)
+		defer cleanup()
+
+		eventID := createTestEvent(t, "Test Event")
+		subscription := createTestWebhookSubscription(t, repo, eventID, model.EventTypeOrderCreated)
+
+		require.NoError(t, repo.CreateDeliveries(ctx, []*model.WebhookDelivery{newTestWebhookDelivery(subscription.ID, 1)}))
+		require.NoError(t, repo.CreateDeliveries(ctx, []*model.WebhookDelivery{newTestWebhookDelivery(subscription.ID, 1)}))
+
+		deliveries, err := repo.ListDeliveriesBySubscriptionID(ctx, subscription.ID)
+		require.NoError(t, err)
+		require.Len(t, deliveries, 1)
+		assert.Equal(t, model.WebhookDeliveryStatusPending, deliveries[0].Status)
+		assert.Equal(t, 0, deliveries[0].Attempts)
+	})
+
+	t.Run("ClaimDueDeliveries leases due deliveries", func(t *testing.T) {
+		cleanup := setupTestWithTruncate(t)
+		defer cleanup()
+
+		eventID := createTestEvent(t, "Test Event")
+		subscription := createTestWebhookSubscription(t, repo, eventID, model.EventTypeOrderCreated)
+		require.NoError(t, repo.CreateDeliveries(ctx, []*model.WebhookDelivery{newTestWebhookDelivery(subscription.ID, 1)}))
+
+		now := time.Now().UTC().Add(time.Second)
+		tasks, err := repo.ClaimDueDeliveries(ctx, now, now.Add(time.Minute), 10)
+		require.NoError(t, err)
+		require.Len(t, tasks, 1)
+		assert.Equal(t, subscription.URL, tasks[0].URL)
+		assert.Equal(t, subscription.Secret, tasks[0].Secret)
+
+		// 租約期間不會被再次領取
+		tasks, err = repo.ClaimDueDeliveries(ctx, now, now.Add(time.Minute), 10)
+		require.NoError(t, err)
+		assert.Len(t, tasks, 0)
+	})
+
+	t.Run("RecordDeliveryAttempt and ResetDelivery", func(t *testing.T) {
+		cleanup := setupTestWithTruncate(t)
+		defer cleanup()
+
+		eventID := createTestEvent(t, "Test Event")
+		subscription := createTestWebhookSubscription(t, repo, eventID, model.EventTypeOrderCreated)
+		delivery := newTestWebhookDelivery(subscription.ID, 1)
+		require.NoError(t, repo.CreateDeliveries(ctx, []*model.WebhookDelivery{delivery}))
+
+		found, err := repo.FindDeliveryByDeliveryID(ctx, subscription.ID, delivery.DeliveryID)
+		require.NoError(t, err)
+
+		statusCode := 500
+		errMsg := "unexpected status code 500"
+		err = repo.RecordDeliveryAttempt(ctx, found.ID, model.WebhookDeliveryAttempt{
+			Status:        model.WebhookDeliveryStatusFailed,
+			StatusCode:    &statusCode,
+			Error:         &errMsg,
+			NextAttemptAt: time.Now().UTC(),
+		})
+		require.NoError(t, err)
+
+		failed, err := repo.FindDeliveryByDeliveryID(ctx, subscription.ID, delivery.DeliveryID)
+		require.NoError(t, err)
+		assert.Equal(t, model.WebhookDeliveryStatusFailed, failed.Status)
+		assert.Equal(t, 1, failed.Attempts)
+		require.NotNil(t, failed.LastStatusCode)
+		assert.Equal(t, 500, *failed.LastStatusCode)
+
+		reset, err := repo.ResetDelivery(ctx, found.ID)
+		require.NoError(t, err)
+		assert.Equal(t, model.WebhookDeliveryStatusPending, reset.Status)
+		assert.Equal(t, 0, reset.Attempts)
+	})
+
+	t.Run("FindDeliveryByDeliveryID - ErrWebhookDeliveryNotFound", func(t *testing.T) {
+		cleanup := setupTestWithTruncate(t)
+		defer cleanup()
+
+		_, err := repo.FindDeliveryByDeliveryID(ctx, 1, uuid.New())
+		assert.Equal(t, apperrors.ErrWebhookDeliveryNotFound, err)
+	})
+}
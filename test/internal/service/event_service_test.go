@@ -5,6 +5,7 @@ import (
 	"errors"
 	"testing"
 
+	"go-gin-high-concurrency/internal/cache"
 	cacheMocks "go-gin-high-concurrency/internal/cache/mocks"
 	"go-gin-high-concurrency/internal/model"
 	repoMocks "go-gin-high-concurrency/internal/repository/mocks"
@@ -13,18 +14,27 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
 func setupEventServiceMocks(t *testing.T) (
 	*repoMocks.MockEventRepository,
 	*repoMocks.MockTicketRepository,
+	*repoMocks.MockSeatRepository,
+	*repoMocks.MockPresaleRepository,
 	*cacheMocks.MockRedisTicketInventoryManager,
+	*cacheMocks.MockRedisSeatHoldManager,
+	*cacheMocks.MockRedisPresaleManager,
 ) {
 	eventRepo := repoMocks.NewMockEventRepository(t)
 	ticketRepo := repoMocks.NewMockTicketRepository(t)
+	seatRepo := repoMocks.NewMockSeatRepository(t)
 	inventoryManager := cacheMocks.NewMockRedisTicketInventoryManager(t)
-	return eventRepo, ticketRepo, inventoryManager
+	seatHoldManager := cacheMocks.NewMockRedisSeatHoldManager(t)
+	presaleRepo := repoMocks.NewMockPresaleRepository(t)
+	presaleManager := cacheMocks.NewMockRedisPresaleManager(t)
+	return eventRepo, ticketRepo, seatRepo, presaleRepo, inventoryManager, seatHoldManager, presaleManager
 }
 
 func TestEventService_OpenForSale(t *testing.T) {
@@ -33,18 +43,25 @@ func TestEventService_OpenForSale(t *testing.T) {
 	event := &model.Event{ID: 1, EventID: eventID, Name: "Test Event"}
 
 	t.Run("Success - warms all tickets under event", func(t *testing.T) {
-		eventRepo, ticketRepo, inventoryManager := setupEventServiceMocks(t)
-		eventService := service.NewEventService(eventRepo, ticketRepo, inventoryManager)
+		eventRepo, ticketRepo, seatRepo, presaleRepo, inventoryManager, seatHoldManager, presaleManager := setupEventServiceMocks(t)
+		eventService := service.NewEventService(eventRepo, ticketRepo, seatRepo, presaleRepo, inventoryManager, seatHoldManager, presaleManager)
 
 		tickets := []*model.Ticket{
 			{ID: 10, EventID: 1, Name: "A", TotalStock: 100, Price: 50, MaxPerUser: 2},
 			{ID: 11, EventID: 1, Name: "B", TotalStock: 200, Price: 80, MaxPerUser: 5},
 		}
+		soldLimit := 20
+		phases := []*model.TicketPricePhase{{TicketID: 10, Name: "Early Bird", Price: 40, SoldLimit: &soldLimit, Position: 1}}
 
 		eventRepo.EXPECT().FindByEventID(ctx, eventID).Return(event, nil).Once()
 		ticketRepo.EXPECT().ListByEventID(ctx, 1).Return(tickets, nil).Once()
-		inventoryManager.EXPECT().WarmUpInventory(ctx, 10, 100, 50.0, 2).Return(nil).Once()
-		inventoryManager.EXPECT().WarmUpInventory(ctx, 11, 200, 80.0, 5).Return(nil).Once()
+		inventoryManager.EXPECT().SetEventLimit(ctx, 1, 0).Return(nil).Once()
+		inventoryManager.EXPECT().WarmUpInventory(ctx, 10, 1, 100, 50.0, 2).Return(nil).Once()
+		inventoryManager.EXPECT().WarmUpInventory(ctx, 11, 1, 200, 80.0, 5).Return(nil).Once()
+		ticketRepo.EXPECT().ListPricePhases(ctx, 10).Return(phases, nil).Once()
+		ticketRepo.EXPECT().ListPricePhases(ctx, 11).Return([]*model.TicketPricePhase{}, nil).Once()
+		inventoryManager.EXPECT().SetPricePhases(ctx, 10, phases).Return(nil).Once()
+		inventoryManager.EXPECT().SetPricePhases(ctx, 11, []*model.TicketPricePhase{}).Return(nil).Once()
 
 		err := eventService.OpenForSale(ctx, eventID)
 
@@ -54,12 +71,82 @@ func TestEventService_OpenForSale(t *testing.T) {
 		inventoryManager.AssertExpectations(t)
 	})
 
+	t.Run("Success - seated ticket loads sold seats", func(t *testing.T) {
+		eventRepo, ticketRepo, seatRepo, presaleRepo, inventoryManager, seatHoldManager, presaleManager := setupEventServiceMocks(t)
+		eventService := service.NewEventService(eventRepo, ticketRepo, seatRepo, presaleRepo, inventoryManager, seatHoldManager, presaleManager)
+
+		sectionID := 3
+		tickets := []*model.Ticket{
+			{ID: 10, EventID: 1, Name: "A", TotalStock: 100, Price: 50, MaxPerUser: 2},
+			{ID: 11, EventID: 1, Name: "Seated", TotalStock: 40, Price: 80, MaxPerUser: 4, SectionID: &sectionID},
+		}
+
+		eventRepo.EXPECT().FindByEventID(ctx, eventID).Return(event, nil).Once()
+		ticketRepo.EXPECT().ListByEventID(ctx, 1).Return(tickets, nil).Once()
+		inventoryManager.EXPECT().SetEventLimit(ctx, 1, 0).Return(nil).Once()
+		inventoryManager.EXPECT().WarmUpInventory(ctx, 10, 1, 100, 50.0, 2).Return(nil).Once()
+		inventoryManager.EXPECT().WarmUpInventory(ctx, 11, 1, 40, 80.0, 4).Return(nil).Once()
+		ticketRepo.EXPECT().ListPricePhases(ctx, mock.Anything).Return([]*model.TicketPricePhase{}, nil).Twice()
+		inventoryManager.EXPECT().SetPricePhases(ctx, mock.Anything, []*model.TicketPricePhase{}).Return(nil).Twice()
+		seatRepo.EXPECT().ListSoldSeatIDs(ctx, 11).Return([]int{7}, nil).Once()
+		seatHoldManager.EXPECT().WarmUpSeats(ctx, 11, []int{7}).Return(nil).Once()
+
+		err := eventService.OpenForSale(ctx, eventID)
+
+		require.NoError(t, err)
+		seatRepo.AssertNotCalled(t, "ListSoldSeatIDs", ctx, 10)
+	})
+
+	t.Run("Success - presale ticket loads access codes and allowlist", func(t *testing.T) {
+		eventRepo, ticketRepo, seatRepo, presaleRepo, inventoryManager, seatHoldManager, presaleManager := setupEventServiceMocks(t)
+		eventService := service.NewEventService(eventRepo, ticketRepo, seatRepo, presaleRepo, inventoryManager, seatHoldManager, presaleManager)
+
+		tickets := []*model.Ticket{
+			{ID: 10, EventID: 1, Name: "A", TotalStock: 100, Price: 50, MaxPerUser: 2},
+			{ID: 11, EventID: 1, Name: "Fan Club", TotalStock: 20, Price: 80, MaxPerUser: 2, Presale: true},
+		}
+		maxUses := 5
+		accessCodes := []*model.PresaleAccessCode{{TicketID: 11, Code: "FANCLUB", MaxUses: &maxUses}}
+
+		eventRepo.EXPECT().FindByEventID(ctx, eventID).Return(event, nil).Once()
+		ticketRepo.EXPECT().ListByEventID(ctx, 1).Return(tickets, nil).Once()
+		inventoryManager.EXPECT().SetEventLimit(ctx, 1, 0).Return(nil).Once()
+		inventoryManager.EXPECT().WarmUpInventory(ctx, mock.Anything, 1, mock.Anything, mock.Anything, 2).Return(nil).Twice()
+		ticketRepo.EXPECT().ListPricePhases(ctx, mock.Anything).Return([]*model.TicketPricePhase{}, nil).Twice()
+		inventoryManager.EXPECT().SetPricePhases(ctx, mock.Anything, []*model.TicketPricePhase{}).Return(nil).Twice()
+		presaleRepo.EXPECT().ListAccessCodes(ctx, 11).Return(accessCodes, nil).Once()
+		presaleRepo.EXPECT().ListAllowlistUserIDs(ctx, 11).Return([]int{100}, nil).Once()
+		presaleRepo.EXPECT().CountActiveAccessCodeUses(ctx, 11).Return(map[string]int{"FANCLUB": 2}, nil).Once()
+		presaleManager.EXPECT().WarmUp(ctx, 11, accessCodes, []int{100}, map[string]int{"FANCLUB": 2}).Return(nil).Once()
+
+		err := eventService.OpenForSale(ctx, eventID)
+
+		require.NoError(t, err)
+		presaleRepo.AssertNotCalled(t, "ListAccessCodes", ctx, 10)
+	})
+
+	t.Run("Success - event cap synced before tickets", func(t *testing.T) {
+		eventRepo, ticketRepo, seatRepo, presaleRepo, inventoryManager, seatHoldManager, presaleManager := setupEventServiceMocks(t)
+		eventService := service.NewEventService(eventRepo, ticketRepo, seatRepo, presaleRepo, inventoryManager, seatHoldManager, presaleManager)
+
+		maxPerUser := 6
+		cappedEvent := &model.Event{ID: 1, EventID: eventID, Name: "Test Event", MaxPerUser: &maxPerUser}
+		eventRepo.EXPECT().FindByEventID(ctx, eventID).Return(cappedEvent, nil).Once()
+		ticketRepo.EXPECT().ListByEventID(ctx, 1).Return([]*model.Ticket{}, nil).Once()
+		inventoryManager.EXPECT().SetEventLimit(ctx, 1, 6).Return(nil).Once()
+
+		err := eventService.OpenForSale(ctx, eventID)
+
+		require.NoError(t, err)
+	})
+
 	t.Run("Success - no tickets under event", func(t *testing.T) {
-		eventRepo, ticketRepo, inventoryManager := setupEventServiceMocks(t)
-		eventService := service.NewEventService(eventRepo, ticketRepo, inventoryManager)
+		eventRepo, ticketRepo, seatRepo, presaleRepo, inventoryManager, seatHoldManager, presaleManager := setupEventServiceMocks(t)
+		eventService := service.NewEventService(eventRepo, ticketRepo, seatRepo, presaleRepo, inventoryManager, seatHoldManager, presaleManager)
 
 		eventRepo.EXPECT().FindByEventID(ctx, eventID).Return(event, nil).Once()
 		ticketRepo.EXPECT().ListByEventID(ctx, 1).Return([]*model.Ticket{}, nil).Once()
+		inventoryManager.EXPECT().SetEventLimit(ctx, 1, 0).Return(nil).Once()
 
 		err := eventService.OpenForSale(ctx, eventID)
 
@@ -70,8 +157,8 @@ func TestEventService_OpenForSale(t *testing.T) {
 	})
 
 	t.Run("Failed - event not found", func(t *testing.T) {
-		eventRepo, ticketRepo, inventoryManager := setupEventServiceMocks(t)
-		eventService := service.NewEventService(eventRepo, ticketRepo, inventoryManager)
+		eventRepo, ticketRepo, seatRepo, presaleRepo, inventoryManager, seatHoldManager, presaleManager := setupEventServiceMocks(t)
+		eventService := service.NewEventService(eventRepo, ticketRepo, seatRepo, presaleRepo, inventoryManager, seatHoldManager, presaleManager)
 
 		eventRepo.EXPECT().FindByEventID(ctx, eventID).Return(nil, app_errors.ErrEventNotFound).Once()
 
@@ -85,8 +172,8 @@ func TestEventService_OpenForSale(t *testing.T) {
 	})
 
 	t.Run("Failed - ListByEventID error", func(t *testing.T) {
-		eventRepo, ticketRepo, inventoryManager := setupEventServiceMocks(t)
-		eventService := service.NewEventService(eventRepo, ticketRepo, inventoryManager)
+		eventRepo, ticketRepo, seatRepo, presaleRepo, inventoryManager, seatHoldManager, presaleManager := setupEventServiceMocks(t)
+		eventService := service.NewEventService(eventRepo, ticketRepo, seatRepo, presaleRepo, inventoryManager, seatHoldManager, presaleManager)
 
 		eventRepo.EXPECT().FindByEventID(ctx, eventID).Return(event, nil).Once()
 		ticketRepo.EXPECT().ListByEventID(ctx, 1).Return(nil, errors.New("db error")).Once()
@@ -101,8 +188,8 @@ func TestEventService_OpenForSale(t *testing.T) {
 	})
 
 	t.Run("Failed - WarmUpInventory error", func(t *testing.T) {
-		eventRepo, ticketRepo, inventoryManager := setupEventServiceMocks(t)
-		eventService := service.NewEventService(eventRepo, ticketRepo, inventoryManager)
+		eventRepo, ticketRepo, seatRepo, presaleRepo, inventoryManager, seatHoldManager, presaleManager := setupEventServiceMocks(t)
+		eventService := service.NewEventService(eventRepo, ticketRepo, seatRepo, presaleRepo, inventoryManager, seatHoldManager, presaleManager)
 
 		tickets := []*model.Ticket{
 			{ID: 10, EventID: 1, TotalStock: 100, Price: 50, MaxPerUser: 2},
@@ -110,7 +197,8 @@ func TestEventService_OpenForSale(t *testing.T) {
 
 		eventRepo.EXPECT().FindByEventID(ctx, eventID).Return(event, nil).Once()
 		ticketRepo.EXPECT().ListByEventID(ctx, 1).Return(tickets, nil).Once()
-		inventoryManager.EXPECT().WarmUpInventory(ctx, 10, 100, 50.0, 2).Return(errors.New("redis error")).Once()
+		inventoryManager.EXPECT().SetEventLimit(ctx, 1, 0).Return(nil).Once()
+		inventoryManager.EXPECT().WarmUpInventory(ctx, 10, 1, 100, 50.0, 2).Return(errors.New("redis error")).Once()
 
 		err := eventService.OpenForSale(ctx, eventID)
 
@@ -121,3 +209,164 @@ func TestEventService_OpenForSale(t *testing.T) {
 		inventoryManager.AssertExpectations(t)
 	})
 }
+
+func TestEventService_UpdateByEventID(t *testing.T) {
+	ctx := context.Background()
+	eventID := uuid.MustParse("a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11")
+	event := &model.Event{ID: 1, EventID: eventID, Name: "Test Event"}
+
+	t.Run("Success - max per user synced to Redis", func(t *testing.T) {
+		eventRepo, ticketRepo, seatRepo, presaleRepo, inventoryManager, seatHoldManager, presaleManager := setupEventServiceMocks(t)
+		eventService := service.NewEventService(eventRepo, ticketRepo, seatRepo, presaleRepo, inventoryManager, seatHoldManager, presaleManager)
+
+		maxPerUser := 6
+		params := model.UpdateEventParams{MaxPerUser: &maxPerUser}
+		eventRepo.EXPECT().FindByEventID(ctx, eventID).Return(event, nil).Once()
+		eventRepo.EXPECT().Update(ctx, 1, params).
+			Return(&model.Event{ID: 1, EventID: eventID, Name: "Test Event", MaxPerUser: &maxPerUser}, nil).Once()
+		inventoryManager.EXPECT().SetEventLimit(ctx, 1, 6).Return(nil).Once()
+
+		updated, err := eventService.UpdateByEventID(ctx, eventID, params)
+
+		require.NoError(t, err)
+		assert.Equal(t, 6, *updated.MaxPerUser)
+	})
+
+	t.Run("Success - removing cap stores zero in Redis", func(t *testing.T) {
+		eventRepo, ticketRepo, seatRepo, presaleRepo, inventoryManager, seatHoldManager, presaleManager := setupEventServiceMocks(t)
+		eventService := service.NewEventService(eventRepo, ticketRepo, seatRepo, presaleRepo, inventoryManager, seatHoldManager, presaleManager)
+
+		noCap := 0
+		params := model.UpdateEventParams{MaxPerUser: &noCap}
+		eventRepo.EXPECT().FindByEventID(ctx, eventID).Return(event, nil).Once()
+		eventRepo.EXPECT().Update(ctx, 1, params).Return(event, nil).Once()
+		inventoryManager.EXPECT().SetEventLimit(ctx, 1, 0).Return(nil).Once()
+
+		_, err := eventService.UpdateByEventID(ctx, eventID, params)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("Success - name only does not touch Redis", func(t *testing.T) {
+		eventRepo, ticketRepo, seatRepo, presaleRepo, inventoryManager, seatHoldManager, presaleManager := setupEventServiceMocks(t)
+		eventService := service.NewEventService(eventRepo, ticketRepo, seatRepo, presaleRepo, inventoryManager, seatHoldManager, presaleManager)
+
+		name := "Renamed"
+		params := model.UpdateEventParams{Name: &name}
+		eventRepo.EXPECT().FindByEventID(ctx, eventID).Return(event, nil).Once()
+		eventRepo.EXPECT().Update(ctx, 1, params).Return(&model.Event{ID: 1, EventID: eventID, Name: name}, nil).Once()
+
+		_, err := eventService.UpdateByEventID(ctx, eventID, params)
+
+		require.NoError(t, err)
+		inventoryManager.AssertNotCalled(t, "SetEventLimit")
+	})
+}
+
+func TestEventService_SubscribeStock(t *testing.T) {
+	ctx := context.Background()
+	eventID := uuid.MustParse("a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11")
+	event := &model.Event{ID: 1, EventID: eventID, Name: "Test Event"}
+	ticketA := &model.Ticket{ID: 10, TicketID: uuid.New(), EventID: 1, Name: "A", RemainingStock: 100}
+	ticketB := &model.Ticket{ID: 11, TicketID: uuid.New(), EventID: 1, Name: "B", RemainingStock: 50}
+
+	t.Run("Success - snapshot then live updates", func(t *testing.T) {
+		eventRepo, ticketRepo, seatRepo, presaleRepo, inventoryManager, seatHoldManager, presaleManager := setupEventServiceMocks(t)
+		eventService := service.NewEventService(eventRepo, ticketRepo, seatRepo, presaleRepo, inventoryManager, seatHoldManager, presaleManager)
+
+		subCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		updates := make(chan cache.StockUpdate, 1)
+		eventRepo.EXPECT().FindByEventID(subCtx, eventID).Return(event, nil).Once()
+		ticketRepo.EXPECT().ListByEventID(subCtx, 1).Return([]*model.Ticket{ticketA, ticketB}, nil).Once()
+		inventoryManager.EXPECT().SubscribeStock(mock.Anything, []int{10, 11}).Return((<-chan cache.StockUpdate)(updates), nil).Once()
+		inventoryManager.EXPECT().GetStock(subCtx, 10).Return(12, nil).Once()
+		// 尚未預熱的票種以資料庫庫存為準
+		inventoryManager.EXPECT().GetStock(subCtx, 11).Return(-1, app_errors.ErrTicketNotFound).Once()
+
+		stocks, err := eventService.SubscribeStock(subCtx, eventID)
+		require.NoError(t, err)
+
+		first := <-stocks
+		assert.Equal(t, ticketA.TicketID, first.TicketID)
+		assert.Equal(t, 12, first.Stock)
+		assert.Equal(t, model.StockStatusAvailable, first.Status)
+
+		second := <-stocks
+		assert.Equal(t, ticketB.TicketID, second.TicketID)
+		assert.Equal(t, 50, second.Stock)
+
+		updates <- cache.StockUpdate{TicketID: 10, Stock: 0}
+		live := <-stocks
+		assert.Equal(t, ticketA.TicketID, live.TicketID)
+		assert.Equal(t, model.StockStatusSoldOut, live.Status)
+
+		close(updates)
+		_, ok := <-stocks
+		assert.False(t, ok)
+	})
+
+	t.Run("Failed - no tickets under event", func(t *testing.T) {
+		eventRepo, ticketRepo, seatRepo, presaleRepo, inventoryManager, seatHoldManager, presaleManager := setupEventServiceMocks(t)
+		eventService := service.NewEventService(eventRepo, ticketRepo, seatRepo, presaleRepo, inventoryManager, seatHoldManager, presaleManager)
+
+		eventRepo.EXPECT().FindByEventID(ctx, eventID).Return(event, nil).Once()
+		ticketRepo.EXPECT().ListByEventID(ctx, 1).Return([]*model.Ticket{}, nil).Once()
+
+		_, err := eventService.SubscribeStock(ctx, eventID)
+		assert.ErrorIs(t, err, app_errors.ErrTicketNotFound)
+		inventoryManager.AssertNotCalled(t, "SubscribeStock")
+	})
+
+	t.Run("Failed - event not found", func(t *testing.T) {
+		eventRepo, ticketRepo, seatRepo, presaleRepo, inventoryManager, seatHoldManager, presaleManager := setupEventServiceMocks(t)
+		eventService := service.NewEventService(eventRepo, ticketRepo, seatRepo, presaleRepo, inventoryManager, seatHoldManager, presaleManager)
+
+		eventRepo.EXPECT().FindByEventID(ctx, eventID).Return(nil, app_errors.ErrEventNotFound).Once()
+
+		_, err := eventService.SubscribeStock(ctx, eventID)
+		assert.ErrorIs(t, err, app_errors.ErrEventNotFound)
+	})
+}
+
+func TestEventService_ListAvailability(t *testing.T) {
+	ctx := context.Background()
+	eventID := uuid.MustParse("a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11")
+	event := &model.Event{ID: 1, EventID: eventID, Name: "Test Event"}
+
+	t.Run("Success - mixes Redis and DB stock", func(t *testing.T) {
+		eventRepo, ticketRepo, seatRepo, presaleRepo, inventoryManager, seatHoldManager, presaleManager := setupEventServiceMocks(t)
+		eventService := service.NewEventService(eventRepo, ticketRepo, seatRepo, presaleRepo, inventoryManager, seatHoldManager, presaleManager)
+
+		tickets := []*model.Ticket{
+			{ID: 10, EventID: 1, Name: "A", Price: 50, TotalStock: 100, RemainingStock: 100},
+			{ID: 11, EventID: 1, Name: "B", Price: 80, TotalStock: 200, RemainingStock: 200},
+		}
+
+		eventRepo.EXPECT().FindByEventID(ctx, eventID).Return(event, nil).Once()
+		ticketRepo.EXPECT().ListByEventID(ctx, 1).Return(tickets, nil).Once()
+		inventoryManager.EXPECT().GetInfo(ctx, 10).Return(cache.RedisTicketInfo{Stock: 0, Price: 50, Limit: 2}, nil).Once()
+		inventoryManager.EXPECT().GetInfo(ctx, 11).Return(cache.RedisTicketInfo{}, app_errors.ErrTicketNotFound).Once()
+
+		availability, err := eventService.ListAvailability(ctx, eventID)
+
+		require.NoError(t, err)
+		require.Len(t, availability, 2)
+		assert.False(t, availability[0].Available)
+		assert.Equal(t, model.StockStatusSoldOut, availability[0].Status)
+		assert.Equal(t, 200, availability[1].RemainingStock)
+		assert.True(t, availability[1].Available)
+	})
+
+	t.Run("Failed - ErrEventNotFound", func(t *testing.T) {
+		eventRepo, ticketRepo, seatRepo, presaleRepo, inventoryManager, seatHoldManager, presaleManager := setupEventServiceMocks(t)
+		eventService := service.NewEventService(eventRepo, ticketRepo, seatRepo, presaleRepo, inventoryManager, seatHoldManager, presaleManager)
+
+		eventRepo.EXPECT().FindByEventID(ctx, eventID).Return(nil, app_errors.ErrEventNotFound).Once()
+
+		_, err := eventService.ListAvailability(ctx, eventID)
+
+		assert.ErrorIs(t, err, app_errors.ErrEventNotFound)
+	})
+}
@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go-gin-high-concurrency/internal/model"
+	"go-gin-high-concurrency/internal/queue"
+	queueMocks "go-gin-high-concurrency/internal/queue/mocks"
+	"go-gin-high-concurrency/internal/service"
+	"go-gin-high-concurrency/pkg/app_errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func okCheck(name string) service.HealthCheck {
+	return service.HealthCheck{Name: name, Check: func(context.Context) error { return nil }}
+}
+
+func TestHealthService(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("readiness ok", func(t *testing.T) {
+		s := service.NewHealthService(nil, []service.HealthCheck{okCheck("postgres"), okCheck("redis")}, nil)
+
+		report := s.Readiness(ctx)
+
+		assert.Equal(t, model.HealthStatusOK, report.Status)
+		assert.Len(t, report.Checks, 3)
+		assert.Equal(t, model.HealthStatusOK, report.Checks["shutdown"].Status)
+		assert.Equal(t, model.HealthStatusOK, report.Checks["redis"].Status)
+	})
+
+	t.Run("readiness fails when any check fails", func(t *testing.T) {
+		s := service.NewHealthService(nil, []service.HealthCheck{
+			okCheck("postgres"),
+			{Name: "redis", Check: func(context.Context) error { return errors.New("connection refused") }},
+		}, nil)
+
+		report := s.Readiness(ctx)
+
+		assert.Equal(t, model.HealthStatusFail, report.Status)
+		assert.Equal(t, model.HealthStatusOK, report.Checks["postgres"].Status)
+		assert.Equal(t, model.HealthCheckResult{Status: model.HealthStatusFail, Error: "connection refused", DurationMs: report.Checks["redis"].DurationMs}, report.Checks["redis"])
+	})
+
+	t.Run("check timeout", func(t *testing.T) {
+		s := service.NewHealthService(nil, []service.HealthCheck{
+			{Name: "postgres", Check: func(ctx context.Context) error {
+				<-ctx.Done()
+				return ctx.Err()
+			}},
+		}, &service.HealthServiceConfig{CheckTimeout: 20 * time.Millisecond})
+
+		report := s.Readiness(ctx)
+
+		assert.Equal(t, model.HealthStatusFail, report.Status)
+		assert.Contains(t, report.Checks["postgres"].Error, "deadline exceeded")
+	})
+
+	t.Run("draining flips readiness but not liveness", func(t *testing.T) {
+		s := service.NewHealthService([]service.HealthCheck{okCheck("order_worker")}, []service.HealthCheck{okCheck("postgres")}, nil)
+
+		s.StartDraining()
+
+		readiness := s.Readiness(ctx)
+		assert.Equal(t, model.HealthStatusFail, readiness.Status)
+		assert.Equal(t, model.HealthStatusFail, readiness.Checks["shutdown"].Status)
+		assert.Equal(t, model.HealthStatusOK, s.Liveness(ctx).Status)
+	})
+
+	t.Run("liveness without checks", func(t *testing.T) {
+		s := service.NewHealthService(nil, nil, nil)
+
+		report := s.Liveness(ctx)
+
+		assert.Equal(t, model.HealthStatusOK, report.Status)
+		assert.Empty(t, report.Checks)
+	})
+}
+
+func TestHeartbeatHealthCheck(t *testing.T) {
+	ctx := context.Background()
+	cases := []struct {
+		name    string
+		last    time.Time
+		wantErr string
+	}{
+		{name: "recent", last: time.Now().Add(-time.Second)},
+		{name: "stale", last: time.Now().Add(-time.Minute), wantErr: "exceeds 30s"},
+		{name: "not started", wantErr: "not started"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			check := service.HeartbeatHealthCheck("order_worker", func() time.Time { return tc.last }, 30*time.Second)
+
+			err := check.Check(ctx)
+
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestStreamLagHealthCheck(t *testing.T) {
+	ctx := context.Background()
+	cases := []struct {
+		name    string
+		stats   *queue.QueueStats
+		err     error
+		wantErr string
+	}{
+		{name: "under thresholds", stats: &queue.QueueStats{Lag: 100, Pending: 10}},
+		{name: "lag exceeded", stats: &queue.QueueStats{Lag: 101, Pending: 10}, wantErr: "lag 101 exceeds 100"},
+		{name: "pending exceeded", stats: &queue.QueueStats{Lag: 0, Pending: 11}, wantErr: "pending 11 exceeds 10"},
+		{name: "consumer group missing", err: app_errors.ErrConsumerGroupNotFound, wantErr: "consumer group not found"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			q := queueMocks.NewMockInspectableOrderQueue(t)
+			q.EXPECT().Stats(mock.Anything).Return(tc.stats, tc.err).Once()
+			check := service.StreamLagHealthCheck(q, 100, 10)
+
+			err := check.Check(ctx)
+
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.wantErr)
+			}
+		})
+	}
+}
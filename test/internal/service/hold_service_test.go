@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	cacheMocks "go-gin-high-concurrency/internal/cache/mocks"
+	"go-gin-high-concurrency/internal/model"
+	"go-gin-high-concurrency/internal/service"
+	"go-gin-high-concurrency/pkg/app_errors"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHoldService_CreateHold(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Success - holds with default ttl", func(t *testing.T) {
+		holdManager := cacheMocks.NewMockRedisTicketHoldManager(t)
+		holdService := service.NewHoldService(holdManager)
+
+		hold := &model.TicketHold{HoldID: uuid.New(), TicketID: 10, UserID: 1, Quantity: 2, Price: 100.0}
+		holdManager.EXPECT().CreateHold(ctx, 10, 1, 2, mock.MatchedBy(func(ttl time.Duration) bool {
+			return ttl > 0
+		})).Return(hold, nil).Once()
+
+		created, err := holdService.CreateHold(ctx, model.CreateHoldRequest{UserID: 1, TicketID: 10, Quantity: 2})
+		require.NoError(t, err)
+		assert.Equal(t, hold.HoldID, created.HoldID)
+	})
+
+	t.Run("Failed - ErrInsufficientStock", func(t *testing.T) {
+		holdManager := cacheMocks.NewMockRedisTicketHoldManager(t)
+		holdService := service.NewHoldService(holdManager)
+
+		holdManager.EXPECT().CreateHold(ctx, 10, 1, 2, mock.Anything).Return(nil, app_errors.ErrInsufficientStock).Once()
+
+		_, err := holdService.CreateHold(ctx, model.CreateHoldRequest{UserID: 1, TicketID: 10, Quantity: 2})
+		assert.ErrorIs(t, err, app_errors.ErrInsufficientStock)
+	})
+}
+
+func TestHoldService_ReleaseExpiredHolds(t *testing.T) {
+	ctx := context.Background()
+	holdManager := cacheMocks.NewMockRedisTicketHoldManager(t)
+	holdService := service.NewHoldService(holdManager)
+
+	before := time.Now()
+	holdManager.EXPECT().ReleaseExpired(ctx, mock.MatchedBy(func(now time.Time) bool {
+		return !now.Before(before.UTC().Truncate(time.Second))
+	}), 50).Return(3, nil).Once()
+
+	released, err := holdService.ReleaseExpiredHolds(ctx, 50)
+	require.NoError(t, err)
+	assert.Equal(t, 3, released)
+}
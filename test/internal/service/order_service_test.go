@@ -5,12 +5,15 @@ import (
 	"errors"
 	"testing"
 
+	"go-gin-high-concurrency/internal/cache"
 	cacheMocks "go-gin-high-concurrency/internal/cache/mocks"
 	"go-gin-high-concurrency/internal/model"
 	queueMocks "go-gin-high-concurrency/internal/queue/mocks"
 	repoMocks "go-gin-high-concurrency/internal/repository/mocks"
 	"go-gin-high-concurrency/internal/service"
+	serviceMocks "go-gin-high-concurrency/internal/service/mocks"
 	"go-gin-high-concurrency/pkg/app_errors"
+	"go-gin-high-concurrency/pkg/logger"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -18,12 +21,27 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-func setupMock(t *testing.T) (*cacheMocks.MockRedisTicketInventoryManager, *queueMocks.MockOrderQueue, *repoMocks.MockOrderRepository, *repoMocks.MockTicketRepository) {
+func setupMock(t *testing.T) (*cacheMocks.MockRedisTicketInventoryManager, *queueMocks.MockOrderQueue, *repoMocks.MockOrderRepository, *repoMocks.MockTicketRepository, *repoMocks.MockOutboxRepository, *repoMocks.MockSeatRepository, *cacheMocks.MockRedisSeatHoldManager, *cacheMocks.MockRedisTicketHoldManager, *repoMocks.MockPromoCodeRepository, *cacheMocks.MockRedisPromoCodeManager, *cacheMocks.MockRedisPresaleManager) {
 	mockInventory := cacheMocks.NewMockRedisTicketInventoryManager(t)
 	mockQueue := queueMocks.NewMockOrderQueue(t)
 	orderRepo := repoMocks.NewMockOrderRepository(t)
 	ticketRepo := repoMocks.NewMockTicketRepository(t)
-	return mockInventory, mockQueue, orderRepo, ticketRepo
+	outboxRepo := repoMocks.NewMockOutboxRepository(t)
+	seatRepo := repoMocks.NewMockSeatRepository(t)
+	mockSeatHold := cacheMocks.NewMockRedisSeatHoldManager(t)
+	mockHold := cacheMocks.NewMockRedisTicketHoldManager(t)
+	promoRepo := repoMocks.NewMockPromoCodeRepository(t)
+	mockPromo := cacheMocks.NewMockRedisPromoCodeManager(t)
+	mockPresale := cacheMocks.NewMockRedisPresaleManager(t)
+	return mockInventory, mockQueue, orderRepo, ticketRepo, outboxRepo, seatRepo, mockSeatHold, mockHold, promoRepo, mockPromo, mockPresale
+}
+
+// allowRisk 放行所有請求的風險評分，供不驗證風險評分的測試使用
+func allowRisk(t *testing.T) *serviceMocks.MockRiskScorer {
+	scorer := serviceMocks.NewMockRiskScorer(t)
+	scorer.EXPECT().Assess(mock.Anything, mock.Anything).Return(&model.RiskAssessment{Decision: model.RiskDecisionAllow}, nil).Maybe()
+	scorer.EXPECT().RecordInsufficientStock(mock.Anything, mock.Anything).Return(nil).Maybe()
+	return scorer
 }
 
 func TestOrderService_PrepareOrder(t *testing.T) {
@@ -31,11 +49,11 @@ func TestOrderService_PrepareOrder(t *testing.T) {
 	db := getTestDB()
 
 	t.Run("Success", func(t *testing.T) {
-		mockInventory, mockQueue, orderRepo, ticketRepo := setupMock(t)
-		orderService := service.NewOrderService(db, orderRepo, ticketRepo, mockInventory, mockQueue)
+		mockInventory, mockQueue, orderRepo, ticketRepo, outboxRepo, seatRepo, mockSeatHold, mockHold, promoRepo, mockPromo, mockPresale := setupMock(t)
+		orderService := service.NewOrderService(db, orderRepo, ticketRepo, seatRepo, outboxRepo, promoRepo, mockInventory, mockSeatHold, mockHold, mockPromo, mockPresale, allowRisk(t), mockQueue)
 
 		mockQueue.EXPECT().PublishOrder(ctx, mock.Anything).Return(nil).Once()
-		mockInventory.EXPECT().DecreStock(ctx, 10, 2, 1).Return(true, 100.0, nil).Once()
+		mockInventory.EXPECT().DecreStock(ctx, 10, 2, 1, "").Return(true, cache.PriceQuote{Price: 100.0}, nil).Once()
 
 		// 執行
 		req := model.CreateOrderRequest{UserID: 1, TicketID: 10, Quantity: 2}
@@ -51,11 +69,44 @@ func TestOrderService_PrepareOrder(t *testing.T) {
 		mockQueue.AssertExpectations(t)
 	})
 
+	t.Run("Success - records price phase", func(t *testing.T) {
+		mockInventory, mockQueue, orderRepo, ticketRepo, outboxRepo, seatRepo, mockSeatHold, mockHold, promoRepo, mockPromo, mockPresale := setupMock(t)
+		orderService := service.NewOrderService(db, orderRepo, ticketRepo, seatRepo, outboxRepo, promoRepo, mockInventory, mockSeatHold, mockHold, mockPromo, mockPresale, allowRisk(t), mockQueue)
+
+		mockInventory.EXPECT().DecreStock(ctx, 10, 2, 1, "").Return(true, cache.PriceQuote{Price: 80.0, Phase: "Early Bird"}, nil).Once()
+		mockQueue.EXPECT().PublishOrder(ctx, mock.Anything).Return(nil).Once()
+
+		req := model.CreateOrderRequest{UserID: 1, TicketID: 10, Quantity: 2}
+		order, err := orderService.PrepareOrder(ctx, req)
+
+		require.NoError(t, err)
+		assert.Equal(t, 160.0, order.TotalPrice)
+		require.NotNil(t, order.PricePhase)
+		assert.Equal(t, "Early Bird", *order.PricePhase)
+	})
+
+	t.Run("Success - carries request id to queue", func(t *testing.T) {
+		mockInventory, mockQueue, orderRepo, ticketRepo, outboxRepo, seatRepo, mockSeatHold, mockHold, promoRepo, mockPromo, mockPresale := setupMock(t)
+		orderService := service.NewOrderService(db, orderRepo, ticketRepo, seatRepo, outboxRepo, promoRepo, mockInventory, mockSeatHold, mockHold, mockPromo, mockPresale, allowRisk(t), mockQueue)
+
+		reqCtx := logger.WithRequestID(ctx, "req-123")
+		mockInventory.EXPECT().DecreStock(reqCtx, 10, 2, 1, "").Return(true, cache.PriceQuote{Price: 100.0}, nil).Once()
+		mockQueue.EXPECT().PublishOrder(reqCtx, mock.MatchedBy(func(o *model.Order) bool {
+			return o.CorrelationID == "req-123"
+		})).Return(nil).Once()
+
+		req := model.CreateOrderRequest{UserID: 1, TicketID: 10, Quantity: 2}
+		_, err := orderService.PrepareOrder(reqCtx, req)
+
+		require.NoError(t, err)
+		mockQueue.AssertExpectations(t)
+	})
+
 	t.Run("Failed - ErrInsufficientStock", func(t *testing.T) {
-		mockInventory, mockQueue, orderRepo, ticketRepo := setupMock(t)
-		orderService := service.NewOrderService(db, orderRepo, ticketRepo, mockInventory, mockQueue)
+		mockInventory, mockQueue, orderRepo, ticketRepo, outboxRepo, seatRepo, mockSeatHold, mockHold, promoRepo, mockPromo, mockPresale := setupMock(t)
+		orderService := service.NewOrderService(db, orderRepo, ticketRepo, seatRepo, outboxRepo, promoRepo, mockInventory, mockSeatHold, mockHold, mockPromo, mockPresale, allowRisk(t), mockQueue)
 
-		mockInventory.EXPECT().DecreStock(ctx, 10, 2, 1).Return(false, 0.0, app_errors.ErrInsufficientStock).Once()
+		mockInventory.EXPECT().DecreStock(ctx, 10, 2, 1, "").Return(false, cache.PriceQuote{}, app_errors.ErrInsufficientStock).Once()
 
 		// 執行
 		req := model.CreateOrderRequest{UserID: 1, TicketID: 10, Quantity: 2}
@@ -71,10 +122,10 @@ func TestOrderService_PrepareOrder(t *testing.T) {
 	})
 
 	t.Run("Failed - RollbackStock", func(t *testing.T) {
-		mockInventory, mockQueue, orderRepo, ticketRepo := setupMock(t)
-		orderService := service.NewOrderService(db, orderRepo, ticketRepo, mockInventory, mockQueue)
+		mockInventory, mockQueue, orderRepo, ticketRepo, outboxRepo, seatRepo, mockSeatHold, mockHold, promoRepo, mockPromo, mockPresale := setupMock(t)
+		orderService := service.NewOrderService(db, orderRepo, ticketRepo, seatRepo, outboxRepo, promoRepo, mockInventory, mockSeatHold, mockHold, mockPromo, mockPresale, allowRisk(t), mockQueue)
 
-		mockInventory.EXPECT().DecreStock(ctx, 10, 2, 1).Return(true, 100.0, nil).Once()
+		mockInventory.EXPECT().DecreStock(ctx, 10, 2, 1, "").Return(true, cache.PriceQuote{Price: 100.0}, nil).Once()
 		mockInventory.EXPECT().RollbackStock(mock.Anything, 10, 2, 1).Return(nil).Once()
 		mockQueue.EXPECT().PublishOrder(ctx, mock.Anything).Return(errors.New("failed to publish order")).Once()
 
@@ -91,11 +142,25 @@ func TestOrderService_PrepareOrder(t *testing.T) {
 		mockQueue.AssertExpectations(t)
 	})
 
+	t.Run("Failed - queue full returns ErrQueueFull", func(t *testing.T) {
+		mockInventory, mockQueue, orderRepo, ticketRepo, outboxRepo, seatRepo, mockSeatHold, mockHold, promoRepo, mockPromo, mockPresale := setupMock(t)
+		orderService := service.NewOrderService(db, orderRepo, ticketRepo, seatRepo, outboxRepo, promoRepo, mockInventory, mockSeatHold, mockHold, mockPromo, mockPresale, allowRisk(t), mockQueue)
+
+		mockInventory.EXPECT().DecreStock(ctx, 10, 2, 1, "").Return(true, cache.PriceQuote{Price: 100.0}, nil).Once()
+		mockInventory.EXPECT().RollbackStock(mock.Anything, 10, 2, 1).Return(nil).Once()
+		mockQueue.EXPECT().PublishOrder(ctx, mock.Anything).Return(app_errors.ErrQueueFull).Once()
+
+		req := model.CreateOrderRequest{UserID: 1, TicketID: 10, Quantity: 2}
+		_, err := orderService.PrepareOrder(ctx, req)
+
+		assert.ErrorIs(t, err, app_errors.ErrQueueFull)
+	})
+
 	t.Run("Failed - RollbackStock(Failed to rollback stock)", func(t *testing.T) {
-		mockInventory, mockQueue, orderRepo, ticketRepo := setupMock(t)
-		orderService := service.NewOrderService(db, orderRepo, ticketRepo, mockInventory, mockQueue)
+		mockInventory, mockQueue, orderRepo, ticketRepo, outboxRepo, seatRepo, mockSeatHold, mockHold, promoRepo, mockPromo, mockPresale := setupMock(t)
+		orderService := service.NewOrderService(db, orderRepo, ticketRepo, seatRepo, outboxRepo, promoRepo, mockInventory, mockSeatHold, mockHold, mockPromo, mockPresale, allowRisk(t), mockQueue)
 
-		mockInventory.EXPECT().DecreStock(ctx, 10, 2, 1).Return(true, 100.0, nil).Once()
+		mockInventory.EXPECT().DecreStock(ctx, 10, 2, 1, "").Return(true, cache.PriceQuote{Price: 100.0}, nil).Once()
 		mockInventory.EXPECT().RollbackStock(mock.Anything, 10, 2, 1).Return(errors.New("failed to rollback stock")).Once()
 		mockQueue.EXPECT().PublishOrder(ctx, mock.Anything).Return(errors.New("failed to publish order")).Once()
 
@@ -113,18 +178,494 @@ func TestOrderService_PrepareOrder(t *testing.T) {
 	})
 }
 
+func TestOrderService_PrepareSeatedOrder(t *testing.T) {
+	ctx := context.Background()
+	db := getTestDB()
+
+	t.Run("Success - commits held seats", func(t *testing.T) {
+		mockInventory, mockQueue, orderRepo, ticketRepo, outboxRepo, seatRepo, mockSeatHold, mockHold, promoRepo, mockPromo, mockPresale := setupMock(t)
+		orderService := service.NewOrderService(db, orderRepo, ticketRepo, seatRepo, outboxRepo, promoRepo, mockInventory, mockSeatHold, mockHold, mockPromo, mockPresale, allowRisk(t), mockQueue)
+
+		mockSeatHold.EXPECT().CommitSeats(ctx, 10, 1, []int{101, 102}, "").Return(cache.PriceQuote{Price: 80.0}, nil).Once()
+		mockQueue.EXPECT().PublishOrder(ctx, mock.MatchedBy(func(o *model.Order) bool {
+			return len(o.SeatIDs) == 2 && o.TotalPrice == 160.0
+		})).Return(nil).Once()
+
+		req := model.CreateOrderRequest{UserID: 1, TicketID: 10, Quantity: 2, SeatIDs: []int{101, 102}}
+		order, err := orderService.PrepareOrder(ctx, req)
+
+		require.NoError(t, err)
+		assert.Equal(t, []int{101, 102}, order.SeatIDs)
+		mockInventory.AssertNotCalled(t, "DecreStock")
+	})
+
+	t.Run("Failed - seat count does not match quantity", func(t *testing.T) {
+		mockInventory, mockQueue, orderRepo, ticketRepo, outboxRepo, seatRepo, mockSeatHold, mockHold, promoRepo, mockPromo, mockPresale := setupMock(t)
+		orderService := service.NewOrderService(db, orderRepo, ticketRepo, seatRepo, outboxRepo, promoRepo, mockInventory, mockSeatHold, mockHold, mockPromo, mockPresale, allowRisk(t), mockQueue)
+
+		req := model.CreateOrderRequest{UserID: 1, TicketID: 10, Quantity: 3, SeatIDs: []int{101, 102}}
+		_, err := orderService.PrepareOrder(ctx, req)
+
+		assert.ErrorIs(t, err, app_errors.ErrInvalidInput)
+		mockSeatHold.AssertNotCalled(t, "CommitSeats")
+	})
+
+	t.Run("Failed - ErrSeatHoldExpired", func(t *testing.T) {
+		mockInventory, mockQueue, orderRepo, ticketRepo, outboxRepo, seatRepo, mockSeatHold, mockHold, promoRepo, mockPromo, mockPresale := setupMock(t)
+		orderService := service.NewOrderService(db, orderRepo, ticketRepo, seatRepo, outboxRepo, promoRepo, mockInventory, mockSeatHold, mockHold, mockPromo, mockPresale, allowRisk(t), mockQueue)
+
+		mockSeatHold.EXPECT().CommitSeats(ctx, 10, 1, []int{101}, "").Return(cache.PriceQuote{}, app_errors.ErrSeatHoldExpired).Once()
+
+		req := model.CreateOrderRequest{UserID: 1, TicketID: 10, Quantity: 1, SeatIDs: []int{101}}
+		_, err := orderService.PrepareOrder(ctx, req)
+
+		assert.ErrorIs(t, err, app_errors.ErrSeatHoldExpired)
+		mockQueue.AssertNotCalled(t, "PublishOrder")
+	})
+
+	t.Run("Failed - publish failure rolls back seats", func(t *testing.T) {
+		mockInventory, mockQueue, orderRepo, ticketRepo, outboxRepo, seatRepo, mockSeatHold, mockHold, promoRepo, mockPromo, mockPresale := setupMock(t)
+		orderService := service.NewOrderService(db, orderRepo, ticketRepo, seatRepo, outboxRepo, promoRepo, mockInventory, mockSeatHold, mockHold, mockPromo, mockPresale, allowRisk(t), mockQueue)
+
+		mockSeatHold.EXPECT().CommitSeats(ctx, 10, 1, []int{101}, "").Return(cache.PriceQuote{Price: 80.0}, nil).Once()
+		mockQueue.EXPECT().PublishOrder(ctx, mock.Anything).Return(errors.New("failed to publish order")).Once()
+		mockSeatHold.EXPECT().RollbackSeats(mock.Anything, 10, 1, []int{101}).Return(nil).Once()
+
+		req := model.CreateOrderRequest{UserID: 1, TicketID: 10, Quantity: 1, SeatIDs: []int{101}}
+		_, err := orderService.PrepareOrder(ctx, req)
+
+		assert.ErrorIs(t, err, app_errors.ErrInternalServerError)
+	})
+}
+
+func TestOrderService_PrepareHeldOrder(t *testing.T) {
+	ctx := context.Background()
+	db := getTestDB()
+	holdID := uuid.New()
+
+	t.Run("Success - converts hold without decrementing stock", func(t *testing.T) {
+		mockInventory, mockQueue, orderRepo, ticketRepo, outboxRepo, seatRepo, mockSeatHold, mockHold, promoRepo, mockPromo, mockPresale := setupMock(t)
+		orderService := service.NewOrderService(db, orderRepo, ticketRepo, seatRepo, outboxRepo, promoRepo, mockInventory, mockSeatHold, mockHold, mockPromo, mockPresale, allowRisk(t), mockQueue)
+
+		mockHold.EXPECT().ConvertHold(ctx, holdID, 1, 10, 2).Return(&model.TicketHold{HoldID: holdID, Price: 100.0}, nil).Once()
+		mockQueue.EXPECT().PublishOrder(ctx, mock.MatchedBy(func(o *model.Order) bool {
+			return o.TicketID == 10 && o.Quantity == 2 && o.TotalPrice == 200.0
+		})).Return(nil).Once()
+
+		req := model.CreateOrderRequest{UserID: 1, TicketID: 10, Quantity: 2, HoldID: &holdID}
+		order, err := orderService.PrepareOrder(ctx, req)
+
+		require.NoError(t, err)
+		assert.Equal(t, model.OrderStatusPending, order.Status)
+		mockInventory.AssertNotCalled(t, "DecreStock")
+	})
+
+	t.Run("Failed - ErrHoldExpired", func(t *testing.T) {
+		mockInventory, mockQueue, orderRepo, ticketRepo, outboxRepo, seatRepo, mockSeatHold, mockHold, promoRepo, mockPromo, mockPresale := setupMock(t)
+		orderService := service.NewOrderService(db, orderRepo, ticketRepo, seatRepo, outboxRepo, promoRepo, mockInventory, mockSeatHold, mockHold, mockPromo, mockPresale, allowRisk(t), mockQueue)
+
+		mockHold.EXPECT().ConvertHold(ctx, holdID, 1, 10, 2).Return(nil, app_errors.ErrHoldExpired).Once()
+
+		req := model.CreateOrderRequest{UserID: 1, TicketID: 10, Quantity: 2, HoldID: &holdID}
+		_, err := orderService.PrepareOrder(ctx, req)
+
+		assert.ErrorIs(t, err, app_errors.ErrHoldExpired)
+		mockQueue.AssertNotCalled(t, "PublishOrder")
+	})
+
+	t.Run("Failed - publish failure rolls back stock", func(t *testing.T) {
+		mockInventory, mockQueue, orderRepo, ticketRepo, outboxRepo, seatRepo, mockSeatHold, mockHold, promoRepo, mockPromo, mockPresale := setupMock(t)
+		orderService := service.NewOrderService(db, orderRepo, ticketRepo, seatRepo, outboxRepo, promoRepo, mockInventory, mockSeatHold, mockHold, mockPromo, mockPresale, allowRisk(t), mockQueue)
+
+		mockHold.EXPECT().ConvertHold(ctx, holdID, 1, 10, 2).Return(&model.TicketHold{HoldID: holdID, Price: 100.0}, nil).Once()
+		mockQueue.EXPECT().PublishOrder(ctx, mock.Anything).Return(errors.New("failed to publish order")).Once()
+		mockInventory.EXPECT().RollbackStock(mock.Anything, 10, 2, 1).Return(nil).Once()
+
+		req := model.CreateOrderRequest{UserID: 1, TicketID: 10, Quantity: 2, HoldID: &holdID}
+		_, err := orderService.PrepareOrder(ctx, req)
+
+		assert.ErrorIs(t, err, app_errors.ErrInternalServerError)
+	})
+}
+
+func TestOrderService_PrepareOrderPriceLock(t *testing.T) {
+	ctx := context.Background()
+	db := getTestDB()
+	holdID := uuid.New()
+
+	t.Run("Success - expected price matches", func(t *testing.T) {
+		mockInventory, mockQueue, orderRepo, ticketRepo, outboxRepo, seatRepo, mockSeatHold, mockHold, promoRepo, mockPromo, mockPresale := setupMock(t)
+		orderService := service.NewOrderService(db, orderRepo, ticketRepo, seatRepo, outboxRepo, promoRepo, mockInventory, mockSeatHold, mockHold, mockPromo, mockPresale, allowRisk(t), mockQueue)
+
+		mockInventory.EXPECT().DecreStock(ctx, 10, 2, 1, "").Return(true, cache.PriceQuote{Price: 100.0}, nil).Once()
+		mockQueue.EXPECT().PublishOrder(ctx, mock.MatchedBy(func(o *model.Order) bool {
+			return o.TotalPrice == 200.0
+		})).Return(nil).Once()
+
+		expected := 100.0
+		req := model.CreateOrderRequest{UserID: 1, TicketID: 10, Quantity: 2, ExpectedPrice: &expected}
+		_, err := orderService.PrepareOrder(ctx, req)
+
+		require.NoError(t, err)
+		mockInventory.AssertNotCalled(t, "RollbackStock")
+	})
+
+	t.Run("Success - non-integer price compared in cents", func(t *testing.T) {
+		mockInventory, mockQueue, orderRepo, ticketRepo, outboxRepo, seatRepo, mockSeatHold, mockHold, promoRepo, mockPromo, mockPresale := setupMock(t)
+		orderService := service.NewOrderService(db, orderRepo, ticketRepo, seatRepo, outboxRepo, promoRepo, mockInventory, mockSeatHold, mockHold, mockPromo, mockPresale, allowRisk(t), mockQueue)
+
+		// 階段價格經浮點運算後為 3.3000000000000003，與使用者看到的 3.3 只差浮點誤差
+		quoted := 1.1 * 3
+		mockInventory.EXPECT().DecreStock(ctx, 10, 1, 1, "").Return(true, cache.PriceQuote{Price: quoted}, nil).Once()
+		mockQueue.EXPECT().PublishOrder(ctx, mock.Anything).Return(nil).Once()
+
+		expected := 3.3
+		req := model.CreateOrderRequest{UserID: 1, TicketID: 10, Quantity: 1, ExpectedPrice: &expected}
+		_, err := orderService.PrepareOrder(ctx, req)
+
+		require.NoError(t, err)
+		mockInventory.AssertNotCalled(t, "RollbackStock")
+	})
+
+	t.Run("Failed - one cent difference rolls back stock", func(t *testing.T) {
+		mockInventory, mockQueue, orderRepo, ticketRepo, outboxRepo, seatRepo, mockSeatHold, mockHold, promoRepo, mockPromo, mockPresale := setupMock(t)
+		orderService := service.NewOrderService(db, orderRepo, ticketRepo, seatRepo, outboxRepo, promoRepo, mockInventory, mockSeatHold, mockHold, mockPromo, mockPresale, allowRisk(t), mockQueue)
+
+		mockInventory.EXPECT().DecreStock(ctx, 10, 1, 1, "").Return(true, cache.PriceQuote{Price: 99.99}, nil).Once()
+		mockInventory.EXPECT().RollbackStock(mock.Anything, 10, 1, 1).Return(nil).Once()
+
+		expected := 99.98
+		req := model.CreateOrderRequest{UserID: 1, TicketID: 10, Quantity: 1, ExpectedPrice: &expected}
+		_, err := orderService.PrepareOrder(ctx, req)
+
+		assert.ErrorIs(t, err, app_errors.ErrPriceChanged)
+		mockQueue.AssertNotCalled(t, "PublishOrder")
+	})
+
+	t.Run("Failed - price changed rolls back stock", func(t *testing.T) {
+		mockInventory, mockQueue, orderRepo, ticketRepo, outboxRepo, seatRepo, mockSeatHold, mockHold, promoRepo, mockPromo, mockPresale := setupMock(t)
+		orderService := service.NewOrderService(db, orderRepo, ticketRepo, seatRepo, outboxRepo, promoRepo, mockInventory, mockSeatHold, mockHold, mockPromo, mockPresale, allowRisk(t), mockQueue)
+
+		mockInventory.EXPECT().DecreStock(ctx, 10, 2, 1, "").Return(true, cache.PriceQuote{Price: 120.0}, nil).Once()
+		mockInventory.EXPECT().RollbackStock(mock.Anything, 10, 2, 1).Return(nil).Once()
+
+		expected := 100.0
+		req := model.CreateOrderRequest{UserID: 1, TicketID: 10, Quantity: 2, ExpectedPrice: &expected}
+		_, err := orderService.PrepareOrder(ctx, req)
+
+		assert.ErrorIs(t, err, app_errors.ErrPriceChanged)
+		mockQueue.AssertNotCalled(t, "PublishOrder")
+	})
+
+	t.Run("Failed - price changed releases seats", func(t *testing.T) {
+		mockInventory, mockQueue, orderRepo, ticketRepo, outboxRepo, seatRepo, mockSeatHold, mockHold, promoRepo, mockPromo, mockPresale := setupMock(t)
+		orderService := service.NewOrderService(db, orderRepo, ticketRepo, seatRepo, outboxRepo, promoRepo, mockInventory, mockSeatHold, mockHold, mockPromo, mockPresale, allowRisk(t), mockQueue)
+
+		mockSeatHold.EXPECT().CommitSeats(ctx, 10, 1, []int{101}, "").Return(cache.PriceQuote{Price: 90.0}, nil).Once()
+		mockSeatHold.EXPECT().RollbackSeats(mock.Anything, 10, 1, []int{101}).Return(nil).Once()
+
+		expected := 80.0
+		req := model.CreateOrderRequest{UserID: 1, TicketID: 10, Quantity: 1, SeatIDs: []int{101}, ExpectedPrice: &expected}
+		_, err := orderService.PrepareOrder(ctx, req)
+
+		assert.ErrorIs(t, err, app_errors.ErrPriceChanged)
+		mockQueue.AssertNotCalled(t, "PublishOrder")
+	})
+
+	t.Run("Failed - held price differs rolls back stock", func(t *testing.T) {
+		mockInventory, mockQueue, orderRepo, ticketRepo, outboxRepo, seatRepo, mockSeatHold, mockHold, promoRepo, mockPromo, mockPresale := setupMock(t)
+		orderService := service.NewOrderService(db, orderRepo, ticketRepo, seatRepo, outboxRepo, promoRepo, mockInventory, mockSeatHold, mockHold, mockPromo, mockPresale, allowRisk(t), mockQueue)
+
+		mockHold.EXPECT().ConvertHold(ctx, holdID, 1, 10, 2).Return(&model.TicketHold{HoldID: holdID, Price: 100.0}, nil).Once()
+		mockInventory.EXPECT().RollbackStock(mock.Anything, 10, 2, 1).Return(nil).Once()
+
+		expected := 120.0
+		req := model.CreateOrderRequest{UserID: 1, TicketID: 10, Quantity: 2, HoldID: &holdID, ExpectedPrice: &expected}
+		_, err := orderService.PrepareOrder(ctx, req)
+
+		assert.ErrorIs(t, err, app_errors.ErrPriceChanged)
+		mockQueue.AssertNotCalled(t, "PublishOrder")
+	})
+}
+
+func TestOrderService_PrepareOrderPromoCode(t *testing.T) {
+	ctx := context.Background()
+	db := getTestDB()
+
+	t.Run("Success - discount applied to total price", func(t *testing.T) {
+		mockInventory, mockQueue, orderRepo, ticketRepo, outboxRepo, seatRepo, mockSeatHold, mockHold, promoRepo, mockPromo, mockPresale := setupMock(t)
+		orderService := service.NewOrderService(db, orderRepo, ticketRepo, seatRepo, outboxRepo, promoRepo, mockInventory, mockSeatHold, mockHold, mockPromo, mockPresale, allowRisk(t), mockQueue)
+
+		mockInventory.EXPECT().DecreStock(ctx, 10, 2, 1, "").Return(true, cache.PriceQuote{Price: 100.0}, nil).Once()
+		mockPromo.EXPECT().Redeem(ctx, "SAVE10", 10, 1).
+			Return(&model.PromoCode{ID: 3, Code: "SAVE10", DiscountType: model.DiscountTypePercentage, DiscountValue: 10}, nil).Once()
+		mockQueue.EXPECT().PublishOrder(ctx, mock.Anything).Return(nil).Once()
+
+		code := " save10 "
+		req := model.CreateOrderRequest{UserID: 1, TicketID: 10, Quantity: 2, PromoCode: &code}
+		order, err := orderService.PrepareOrder(ctx, req)
+
+		require.NoError(t, err)
+		assert.Equal(t, 180.0, order.TotalPrice)
+		assert.Equal(t, 20.0, order.DiscountAmount)
+		require.NotNil(t, order.PromoCode)
+		assert.Equal(t, "SAVE10", *order.PromoCode)
+	})
+
+	t.Run("Success - loads promo code into Redis on first use", func(t *testing.T) {
+		mockInventory, mockQueue, orderRepo, ticketRepo, outboxRepo, seatRepo, mockSeatHold, mockHold, promoRepo, mockPromo, mockPresale := setupMock(t)
+		orderService := service.NewOrderService(db, orderRepo, ticketRepo, seatRepo, outboxRepo, promoRepo, mockInventory, mockSeatHold, mockHold, mockPromo, mockPresale, allowRisk(t), mockQueue)
+
+		promo := &model.PromoCode{ID: 3, Code: "FLAT50", DiscountType: model.DiscountTypeFixed, DiscountValue: 50}
+		mockInventory.EXPECT().DecreStock(ctx, 10, 1, 1, "").Return(true, cache.PriceQuote{Price: 100.0}, nil).Once()
+		mockPromo.EXPECT().Redeem(ctx, "FLAT50", 10, 1).Return(nil, app_errors.ErrPromoCodeNotFound).Once()
+		mockPromo.EXPECT().IsMissing(ctx, "FLAT50").Return(false, nil).Once()
+		promoRepo.EXPECT().FindByCode(ctx, "FLAT50").Return(promo, nil).Once()
+		promoRepo.EXPECT().CountActiveRedemptionsByUser(ctx, 3).Return(map[int]int{2: 1}, nil).Once()
+		mockPromo.EXPECT().WarmUp(ctx, promo, map[int]int{2: 1}).Return(nil).Once()
+		mockPromo.EXPECT().Redeem(ctx, "FLAT50", 10, 1).Return(promo, nil).Once()
+		mockQueue.EXPECT().PublishOrder(ctx, mock.Anything).Return(nil).Once()
+
+		code := "FLAT50"
+		req := model.CreateOrderRequest{UserID: 1, TicketID: 10, Quantity: 1, PromoCode: &code}
+		order, err := orderService.PrepareOrder(ctx, req)
+
+		require.NoError(t, err)
+		assert.Equal(t, 50.0, order.TotalPrice)
+	})
+
+	t.Run("Failed - unknown promo code rolls back stock", func(t *testing.T) {
+		mockInventory, mockQueue, orderRepo, ticketRepo, outboxRepo, seatRepo, mockSeatHold, mockHold, promoRepo, mockPromo, mockPresale := setupMock(t)
+		orderService := service.NewOrderService(db, orderRepo, ticketRepo, seatRepo, outboxRepo, promoRepo, mockInventory, mockSeatHold, mockHold, mockPromo, mockPresale, allowRisk(t), mockQueue)
+
+		mockInventory.EXPECT().DecreStock(ctx, 10, 2, 1, "").Return(true, cache.PriceQuote{Price: 100.0}, nil).Once()
+		mockPromo.EXPECT().Redeem(ctx, "NOPE", 10, 1).Return(nil, app_errors.ErrPromoCodeNotFound).Once()
+		mockPromo.EXPECT().IsMissing(ctx, "NOPE").Return(false, nil).Once()
+		promoRepo.EXPECT().FindByCode(ctx, "NOPE").Return(nil, app_errors.ErrPromoCodeNotFound).Once()
+		mockPromo.EXPECT().MarkMissing(ctx, "NOPE", mock.AnythingOfType("time.Duration")).Return(nil).Once()
+		mockInventory.EXPECT().RollbackStock(mock.Anything, 10, 2, 1).Return(nil).Once()
+
+		code := "NOPE"
+		req := model.CreateOrderRequest{UserID: 1, TicketID: 10, Quantity: 2, PromoCode: &code}
+		_, err := orderService.PrepareOrder(ctx, req)
+
+		assert.ErrorIs(t, err, app_errors.ErrPromoCodeNotFound)
+		mockQueue.AssertNotCalled(t, "PublishOrder")
+	})
+
+	t.Run("Failed - known missing promo code skips database", func(t *testing.T) {
+		mockInventory, mockQueue, orderRepo, ticketRepo, outboxRepo, seatRepo, mockSeatHold, mockHold, promoRepo, mockPromo, mockPresale := setupMock(t)
+		orderService := service.NewOrderService(db, orderRepo, ticketRepo, seatRepo, outboxRepo, promoRepo, mockInventory, mockSeatHold, mockHold, mockPromo, mockPresale, allowRisk(t), mockQueue)
+
+		mockInventory.EXPECT().DecreStock(ctx, 10, 2, 1, "").Return(true, cache.PriceQuote{Price: 100.0}, nil).Once()
+		mockPromo.EXPECT().Redeem(ctx, "NOPE", 10, 1).Return(nil, app_errors.ErrPromoCodeNotFound).Once()
+		mockPromo.EXPECT().IsMissing(ctx, "NOPE").Return(true, nil).Once()
+		mockInventory.EXPECT().RollbackStock(mock.Anything, 10, 2, 1).Return(nil).Once()
+
+		code := "NOPE"
+		req := model.CreateOrderRequest{UserID: 1, TicketID: 10, Quantity: 2, PromoCode: &code}
+		_, err := orderService.PrepareOrder(ctx, req)
+
+		assert.ErrorIs(t, err, app_errors.ErrPromoCodeNotFound)
+		promoRepo.AssertNotCalled(t, "FindByCode", mock.Anything, mock.Anything)
+		mockQueue.AssertNotCalled(t, "PublishOrder")
+	})
+
+	t.Run("Failed - exhausted promo code releases seats", func(t *testing.T) {
+		mockInventory, mockQueue, orderRepo, ticketRepo, outboxRepo, seatRepo, mockSeatHold, mockHold, promoRepo, mockPromo, mockPresale := setupMock(t)
+		orderService := service.NewOrderService(db, orderRepo, ticketRepo, seatRepo, outboxRepo, promoRepo, mockInventory, mockSeatHold, mockHold, mockPromo, mockPresale, allowRisk(t), mockQueue)
+
+		mockSeatHold.EXPECT().CommitSeats(ctx, 10, 1, []int{101}, "").Return(cache.PriceQuote{Price: 80.0}, nil).Once()
+		mockPromo.EXPECT().Redeem(ctx, "ONCE", 10, 1).Return(nil, app_errors.ErrPromoCodeExhausted).Once()
+		mockSeatHold.EXPECT().RollbackSeats(mock.Anything, 10, 1, []int{101}).Return(nil).Once()
+
+		code := "ONCE"
+		req := model.CreateOrderRequest{UserID: 1, TicketID: 10, Quantity: 1, SeatIDs: []int{101}, PromoCode: &code}
+		_, err := orderService.PrepareOrder(ctx, req)
+
+		assert.ErrorIs(t, err, app_errors.ErrPromoCodeExhausted)
+		mockQueue.AssertNotCalled(t, "PublishOrder")
+	})
+
+	t.Run("Failed - publish error returns promo code", func(t *testing.T) {
+		mockInventory, mockQueue, orderRepo, ticketRepo, outboxRepo, seatRepo, mockSeatHold, mockHold, promoRepo, mockPromo, mockPresale := setupMock(t)
+		orderService := service.NewOrderService(db, orderRepo, ticketRepo, seatRepo, outboxRepo, promoRepo, mockInventory, mockSeatHold, mockHold, mockPromo, mockPresale, allowRisk(t), mockQueue)
+
+		mockInventory.EXPECT().DecreStock(ctx, 10, 1, 1, "").Return(true, cache.PriceQuote{Price: 100.0}, nil).Once()
+		mockPromo.EXPECT().Redeem(ctx, "FLAT50", 10, 1).
+			Return(&model.PromoCode{ID: 3, Code: "FLAT50", DiscountType: model.DiscountTypeFixed, DiscountValue: 50}, nil).Once()
+		mockQueue.EXPECT().PublishOrder(ctx, mock.Anything).Return(errors.New("failed to publish order")).Once()
+		mockInventory.EXPECT().RollbackStock(mock.Anything, 10, 1, 1).Return(nil).Once()
+		mockPromo.EXPECT().Return(mock.Anything, "FLAT50", 1).Return(nil).Once()
+
+		code := "FLAT50"
+		req := model.CreateOrderRequest{UserID: 1, TicketID: 10, Quantity: 1, PromoCode: &code}
+		_, err := orderService.PrepareOrder(ctx, req)
+
+		assert.ErrorIs(t, err, app_errors.ErrInternalServerError)
+	})
+}
+
+func TestOrderService_PrepareOrderPresale(t *testing.T) {
+	ctx := context.Background()
+	db := getTestDB()
+
+	t.Run("Success - consumed access code recorded on order", func(t *testing.T) {
+		mockInventory, mockQueue, orderRepo, ticketRepo, outboxRepo, seatRepo, mockSeatHold, mockHold, promoRepo, mockPromo, mockPresale := setupMock(t)
+		orderService := service.NewOrderService(db, orderRepo, ticketRepo, seatRepo, outboxRepo, promoRepo, mockInventory, mockSeatHold, mockHold, mockPromo, mockPresale, allowRisk(t), mockQueue)
+
+		mockInventory.EXPECT().DecreStock(ctx, 10, 1, 1, "FANCLUB").
+			Return(true, cache.PriceQuote{Price: 100.0, AccessCode: "FANCLUB"}, nil).Once()
+		mockQueue.EXPECT().PublishOrder(ctx, mock.Anything).Return(nil).Once()
+
+		code := " fanclub "
+		req := model.CreateOrderRequest{UserID: 1, TicketID: 10, Quantity: 1, AccessCode: &code}
+		order, err := orderService.PrepareOrder(ctx, req)
+
+		require.NoError(t, err)
+		require.NotNil(t, order.AccessCode)
+		assert.Equal(t, "FANCLUB", *order.AccessCode)
+	})
+
+	t.Run("Success - allow-listed user does not record access code", func(t *testing.T) {
+		mockInventory, mockQueue, orderRepo, ticketRepo, outboxRepo, seatRepo, mockSeatHold, mockHold, promoRepo, mockPromo, mockPresale := setupMock(t)
+		orderService := service.NewOrderService(db, orderRepo, ticketRepo, seatRepo, outboxRepo, promoRepo, mockInventory, mockSeatHold, mockHold, mockPromo, mockPresale, allowRisk(t), mockQueue)
+
+		mockSeatHold.EXPECT().CommitSeats(ctx, 10, 1, []int{101}, "FANCLUB").Return(cache.PriceQuote{Price: 80.0}, nil).Once()
+		mockQueue.EXPECT().PublishOrder(ctx, mock.Anything).Return(nil).Once()
+
+		code := "FANCLUB"
+		req := model.CreateOrderRequest{UserID: 1, TicketID: 10, Quantity: 1, SeatIDs: []int{101}, AccessCode: &code}
+		order, err := orderService.PrepareOrder(ctx, req)
+
+		require.NoError(t, err)
+		assert.Nil(t, order.AccessCode)
+	})
+
+	t.Run("Failed - access denied", func(t *testing.T) {
+		mockInventory, mockQueue, orderRepo, ticketRepo, outboxRepo, seatRepo, mockSeatHold, mockHold, promoRepo, mockPromo, mockPresale := setupMock(t)
+		orderService := service.NewOrderService(db, orderRepo, ticketRepo, seatRepo, outboxRepo, promoRepo, mockInventory, mockSeatHold, mockHold, mockPromo, mockPresale, allowRisk(t), mockQueue)
+
+		mockInventory.EXPECT().DecreStock(ctx, 10, 1, 1, "").Return(false, cache.PriceQuote{}, app_errors.ErrPresaleAccessDenied).Once()
+
+		req := model.CreateOrderRequest{UserID: 1, TicketID: 10, Quantity: 1}
+		_, err := orderService.PrepareOrder(ctx, req)
+
+		assert.ErrorIs(t, err, app_errors.ErrPresaleAccessDenied)
+		mockPresale.AssertNotCalled(t, "ReturnAccessCode")
+	})
+
+	t.Run("Failed - promo code error returns access code", func(t *testing.T) {
+		mockInventory, mockQueue, orderRepo, ticketRepo, outboxRepo, seatRepo, mockSeatHold, mockHold, promoRepo, mockPromo, mockPresale := setupMock(t)
+		orderService := service.NewOrderService(db, orderRepo, ticketRepo, seatRepo, outboxRepo, promoRepo, mockInventory, mockSeatHold, mockHold, mockPromo, mockPresale, allowRisk(t), mockQueue)
+
+		mockInventory.EXPECT().DecreStock(ctx, 10, 1, 1, "FANCLUB").
+			Return(true, cache.PriceQuote{Price: 100.0, AccessCode: "FANCLUB"}, nil).Once()
+		mockPromo.EXPECT().Redeem(ctx, "ONCE", 10, 1).Return(nil, app_errors.ErrPromoCodeExhausted).Once()
+		mockInventory.EXPECT().RollbackStock(mock.Anything, 10, 1, 1).Return(nil).Once()
+		mockPresale.EXPECT().ReturnAccessCode(mock.Anything, 10, "FANCLUB").Return(nil).Once()
+
+		accessCode, promoCode := "FANCLUB", "ONCE"
+		req := model.CreateOrderRequest{UserID: 1, TicketID: 10, Quantity: 1, AccessCode: &accessCode, PromoCode: &promoCode}
+		_, err := orderService.PrepareOrder(ctx, req)
+
+		assert.ErrorIs(t, err, app_errors.ErrPromoCodeExhausted)
+		mockQueue.AssertNotCalled(t, "PublishOrder")
+	})
+}
+
+func TestOrderService_PrepareOrderRisk(t *testing.T) {
+	ctx := context.Background()
+	db := getTestDB()
+	req := model.CreateOrderRequest{UserID: 1, TicketID: 10, Quantity: 2}
+
+	t.Run("Failed - blocked before decrementing stock", func(t *testing.T) {
+		mockInventory, mockQueue, orderRepo, ticketRepo, outboxRepo, seatRepo, mockSeatHold, mockHold, promoRepo, mockPromo, mockPresale := setupMock(t)
+		scorer := serviceMocks.NewMockRiskScorer(t)
+		orderService := service.NewOrderService(db, orderRepo, ticketRepo, seatRepo, outboxRepo, promoRepo, mockInventory, mockSeatHold, mockHold, mockPromo, mockPresale, scorer, mockQueue)
+
+		scorer.EXPECT().Assess(ctx, req).Return(&model.RiskAssessment{
+			Decision: model.RiskDecisionBlock,
+			Score:    100,
+			Flags:    []string{model.RiskFlagUserVelocity, model.RiskFlagSharedPayment},
+		}, nil).Once()
+
+		_, err := orderService.PrepareOrder(ctx, req)
+
+		assert.ErrorIs(t, err, app_errors.ErrOrderBlocked)
+		mockInventory.AssertNotCalled(t, "DecreStock")
+		mockQueue.AssertNotCalled(t, "PublishOrder")
+	})
+
+	t.Run("Success - flagged order records risk", func(t *testing.T) {
+		mockInventory, mockQueue, orderRepo, ticketRepo, outboxRepo, seatRepo, mockSeatHold, mockHold, promoRepo, mockPromo, mockPresale := setupMock(t)
+		scorer := serviceMocks.NewMockRiskScorer(t)
+		orderService := service.NewOrderService(db, orderRepo, ticketRepo, seatRepo, outboxRepo, promoRepo, mockInventory, mockSeatHold, mockHold, mockPromo, mockPresale, scorer, mockQueue)
+
+		scorer.EXPECT().Assess(ctx, req).Return(&model.RiskAssessment{
+			Decision: model.RiskDecisionFlag,
+			Score:    30,
+			Flags:    []string{model.RiskFlagIPVelocity},
+		}, nil).Once()
+		mockInventory.EXPECT().DecreStock(ctx, 10, 2, 1, "").Return(true, cache.PriceQuote{Price: 100.0}, nil).Once()
+		mockQueue.EXPECT().PublishOrder(ctx, mock.MatchedBy(func(o *model.Order) bool {
+			return o.RiskScore == 30 && len(o.RiskFlags) == 1 && o.RiskFlags[0] == model.RiskFlagIPVelocity
+		})).Return(nil).Once()
+
+		order, err := orderService.PrepareOrder(ctx, req)
+
+		require.NoError(t, err)
+		assert.Equal(t, 30, order.RiskScore)
+		assert.Equal(t, []string{model.RiskFlagIPVelocity}, order.RiskFlags)
+	})
+
+	t.Run("Success - scorer failure allows order", func(t *testing.T) {
+		mockInventory, mockQueue, orderRepo, ticketRepo, outboxRepo, seatRepo, mockSeatHold, mockHold, promoRepo, mockPromo, mockPresale := setupMock(t)
+		scorer := serviceMocks.NewMockRiskScorer(t)
+		orderService := service.NewOrderService(db, orderRepo, ticketRepo, seatRepo, outboxRepo, promoRepo, mockInventory, mockSeatHold, mockHold, mockPromo, mockPresale, scorer, mockQueue)
+
+		scorer.EXPECT().Assess(ctx, req).Return(nil, errors.New("redis unavailable")).Once()
+		mockInventory.EXPECT().DecreStock(ctx, 10, 2, 1, "").Return(true, cache.PriceQuote{Price: 100.0}, nil).Once()
+		mockQueue.EXPECT().PublishOrder(ctx, mock.Anything).Return(nil).Once()
+
+		order, err := orderService.PrepareOrder(ctx, req)
+
+		require.NoError(t, err)
+		assert.Zero(t, order.RiskScore)
+		assert.Empty(t, order.RiskFlags)
+	})
+
+	t.Run("Failed - insufficient stock recorded", func(t *testing.T) {
+		mockInventory, mockQueue, orderRepo, ticketRepo, outboxRepo, seatRepo, mockSeatHold, mockHold, promoRepo, mockPromo, mockPresale := setupMock(t)
+		scorer := serviceMocks.NewMockRiskScorer(t)
+		orderService := service.NewOrderService(db, orderRepo, ticketRepo, seatRepo, outboxRepo, promoRepo, mockInventory, mockSeatHold, mockHold, mockPromo, mockPresale, scorer, mockQueue)
+
+		scorer.EXPECT().Assess(ctx, req).Return(&model.RiskAssessment{Decision: model.RiskDecisionAllow}, nil).Once()
+		mockInventory.EXPECT().DecreStock(ctx, 10, 2, 1, "").Return(false, cache.PriceQuote{}, nil).Once()
+		scorer.EXPECT().RecordInsufficientStock(mock.Anything, req).Return(nil).Once()
+
+		_, err := orderService.PrepareOrder(ctx, req)
+
+		assert.ErrorIs(t, err, app_errors.ErrInsufficientStock)
+	})
+}
+
 func TestOrderService_DispatchOrder(t *testing.T) {
 	ctx := context.Background()
 	db := getTestDB()
 
 	t.Run("Success", func(t *testing.T) {
-		mockInventory, mockQueue, orderRepo, ticketRepo := setupMock(t)
-		orderService := service.NewOrderService(db, orderRepo, ticketRepo, mockInventory, mockQueue)
+		mockInventory, mockQueue, orderRepo, ticketRepo, outboxRepo, seatRepo, mockSeatHold, mockHold, promoRepo, mockPromo, mockPresale := setupMock(t)
+		orderService := service.NewOrderService(db, orderRepo, ticketRepo, seatRepo, outboxRepo, promoRepo, mockInventory, mockSeatHold, mockHold, mockPromo, mockPresale, allowRisk(t), mockQueue)
 
 		expectedOrder := &model.Order{ID: 1, RequestID: "123", UserID: 1, TicketID: 10, Quantity: 2, TotalPrice: 100.0, Status: model.OrderStatusPending}
 		// Mock
 		orderRepo.EXPECT().Create(ctx, mock.Anything, mock.Anything).Return(expectedOrder, nil)
-		ticketRepo.EXPECT().DecrementStock(ctx, mock.Anything, 10, 2).Return(nil).Once()
+		orderRepo.EXPECT().CreateStatusHistory(ctx, mock.Anything, mock.Anything).Return(&model.OrderStatusHistory{ID: 1}, nil).Once()
+		ticketRepo.EXPECT().DecrementStock(ctx, mock.Anything, 10, 2).Return(&model.Ticket{ID: 10, RemainingStock: 98}, nil).Once()
+		outboxRepo.EXPECT().Create(ctx, mock.Anything, mock.MatchedBy(func(e *model.OutboxEvent) bool {
+			return e.AggregateType == model.AggregateTypeOrder && e.EventType == model.EventTypeOrderCreated
+		})).Return(&model.OutboxEvent{ID: 1}, nil).Once()
 
 		// 執行
 		order := expectedOrder
@@ -137,15 +678,103 @@ func TestOrderService_DispatchOrder(t *testing.T) {
 		// 驗證 Mock 是否按照預期運作
 		orderRepo.AssertExpectations(t)
 		ticketRepo.AssertExpectations(t)
+		outboxRepo.AssertExpectations(t)
+	})
+
+	t.Run("Success - SoldOut", func(t *testing.T) {
+		mockInventory, mockQueue, orderRepo, ticketRepo, outboxRepo, seatRepo, mockSeatHold, mockHold, promoRepo, mockPromo, mockPresale := setupMock(t)
+		orderService := service.NewOrderService(db, orderRepo, ticketRepo, seatRepo, outboxRepo, promoRepo, mockInventory, mockSeatHold, mockHold, mockPromo, mockPresale, allowRisk(t), mockQueue)
+
+		// Mock：這筆訂單買走最後兩張票
+		ticketID := uuid.New()
+		orderRepo.EXPECT().Create(ctx, mock.Anything, mock.Anything).Return(&model.Order{ID: 1, UserID: 1, TicketID: 10, Quantity: 2, TotalPrice: 100.0, Status: model.OrderStatusPending}, nil).Once()
+		orderRepo.EXPECT().CreateStatusHistory(ctx, mock.Anything, mock.Anything).Return(&model.OrderStatusHistory{ID: 1}, nil).Once()
+		ticketRepo.EXPECT().DecrementStock(ctx, mock.Anything, 10, 2).Return(&model.Ticket{ID: 10, TicketID: ticketID, RemainingStock: 0}, nil).Once()
+		outboxRepo.EXPECT().Create(ctx, mock.Anything, mock.MatchedBy(func(e *model.OutboxEvent) bool {
+			return e.EventType == model.EventTypeOrderCreated
+		})).Return(&model.OutboxEvent{ID: 1}, nil).Once()
+		outboxRepo.EXPECT().Create(ctx, mock.Anything, mock.MatchedBy(func(e *model.OutboxEvent) bool {
+			return e.AggregateType == model.AggregateTypeTicket &&
+				e.AggregateID == ticketID &&
+				e.EventType == model.EventTypeTicketSoldOut
+		})).Return(&model.OutboxEvent{ID: 2}, nil).Once()
+
+		// 執行
+		order := &model.Order{ID: 1, UserID: 1, TicketID: 10, Quantity: 2, TotalPrice: 100.0, Status: model.OrderStatusPending}
+		err := orderService.DispatchOrder(ctx, order)
+
+		// 驗證結果
+		require.NoError(t, err)
+		outboxRepo.AssertExpectations(t)
+	})
+
+	t.Run("Success - Seated order writes seat assignments", func(t *testing.T) {
+		mockInventory, mockQueue, orderRepo, ticketRepo, outboxRepo, seatRepo, mockSeatHold, mockHold, promoRepo, mockPromo, mockPresale := setupMock(t)
+		orderService := service.NewOrderService(db, orderRepo, ticketRepo, seatRepo, outboxRepo, promoRepo, mockInventory, mockSeatHold, mockHold, mockPromo, mockPresale, allowRisk(t), mockQueue)
+
+		orderRepo.EXPECT().Create(ctx, mock.Anything, mock.Anything).Return(&model.Order{ID: 5, UserID: 1, TicketID: 10, Quantity: 2, Status: model.OrderStatusPending}, nil).Once()
+		orderRepo.EXPECT().CreateStatusHistory(ctx, mock.Anything, mock.Anything).Return(&model.OrderStatusHistory{ID: 1}, nil).Once()
+		seatRepo.EXPECT().CreateOrderSeats(ctx, mock.Anything, mock.MatchedBy(func(seats []*model.OrderSeat) bool {
+			return len(seats) == 2 &&
+				seats[0].OrderID == 5 && seats[0].TicketID == 10 && seats[0].SeatID == 101 &&
+				seats[1].SeatID == 102
+		})).Return(nil).Once()
+		ticketRepo.EXPECT().DecrementStock(ctx, mock.Anything, 10, 2).Return(&model.Ticket{ID: 10, RemainingStock: 48}, nil).Once()
+		outboxRepo.EXPECT().Create(ctx, mock.Anything, mock.Anything).Return(&model.OutboxEvent{ID: 1}, nil).Once()
+
+		order := &model.Order{UserID: 1, TicketID: 10, Quantity: 2, Status: model.OrderStatusPending, SeatIDs: []int{101, 102}}
+		err := orderService.DispatchOrder(ctx, order)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("Success - Promo order writes redemption", func(t *testing.T) {
+		mockInventory, mockQueue, orderRepo, ticketRepo, outboxRepo, seatRepo, mockSeatHold, mockHold, promoRepo, mockPromo, mockPresale := setupMock(t)
+		orderService := service.NewOrderService(db, orderRepo, ticketRepo, seatRepo, outboxRepo, promoRepo, mockInventory, mockSeatHold, mockHold, mockPromo, mockPresale, allowRisk(t), mockQueue)
+
+		code := "SAVE10"
+		orderRepo.EXPECT().Create(ctx, mock.Anything, mock.Anything).
+			Return(&model.Order{ID: 5, UserID: 1, TicketID: 10, Quantity: 2, TotalPrice: 180, PromoCode: &code, DiscountAmount: 20, Status: model.OrderStatusPending}, nil).Once()
+		orderRepo.EXPECT().CreateStatusHistory(ctx, mock.Anything, mock.Anything).Return(&model.OrderStatusHistory{ID: 1}, nil).Once()
+		promoRepo.EXPECT().FindByCode(ctx, code).Return(&model.PromoCode{ID: 3, Code: code}, nil).Once()
+		promoRepo.EXPECT().CreateRedemption(ctx, mock.Anything, &model.PromoRedemption{PromoCodeID: 3, OrderID: 5, UserID: 1, DiscountAmount: 20}).
+			Return(&model.PromoRedemption{ID: 1}, nil).Once()
+		ticketRepo.EXPECT().DecrementStock(ctx, mock.Anything, 10, 2).Return(&model.Ticket{ID: 10, RemainingStock: 48}, nil).Once()
+		outboxRepo.EXPECT().Create(ctx, mock.Anything, mock.Anything).Return(&model.OutboxEvent{ID: 1}, nil).Once()
+
+		order := &model.Order{UserID: 1, TicketID: 10, Quantity: 2, TotalPrice: 180, PromoCode: &code, DiscountAmount: 20, Status: model.OrderStatusPending}
+		err := orderService.DispatchOrder(ctx, order)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("Failed - Outbox", func(t *testing.T) {
+		mockInventory, mockQueue, orderRepo, ticketRepo, outboxRepo, seatRepo, mockSeatHold, mockHold, promoRepo, mockPromo, mockPresale := setupMock(t)
+		orderService := service.NewOrderService(db, orderRepo, ticketRepo, seatRepo, outboxRepo, promoRepo, mockInventory, mockSeatHold, mockHold, mockPromo, mockPresale, allowRisk(t), mockQueue)
+
+		// Mock
+		orderRepo.EXPECT().Create(ctx, mock.Anything, mock.Anything).Return(&model.Order{ID: 1, UserID: 1, TicketID: 10, Quantity: 2, TotalPrice: 100.0, Status: model.OrderStatusPending}, nil).Once()
+		orderRepo.EXPECT().CreateStatusHistory(ctx, mock.Anything, mock.Anything).Return(&model.OrderStatusHistory{ID: 1}, nil).Once()
+		ticketRepo.EXPECT().DecrementStock(ctx, mock.Anything, 10, 2).Return(&model.Ticket{ID: 10, RemainingStock: 98}, nil).Once()
+		outboxRepo.EXPECT().Create(ctx, mock.Anything, mock.Anything).Return(nil, errors.New("outbox error")).Once()
+
+		// 執行
+		order := &model.Order{ID: 1, UserID: 1, TicketID: 10, Quantity: 2, TotalPrice: 100.0, Status: model.OrderStatusPending}
+		err := orderService.DispatchOrder(ctx, order)
+
+		// 驗證結果：outbox 寫入失敗時整筆交易回滾，Worker 會重試
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "outbox error")
 	})
 
 	t.Run("Failed - DecrementStock", func(t *testing.T) {
-		mockInventory, mockQueue, orderRepo, ticketRepo := setupMock(t)
-		orderService := service.NewOrderService(db, orderRepo, ticketRepo, mockInventory, mockQueue)
+		mockInventory, mockQueue, orderRepo, ticketRepo, outboxRepo, seatRepo, mockSeatHold, mockHold, promoRepo, mockPromo, mockPresale := setupMock(t)
+		orderService := service.NewOrderService(db, orderRepo, ticketRepo, seatRepo, outboxRepo, promoRepo, mockInventory, mockSeatHold, mockHold, mockPromo, mockPresale, allowRisk(t), mockQueue)
 
 		// Mock
 		orderRepo.EXPECT().Create(ctx, mock.Anything, mock.Anything).Return(&model.Order{ID: 1, UserID: 1, TicketID: 10, Quantity: 2, TotalPrice: 100.0, Status: model.OrderStatusPending}, nil).Once()
-		ticketRepo.EXPECT().DecrementStock(ctx, mock.Anything, 10, 2).Return(errors.New("db error")).Once()
+		orderRepo.EXPECT().CreateStatusHistory(ctx, mock.Anything, mock.Anything).Return(&model.OrderStatusHistory{ID: 1}, nil).Once()
+		ticketRepo.EXPECT().DecrementStock(ctx, mock.Anything, 10, 2).Return(nil, errors.New("db error")).Once()
 
 		// 執行
 		order := &model.Order{ID: 1, UserID: 1, TicketID: 10, Quantity: 2, TotalPrice: 100.0, Status: model.OrderStatusPending}
@@ -167,8 +796,8 @@ func TestOrderService_RemainingMethods(t *testing.T) {
 
 	// --- 1. OrderList ---
 	t.Run("OrderList - Success", func(t *testing.T) {
-		mockInventory, mockQueue, orderRepo, ticketRepo := setupMock(t)
-		orderService := service.NewOrderService(db, orderRepo, ticketRepo, mockInventory, mockQueue)
+		mockInventory, mockQueue, orderRepo, ticketRepo, outboxRepo, seatRepo, mockSeatHold, mockHold, promoRepo, mockPromo, mockPresale := setupMock(t)
+		orderService := service.NewOrderService(db, orderRepo, ticketRepo, seatRepo, outboxRepo, promoRepo, mockInventory, mockSeatHold, mockHold, mockPromo, mockPresale, allowRisk(t), mockQueue)
 
 		expectedOrders := []*model.Order{{ID: 1}, {ID: 2}}
 		orderRepo.EXPECT().List(ctx).Return(expectedOrders, nil).Once()
@@ -180,8 +809,8 @@ func TestOrderService_RemainingMethods(t *testing.T) {
 
 	// --- 2. GetOrderByOrderID ---
 	t.Run("GetOrderByOrderID - Success", func(t *testing.T) {
-		mockInventory, mockQueue, orderRepo, ticketRepo := setupMock(t)
-		orderService := service.NewOrderService(db, orderRepo, ticketRepo, mockInventory, mockQueue)
+		mockInventory, mockQueue, orderRepo, ticketRepo, outboxRepo, seatRepo, mockSeatHold, mockHold, promoRepo, mockPromo, mockPresale := setupMock(t)
+		orderService := service.NewOrderService(db, orderRepo, ticketRepo, seatRepo, outboxRepo, promoRepo, mockInventory, mockSeatHold, mockHold, mockPromo, mockPresale, allowRisk(t), mockQueue)
 
 		orderID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440000")
 		expectedOrder := &model.Order{ID: 1, OrderID: orderID}
@@ -194,69 +823,188 @@ func TestOrderService_RemainingMethods(t *testing.T) {
 
 	// --- 3. ConfirmOrderByOrderID ---
 	t.Run("ConfirmOrderByOrderID - Success", func(t *testing.T) {
-		mockInventory, mockQueue, orderRepo, ticketRepo := setupMock(t)
-		orderService := service.NewOrderService(db, orderRepo, ticketRepo, mockInventory, mockQueue)
+		mockInventory, mockQueue, orderRepo, ticketRepo, outboxRepo, seatRepo, mockSeatHold, mockHold, promoRepo, mockPromo, mockPresale := setupMock(t)
+		orderService := service.NewOrderService(db, orderRepo, ticketRepo, seatRepo, outboxRepo, promoRepo, mockInventory, mockSeatHold, mockHold, mockPromo, mockPresale, allowRisk(t), mockQueue)
 
 		orderID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440001")
 		orderRepo.EXPECT().FindByOrderID(ctx, orderID).Return(&model.Order{ID: 1, Status: model.OrderStatusPending}, nil).Once()
+		orderRepo.EXPECT().FindByIDWithLock(ctx, mock.Anything, 1).Return(&model.Order{ID: 1, Status: model.OrderStatusPending}, nil).Once()
 		orderRepo.EXPECT().UpdateStatusWithLock(ctx, mock.Anything, 1, model.OrderStatusConfirmed).
 			Return(&model.Order{ID: 1}, nil).Once()
+		orderRepo.EXPECT().CreateStatusHistory(ctx, mock.Anything, mock.Anything).Return(&model.OrderStatusHistory{ID: 1}, nil).Once()
+		outboxRepo.EXPECT().Create(ctx, mock.Anything, mock.MatchedBy(func(e *model.OutboxEvent) bool {
+			return e.EventType == model.EventTypeOrderConfirmed
+		})).Return(&model.OutboxEvent{ID: 1}, nil).Once()
 
-		err := orderService.ConfirmOrderByOrderID(ctx, orderID)
+		err := orderService.ConfirmOrderByOrderID(ctx, orderID, model.OrderStatusChange{Actor: model.OrderActorUser})
 		assert.NoError(t, err)
 	})
 
 	t.Run("ConfirmOrderByOrderID - ErrInvalidOrderStatus when not pending", func(t *testing.T) {
-		mockInventory, mockQueue, orderRepo, ticketRepo := setupMock(t)
-		orderService := service.NewOrderService(db, orderRepo, ticketRepo, mockInventory, mockQueue)
+		mockInventory, mockQueue, orderRepo, ticketRepo, outboxRepo, seatRepo, mockSeatHold, mockHold, promoRepo, mockPromo, mockPresale := setupMock(t)
+		orderService := service.NewOrderService(db, orderRepo, ticketRepo, seatRepo, outboxRepo, promoRepo, mockInventory, mockSeatHold, mockHold, mockPromo, mockPresale, allowRisk(t), mockQueue)
 
 		orderID := uuid.MustParse("550e8400-e29b-41d4-a716-44665544001a")
 		orderRepo.EXPECT().FindByOrderID(ctx, orderID).Return(&model.Order{ID: 1, Status: model.OrderStatusConfirmed}, nil).Once()
 
-		err := orderService.ConfirmOrderByOrderID(ctx, orderID)
+		err := orderService.ConfirmOrderByOrderID(ctx, orderID, model.OrderStatusChange{Actor: model.OrderActorUser})
 		require.Error(t, err)
 		assert.ErrorIs(t, err, app_errors.ErrInvalidOrderStatus)
 		orderRepo.AssertNotCalled(t, "UpdateStatusWithLock")
 	})
 
 	t.Run("ConfirmOrderByOrderID - Failed On Update", func(t *testing.T) {
-		mockInventory, mockQueue, orderRepo, ticketRepo := setupMock(t)
-		orderService := service.NewOrderService(db, orderRepo, ticketRepo, mockInventory, mockQueue)
+		mockInventory, mockQueue, orderRepo, ticketRepo, outboxRepo, seatRepo, mockSeatHold, mockHold, promoRepo, mockPromo, mockPresale := setupMock(t)
+		orderService := service.NewOrderService(db, orderRepo, ticketRepo, seatRepo, outboxRepo, promoRepo, mockInventory, mockSeatHold, mockHold, mockPromo, mockPresale, allowRisk(t), mockQueue)
 
 		orderID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440002")
 		orderRepo.EXPECT().FindByOrderID(ctx, orderID).Return(&model.Order{ID: 1, Status: model.OrderStatusPending}, nil).Once()
+		orderRepo.EXPECT().FindByIDWithLock(ctx, mock.Anything, 1).Return(&model.Order{ID: 1, Status: model.OrderStatusPending}, nil).Once()
 		orderRepo.EXPECT().UpdateStatusWithLock(ctx, mock.Anything, 1, model.OrderStatusConfirmed).
 			Return(nil, errors.New("update error")).Once()
 
-		err := orderService.ConfirmOrderByOrderID(ctx, orderID)
+		err := orderService.ConfirmOrderByOrderID(ctx, orderID, model.OrderStatusChange{Actor: model.OrderActorUser})
 		assert.Error(t, err)
 	})
 
+	t.Run("ConfirmOrderByOrderID - ErrInvalidOrderStatus when changed concurrently", func(t *testing.T) {
+		mockInventory, mockQueue, orderRepo, ticketRepo, outboxRepo, seatRepo, mockSeatHold, mockHold, promoRepo, mockPromo, mockPresale := setupMock(t)
+		orderService := service.NewOrderService(db, orderRepo, ticketRepo, seatRepo, outboxRepo, promoRepo, mockInventory, mockSeatHold, mockHold, mockPromo, mockPresale, allowRisk(t), mockQueue)
+
+		// 讀取時仍為 pending，但鎖定後發現已被其他請求取消
+		orderID := uuid.MustParse("550e8400-e29b-41d4-a716-44665544002b")
+		orderRepo.EXPECT().FindByOrderID(ctx, orderID).Return(&model.Order{ID: 1, Status: model.OrderStatusPending}, nil).Once()
+		orderRepo.EXPECT().FindByIDWithLock(ctx, mock.Anything, 1).Return(&model.Order{ID: 1, Status: model.OrderStatusCancelled}, nil).Once()
+
+		err := orderService.ConfirmOrderByOrderID(ctx, orderID, model.OrderStatusChange{Actor: model.OrderActorUser})
+		require.Error(t, err)
+		assert.ErrorIs(t, err, app_errors.ErrInvalidOrderStatus)
+		orderRepo.AssertNotCalled(t, "UpdateStatusWithLock")
+		orderRepo.AssertNotCalled(t, "CreateStatusHistory")
+	})
+
+	t.Run("ConfirmOrderByOrderID - Records history with actor and reason", func(t *testing.T) {
+		mockInventory, mockQueue, orderRepo, ticketRepo, outboxRepo, seatRepo, mockSeatHold, mockHold, promoRepo, mockPromo, mockPresale := setupMock(t)
+		orderService := service.NewOrderService(db, orderRepo, ticketRepo, seatRepo, outboxRepo, promoRepo, mockInventory, mockSeatHold, mockHold, mockPromo, mockPresale, allowRisk(t), mockQueue)
+
+		orderID := uuid.MustParse("550e8400-e29b-41d4-a716-44665544002c")
+		reason := "paid"
+		orderRepo.EXPECT().FindByOrderID(ctx, orderID).Return(&model.Order{ID: 1, Status: model.OrderStatusPending}, nil).Once()
+		orderRepo.EXPECT().FindByIDWithLock(ctx, mock.Anything, 1).Return(&model.Order{ID: 1, Status: model.OrderStatusPending}, nil).Once()
+		orderRepo.EXPECT().UpdateStatusWithLock(ctx, mock.Anything, 1, model.OrderStatusConfirmed).
+			Return(&model.Order{ID: 1}, nil).Once()
+		orderRepo.EXPECT().CreateStatusHistory(ctx, mock.Anything, mock.MatchedBy(func(h *model.OrderStatusHistory) bool {
+			return h.OrderID == 1 &&
+				h.FromStatus != nil && *h.FromStatus == model.OrderStatusPending &&
+				h.ToStatus == model.OrderStatusConfirmed &&
+				h.Actor == model.OrderActorAdmin &&
+				h.Reason != nil && *h.Reason == reason
+		})).Return(&model.OrderStatusHistory{ID: 1}, nil).Once()
+		outboxRepo.EXPECT().Create(ctx, mock.Anything, mock.Anything).Return(&model.OutboxEvent{ID: 1}, nil).Once()
+
+		err := orderService.ConfirmOrderByOrderID(ctx, orderID, model.OrderStatusChange{Actor: model.OrderActorAdmin, Reason: &reason})
+		assert.NoError(t, err)
+	})
+
 	// --- 4. CancelOrderByOrderID ---
 	t.Run("CancelOrderByOrderID - Success", func(t *testing.T) {
-		mockInventory, mockQueue, orderRepo, ticketRepo := setupMock(t)
-		orderService := service.NewOrderService(db, orderRepo, ticketRepo, mockInventory, mockQueue)
+		mockInventory, mockQueue, orderRepo, ticketRepo, outboxRepo, seatRepo, mockSeatHold, mockHold, promoRepo, mockPromo, mockPresale := setupMock(t)
+		orderService := service.NewOrderService(db, orderRepo, ticketRepo, seatRepo, outboxRepo, promoRepo, mockInventory, mockSeatHold, mockHold, mockPromo, mockPresale, allowRisk(t), mockQueue)
 
 		orderID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440003")
-		cancelledOrder := &model.Order{ID: 1, TicketID: 10, Quantity: 2}
+		cancelledOrder := &model.Order{ID: 1, UserID: 7, TicketID: 10, Quantity: 2}
 		orderRepo.EXPECT().FindByOrderID(ctx, orderID).Return(&model.Order{ID: 1, Status: model.OrderStatusPending}, nil).Once()
+		orderRepo.EXPECT().FindByIDWithLock(ctx, mock.Anything, 1).Return(&model.Order{ID: 1, Status: model.OrderStatusPending}, nil).Once()
 		orderRepo.EXPECT().UpdateStatusWithLock(ctx, mock.Anything, 1, model.OrderStatusCancelled).
 			Return(cancelledOrder, nil).Once()
+		orderRepo.EXPECT().CreateStatusHistory(ctx, mock.Anything, mock.Anything).Return(&model.OrderStatusHistory{ID: 1}, nil).Once()
 		ticketRepo.EXPECT().IncrementStock(ctx, mock.Anything, 10, 2).
 			Return(nil).Once()
+		seatRepo.EXPECT().ReleaseOrderSeats(ctx, mock.Anything, 1).Return([]int{}, nil).Once()
+		outboxRepo.EXPECT().Create(ctx, mock.Anything, mock.MatchedBy(func(e *model.OutboxEvent) bool {
+			return e.EventType == model.EventTypeOrderCancelled
+		})).Return(&model.OutboxEvent{ID: 1}, nil).Once()
+		// 交易提交後歸還 Redis 庫存，讓候補者可以遞補
+		mockInventory.EXPECT().RollbackStock(mock.Anything, 10, 2, 7).Return(nil).Once()
+
+		err := orderService.CancelOrderByOrderID(ctx, orderID, model.OrderStatusChange{Actor: model.OrderActorUser})
+		assert.NoError(t, err)
+	})
+
+	t.Run("CancelOrderByOrderID - Seated order releases seats in Redis", func(t *testing.T) {
+		mockInventory, mockQueue, orderRepo, ticketRepo, outboxRepo, seatRepo, mockSeatHold, mockHold, promoRepo, mockPromo, mockPresale := setupMock(t)
+		orderService := service.NewOrderService(db, orderRepo, ticketRepo, seatRepo, outboxRepo, promoRepo, mockInventory, mockSeatHold, mockHold, mockPromo, mockPresale, allowRisk(t), mockQueue)
 
-		err := orderService.CancelOrderByOrderID(ctx, orderID)
+		orderID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440004")
+		cancelledOrder := &model.Order{ID: 1, UserID: 7, TicketID: 10, Quantity: 2}
+		orderRepo.EXPECT().FindByOrderID(ctx, orderID).Return(&model.Order{ID: 1, Status: model.OrderStatusPending}, nil).Once()
+		orderRepo.EXPECT().FindByIDWithLock(ctx, mock.Anything, 1).Return(&model.Order{ID: 1, Status: model.OrderStatusPending}, nil).Once()
+		orderRepo.EXPECT().UpdateStatusWithLock(ctx, mock.Anything, 1, model.OrderStatusCancelled).
+			Return(cancelledOrder, nil).Once()
+		orderRepo.EXPECT().CreateStatusHistory(ctx, mock.Anything, mock.Anything).Return(&model.OrderStatusHistory{ID: 1}, nil).Once()
+		ticketRepo.EXPECT().IncrementStock(ctx, mock.Anything, 10, 2).Return(nil).Once()
+		seatRepo.EXPECT().ReleaseOrderSeats(ctx, mock.Anything, 1).Return([]int{101, 102}, nil).Once()
+		outboxRepo.EXPECT().Create(ctx, mock.Anything, mock.Anything).Return(&model.OutboxEvent{ID: 1}, nil).Once()
+		mockSeatHold.EXPECT().RollbackSeats(mock.Anything, 10, 7, []int{101, 102}).Return(nil).Once()
+
+		err := orderService.CancelOrderByOrderID(ctx, orderID, model.OrderStatusChange{})
+		assert.NoError(t, err)
+		mockInventory.AssertNotCalled(t, "RollbackStock")
+	})
+
+	t.Run("CancelOrderByOrderID - Promo order returns redemption", func(t *testing.T) {
+		mockInventory, mockQueue, orderRepo, ticketRepo, outboxRepo, seatRepo, mockSeatHold, mockHold, promoRepo, mockPromo, mockPresale := setupMock(t)
+		orderService := service.NewOrderService(db, orderRepo, ticketRepo, seatRepo, outboxRepo, promoRepo, mockInventory, mockSeatHold, mockHold, mockPromo, mockPresale, allowRisk(t), mockQueue)
+
+		orderID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440005")
+		code := "SAVE10"
+		cancelledOrder := &model.Order{ID: 1, UserID: 7, TicketID: 10, Quantity: 2, PromoCode: &code}
+		orderRepo.EXPECT().FindByOrderID(ctx, orderID).Return(&model.Order{ID: 1, Status: model.OrderStatusPending}, nil).Once()
+		orderRepo.EXPECT().FindByIDWithLock(ctx, mock.Anything, 1).Return(&model.Order{ID: 1, Status: model.OrderStatusPending}, nil).Once()
+		orderRepo.EXPECT().UpdateStatusWithLock(ctx, mock.Anything, 1, model.OrderStatusCancelled).
+			Return(cancelledOrder, nil).Once()
+		orderRepo.EXPECT().CreateStatusHistory(ctx, mock.Anything, mock.Anything).Return(&model.OrderStatusHistory{ID: 1}, nil).Once()
+		ticketRepo.EXPECT().IncrementStock(ctx, mock.Anything, 10, 2).Return(nil).Once()
+		seatRepo.EXPECT().ReleaseOrderSeats(ctx, mock.Anything, 1).Return([]int{}, nil).Once()
+		promoRepo.EXPECT().ReturnRedemption(ctx, mock.Anything, 1).Return(nil).Once()
+		outboxRepo.EXPECT().Create(ctx, mock.Anything, mock.Anything).Return(&model.OutboxEvent{ID: 1}, nil).Once()
+		mockPromo.EXPECT().Return(mock.Anything, code, 7).Return(nil).Once()
+		mockInventory.EXPECT().RollbackStock(mock.Anything, 10, 2, 7).Return(nil).Once()
+
+		err := orderService.CancelOrderByOrderID(ctx, orderID, model.OrderStatusChange{})
+		assert.NoError(t, err)
+	})
+
+	t.Run("CancelOrderByOrderID - Presale order returns access code", func(t *testing.T) {
+		mockInventory, mockQueue, orderRepo, ticketRepo, outboxRepo, seatRepo, mockSeatHold, mockHold, promoRepo, mockPromo, mockPresale := setupMock(t)
+		orderService := service.NewOrderService(db, orderRepo, ticketRepo, seatRepo, outboxRepo, promoRepo, mockInventory, mockSeatHold, mockHold, mockPromo, mockPresale, allowRisk(t), mockQueue)
+
+		orderID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440006")
+		code := "FANCLUB"
+		cancelledOrder := &model.Order{ID: 1, UserID: 7, TicketID: 10, Quantity: 1, AccessCode: &code}
+		orderRepo.EXPECT().FindByOrderID(ctx, orderID).Return(&model.Order{ID: 1, Status: model.OrderStatusPending}, nil).Once()
+		orderRepo.EXPECT().FindByIDWithLock(ctx, mock.Anything, 1).Return(&model.Order{ID: 1, Status: model.OrderStatusPending}, nil).Once()
+		orderRepo.EXPECT().UpdateStatusWithLock(ctx, mock.Anything, 1, model.OrderStatusCancelled).
+			Return(cancelledOrder, nil).Once()
+		orderRepo.EXPECT().CreateStatusHistory(ctx, mock.Anything, mock.Anything).Return(&model.OrderStatusHistory{ID: 1}, nil).Once()
+		ticketRepo.EXPECT().IncrementStock(ctx, mock.Anything, 10, 1).Return(nil).Once()
+		seatRepo.EXPECT().ReleaseOrderSeats(ctx, mock.Anything, 1).Return([]int{}, nil).Once()
+		outboxRepo.EXPECT().Create(ctx, mock.Anything, mock.Anything).Return(&model.OutboxEvent{ID: 1}, nil).Once()
+		mockPresale.EXPECT().ReturnAccessCode(mock.Anything, 10, code).Return(nil).Once()
+		mockInventory.EXPECT().RollbackStock(mock.Anything, 10, 1, 7).Return(nil).Once()
+
+		err := orderService.CancelOrderByOrderID(ctx, orderID, model.OrderStatusChange{})
 		assert.NoError(t, err)
 	})
 
 	t.Run("CancelOrderByOrderID - ErrInvalidOrderStatus when not pending", func(t *testing.T) {
-		mockInventory, mockQueue, orderRepo, ticketRepo := setupMock(t)
-		orderService := service.NewOrderService(db, orderRepo, ticketRepo, mockInventory, mockQueue)
+		mockInventory, mockQueue, orderRepo, ticketRepo, outboxRepo, seatRepo, mockSeatHold, mockHold, promoRepo, mockPromo, mockPresale := setupMock(t)
+		orderService := service.NewOrderService(db, orderRepo, ticketRepo, seatRepo, outboxRepo, promoRepo, mockInventory, mockSeatHold, mockHold, mockPromo, mockPresale, allowRisk(t), mockQueue)
 
 		orderID := uuid.MustParse("550e8400-e29b-41d4-a716-44665544003a")
 		orderRepo.EXPECT().FindByOrderID(ctx, orderID).Return(&model.Order{ID: 1, Status: model.OrderStatusCancelled}, nil).Once()
 
-		err := orderService.CancelOrderByOrderID(ctx, orderID)
+		err := orderService.CancelOrderByOrderID(ctx, orderID, model.OrderStatusChange{Actor: model.OrderActorUser})
 		require.Error(t, err)
 		assert.ErrorIs(t, err, app_errors.ErrInvalidOrderStatus)
 		orderRepo.AssertNotCalled(t, "UpdateStatusWithLock")
@@ -264,25 +1012,147 @@ func TestOrderService_RemainingMethods(t *testing.T) {
 	})
 
 	t.Run("CancelOrderByOrderID - Failed On IncrementStock", func(t *testing.T) {
-		mockInventory, mockQueue, orderRepo, ticketRepo := setupMock(t)
-		orderService := service.NewOrderService(db, orderRepo, ticketRepo, mockInventory, mockQueue)
+		mockInventory, mockQueue, orderRepo, ticketRepo, outboxRepo, seatRepo, mockSeatHold, mockHold, promoRepo, mockPromo, mockPresale := setupMock(t)
+		orderService := service.NewOrderService(db, orderRepo, ticketRepo, seatRepo, outboxRepo, promoRepo, mockInventory, mockSeatHold, mockHold, mockPromo, mockPresale, allowRisk(t), mockQueue)
 
 		orderID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440004")
 		cancelledOrder := &model.Order{ID: 1, TicketID: 10, Quantity: 2}
 		orderRepo.EXPECT().FindByOrderID(ctx, orderID).Return(&model.Order{ID: 1, Status: model.OrderStatusPending}, nil).Once()
+		orderRepo.EXPECT().FindByIDWithLock(ctx, mock.Anything, 1).Return(&model.Order{ID: 1, Status: model.OrderStatusPending}, nil).Once()
 		orderRepo.EXPECT().UpdateStatusWithLock(ctx, mock.Anything, 1, model.OrderStatusCancelled).
 			Return(cancelledOrder, nil).Once()
+		orderRepo.EXPECT().CreateStatusHistory(ctx, mock.Anything, mock.Anything).Return(&model.OrderStatusHistory{ID: 1}, nil).Once()
 		ticketRepo.EXPECT().IncrementStock(ctx, mock.Anything, 10, 2).
 			Return(errors.New("db error")).Once()
 
-		err := orderService.CancelOrderByOrderID(ctx, orderID)
+		err := orderService.CancelOrderByOrderID(ctx, orderID, model.OrderStatusChange{Actor: model.OrderActorUser})
 		assert.Error(t, err)
 	})
 
-	// --- 5. DeleteOrderByOrderID ---
+	// --- 5. ExpireOrderByOrderID ---
+	t.Run("ExpireOrderByOrderID - Success restores stock and promo code", func(t *testing.T) {
+		mockInventory, mockQueue, orderRepo, ticketRepo, outboxRepo, seatRepo, mockSeatHold, mockHold, promoRepo, mockPromo, mockPresale := setupMock(t)
+		orderService := service.NewOrderService(db, orderRepo, ticketRepo, seatRepo, outboxRepo, promoRepo, mockInventory, mockSeatHold, mockHold, mockPromo, mockPresale, allowRisk(t), mockQueue)
+
+		orderID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440040")
+		code := "SAVE10"
+		expiredOrder := &model.Order{ID: 1, UserID: 7, TicketID: 10, Quantity: 2, PromoCode: &code}
+		orderRepo.EXPECT().FindByOrderID(ctx, orderID).Return(&model.Order{ID: 1, Status: model.OrderStatusPending}, nil).Once()
+		orderRepo.EXPECT().FindByIDWithLock(ctx, mock.Anything, 1).Return(&model.Order{ID: 1, Status: model.OrderStatusPending}, nil).Once()
+		orderRepo.EXPECT().UpdateStatusWithLock(ctx, mock.Anything, 1, model.OrderStatusExpired).
+			Return(expiredOrder, nil).Once()
+		orderRepo.EXPECT().CreateStatusHistory(ctx, mock.Anything, mock.MatchedBy(func(h *model.OrderStatusHistory) bool {
+			return h.OrderID == 1 &&
+				h.FromStatus != nil && *h.FromStatus == model.OrderStatusPending &&
+				h.ToStatus == model.OrderStatusExpired &&
+				h.Actor == model.OrderActorSystem
+		})).Return(&model.OrderStatusHistory{ID: 1}, nil).Once()
+		ticketRepo.EXPECT().IncrementStock(ctx, mock.Anything, 10, 2).Return(nil).Once()
+		seatRepo.EXPECT().ReleaseOrderSeats(ctx, mock.Anything, 1).Return([]int{}, nil).Once()
+		promoRepo.EXPECT().ReturnRedemption(ctx, mock.Anything, 1).Return(nil).Once()
+		outboxRepo.EXPECT().Create(ctx, mock.Anything, mock.MatchedBy(func(e *model.OutboxEvent) bool {
+			return e.EventType == model.EventTypeOrderExpired
+		})).Return(&model.OutboxEvent{ID: 1}, nil).Once()
+		mockPromo.EXPECT().Return(mock.Anything, code, 7).Return(nil).Once()
+		mockInventory.EXPECT().RollbackStock(mock.Anything, 10, 2, 7).Return(nil).Once()
+
+		err := orderService.ExpireOrderByOrderID(ctx, orderID, model.OrderStatusChange{})
+		assert.NoError(t, err)
+	})
+
+	t.Run("ExpireOrderByOrderID - Seated order releases seats in Redis", func(t *testing.T) {
+		mockInventory, mockQueue, orderRepo, ticketRepo, outboxRepo, seatRepo, mockSeatHold, mockHold, promoRepo, mockPromo, mockPresale := setupMock(t)
+		orderService := service.NewOrderService(db, orderRepo, ticketRepo, seatRepo, outboxRepo, promoRepo, mockInventory, mockSeatHold, mockHold, mockPromo, mockPresale, allowRisk(t), mockQueue)
+
+		orderID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440041")
+		expiredOrder := &model.Order{ID: 1, UserID: 7, TicketID: 10, Quantity: 2}
+		orderRepo.EXPECT().FindByOrderID(ctx, orderID).Return(&model.Order{ID: 1, Status: model.OrderStatusPending}, nil).Once()
+		orderRepo.EXPECT().FindByIDWithLock(ctx, mock.Anything, 1).Return(&model.Order{ID: 1, Status: model.OrderStatusPending}, nil).Once()
+		orderRepo.EXPECT().UpdateStatusWithLock(ctx, mock.Anything, 1, model.OrderStatusExpired).
+			Return(expiredOrder, nil).Once()
+		orderRepo.EXPECT().CreateStatusHistory(ctx, mock.Anything, mock.Anything).Return(&model.OrderStatusHistory{ID: 1}, nil).Once()
+		ticketRepo.EXPECT().IncrementStock(ctx, mock.Anything, 10, 2).Return(nil).Once()
+		seatRepo.EXPECT().ReleaseOrderSeats(ctx, mock.Anything, 1).Return([]int{101, 102}, nil).Once()
+		outboxRepo.EXPECT().Create(ctx, mock.Anything, mock.Anything).Return(&model.OutboxEvent{ID: 1}, nil).Once()
+		mockSeatHold.EXPECT().RollbackSeats(mock.Anything, 10, 7, []int{101, 102}).Return(nil).Once()
+
+		err := orderService.ExpireOrderByOrderID(ctx, orderID, model.OrderStatusChange{})
+		assert.NoError(t, err)
+		mockInventory.AssertNotCalled(t, "RollbackStock")
+	})
+
+	t.Run("ExpireOrderByOrderID - ErrInvalidOrderStatus when confirmed", func(t *testing.T) {
+		mockInventory, mockQueue, orderRepo, ticketRepo, outboxRepo, seatRepo, mockSeatHold, mockHold, promoRepo, mockPromo, mockPresale := setupMock(t)
+		orderService := service.NewOrderService(db, orderRepo, ticketRepo, seatRepo, outboxRepo, promoRepo, mockInventory, mockSeatHold, mockHold, mockPromo, mockPresale, allowRisk(t), mockQueue)
+
+		orderID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440042")
+		orderRepo.EXPECT().FindByOrderID(ctx, orderID).Return(&model.Order{ID: 1, Status: model.OrderStatusConfirmed}, nil).Once()
+
+		err := orderService.ExpireOrderByOrderID(ctx, orderID, model.OrderStatusChange{})
+		assert.ErrorIs(t, err, app_errors.ErrInvalidOrderStatus)
+		orderRepo.AssertNotCalled(t, "UpdateStatusWithLock")
+		ticketRepo.AssertNotCalled(t, "IncrementStock")
+	})
+
+	// --- 6. RefundOrderByOrderID ---
+	t.Run("RefundOrderByOrderID - Success returns tickets to stock", func(t *testing.T) {
+		mockInventory, mockQueue, orderRepo, ticketRepo, outboxRepo, seatRepo, mockSeatHold, mockHold, promoRepo, mockPromo, mockPresale := setupMock(t)
+		orderService := service.NewOrderService(db, orderRepo, ticketRepo, seatRepo, outboxRepo, promoRepo, mockInventory, mockSeatHold, mockHold, mockPromo, mockPresale, allowRisk(t), mockQueue)
+
+		orderID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440043")
+		reason := "event postponed"
+		refundedOrder := &model.Order{ID: 1, UserID: 7, TicketID: 10, Quantity: 2}
+		orderRepo.EXPECT().FindByOrderID(ctx, orderID).Return(&model.Order{ID: 1, Status: model.OrderStatusConfirmed}, nil).Once()
+		orderRepo.EXPECT().FindByIDWithLock(ctx, mock.Anything, 1).Return(&model.Order{ID: 1, Status: model.OrderStatusConfirmed}, nil).Once()
+		orderRepo.EXPECT().UpdateStatusWithLock(ctx, mock.Anything, 1, model.OrderStatusRefunded).
+			Return(refundedOrder, nil).Once()
+		orderRepo.EXPECT().CreateStatusHistory(ctx, mock.Anything, mock.MatchedBy(func(h *model.OrderStatusHistory) bool {
+			return h.FromStatus != nil && *h.FromStatus == model.OrderStatusConfirmed &&
+				h.ToStatus == model.OrderStatusRefunded &&
+				h.Actor == model.OrderActorUser &&
+				h.Reason != nil && *h.Reason == reason
+		})).Return(&model.OrderStatusHistory{ID: 1}, nil).Once()
+		ticketRepo.EXPECT().IncrementStock(ctx, mock.Anything, 10, 2).Return(nil).Once()
+		seatRepo.EXPECT().ReleaseOrderSeats(ctx, mock.Anything, 1).Return([]int{}, nil).Once()
+		outboxRepo.EXPECT().Create(ctx, mock.Anything, mock.MatchedBy(func(e *model.OutboxEvent) bool {
+			return e.EventType == model.EventTypeOrderRefunded
+		})).Return(&model.OutboxEvent{ID: 1}, nil).Once()
+		mockInventory.EXPECT().RollbackStock(mock.Anything, 10, 2, 7).Return(nil).Once()
+
+		err := orderService.RefundOrderByOrderID(ctx, orderID, model.OrderStatusChange{Actor: model.OrderActorUser, Reason: &reason})
+		assert.NoError(t, err)
+	})
+
+	t.Run("RefundOrderByOrderID - ErrInvalidOrderStatus when pending", func(t *testing.T) {
+		mockInventory, mockQueue, orderRepo, ticketRepo, outboxRepo, seatRepo, mockSeatHold, mockHold, promoRepo, mockPromo, mockPresale := setupMock(t)
+		orderService := service.NewOrderService(db, orderRepo, ticketRepo, seatRepo, outboxRepo, promoRepo, mockInventory, mockSeatHold, mockHold, mockPromo, mockPresale, allowRisk(t), mockQueue)
+
+		orderID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440044")
+		orderRepo.EXPECT().FindByOrderID(ctx, orderID).Return(&model.Order{ID: 1, Status: model.OrderStatusPending}, nil).Once()
+
+		err := orderService.RefundOrderByOrderID(ctx, orderID, model.OrderStatusChange{})
+		assert.ErrorIs(t, err, app_errors.ErrInvalidOrderStatus)
+		orderRepo.AssertNotCalled(t, "UpdateStatusWithLock")
+	})
+
+	t.Run("RefundOrderByOrderID - ErrInvalidOrderStatus when changed concurrently", func(t *testing.T) {
+		mockInventory, mockQueue, orderRepo, ticketRepo, outboxRepo, seatRepo, mockSeatHold, mockHold, promoRepo, mockPromo, mockPresale := setupMock(t)
+		orderService := service.NewOrderService(db, orderRepo, ticketRepo, seatRepo, outboxRepo, promoRepo, mockInventory, mockSeatHold, mockHold, mockPromo, mockPresale, allowRisk(t), mockQueue)
+
+		orderID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440045")
+		orderRepo.EXPECT().FindByOrderID(ctx, orderID).Return(&model.Order{ID: 1, Status: model.OrderStatusConfirmed}, nil).Once()
+		orderRepo.EXPECT().FindByIDWithLock(ctx, mock.Anything, 1).Return(&model.Order{ID: 1, Status: model.OrderStatusRefunded}, nil).Once()
+
+		err := orderService.RefundOrderByOrderID(ctx, orderID, model.OrderStatusChange{})
+		assert.ErrorIs(t, err, app_errors.ErrInvalidOrderStatus)
+		orderRepo.AssertNotCalled(t, "UpdateStatusWithLock")
+		ticketRepo.AssertNotCalled(t, "IncrementStock")
+	})
+
+	// --- 7. DeleteOrderByOrderID ---
 	t.Run("DeleteOrderByOrderID - Success", func(t *testing.T) {
-		mockInventory, mockQueue, orderRepo, ticketRepo := setupMock(t)
-		orderService := service.NewOrderService(db, orderRepo, ticketRepo, mockInventory, mockQueue)
+		mockInventory, mockQueue, orderRepo, ticketRepo, outboxRepo, seatRepo, mockSeatHold, mockHold, promoRepo, mockPromo, mockPresale := setupMock(t)
+		orderService := service.NewOrderService(db, orderRepo, ticketRepo, seatRepo, outboxRepo, promoRepo, mockInventory, mockSeatHold, mockHold, mockPromo, mockPresale, allowRisk(t), mockQueue)
 
 		orderID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440005")
 		orderRepo.EXPECT().FindByOrderID(ctx, orderID).Return(&model.Order{ID: 1}, nil).Once()
@@ -291,4 +1161,34 @@ func TestOrderService_RemainingMethods(t *testing.T) {
 		err := orderService.DeleteOrderByOrderID(ctx, orderID)
 		assert.NoError(t, err)
 	})
+	// --- 8. GetOrderStatusHistory ---
+	t.Run("GetOrderStatusHistory - Success", func(t *testing.T) {
+		mockInventory, mockQueue, orderRepo, ticketRepo, outboxRepo, seatRepo, mockSeatHold, mockHold, promoRepo, mockPromo, mockPresale := setupMock(t)
+		orderService := service.NewOrderService(db, orderRepo, ticketRepo, seatRepo, outboxRepo, promoRepo, mockInventory, mockSeatHold, mockHold, mockPromo, mockPresale, allowRisk(t), mockQueue)
+
+		orderID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440006")
+		pending := model.OrderStatusPending
+		expected := []*model.OrderStatusHistory{
+			{ID: 1, OrderID: 1, ToStatus: model.OrderStatusPending, Actor: model.OrderActorSystem},
+			{ID: 2, OrderID: 1, FromStatus: &pending, ToStatus: model.OrderStatusConfirmed, Actor: model.OrderActorUser},
+		}
+		orderRepo.EXPECT().FindByOrderID(ctx, orderID).Return(&model.Order{ID: 1}, nil).Once()
+		orderRepo.EXPECT().ListStatusHistory(ctx, 1).Return(expected, nil).Once()
+
+		history, err := orderService.GetOrderStatusHistory(ctx, orderID)
+		require.NoError(t, err)
+		assert.Len(t, history, 2)
+	})
+
+	t.Run("GetOrderStatusHistory - ErrOrderNotFound", func(t *testing.T) {
+		mockInventory, mockQueue, orderRepo, ticketRepo, outboxRepo, seatRepo, mockSeatHold, mockHold, promoRepo, mockPromo, mockPresale := setupMock(t)
+		orderService := service.NewOrderService(db, orderRepo, ticketRepo, seatRepo, outboxRepo, promoRepo, mockInventory, mockSeatHold, mockHold, mockPromo, mockPresale, allowRisk(t), mockQueue)
+
+		orderID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440007")
+		orderRepo.EXPECT().FindByOrderID(ctx, orderID).Return(nil, app_errors.ErrOrderNotFound).Once()
+
+		_, err := orderService.GetOrderStatusHistory(ctx, orderID)
+		assert.ErrorIs(t, err, app_errors.ErrOrderNotFound)
+		orderRepo.AssertNotCalled(t, "ListStatusHistory")
+	})
 }
@@ -0,0 +1,135 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	cacheMocks "go-gin-high-concurrency/internal/cache/mocks"
+	"go-gin-high-concurrency/internal/model"
+	repoMocks "go-gin-high-concurrency/internal/repository/mocks"
+	"go-gin-high-concurrency/internal/service"
+	"go-gin-high-concurrency/pkg/app_errors"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func setupPresaleServiceMocks(t *testing.T) (
+	*repoMocks.MockPresaleRepository,
+	*repoMocks.MockTicketRepository,
+	*cacheMocks.MockRedisPresaleManager,
+) {
+	presaleRepo := repoMocks.NewMockPresaleRepository(t)
+	ticketRepo := repoMocks.NewMockTicketRepository(t)
+	presaleManager := cacheMocks.NewMockRedisPresaleManager(t)
+	return presaleRepo, ticketRepo, presaleManager
+}
+
+func TestPresaleService_CreateAccessCode(t *testing.T) {
+	ctx := context.Background()
+	ticketID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440000")
+
+	t.Run("Success - normalizes code and syncs Redis", func(t *testing.T) {
+		presaleRepo, ticketRepo, presaleManager := setupPresaleServiceMocks(t)
+		presaleService := service.NewPresaleService(presaleRepo, ticketRepo, presaleManager)
+
+		maxUses := 100
+		ticketRepo.EXPECT().FindByTicketID(ctx, ticketID).Return(&model.Ticket{ID: 10, Presale: true}, nil).Once()
+		presaleRepo.EXPECT().CreateAccessCode(ctx, mock.MatchedBy(func(a *model.PresaleAccessCode) bool {
+			return a.TicketID == 10 && a.Code == "FANCLUB" && *a.MaxUses == 100
+		})).RunAndReturn(func(_ context.Context, a *model.PresaleAccessCode) (*model.PresaleAccessCode, error) {
+			a.ID = 3
+			return a, nil
+		}).Once()
+		presaleManager.EXPECT().AddAccessCode(ctx, 10, mock.MatchedBy(func(a *model.PresaleAccessCode) bool {
+			return a.ID == 3
+		})).Return(nil).Once()
+
+		created, err := presaleService.CreateAccessCode(ctx, ticketID, model.CreateAccessCodeRequest{Code: " fanclub ", MaxUses: &maxUses})
+
+		require.NoError(t, err)
+		assert.Equal(t, "FANCLUB", created.Code)
+	})
+
+	t.Run("Failed - ticket is not presale", func(t *testing.T) {
+		presaleRepo, ticketRepo, presaleManager := setupPresaleServiceMocks(t)
+		presaleService := service.NewPresaleService(presaleRepo, ticketRepo, presaleManager)
+
+		ticketRepo.EXPECT().FindByTicketID(ctx, ticketID).Return(&model.Ticket{ID: 10}, nil).Once()
+
+		_, err := presaleService.CreateAccessCode(ctx, ticketID, model.CreateAccessCodeRequest{Code: "FANCLUB"})
+
+		assert.ErrorIs(t, err, app_errors.ErrInvalidInput)
+		presaleRepo.AssertNotCalled(t, "CreateAccessCode")
+	})
+
+	t.Run("Failed - duplicate code", func(t *testing.T) {
+		presaleRepo, ticketRepo, presaleManager := setupPresaleServiceMocks(t)
+		presaleService := service.NewPresaleService(presaleRepo, ticketRepo, presaleManager)
+
+		ticketRepo.EXPECT().FindByTicketID(ctx, ticketID).Return(&model.Ticket{ID: 10, Presale: true}, nil).Once()
+		presaleRepo.EXPECT().CreateAccessCode(ctx, mock.Anything).Return(nil, app_errors.ErrAlreadyExists).Once()
+
+		_, err := presaleService.CreateAccessCode(ctx, ticketID, model.CreateAccessCodeRequest{Code: "FANCLUB"})
+
+		assert.ErrorIs(t, err, app_errors.ErrAlreadyExists)
+		presaleManager.AssertNotCalled(t, "AddAccessCode")
+	})
+}
+
+func TestPresaleService_Allowlist(t *testing.T) {
+	ctx := context.Background()
+	ticketID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440000")
+
+	t.Run("Success - AddAllowlist syncs Redis", func(t *testing.T) {
+		presaleRepo, ticketRepo, presaleManager := setupPresaleServiceMocks(t)
+		presaleService := service.NewPresaleService(presaleRepo, ticketRepo, presaleManager)
+
+		ticketRepo.EXPECT().FindByTicketID(ctx, ticketID).Return(&model.Ticket{ID: 10, Presale: true}, nil).Once()
+		presaleRepo.EXPECT().AddAllowlistUsers(ctx, 10, []int{1, 2}).Return(nil).Once()
+		presaleManager.EXPECT().AddAllowlist(ctx, 10, []int{1, 2}).Return(nil).Once()
+
+		err := presaleService.AddAllowlist(ctx, ticketID, []int{1, 2})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Failed - AddAllowlist unknown user", func(t *testing.T) {
+		presaleRepo, ticketRepo, presaleManager := setupPresaleServiceMocks(t)
+		presaleService := service.NewPresaleService(presaleRepo, ticketRepo, presaleManager)
+
+		ticketRepo.EXPECT().FindByTicketID(ctx, ticketID).Return(&model.Ticket{ID: 10, Presale: true}, nil).Once()
+		presaleRepo.EXPECT().AddAllowlistUsers(ctx, 10, []int{999}).Return(app_errors.ErrUserNotFound).Once()
+
+		err := presaleService.AddAllowlist(ctx, ticketID, []int{999})
+
+		assert.ErrorIs(t, err, app_errors.ErrUserNotFound)
+		presaleManager.AssertNotCalled(t, "AddAllowlist")
+	})
+
+	t.Run("Success - RemoveAllowlist syncs Redis", func(t *testing.T) {
+		presaleRepo, ticketRepo, presaleManager := setupPresaleServiceMocks(t)
+		presaleService := service.NewPresaleService(presaleRepo, ticketRepo, presaleManager)
+
+		ticketRepo.EXPECT().FindByTicketID(ctx, ticketID).Return(&model.Ticket{ID: 10, Presale: true}, nil).Once()
+		presaleRepo.EXPECT().RemoveAllowlistUser(ctx, 10, 1).Return(nil).Once()
+		presaleManager.EXPECT().RemoveAllowlist(ctx, 10, 1).Return(nil).Once()
+
+		err := presaleService.RemoveAllowlist(ctx, ticketID, 1)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Failed - ticket not found", func(t *testing.T) {
+		presaleRepo, ticketRepo, presaleManager := setupPresaleServiceMocks(t)
+		presaleService := service.NewPresaleService(presaleRepo, ticketRepo, presaleManager)
+
+		ticketRepo.EXPECT().FindByTicketID(ctx, ticketID).Return(nil, app_errors.ErrTicketNotFound).Once()
+
+		_, err := presaleService.ListAllowlist(ctx, ticketID)
+
+		assert.ErrorIs(t, err, app_errors.ErrTicketNotFound)
+	})
+}
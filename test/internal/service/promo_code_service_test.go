@@ -0,0 +1,152 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	cacheMocks "go-gin-high-concurrency/internal/cache/mocks"
+	"go-gin-high-concurrency/internal/model"
+	repoMocks "go-gin-high-concurrency/internal/repository/mocks"
+	"go-gin-high-concurrency/internal/service"
+	"go-gin-high-concurrency/pkg/app_errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func setupPromoCodeServiceMocks(t *testing.T) (
+	*repoMocks.MockPromoCodeRepository,
+	*repoMocks.MockEventRepository,
+	*repoMocks.MockTicketRepository,
+	*cacheMocks.MockRedisPromoCodeManager,
+) {
+	promoRepo := repoMocks.NewMockPromoCodeRepository(t)
+	eventRepo := repoMocks.NewMockEventRepository(t)
+	ticketRepo := repoMocks.NewMockTicketRepository(t)
+	promoManager := cacheMocks.NewMockRedisPromoCodeManager(t)
+	return promoRepo, eventRepo, ticketRepo, promoManager
+}
+
+func TestPromoCodeService_Create(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Success - normalizes code and warms up Redis", func(t *testing.T) {
+		promoRepo, eventRepo, ticketRepo, promoManager := setupPromoCodeServiceMocks(t)
+		promoService := service.NewPromoCodeService(promoRepo, eventRepo, ticketRepo, promoManager)
+
+		ticketID := 10
+		ticketRepo.EXPECT().FindByID(ctx, 10).Return(&model.Ticket{ID: 10, EventID: 1}, nil).Once()
+		promoRepo.EXPECT().Create(ctx, mock.MatchedBy(func(p *model.PromoCode) bool {
+			return p.Code == "EARLY20"
+		})).RunAndReturn(func(_ context.Context, p *model.PromoCode) (*model.PromoCode, error) {
+			p.ID = 3
+			return p, nil
+		}).Once()
+		promoManager.EXPECT().WarmUp(ctx, mock.MatchedBy(func(p *model.PromoCode) bool {
+			return p.ID == 3
+		}), map[int]int(nil)).Return(nil).Once()
+
+		created, err := promoService.Create(ctx, &model.PromoCode{
+			Code: " early20 ", DiscountType: model.DiscountTypePercentage, DiscountValue: 20, TicketID: &ticketID,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "EARLY20", created.Code)
+	})
+
+	t.Run("Success - Redis warm up failure does not fail creation", func(t *testing.T) {
+		promoRepo, eventRepo, ticketRepo, promoManager := setupPromoCodeServiceMocks(t)
+		promoService := service.NewPromoCodeService(promoRepo, eventRepo, ticketRepo, promoManager)
+
+		promoRepo.EXPECT().Create(ctx, mock.Anything).Return(&model.PromoCode{ID: 3, Code: "FLAT50"}, nil).Once()
+		promoManager.EXPECT().WarmUp(ctx, mock.Anything, map[int]int(nil)).Return(errors.New("redis down")).Once()
+
+		_, err := promoService.Create(ctx, &model.PromoCode{Code: "FLAT50", DiscountType: model.DiscountTypeFixed, DiscountValue: 50})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Failed - invalid discount", func(t *testing.T) {
+		promoRepo, eventRepo, ticketRepo, promoManager := setupPromoCodeServiceMocks(t)
+		promoService := service.NewPromoCodeService(promoRepo, eventRepo, ticketRepo, promoManager)
+
+		_, err := promoService.Create(ctx, &model.PromoCode{Code: "HALF", DiscountType: model.DiscountTypePercentage, DiscountValue: 150})
+
+		assert.ErrorIs(t, err, app_errors.ErrInvalidInput)
+		promoRepo.AssertNotCalled(t, "Create")
+	})
+
+	t.Run("Failed - validity window ends before it starts", func(t *testing.T) {
+		promoRepo, eventRepo, ticketRepo, promoManager := setupPromoCodeServiceMocks(t)
+		promoService := service.NewPromoCodeService(promoRepo, eventRepo, ticketRepo, promoManager)
+
+		startsAt := time.Now()
+		endsAt := startsAt.Add(-time.Hour)
+		_, err := promoService.Create(ctx, &model.PromoCode{
+			Code: "BACKWARDS", DiscountType: model.DiscountTypeFixed, DiscountValue: 50, StartsAt: &startsAt, EndsAt: &endsAt,
+		})
+
+		assert.ErrorIs(t, err, app_errors.ErrInvalidInput)
+	})
+
+	t.Run("Failed - ticket does not belong to event", func(t *testing.T) {
+		promoRepo, eventRepo, ticketRepo, promoManager := setupPromoCodeServiceMocks(t)
+		promoService := service.NewPromoCodeService(promoRepo, eventRepo, ticketRepo, promoManager)
+
+		eventID, ticketID := 2, 10
+		ticketRepo.EXPECT().FindByID(ctx, 10).Return(&model.Ticket{ID: 10, EventID: 1}, nil).Once()
+
+		_, err := promoService.Create(ctx, &model.PromoCode{
+			Code: "MISMATCH", DiscountType: model.DiscountTypeFixed, DiscountValue: 50, EventID: &eventID, TicketID: &ticketID,
+		})
+
+		assert.ErrorIs(t, err, app_errors.ErrInvalidInput)
+		promoRepo.AssertNotCalled(t, "Create")
+	})
+
+	t.Run("Failed - ErrEventNotFound", func(t *testing.T) {
+		promoRepo, eventRepo, ticketRepo, promoManager := setupPromoCodeServiceMocks(t)
+		promoService := service.NewPromoCodeService(promoRepo, eventRepo, ticketRepo, promoManager)
+
+		eventID := 99
+		eventRepo.EXPECT().FindByID(ctx, 99).Return(nil, app_errors.ErrEventNotFound).Once()
+
+		_, err := promoService.Create(ctx, &model.PromoCode{
+			Code: "GHOST", DiscountType: model.DiscountTypeFixed, DiscountValue: 50, EventID: &eventID,
+		})
+
+		assert.ErrorIs(t, err, app_errors.ErrEventNotFound)
+	})
+}
+
+func TestPromoCodeService_ListRedemptions(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Success", func(t *testing.T) {
+		promoRepo, eventRepo, ticketRepo, promoManager := setupPromoCodeServiceMocks(t)
+		promoService := service.NewPromoCodeService(promoRepo, eventRepo, ticketRepo, promoManager)
+
+		promoRepo.EXPECT().FindByCode(ctx, "SAVE10").Return(&model.PromoCode{ID: 3, Code: "SAVE10"}, nil).Once()
+		promoRepo.EXPECT().ListRedemptions(ctx, 3).Return([]*model.PromoRedemption{{UserID: 1, DiscountAmount: 20}}, nil).Once()
+
+		redemptions, err := promoService.ListRedemptions(ctx, "save10")
+
+		require.NoError(t, err)
+		require.Len(t, redemptions, 1)
+		assert.Equal(t, 20.0, redemptions[0].DiscountAmount)
+	})
+
+	t.Run("Failed - ErrPromoCodeNotFound", func(t *testing.T) {
+		promoRepo, eventRepo, ticketRepo, promoManager := setupPromoCodeServiceMocks(t)
+		promoService := service.NewPromoCodeService(promoRepo, eventRepo, ticketRepo, promoManager)
+
+		promoRepo.EXPECT().FindByCode(ctx, "MISSING").Return(nil, app_errors.ErrPromoCodeNotFound).Once()
+
+		_, err := promoService.ListRedemptions(ctx, "missing")
+
+		assert.ErrorIs(t, err, app_errors.ErrPromoCodeNotFound)
+	})
+}
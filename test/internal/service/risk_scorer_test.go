@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	cacheMocks "go-gin-high-concurrency/internal/cache/mocks"
+	"go-gin-high-concurrency/internal/model"
+	"go-gin-high-concurrency/internal/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRiskScorer_Assess(t *testing.T) {
+	ctx := context.Background()
+	paymentFingerprint := "card-1"
+	req := model.CreateOrderRequest{
+		UserID:             1,
+		TicketID:           10,
+		Quantity:           2,
+		ClientIP:           "10.0.0.1",
+		DeviceFingerprint:  "device-a",
+		PaymentFingerprint: &paymentFingerprint,
+	}
+	signals := model.RiskSignals{
+		UserID:             1,
+		TicketID:           10,
+		Quantity:           2,
+		ClientIP:           "10.0.0.1",
+		DeviceFingerprint:  "device-a",
+		PaymentFingerprint: "card-1",
+	}
+
+	cases := []struct {
+		name     string
+		counters model.RiskCounters
+		decision model.RiskDecision
+		score    int
+		flags    []string
+	}{
+		{
+			name:     "allow - under thresholds",
+			counters: model.RiskCounters{UserAttempts: 10, IPAttempts: 30, DeviceAttempts: 10, StockMisses: 5, PaymentUsers: 3},
+			decision: model.RiskDecisionAllow,
+		},
+		{
+			name:     "allow - stock misses alone below flag score",
+			counters: model.RiskCounters{UserAttempts: 1, StockMisses: 6},
+			decision: model.RiskDecisionAllow,
+			score:    20,
+			flags:    []string{model.RiskFlagStockMisses},
+		},
+		{
+			name:     "flag - ip velocity",
+			counters: model.RiskCounters{UserAttempts: 1, IPAttempts: 31},
+			decision: model.RiskDecisionFlag,
+			score:    30,
+			flags:    []string{model.RiskFlagIPVelocity},
+		},
+		{
+			name:     "flag - shared payment",
+			counters: model.RiskCounters{UserAttempts: 1, PaymentUsers: 4},
+			decision: model.RiskDecisionFlag,
+			score:    40,
+			flags:    []string{model.RiskFlagSharedPayment},
+		},
+		{
+			name:     "block - combined signals",
+			counters: model.RiskCounters{UserAttempts: 11, DeviceAttempts: 11, StockMisses: 6},
+			decision: model.RiskDecisionBlock,
+			score:    80,
+			flags:    []string{model.RiskFlagUserVelocity, model.RiskFlagDeviceVelocity, model.RiskFlagStockMisses},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			manager := cacheMocks.NewMockRedisRiskSignalManager(t)
+			scorer := service.NewRiskScorer(manager, nil)
+			counters := tc.counters
+			manager.EXPECT().RecordAttempt(ctx, signals, time.Minute, 24*time.Hour).Return(&counters, nil).Once()
+
+			assessment, err := scorer.Assess(ctx, req)
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.decision, assessment.Decision)
+			assert.Equal(t, tc.score, assessment.Score)
+			assert.Equal(t, tc.flags, assessment.Flags)
+		})
+	}
+
+	t.Run("custom config", func(t *testing.T) {
+		manager := cacheMocks.NewMockRedisRiskSignalManager(t)
+		scorer := service.NewRiskScorer(manager, &service.RiskScorerConfig{
+			Window:          10 * time.Second,
+			MaxUserAttempts: 2,
+			BlockScore:      30,
+		})
+		manager.EXPECT().RecordAttempt(ctx, signals, 10*time.Second, 24*time.Hour).
+			Return(&model.RiskCounters{UserAttempts: 3}, nil).Once()
+
+		assessment, err := scorer.Assess(ctx, req)
+
+		require.NoError(t, err)
+		assert.Equal(t, model.RiskDecisionBlock, assessment.Decision)
+	})
+
+	t.Run("update config", func(t *testing.T) {
+		manager := cacheMocks.NewMockRedisRiskSignalManager(t)
+		scorer := service.NewRiskScorer(manager, nil)
+		// 只調整 IP 上限，其餘保留原值
+		scorer.UpdateConfig(service.RiskScorerConfig{Window: 30 * time.Second, MaxIPAttempts: 100})
+		manager.EXPECT().RecordAttempt(ctx, signals, 30*time.Second, 24*time.Hour).
+			Return(&model.RiskCounters{IPAttempts: 31, UserAttempts: 11}, nil).Once()
+
+		assessment, err := scorer.Assess(ctx, req)
+
+		require.NoError(t, err)
+		assert.Equal(t, model.RiskDecisionFlag, assessment.Decision)
+		assert.Equal(t, []string{model.RiskFlagUserVelocity}, assessment.Flags)
+	})
+
+	t.Run("signal manager error", func(t *testing.T) {
+		manager := cacheMocks.NewMockRedisRiskSignalManager(t)
+		scorer := service.NewRiskScorer(manager, nil)
+		manager.EXPECT().RecordAttempt(ctx, mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("redis unavailable")).Once()
+
+		_, err := scorer.Assess(ctx, req)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestRiskScorer_RecordInsufficientStock(t *testing.T) {
+	manager := cacheMocks.NewMockRedisRiskSignalManager(t)
+	scorer := service.NewRiskScorer(manager, nil)
+	manager.EXPECT().RecordStockMiss(mock.Anything, 1, time.Minute).Return(nil).Once()
+
+	err := scorer.RecordInsufficientStock(context.Background(), model.CreateOrderRequest{UserID: 1, TicketID: 10, Quantity: 1})
+
+	assert.NoError(t, err)
+}
@@ -0,0 +1,50 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"go-gin-high-concurrency/internal/model"
+	repoMocks "go-gin-high-concurrency/internal/repository/mocks"
+	"go-gin-high-concurrency/internal/service"
+	"go-gin-high-concurrency/pkg/app_errors"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRiskService_ListFlaggedOrders(t *testing.T) {
+	ctx := context.Background()
+	eventID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		orderRepo := repoMocks.NewMockOrderRepository(t)
+		eventRepo := repoMocks.NewMockEventRepository(t)
+		riskService := service.NewRiskService(orderRepo, eventRepo)
+
+		eventRepo.EXPECT().FindByEventID(ctx, eventID).Return(&model.Event{ID: 1, EventID: eventID}, nil).Once()
+		orderRepo.EXPECT().ListFlaggedByEventID(ctx, 1).Return([]*model.Order{
+			{ID: 5, RiskScore: 30, RiskFlags: []string{model.RiskFlagIPVelocity}},
+		}, nil).Once()
+
+		orders, err := riskService.ListFlaggedOrders(ctx, eventID)
+
+		require.NoError(t, err)
+		require.Len(t, orders, 1)
+		assert.Equal(t, 5, orders[0].ID)
+	})
+
+	t.Run("Failed - event not found", func(t *testing.T) {
+		orderRepo := repoMocks.NewMockOrderRepository(t)
+		eventRepo := repoMocks.NewMockEventRepository(t)
+		riskService := service.NewRiskService(orderRepo, eventRepo)
+
+		eventRepo.EXPECT().FindByEventID(ctx, eventID).Return(nil, app_errors.ErrEventNotFound).Once()
+
+		_, err := riskService.ListFlaggedOrders(ctx, eventID)
+
+		assert.ErrorIs(t, err, app_errors.ErrEventNotFound)
+		orderRepo.AssertNotCalled(t, "ListFlaggedByEventID")
+	})
+}
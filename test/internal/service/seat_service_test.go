@@ -0,0 +1,135 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	cacheMocks "go-gin-high-concurrency/internal/cache/mocks"
+	"go-gin-high-concurrency/internal/model"
+	repoMocks "go-gin-high-concurrency/internal/repository/mocks"
+	"go-gin-high-concurrency/internal/service"
+	"go-gin-high-concurrency/pkg/app_errors"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func setupSeatServiceMocks(t *testing.T) (
+	*repoMocks.MockSeatRepository,
+	*repoMocks.MockTicketRepository,
+	*cacheMocks.MockRedisSeatHoldManager,
+) {
+	seatRepo := repoMocks.NewMockSeatRepository(t)
+	ticketRepo := repoMocks.NewMockTicketRepository(t)
+	seatHoldManager := cacheMocks.NewMockRedisSeatHoldManager(t)
+	return seatRepo, ticketRepo, seatHoldManager
+}
+
+func newSeatedTicket(ticketID uuid.UUID) *model.Ticket {
+	sectionID := 3
+	return &model.Ticket{ID: 10, TicketID: ticketID, EventID: 1, Name: "Seated", TotalStock: 3, MaxPerUser: 2, SectionID: &sectionID}
+}
+
+func TestSeatService_ListSeatAvailability(t *testing.T) {
+	ctx := context.Background()
+	ticketID := uuid.New()
+	seats := []*model.Seat{
+		{ID: 1, SectionID: 3, Row: "A", Number: 1},
+		{ID: 2, SectionID: 3, Row: "A", Number: 2},
+		{ID: 3, SectionID: 3, Row: "A", Number: 3},
+	}
+
+	t.Run("Success - merges DB and Redis states", func(t *testing.T) {
+		seatRepo, ticketRepo, seatHoldManager := setupSeatServiceMocks(t)
+		seatService := service.NewSeatService(nil, seatRepo, ticketRepo, seatHoldManager)
+
+		ticketRepo.EXPECT().FindByTicketID(ctx, ticketID).Return(newSeatedTicket(ticketID), nil).Once()
+		seatRepo.EXPECT().ListSeatsBySectionID(ctx, 3).Return(seats, nil).Once()
+		seatRepo.EXPECT().ListSoldSeatIDs(ctx, 10).Return([]int{1}, nil).Once()
+		seatHoldManager.EXPECT().GetSeatStatuses(ctx, 10, []int{1, 2, 3}).
+			Return(map[int]model.SeatStatus{2: model.SeatStatusHeld}, nil).Once()
+
+		availability, err := seatService.ListSeatAvailability(ctx, ticketID)
+
+		require.NoError(t, err)
+		require.Len(t, availability, 3)
+		assert.Equal(t, model.SeatStatusSold, availability[0].Status)
+		assert.Equal(t, model.SeatStatusHeld, availability[1].Status)
+		assert.Equal(t, model.SeatStatusAvailable, availability[2].Status)
+		assert.Equal(t, "A", availability[2].Row)
+	})
+
+	t.Run("Failed - general admission ticket", func(t *testing.T) {
+		seatRepo, ticketRepo, seatHoldManager := setupSeatServiceMocks(t)
+		seatService := service.NewSeatService(nil, seatRepo, ticketRepo, seatHoldManager)
+
+		ticketRepo.EXPECT().FindByTicketID(ctx, ticketID).Return(&model.Ticket{ID: 10, TicketID: ticketID}, nil).Once()
+
+		_, err := seatService.ListSeatAvailability(ctx, ticketID)
+
+		assert.ErrorIs(t, err, app_errors.ErrInvalidInput)
+	})
+}
+
+func TestSeatService_HoldSeats(t *testing.T) {
+	ctx := context.Background()
+	ticketID := uuid.New()
+	seats := []*model.Seat{{ID: 1, SectionID: 3}, {ID: 2, SectionID: 3}}
+
+	t.Run("Success", func(t *testing.T) {
+		seatRepo, ticketRepo, seatHoldManager := setupSeatServiceMocks(t)
+		seatService := service.NewSeatService(nil, seatRepo, ticketRepo, seatHoldManager)
+
+		ticketRepo.EXPECT().FindByTicketID(ctx, ticketID).Return(newSeatedTicket(ticketID), nil).Once()
+		seatRepo.EXPECT().ListSeatsBySectionID(ctx, 3).Return(seats, nil).Once()
+		seatHoldManager.EXPECT().HoldSeats(ctx, 10, 7, []int{1, 2}, mock.AnythingOfType("time.Duration"), "FANCLUB").Return(nil).Once()
+
+		hold, err := seatService.HoldSeats(ctx, ticketID, 7, []int{1, 2}, " fanclub ")
+
+		require.NoError(t, err)
+		assert.Equal(t, ticketID, hold.TicketID)
+		assert.Equal(t, []int{1, 2}, hold.SeatIDs)
+		assert.True(t, hold.ExpiresAt.After(time.Now()))
+	})
+
+	t.Run("Failed - seat outside ticket section", func(t *testing.T) {
+		seatRepo, ticketRepo, seatHoldManager := setupSeatServiceMocks(t)
+		seatService := service.NewSeatService(nil, seatRepo, ticketRepo, seatHoldManager)
+
+		ticketRepo.EXPECT().FindByTicketID(ctx, ticketID).Return(newSeatedTicket(ticketID), nil).Once()
+		seatRepo.EXPECT().ListSeatsBySectionID(ctx, 3).Return(seats, nil).Once()
+
+		_, err := seatService.HoldSeats(ctx, ticketID, 7, []int{1, 99}, "")
+
+		assert.ErrorIs(t, err, app_errors.ErrInvalidInput)
+		seatHoldManager.AssertNotCalled(t, "HoldSeats")
+	})
+
+	t.Run("Failed - duplicate seats", func(t *testing.T) {
+		seatRepo, ticketRepo, seatHoldManager := setupSeatServiceMocks(t)
+		seatService := service.NewSeatService(nil, seatRepo, ticketRepo, seatHoldManager)
+
+		ticketRepo.EXPECT().FindByTicketID(ctx, ticketID).Return(newSeatedTicket(ticketID), nil).Once()
+		seatRepo.EXPECT().ListSeatsBySectionID(ctx, 3).Return(seats, nil).Once()
+
+		_, err := seatService.HoldSeats(ctx, ticketID, 7, []int{1, 1}, "")
+
+		assert.ErrorIs(t, err, app_errors.ErrInvalidInput)
+	})
+
+	t.Run("Failed - ErrSeatUnavailable", func(t *testing.T) {
+		seatRepo, ticketRepo, seatHoldManager := setupSeatServiceMocks(t)
+		seatService := service.NewSeatService(nil, seatRepo, ticketRepo, seatHoldManager)
+
+		ticketRepo.EXPECT().FindByTicketID(ctx, ticketID).Return(newSeatedTicket(ticketID), nil).Once()
+		seatRepo.EXPECT().ListSeatsBySectionID(ctx, 3).Return(seats, nil).Once()
+		seatHoldManager.EXPECT().HoldSeats(ctx, 10, 7, []int{2}, mock.Anything, "").Return(app_errors.ErrSeatUnavailable).Once()
+
+		_, err := seatService.HoldSeats(ctx, ticketID, 7, []int{2}, "")
+
+		assert.ErrorIs(t, err, app_errors.ErrSeatUnavailable)
+	})
+}
@@ -0,0 +1,358 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go-gin-high-concurrency/internal/cache"
+	cacheMocks "go-gin-high-concurrency/internal/cache/mocks"
+	"go-gin-high-concurrency/internal/model"
+	repoMocks "go-gin-high-concurrency/internal/repository/mocks"
+	"go-gin-high-concurrency/internal/service"
+	"go-gin-high-concurrency/pkg/app_errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func setupTicketServiceMocks(t *testing.T) (
+	*repoMocks.MockTicketRepository,
+	*repoMocks.MockSeatRepository,
+	*cacheMocks.MockRedisTicketInventoryManager,
+) {
+	ticketRepo := repoMocks.NewMockTicketRepository(t)
+	seatRepo := repoMocks.NewMockSeatRepository(t)
+	inventoryManager := cacheMocks.NewMockRedisTicketInventoryManager(t)
+	return ticketRepo, seatRepo, inventoryManager
+}
+
+func TestTicketService_Create(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Success - seated ticket stock equals section seats", func(t *testing.T) {
+		ticketRepo, seatRepo, inventoryManager := setupTicketServiceMocks(t)
+		ticketService := service.NewTicketService(nil, ticketRepo, seatRepo, inventoryManager)
+
+		sectionID := 3
+		seatRepo.EXPECT().ListSeatsBySectionID(ctx, 3).Return([]*model.Seat{{ID: 1}, {ID: 2}, {ID: 3}}, nil).Once()
+		ticketRepo.EXPECT().Create(ctx, mock.MatchedBy(func(t *model.Ticket) bool {
+			return t.TotalStock == 3 && t.RemainingStock == 3 && t.TicketID != uuid.Nil
+		})).RunAndReturn(func(_ context.Context, t *model.Ticket) (*model.Ticket, error) {
+			return t, nil
+		}).Once()
+
+		created, err := ticketService.Create(ctx, &model.Ticket{EventID: 1, Name: "Seated", Price: 80, MaxPerUser: 4, SectionID: &sectionID})
+
+		require.NoError(t, err)
+		assert.True(t, created.IsSeated())
+	})
+
+	t.Run("Failed - section without seats", func(t *testing.T) {
+		ticketRepo, seatRepo, inventoryManager := setupTicketServiceMocks(t)
+		ticketService := service.NewTicketService(nil, ticketRepo, seatRepo, inventoryManager)
+
+		sectionID := 3
+		seatRepo.EXPECT().ListSeatsBySectionID(ctx, 3).Return([]*model.Seat{}, nil).Once()
+
+		_, err := ticketService.Create(ctx, &model.Ticket{EventID: 1, Name: "Seated", SectionID: &sectionID})
+
+		assert.ErrorIs(t, err, app_errors.ErrInvalidInput)
+		ticketRepo.AssertNotCalled(t, "Create")
+	})
+}
+
+func TestTicketService_GetAvailability(t *testing.T) {
+	ctx := context.Background()
+	ticketID := uuid.MustParse("b0eebc99-9c0b-4ef8-bb6d-6bb9bd380a22")
+	ticket := &model.Ticket{ID: 10, TicketID: ticketID, EventID: 1, Name: "VIP", Price: 100, TotalStock: 100, RemainingStock: 100, MaxPerUser: 2}
+
+	t.Run("Success - reads stock and price from Redis", func(t *testing.T) {
+		ticketRepo, seatRepo, inventoryManager := setupTicketServiceMocks(t)
+		ticketService := service.NewTicketService(nil, ticketRepo, seatRepo, inventoryManager)
+
+		ticketRepo.EXPECT().FindByTicketID(ctx, ticketID).Return(ticket, nil).Once()
+		inventoryManager.EXPECT().GetInfo(ctx, 10).Return(cache.RedisTicketInfo{Stock: 3, Price: 120, Limit: 2}, nil).Once()
+
+		availability, err := ticketService.GetAvailability(ctx, ticketID)
+
+		require.NoError(t, err)
+		assert.Equal(t, ticketID, availability.TicketID)
+		assert.Equal(t, 3, availability.RemainingStock)
+		assert.Equal(t, 120.0, availability.Price)
+		assert.True(t, availability.Available)
+		assert.Equal(t, model.StockStatusLowStock, availability.Status)
+	})
+
+	t.Run("Success - falls back to DB when Redis is not warmed", func(t *testing.T) {
+		ticketRepo, seatRepo, inventoryManager := setupTicketServiceMocks(t)
+		ticketService := service.NewTicketService(nil, ticketRepo, seatRepo, inventoryManager)
+
+		ticketRepo.EXPECT().FindByTicketID(ctx, ticketID).Return(ticket, nil).Once()
+		inventoryManager.EXPECT().GetInfo(ctx, 10).Return(cache.RedisTicketInfo{}, app_errors.ErrTicketNotFound).Once()
+
+		availability, err := ticketService.GetAvailability(ctx, ticketID)
+
+		require.NoError(t, err)
+		assert.Equal(t, 100, availability.RemainingStock)
+		assert.Equal(t, 100.0, availability.Price)
+		assert.Equal(t, model.StockStatusAvailable, availability.Status)
+	})
+
+	t.Run("Failed - Redis error", func(t *testing.T) {
+		ticketRepo, seatRepo, inventoryManager := setupTicketServiceMocks(t)
+		ticketService := service.NewTicketService(nil, ticketRepo, seatRepo, inventoryManager)
+
+		redisErr := errors.New("redis down")
+		ticketRepo.EXPECT().FindByTicketID(ctx, ticketID).Return(ticket, nil).Once()
+		inventoryManager.EXPECT().GetInfo(ctx, 10).Return(cache.RedisTicketInfo{}, redisErr).Once()
+
+		_, err := ticketService.GetAvailability(ctx, ticketID)
+
+		assert.ErrorIs(t, err, redisErr)
+	})
+
+	t.Run("Failed - ErrTicketNotFound", func(t *testing.T) {
+		ticketRepo, seatRepo, inventoryManager := setupTicketServiceMocks(t)
+		ticketService := service.NewTicketService(nil, ticketRepo, seatRepo, inventoryManager)
+
+		ticketRepo.EXPECT().FindByTicketID(ctx, ticketID).Return(nil, app_errors.ErrTicketNotFound).Once()
+
+		_, err := ticketService.GetAvailability(ctx, ticketID)
+
+		assert.ErrorIs(t, err, app_errors.ErrTicketNotFound)
+		inventoryManager.AssertNotCalled(t, "GetInfo")
+	})
+}
+
+func TestTicketService_AdjustStock(t *testing.T) {
+	ctx := context.Background()
+	ticketID := uuid.MustParse("b0eebc99-9c0b-4ef8-bb6d-6bb9bd380a22")
+	ticket := &model.Ticket{ID: 10, TicketID: ticketID, EventID: 1, Name: "VIP", Price: 100, TotalStock: 100, RemainingStock: 80, MaxPerUser: 2}
+
+	t.Run("Success - updates DB, Redis and records adjustment", func(t *testing.T) {
+		ticketRepo, seatRepo, inventoryManager := setupTicketServiceMocks(t)
+		ticketService := service.NewTicketService(getTestDB(), ticketRepo, seatRepo, inventoryManager)
+
+		reason := "加開"
+		ticketRepo.EXPECT().FindByTicketID(ctx, ticketID).Return(ticket, nil).Once()
+		ticketRepo.EXPECT().AdjustStock(ctx, mock.Anything, 10, 50).
+			Return(&model.Ticket{ID: 10, TotalStock: 150, RemainingStock: 130}, nil).Once()
+		ticketRepo.EXPECT().CreateInventoryAdjustment(ctx, mock.Anything, mock.MatchedBy(func(a *model.InventoryAdjustment) bool {
+			return a.TicketID == 10 && a.Delta == 50 && a.TotalStock == 150 && a.RemainingStock == 130 &&
+				a.Actor == model.InventoryActorAdmin && a.Reason == &reason
+		})).RunAndReturn(func(_ context.Context, _ pgx.Tx, a *model.InventoryAdjustment) (*model.InventoryAdjustment, error) {
+			return a, nil
+		}).Once()
+		inventoryManager.EXPECT().AdjustStock(ctx, 10, 50).Return(nil).Once()
+
+		adjustment, err := ticketService.AdjustStock(ctx, ticketID, model.AdjustStockRequest{Delta: 50, Reason: &reason})
+
+		require.NoError(t, err)
+		assert.Equal(t, 150, adjustment.TotalStock)
+		assert.Equal(t, 130, adjustment.RemainingStock)
+	})
+
+	t.Run("Failed - Redis stock below decrease rolls back", func(t *testing.T) {
+		ticketRepo, seatRepo, inventoryManager := setupTicketServiceMocks(t)
+		ticketService := service.NewTicketService(getTestDB(), ticketRepo, seatRepo, inventoryManager)
+
+		ticketRepo.EXPECT().FindByTicketID(ctx, ticketID).Return(ticket, nil).Once()
+		ticketRepo.EXPECT().AdjustStock(ctx, mock.Anything, 10, -80).
+			Return(&model.Ticket{ID: 10, TotalStock: 20, RemainingStock: 0}, nil).Once()
+		ticketRepo.EXPECT().CreateInventoryAdjustment(ctx, mock.Anything, mock.Anything).
+			RunAndReturn(func(_ context.Context, _ pgx.Tx, a *model.InventoryAdjustment) (*model.InventoryAdjustment, error) {
+				return a, nil
+			}).Once()
+		inventoryManager.EXPECT().AdjustStock(ctx, 10, -80).Return(app_errors.ErrInsufficientStock).Once()
+
+		_, err := ticketService.AdjustStock(ctx, ticketID, model.AdjustStockRequest{Delta: -80, Actor: "ops"})
+
+		assert.ErrorIs(t, err, app_errors.ErrInsufficientStock)
+	})
+
+	t.Run("Failed - decrease below sold quantity", func(t *testing.T) {
+		ticketRepo, seatRepo, inventoryManager := setupTicketServiceMocks(t)
+		ticketService := service.NewTicketService(getTestDB(), ticketRepo, seatRepo, inventoryManager)
+
+		ticketRepo.EXPECT().FindByTicketID(ctx, ticketID).Return(ticket, nil).Once()
+		ticketRepo.EXPECT().AdjustStock(ctx, mock.Anything, 10, -81).Return(nil, app_errors.ErrInsufficientStock).Once()
+
+		_, err := ticketService.AdjustStock(ctx, ticketID, model.AdjustStockRequest{Delta: -81})
+
+		assert.ErrorIs(t, err, app_errors.ErrInsufficientStock)
+		ticketRepo.AssertNotCalled(t, "CreateInventoryAdjustment")
+		inventoryManager.AssertNotCalled(t, "AdjustStock")
+	})
+
+	t.Run("Failed - zero delta", func(t *testing.T) {
+		ticketRepo, seatRepo, inventoryManager := setupTicketServiceMocks(t)
+		ticketService := service.NewTicketService(nil, ticketRepo, seatRepo, inventoryManager)
+
+		_, err := ticketService.AdjustStock(ctx, ticketID, model.AdjustStockRequest{})
+
+		assert.ErrorIs(t, err, app_errors.ErrInvalidInput)
+		ticketRepo.AssertNotCalled(t, "FindByTicketID")
+	})
+
+	t.Run("Failed - seated ticket", func(t *testing.T) {
+		ticketRepo, seatRepo, inventoryManager := setupTicketServiceMocks(t)
+		ticketService := service.NewTicketService(nil, ticketRepo, seatRepo, inventoryManager)
+
+		sectionID := 3
+		ticketRepo.EXPECT().FindByTicketID(ctx, ticketID).Return(&model.Ticket{ID: 10, TicketID: ticketID, SectionID: &sectionID}, nil).Once()
+
+		_, err := ticketService.AdjustStock(ctx, ticketID, model.AdjustStockRequest{Delta: 10})
+
+		assert.ErrorIs(t, err, app_errors.ErrInvalidInput)
+		ticketRepo.AssertNotCalled(t, "AdjustStock")
+	})
+
+	t.Run("Failed - ErrTicketNotFound", func(t *testing.T) {
+		ticketRepo, seatRepo, inventoryManager := setupTicketServiceMocks(t)
+		ticketService := service.NewTicketService(nil, ticketRepo, seatRepo, inventoryManager)
+
+		ticketRepo.EXPECT().FindByTicketID(ctx, ticketID).Return(nil, app_errors.ErrTicketNotFound).Once()
+
+		_, err := ticketService.AdjustStock(ctx, ticketID, model.AdjustStockRequest{Delta: 10})
+
+		assert.ErrorIs(t, err, app_errors.ErrTicketNotFound)
+	})
+}
+
+func TestTicketService_UpdateByTicketID(t *testing.T) {
+	ctx := context.Background()
+	ticketID := uuid.MustParse("b0eebc99-9c0b-4ef8-bb6d-6bb9bd380a22")
+	ticket := &model.Ticket{ID: 10, TicketID: ticketID, EventID: 1, Name: "VIP", Price: 100, TotalStock: 100, RemainingStock: 80, MaxPerUser: 2}
+
+	t.Run("Success - price change propagates to Redis", func(t *testing.T) {
+		ticketRepo, seatRepo, inventoryManager := setupTicketServiceMocks(t)
+		ticketService := service.NewTicketService(getTestDB(), ticketRepo, seatRepo, inventoryManager)
+
+		price := 150.0
+		params := model.UpdateTicketParams{Price: &price}
+		ticketRepo.EXPECT().FindByTicketID(ctx, ticketID).Return(ticket, nil).Once()
+		ticketRepo.EXPECT().Update(ctx, mock.Anything, ticketID, params).
+			Return(&model.Ticket{ID: 10, TicketID: ticketID, Price: 150, MaxPerUser: 2}, nil).Once()
+		inventoryManager.EXPECT().UpdateInfo(ctx, 10, 150.0, 2).Return(nil).Once()
+
+		updated, err := ticketService.UpdateByTicketID(ctx, ticketID, params)
+
+		require.NoError(t, err)
+		assert.Equal(t, 150.0, updated.Price)
+	})
+
+	t.Run("Success - name only skips Redis", func(t *testing.T) {
+		ticketRepo, seatRepo, inventoryManager := setupTicketServiceMocks(t)
+		ticketService := service.NewTicketService(getTestDB(), ticketRepo, seatRepo, inventoryManager)
+
+		name := "VIP Plus"
+		params := model.UpdateTicketParams{Name: &name}
+		ticketRepo.EXPECT().FindByTicketID(ctx, ticketID).Return(ticket, nil).Once()
+		ticketRepo.EXPECT().Update(ctx, mock.Anything, ticketID, params).
+			Return(&model.Ticket{ID: 10, TicketID: ticketID, Name: name, Price: 100, MaxPerUser: 2}, nil).Once()
+
+		_, err := ticketService.UpdateByTicketID(ctx, ticketID, params)
+
+		require.NoError(t, err)
+		inventoryManager.AssertNotCalled(t, "UpdateInfo")
+	})
+
+	t.Run("Failed - Redis error rolls back", func(t *testing.T) {
+		ticketRepo, seatRepo, inventoryManager := setupTicketServiceMocks(t)
+		ticketService := service.NewTicketService(getTestDB(), ticketRepo, seatRepo, inventoryManager)
+
+		maxPerUser := 4
+		params := model.UpdateTicketParams{MaxPerUser: &maxPerUser}
+		redisErr := errors.New("redis down")
+		ticketRepo.EXPECT().FindByTicketID(ctx, ticketID).Return(ticket, nil).Once()
+		ticketRepo.EXPECT().Update(ctx, mock.Anything, ticketID, params).
+			Return(&model.Ticket{ID: 10, TicketID: ticketID, Price: 100, MaxPerUser: 4}, nil).Once()
+		inventoryManager.EXPECT().UpdateInfo(ctx, 10, 100.0, 4).Return(redisErr).Once()
+
+		_, err := ticketService.UpdateByTicketID(ctx, ticketID, params)
+
+		assert.ErrorIs(t, err, redisErr)
+	})
+
+	t.Run("Failed - ErrTicketNotFound", func(t *testing.T) {
+		ticketRepo, seatRepo, inventoryManager := setupTicketServiceMocks(t)
+		ticketService := service.NewTicketService(nil, ticketRepo, seatRepo, inventoryManager)
+
+		price := 150.0
+		ticketRepo.EXPECT().FindByTicketID(ctx, ticketID).Return(nil, app_errors.ErrTicketNotFound).Once()
+
+		_, err := ticketService.UpdateByTicketID(ctx, ticketID, model.UpdateTicketParams{Price: &price})
+
+		assert.ErrorIs(t, err, app_errors.ErrTicketNotFound)
+		ticketRepo.AssertNotCalled(t, "Update")
+	})
+}
+
+func TestTicketService_SetPricePhases(t *testing.T) {
+	ctx := context.Background()
+	ticketID := uuid.MustParse("b0eebc99-9c0b-4ef8-bb6d-6bb9bd380a22")
+	ticket := &model.Ticket{ID: 10, TicketID: ticketID, EventID: 1, Name: "VIP", Price: 1000, TotalStock: 100, RemainingStock: 100, MaxPerUser: 2}
+
+	t.Run("Success - replaces phases and syncs Redis", func(t *testing.T) {
+		ticketRepo, seatRepo, inventoryManager := setupTicketServiceMocks(t)
+		ticketService := service.NewTicketService(getTestDB(), ticketRepo, seatRepo, inventoryManager)
+
+		soldLimit := 50
+		req := model.SetPricePhasesRequest{Phases: []model.PricePhaseRequest{
+			{Name: "Early Bird", Price: 800, SoldLimit: &soldLimit},
+			{Name: "Regular", Price: 1000},
+		}}
+		saved := []*model.TicketPricePhase{
+			{ID: 1, TicketID: 10, Name: "Early Bird", Price: 800, SoldLimit: &soldLimit, Position: 1},
+			{ID: 2, TicketID: 10, Name: "Regular", Price: 1000, Position: 2},
+		}
+		ticketRepo.EXPECT().FindByTicketID(ctx, ticketID).Return(ticket, nil).Once()
+		ticketRepo.EXPECT().ListPricePhases(ctx, 10).Return([]*model.TicketPricePhase{}, nil).Once()
+		ticketRepo.EXPECT().ReplacePricePhases(ctx, mock.Anything, 10, mock.Anything).
+			Run(func(_ context.Context, _ pgx.Tx, _ int, phases []*model.TicketPricePhase) {
+				require.Len(t, phases, 2)
+				assert.Equal(t, "Early Bird", phases[0].Name)
+				assert.Equal(t, &soldLimit, phases[0].SoldLimit)
+			}).Return(saved, nil).Once()
+		inventoryManager.EXPECT().SetPricePhases(ctx, 10, saved).Return(nil).Once()
+
+		phases, err := ticketService.SetPricePhases(ctx, ticketID, req)
+
+		require.NoError(t, err)
+		assert.Equal(t, saved, phases)
+	})
+
+	t.Run("Failed - Redis error rolls back", func(t *testing.T) {
+		ticketRepo, seatRepo, inventoryManager := setupTicketServiceMocks(t)
+		ticketService := service.NewTicketService(getTestDB(), ticketRepo, seatRepo, inventoryManager)
+
+		redisErr := errors.New("redis down")
+		saved := []*model.TicketPricePhase{{ID: 1, TicketID: 10, Name: "Early Bird", Price: 800, Position: 1}}
+		ticketRepo.EXPECT().FindByTicketID(ctx, ticketID).Return(ticket, nil).Once()
+		ticketRepo.EXPECT().ListPricePhases(ctx, 10).Return([]*model.TicketPricePhase{}, nil).Once()
+		ticketRepo.EXPECT().ReplacePricePhases(ctx, mock.Anything, 10, mock.Anything).Return(saved, nil).Once()
+		inventoryManager.EXPECT().SetPricePhases(ctx, 10, saved).Return(redisErr).Once()
+
+		_, err := ticketService.SetPricePhases(ctx, ticketID, model.SetPricePhasesRequest{
+			Phases: []model.PricePhaseRequest{{Name: "Early Bird", Price: 800}},
+		})
+
+		assert.ErrorIs(t, err, redisErr)
+	})
+
+	t.Run("Failed - ErrTicketNotFound", func(t *testing.T) {
+		ticketRepo, seatRepo, inventoryManager := setupTicketServiceMocks(t)
+		ticketService := service.NewTicketService(nil, ticketRepo, seatRepo, inventoryManager)
+
+		ticketRepo.EXPECT().FindByTicketID(ctx, ticketID).Return(nil, app_errors.ErrTicketNotFound).Once()
+
+		_, err := ticketService.SetPricePhases(ctx, ticketID, model.SetPricePhasesRequest{})
+
+		assert.ErrorIs(t, err, app_errors.ErrTicketNotFound)
+		ticketRepo.AssertNotCalled(t, "ReplacePricePhases")
+	})
+}
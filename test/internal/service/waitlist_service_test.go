@@ -0,0 +1,147 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	cacheMocks "go-gin-high-concurrency/internal/cache/mocks"
+	"go-gin-high-concurrency/internal/model"
+	repoMocks "go-gin-high-concurrency/internal/repository/mocks"
+	"go-gin-high-concurrency/internal/service"
+	"go-gin-high-concurrency/pkg/app_errors"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func setupWaitlistServiceMocks(t *testing.T) (
+	*repoMocks.MockTicketRepository,
+	*repoMocks.MockOutboxRepository,
+	*cacheMocks.MockRedisWaitlistManager,
+) {
+	ticketRepo := repoMocks.NewMockTicketRepository(t)
+	outboxRepo := repoMocks.NewMockOutboxRepository(t)
+	waitlistManager := cacheMocks.NewMockRedisWaitlistManager(t)
+	return ticketRepo, outboxRepo, waitlistManager
+}
+
+func TestWaitlistService_Join(t *testing.T) {
+	ctx := context.Background()
+	ticketID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		ticketRepo, outboxRepo, waitlistManager := setupWaitlistServiceMocks(t)
+		waitlistService := service.NewWaitlistService(getTestDB(), ticketRepo, outboxRepo, waitlistManager)
+
+		ticketRepo.EXPECT().FindByTicketID(ctx, ticketID).Return(&model.Ticket{ID: 10, TicketID: ticketID}, nil).Once()
+		waitlistManager.EXPECT().Join(ctx, 10, 1, 2).Return(3, nil).Once()
+
+		entry, err := waitlistService.Join(ctx, ticketID, model.JoinWaitlistRequest{UserID: 1, Quantity: 2})
+		require.NoError(t, err)
+		assert.Equal(t, ticketID, entry.TicketID)
+		assert.Equal(t, 3, entry.Position)
+		assert.Equal(t, model.WaitlistStatusWaiting, entry.Status)
+	})
+
+	t.Run("Failed - ErrTicketNotSoldOut", func(t *testing.T) {
+		ticketRepo, outboxRepo, waitlistManager := setupWaitlistServiceMocks(t)
+		waitlistService := service.NewWaitlistService(getTestDB(), ticketRepo, outboxRepo, waitlistManager)
+
+		ticketRepo.EXPECT().FindByTicketID(ctx, ticketID).Return(&model.Ticket{ID: 10, TicketID: ticketID}, nil).Once()
+		waitlistManager.EXPECT().Join(ctx, 10, 1, 2).Return(0, app_errors.ErrTicketNotSoldOut).Once()
+
+		_, err := waitlistService.Join(ctx, ticketID, model.JoinWaitlistRequest{UserID: 1, Quantity: 2})
+		assert.ErrorIs(t, err, app_errors.ErrTicketNotSoldOut)
+	})
+}
+
+func TestWaitlistService_GetEntry(t *testing.T) {
+	ctx := context.Background()
+	ticketID := uuid.New()
+	ticketRepo, outboxRepo, waitlistManager := setupWaitlistServiceMocks(t)
+	waitlistService := service.NewWaitlistService(getTestDB(), ticketRepo, outboxRepo, waitlistManager)
+
+	ticketRepo.EXPECT().FindByTicketID(ctx, ticketID).Return(&model.Ticket{ID: 10, TicketID: ticketID}, nil).Once()
+	waitlistManager.EXPECT().GetEntry(ctx, 10, 1).
+		Return(&model.WaitlistEntry{UserID: 1, Quantity: 2, Status: model.WaitlistStatusWaiting, Position: 1}, nil).Once()
+
+	entry, err := waitlistService.GetEntry(ctx, ticketID, 1)
+	require.NoError(t, err)
+	assert.Equal(t, ticketID, entry.TicketID)
+	assert.Equal(t, 1, entry.Position)
+}
+
+func TestWaitlistService_PromoteWaitlisted(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Success - writes promoted events", func(t *testing.T) {
+		ticketRepo, outboxRepo, waitlistManager := setupWaitlistServiceMocks(t)
+		waitlistService := service.NewWaitlistService(getTestDB(), ticketRepo, outboxRepo, waitlistManager)
+
+		holds := []*model.TicketHold{
+			{HoldID: uuid.New(), TicketID: 10, UserID: 1, Quantity: 2},
+			{HoldID: uuid.New(), TicketID: 10, UserID: 2, Quantity: 1},
+		}
+		waitlistManager.EXPECT().ListUnnotified(ctx).Return(nil, nil).Once()
+		waitlistManager.EXPECT().ListWaitlistedTickets(ctx).Return([]int{10, 11}, nil).Once()
+		waitlistManager.EXPECT().Promote(ctx, 10, mock.Anything, 50).Return(holds, nil).Once()
+		waitlistManager.EXPECT().Promote(ctx, 11, mock.Anything, 50).Return([]*model.TicketHold{}, nil).Once()
+		outboxRepo.EXPECT().Create(ctx, mock.Anything, mock.MatchedBy(func(e *model.OutboxEvent) bool {
+			return e.EventType == model.EventTypeWaitlistPromoted && e.AggregateType == model.AggregateTypeHold
+		})).Return(&model.OutboxEvent{ID: 1}, nil).Times(2)
+		waitlistManager.EXPECT().MarkNotified(ctx, []uuid.UUID{holds[0].HoldID, holds[1].HoldID}).Return(nil).Once()
+
+		promoted, err := waitlistService.PromoteWaitlisted(ctx, 50)
+		require.NoError(t, err)
+		assert.Equal(t, 2, promoted)
+	})
+
+	t.Run("Success - resends unnotified promotions first", func(t *testing.T) {
+		ticketRepo, outboxRepo, waitlistManager := setupWaitlistServiceMocks(t)
+		waitlistService := service.NewWaitlistService(getTestDB(), ticketRepo, outboxRepo, waitlistManager)
+
+		pending := []*model.TicketHold{{HoldID: uuid.New(), TicketID: 10, UserID: 1, Quantity: 2}}
+		waitlistManager.EXPECT().ListUnnotified(ctx).Return(pending, nil).Once()
+		outboxRepo.EXPECT().Create(ctx, mock.Anything, mock.MatchedBy(func(e *model.OutboxEvent) bool {
+			return e.EventType == model.EventTypeWaitlistPromoted && e.AggregateID == pending[0].HoldID
+		})).Return(&model.OutboxEvent{ID: 1}, nil).Once()
+		waitlistManager.EXPECT().MarkNotified(ctx, []uuid.UUID{pending[0].HoldID}).Return(nil).Once()
+		waitlistManager.EXPECT().ListWaitlistedTickets(ctx).Return(nil, nil).Once()
+
+		promoted, err := waitlistService.PromoteWaitlisted(ctx, 50)
+		require.NoError(t, err)
+		assert.Equal(t, 0, promoted)
+	})
+
+	t.Run("Failed - outbox error is returned and promotions stay unnotified", func(t *testing.T) {
+		ticketRepo, outboxRepo, waitlistManager := setupWaitlistServiceMocks(t)
+		waitlistService := service.NewWaitlistService(getTestDB(), ticketRepo, outboxRepo, waitlistManager)
+
+		holds := []*model.TicketHold{{HoldID: uuid.New(), TicketID: 10, UserID: 1, Quantity: 2}}
+		waitlistManager.EXPECT().ListUnnotified(ctx).Return(nil, nil).Once()
+		waitlistManager.EXPECT().ListWaitlistedTickets(ctx).Return([]int{10}, nil).Once()
+		waitlistManager.EXPECT().Promote(ctx, 10, mock.Anything, 50).Return(holds, nil).Once()
+		outboxRepo.EXPECT().Create(ctx, mock.Anything, mock.Anything).Return(nil, errors.New("db down")).Once()
+
+		promoted, err := waitlistService.PromoteWaitlisted(ctx, 50)
+		assert.Error(t, err)
+		assert.Equal(t, 1, promoted)
+		waitlistManager.AssertNotCalled(t, "MarkNotified", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Failed - Promote error", func(t *testing.T) {
+		ticketRepo, outboxRepo, waitlistManager := setupWaitlistServiceMocks(t)
+		waitlistService := service.NewWaitlistService(getTestDB(), ticketRepo, outboxRepo, waitlistManager)
+
+		waitlistManager.EXPECT().ListUnnotified(ctx).Return(nil, nil).Once()
+		waitlistManager.EXPECT().ListWaitlistedTickets(ctx).Return([]int{10}, nil).Once()
+		waitlistManager.EXPECT().Promote(ctx, 10, mock.Anything, 50).Return(nil, errors.New("redis down")).Once()
+
+		_, err := waitlistService.PromoteWaitlisted(ctx, 50)
+		assert.Error(t, err)
+		outboxRepo.AssertNotCalled(t, "Create")
+	})
+}
@@ -0,0 +1,208 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"go-gin-high-concurrency/internal/model"
+	repoMocks "go-gin-high-concurrency/internal/repository/mocks"
+	"go-gin-high-concurrency/internal/service"
+	"go-gin-high-concurrency/pkg/app_errors"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func setupWebhookServiceMocks(t *testing.T) (
+	*repoMocks.MockWebhookRepository,
+	*repoMocks.MockEventRepository,
+	*repoMocks.MockTicketRepository,
+) {
+	webhookRepo := repoMocks.NewMockWebhookRepository(t)
+	eventRepo := repoMocks.NewMockEventRepository(t)
+	ticketRepo := repoMocks.NewMockTicketRepository(t)
+	return webhookRepo, eventRepo, ticketRepo
+}
+
+func TestWebhookService_CreateSubscription(t *testing.T) {
+	ctx := context.Background()
+	eventID := uuid.MustParse("a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11")
+	event := &model.Event{ID: 1, EventID: eventID, Name: "Test Event"}
+
+	t.Run("Success - generates secret when empty", func(t *testing.T) {
+		webhookRepo, eventRepo, ticketRepo := setupWebhookServiceMocks(t)
+		webhookService := service.NewWebhookService(webhookRepo, eventRepo, ticketRepo)
+
+		eventRepo.EXPECT().FindByEventID(ctx, eventID).Return(event, nil).Once()
+		webhookRepo.EXPECT().CreateSubscription(ctx, mock.MatchedBy(func(s *model.WebhookSubscription) bool {
+			return s.EventID == 1 && s.Active && len(s.Secret) == 64 && s.SubscriptionID != uuid.Nil
+		})).RunAndReturn(func(_ context.Context, s *model.WebhookSubscription) (*model.WebhookSubscription, error) {
+			return s, nil
+		}).Once()
+
+		created, err := webhookService.CreateSubscription(ctx, eventID, &model.WebhookSubscription{
+			URL:        "https://organiser.example.com/hooks",
+			EventTypes: []string{model.EventTypeOrderCreated, model.EventTypeTicketSoldOut},
+		})
+		require.NoError(t, err)
+		assert.NotEmpty(t, created.Secret)
+	})
+
+	t.Run("Failed - invalid url", func(t *testing.T) {
+		webhookRepo, eventRepo, ticketRepo := setupWebhookServiceMocks(t)
+		webhookService := service.NewWebhookService(webhookRepo, eventRepo, ticketRepo)
+
+		_, err := webhookService.CreateSubscription(ctx, eventID, &model.WebhookSubscription{
+			URL:        "ftp://organiser.example.com",
+			EventTypes: []string{model.EventTypeOrderCreated},
+		})
+		assert.ErrorIs(t, err, app_errors.ErrInvalidInput)
+	})
+
+	t.Run("Failed - unknown event type", func(t *testing.T) {
+		webhookRepo, eventRepo, ticketRepo := setupWebhookServiceMocks(t)
+		webhookService := service.NewWebhookService(webhookRepo, eventRepo, ticketRepo)
+
+		_, err := webhookService.CreateSubscription(ctx, eventID, &model.WebhookSubscription{
+			URL:        "https://organiser.example.com/hooks",
+			EventTypes: []string{"order.exploded"},
+		})
+		assert.ErrorIs(t, err, app_errors.ErrInvalidInput)
+	})
+
+	t.Run("Failed - ErrEventNotFound", func(t *testing.T) {
+		webhookRepo, eventRepo, ticketRepo := setupWebhookServiceMocks(t)
+		webhookService := service.NewWebhookService(webhookRepo, eventRepo, ticketRepo)
+
+		eventRepo.EXPECT().FindByEventID(ctx, eventID).Return(nil, app_errors.ErrEventNotFound).Once()
+
+		_, err := webhookService.CreateSubscription(ctx, eventID, &model.WebhookSubscription{
+			URL:        "https://organiser.example.com/hooks",
+			EventTypes: []string{model.EventTypeOrderCreated},
+		})
+		assert.ErrorIs(t, err, app_errors.ErrEventNotFound)
+	})
+}
+
+func TestWebhookService_Publish(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Order event - creates a delivery per matching subscription", func(t *testing.T) {
+		webhookRepo, eventRepo, ticketRepo := setupWebhookServiceMocks(t)
+		webhookService := service.NewWebhookService(webhookRepo, eventRepo, ticketRepo)
+
+		accessCode := "FANCLUB"
+		order := &model.Order{OrderID: uuid.New(), TicketID: 10, Status: model.OrderStatusConfirmed,
+			AccessCode: &accessCode, RiskScore: 40, RiskFlags: []string{"velocity"}}
+		event, err := model.NewOrderEvent(model.EventTypeOrderConfirmed, order)
+		require.NoError(t, err)
+		event.ID = 42
+
+		ticketRepo.EXPECT().FindByID(ctx, 10).Return(&model.Ticket{ID: 10, EventID: 1}, nil).Once()
+		webhookRepo.EXPECT().ListActiveSubscriptionsByEventType(ctx, 1, model.EventTypeOrderConfirmed).
+			Return([]*model.WebhookSubscription{{ID: 1}, {ID: 2}}, nil).Once()
+		webhookRepo.EXPECT().CreateDeliveries(ctx, mock.MatchedBy(func(ds []*model.WebhookDelivery) bool {
+			if len(ds) != 2 || ds[0].SubscriptionID != 1 || ds[1].SubscriptionID != 2 {
+				return false
+			}
+			var payload model.WebhookPayload
+			if err := json.Unmarshal(ds[0].Payload, &payload); err != nil {
+				return false
+			}
+			// 預售存取碼及風險評分不送給主辦方
+			var data map[string]any
+			if err := json.Unmarshal(payload.Data, &data); err != nil {
+				return false
+			}
+			_, hasAccessCode := data["access_code"]
+			_, hasRiskScore := data["risk_score"]
+			_, hasRiskFlags := data["risk_flags"]
+			return ds[0].OutboxEventID == 42 &&
+				payload.ID == ds[0].DeliveryID &&
+				payload.Type == model.EventTypeOrderConfirmed &&
+				data["order_id"] == order.OrderID.String() &&
+				!hasAccessCode && !hasRiskScore && !hasRiskFlags
+		})).Return(nil).Once()
+
+		err = webhookService.Publish(ctx, event)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Ticket sold out - resolves event from payload", func(t *testing.T) {
+		webhookRepo, eventRepo, ticketRepo := setupWebhookServiceMocks(t)
+		webhookService := service.NewWebhookService(webhookRepo, eventRepo, ticketRepo)
+
+		event, err := model.NewTicketEvent(model.EventTypeTicketSoldOut, &model.Ticket{ID: 10, TicketID: uuid.New(), EventID: 3})
+		require.NoError(t, err)
+
+		webhookRepo.EXPECT().ListActiveSubscriptionsByEventType(ctx, 3, model.EventTypeTicketSoldOut).
+			Return([]*model.WebhookSubscription{{ID: 5}}, nil).Once()
+		webhookRepo.EXPECT().CreateDeliveries(ctx, mock.Anything).Return(nil).Once()
+
+		err = webhookService.Publish(ctx, event)
+		assert.NoError(t, err)
+	})
+
+	t.Run("No subscriptions - no deliveries", func(t *testing.T) {
+		webhookRepo, eventRepo, ticketRepo := setupWebhookServiceMocks(t)
+		webhookService := service.NewWebhookService(webhookRepo, eventRepo, ticketRepo)
+
+		event, err := model.NewTicketEvent(model.EventTypeTicketSoldOut, &model.Ticket{ID: 10, EventID: 3})
+		require.NoError(t, err)
+
+		webhookRepo.EXPECT().ListActiveSubscriptionsByEventType(ctx, 3, model.EventTypeTicketSoldOut).
+			Return([]*model.WebhookSubscription{}, nil).Once()
+
+		err = webhookService.Publish(ctx, event)
+		assert.NoError(t, err)
+		webhookRepo.AssertNotCalled(t, "CreateDeliveries")
+	})
+
+	t.Run("Ticket deleted - skipped", func(t *testing.T) {
+		webhookRepo, eventRepo, ticketRepo := setupWebhookServiceMocks(t)
+		webhookService := service.NewWebhookService(webhookRepo, eventRepo, ticketRepo)
+
+		event, err := model.NewOrderEvent(model.EventTypeOrderCancelled, &model.Order{TicketID: 10})
+		require.NoError(t, err)
+
+		ticketRepo.EXPECT().FindByID(ctx, 10).Return(nil, app_errors.ErrTicketNotFound).Once()
+
+		err = webhookService.Publish(ctx, event)
+		assert.NoError(t, err)
+	})
+}
+
+func TestWebhookService_Redeliver(t *testing.T) {
+	ctx := context.Background()
+	subscriptionID := uuid.New()
+	deliveryID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		webhookRepo, eventRepo, ticketRepo := setupWebhookServiceMocks(t)
+		webhookService := service.NewWebhookService(webhookRepo, eventRepo, ticketRepo)
+
+		webhookRepo.EXPECT().FindSubscriptionBySubscriptionID(ctx, subscriptionID).Return(&model.WebhookSubscription{ID: 1}, nil).Once()
+		webhookRepo.EXPECT().FindDeliveryByDeliveryID(ctx, 1, deliveryID).Return(&model.WebhookDelivery{ID: 9}, nil).Once()
+		webhookRepo.EXPECT().ResetDelivery(ctx, int64(9)).
+			Return(&model.WebhookDelivery{ID: 9, Status: model.WebhookDeliveryStatusPending}, nil).Once()
+
+		delivery, err := webhookService.Redeliver(ctx, subscriptionID, deliveryID)
+		require.NoError(t, err)
+		assert.Equal(t, model.WebhookDeliveryStatusPending, delivery.Status)
+	})
+
+	t.Run("Failed - ErrWebhookDeliveryNotFound", func(t *testing.T) {
+		webhookRepo, eventRepo, ticketRepo := setupWebhookServiceMocks(t)
+		webhookService := service.NewWebhookService(webhookRepo, eventRepo, ticketRepo)
+
+		webhookRepo.EXPECT().FindSubscriptionBySubscriptionID(ctx, subscriptionID).Return(&model.WebhookSubscription{ID: 1}, nil).Once()
+		webhookRepo.EXPECT().FindDeliveryByDeliveryID(ctx, 1, deliveryID).Return(nil, app_errors.ErrWebhookDeliveryNotFound).Once()
+
+		_, err := webhookService.Redeliver(ctx, subscriptionID, deliveryID)
+		assert.ErrorIs(t, err, app_errors.ErrWebhookDeliveryNotFound)
+		webhookRepo.AssertNotCalled(t, "ResetDelivery")
+	})
+}
@@ -0,0 +1,57 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	serviceMocks "go-gin-high-concurrency/internal/service/mocks"
+	"go-gin-high-concurrency/internal/worker"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHoldSweeper_DrainsFullBatchesThenPolls(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	holdService := serviceMocks.NewMockHoldService(t)
+	polled := make(chan struct{}, 1)
+	// 第一批回收滿了，立即處理下一批；之後沒有到期的保留
+	holdService.EXPECT().ReleaseExpiredHolds(mock.Anything, 2).Return(2, nil).Once()
+	holdService.EXPECT().ReleaseExpiredHolds(mock.Anything, 2).
+		Run(func(context.Context, int) { polled <- struct{}{} }).Return(0, nil).Once()
+	holdService.EXPECT().ReleaseExpiredHolds(mock.Anything, 2).Return(0, nil).Maybe()
+
+	sweeper := worker.NewHoldSweeper(holdService, &worker.HoldSweeperConfig{BatchSize: 2, PollInterval: time.Hour})
+	require.NoError(t, sweeper.Start(ctx))
+
+	select {
+	case <-polled:
+	case <-time.After(time.Second):
+		t.Fatal("expected sweeper to drain the second batch without waiting for the poll interval")
+	}
+}
+
+func TestHoldSweeper_KeepsRunningAfterError(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	holdService := serviceMocks.NewMockHoldService(t)
+	recovered := make(chan struct{}, 1)
+	holdService.EXPECT().ReleaseExpiredHolds(mock.Anything, mock.Anything).Return(0, errors.New("redis down")).Once()
+	holdService.EXPECT().ReleaseExpiredHolds(mock.Anything, mock.Anything).
+		Run(func(context.Context, int) { recovered <- struct{}{} }).Return(0, nil).Once()
+	holdService.EXPECT().ReleaseExpiredHolds(mock.Anything, mock.Anything).Return(0, nil).Maybe()
+
+	sweeper := worker.NewHoldSweeper(holdService, &worker.HoldSweeperConfig{PollInterval: 10 * time.Millisecond})
+	require.NoError(t, sweeper.Start(ctx))
+
+	select {
+	case <-recovered:
+	case <-time.After(time.Second):
+		t.Fatal("expected sweeper to retry after an error")
+	}
+}
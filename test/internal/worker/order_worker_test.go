@@ -6,6 +6,7 @@ import (
 	"go-gin-high-concurrency/internal/queue"
 	"go-gin-high-concurrency/internal/service"
 	"go-gin-high-concurrency/internal/worker"
+	"go-gin-high-concurrency/pkg/logger"
 	"testing"
 	"time"
 )
@@ -27,7 +28,7 @@ func TestOrderWorker_Integration(t *testing.T) {
 	}
 
 	// 3. 啟動 Worker
-	w := worker.NewOrderWorker(mockSvc, q)
+	w := worker.NewOrderWorker(mockSvc, q, nil)
 	w.Start(ctx)
 
 	// 4. 執行：模擬 API 丟入一筆訂單
@@ -55,3 +56,97 @@ func (m *mockOrderService) DispatchOrder(ctx context.Context, o *model.Order) er
 	m.onDispatch(o)
 	return nil
 }
+
+func TestOrderWorker_Concurrency(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	q := queue.NewOrderQueue(10)
+	started := make(chan int, 10)
+	unblock := make(chan struct{})
+	mockSvc := &mockOrderService{
+		onDispatch: func(order *model.Order) {
+			started <- order.ID
+			<-unblock
+		},
+	}
+	w := worker.NewOrderWorker(mockSvc, q, &worker.OrderWorkerConfig{Concurrency: 2})
+	w.Start(ctx)
+
+	for i := 1; i <= 4; i++ {
+		q.PublishOrder(ctx, &model.Order{ID: i, Status: model.OrderStatusPending})
+	}
+
+	waitStarted := func(n int) {
+		t.Helper()
+		for i := 0; i < n; i++ {
+			select {
+			case <-started:
+			case <-time.After(time.Second):
+				t.Fatalf("超時！預期同時處理 %d 筆訂單", n)
+			}
+		}
+	}
+	// 上限為 2：只有兩筆訂單同時處理
+	waitStarted(2)
+	select {
+	case id := <-started:
+		t.Fatalf("超過並行上限，訂單 %d 提前開始處理", id)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// 執行中調高上限：等待中的訂單立即開始處理
+	w.UpdateConfig(worker.OrderWorkerConfig{Concurrency: 4})
+	waitStarted(2)
+	close(unblock)
+}
+
+func TestOrderWorker_Heartbeat(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	q := queue.NewOrderQueue(10)
+	w := worker.NewOrderWorker(&mockOrderService{onDispatch: func(*model.Order) {}}, q, nil)
+	if !w.LastHeartbeat().IsZero() {
+		t.Fatal("尚未啟動不應有心跳")
+	}
+
+	before := time.Now().Add(-time.Second)
+	w.Start(ctx)
+
+	if last := w.LastHeartbeat(); last.Before(before) {
+		t.Errorf("啟動後應更新心跳，got %v", last)
+	}
+}
+
+type ctxOrderService struct {
+	service.OrderService
+	requestIDs chan string
+}
+
+func (m *ctxOrderService) DispatchOrder(ctx context.Context, o *model.Order) error {
+	m.requestIDs <- logger.RequestID(ctx)
+	return nil
+}
+
+func TestOrderWorker_PropagateRequestID(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	q := queue.NewOrderQueue(10)
+	mockSvc := &ctxOrderService{requestIDs: make(chan string, 1)}
+	w := worker.NewOrderWorker(mockSvc, q, nil)
+	w.Start(ctx)
+
+	// 下單請求的 request id 隨訊息傳入，Worker 呼叫 Service 時放回 context
+	q.PublishOrder(ctx, &model.Order{ID: 1, CorrelationID: "req-123", Status: model.OrderStatusPending})
+
+	select {
+	case got := <-mockSvc.requestIDs:
+		if got != "req-123" {
+			t.Errorf("request id = %q, want %q", got, "req-123")
+		}
+	case <-time.After(1 * time.Second):
+		t.Error("超時！Worker 沒有在時間內處理訂單")
+	}
+}
@@ -0,0 +1,39 @@
+package worker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	serviceMocks "go-gin-high-concurrency/internal/service/mocks"
+	"go-gin-high-concurrency/internal/worker"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWaitlistPromoter_PollsWithBatchSize(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	waitlistService := serviceMocks.NewMockWaitlistService(t)
+	polled := make(chan struct{}, 2)
+	waitlistService.EXPECT().PromoteWaitlisted(mock.Anything, 5).
+		Run(func(context.Context, int) {
+			select {
+			case polled <- struct{}{}:
+			default:
+			}
+		}).Return(0, nil)
+
+	promoter := worker.NewWaitlistPromoter(waitlistService, &worker.WaitlistPromoterConfig{BatchSize: 5, PollInterval: 10 * time.Millisecond})
+	require.NoError(t, promoter.Start(ctx))
+
+	for i := 0; i < 2; i++ {
+		select {
+		case <-polled:
+		case <-time.After(time.Second):
+			t.Fatal("expected promoter to poll repeatedly")
+		}
+	}
+}
@@ -0,0 +1,245 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"go-gin-high-concurrency/internal/model"
+	repoMocks "go-gin-high-concurrency/internal/repository/mocks"
+	"go-gin-high-concurrency/internal/worker"
+	"go-gin-high-concurrency/pkg/webhook"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testWebhookSecret = "test-secret"
+
+func newTestDeliveryTask(url string, attempts int) *model.WebhookDeliveryTask {
+	deliveryID := uuid.New()
+	payload, _ := json.Marshal(model.WebhookPayload{
+		ID:        deliveryID,
+		Type:      model.EventTypeOrderCreated,
+		CreatedAt: time.Now(),
+		Data:      json.RawMessage(`{"status":"pending"}`),
+	})
+	return &model.WebhookDeliveryTask{
+		Delivery: &model.WebhookDelivery{
+			ID:         1,
+			DeliveryID: deliveryID,
+			EventType:  model.EventTypeOrderCreated,
+			Payload:    payload,
+			Status:     model.WebhookDeliveryStatusPending,
+			Attempts:   attempts,
+		},
+		URL:    url,
+		Secret: testWebhookSecret,
+	}
+}
+
+// claimOnce 第一次領取回傳 task，之後回傳空批次
+func claimOnce(repo *repoMocks.MockWebhookRepository, task *model.WebhookDeliveryTask) {
+	repo.EXPECT().ClaimDueDeliveries(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*model.WebhookDeliveryTask{task}, nil).Once()
+	repo.EXPECT().ClaimDueDeliveries(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*model.WebhookDeliveryTask{}, nil).Maybe()
+}
+
+func TestWebhookDispatcher_DeliversSignedPayload(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	received := make(chan *http.Request, 1)
+	var body []byte
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ = io.ReadAll(r.Body)
+		received <- r
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer receiver.Close()
+
+	repo := repoMocks.NewMockWebhookRepository(t)
+	task := newTestDeliveryTask(receiver.URL, 0)
+	claimOnce(repo, task)
+
+	recorded := make(chan model.WebhookDeliveryAttempt, 1)
+	repo.EXPECT().RecordDeliveryAttempt(mock.Anything, int64(1), mock.Anything).
+		Run(func(_ context.Context, _ int64, attempt model.WebhookDeliveryAttempt) { recorded <- attempt }).
+		Return(nil).Once()
+
+	dispatcher := worker.NewWebhookDispatcher(repo, &worker.WebhookDispatcherConfig{PollInterval: 20 * time.Millisecond})
+	require.NoError(t, dispatcher.Start(ctx))
+
+	select {
+	case r := <-received:
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.Equal(t, model.EventTypeOrderCreated, r.Header.Get(webhook.HeaderEvent))
+		assert.Equal(t, task.Delivery.DeliveryID.String(), r.Header.Get(webhook.HeaderDelivery))
+
+		timestamp, err := strconv.ParseInt(r.Header.Get(webhook.HeaderTimestamp), 10, 64)
+		require.NoError(t, err)
+		assert.True(t, webhook.Verify(testWebhookSecret, timestamp, body, r.Header.Get(webhook.HeaderSignature)))
+		assert.False(t, webhook.Verify("wrong-secret", timestamp, body, r.Header.Get(webhook.HeaderSignature)))
+		assert.JSONEq(t, string(task.Delivery.Payload), string(body))
+	case <-time.After(2 * time.Second):
+		t.Fatal("receiver did not get the webhook")
+	}
+
+	select {
+	case attempt := <-recorded:
+		assert.Equal(t, model.WebhookDeliveryStatusSucceeded, attempt.Status)
+		require.NotNil(t, attempt.StatusCode)
+		assert.Equal(t, http.StatusOK, *attempt.StatusCode)
+	case <-time.After(2 * time.Second):
+		t.Fatal("delivery attempt was not recorded")
+	}
+}
+
+func TestWebhookDispatcher_RetriesWithBackoff(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer receiver.Close()
+
+	repo := repoMocks.NewMockWebhookRepository(t)
+	// 已失敗 2 次，這是第 3 次嘗試：等待時間應為 InitialBackoff * 2^2
+	task := newTestDeliveryTask(receiver.URL, 2)
+	claimOnce(repo, task)
+
+	recorded := make(chan model.WebhookDeliveryAttempt, 1)
+	repo.EXPECT().RecordDeliveryAttempt(mock.Anything, int64(1), mock.Anything).
+		Run(func(_ context.Context, _ int64, attempt model.WebhookDeliveryAttempt) { recorded <- attempt }).
+		Return(nil).Once()
+
+	dispatcher := worker.NewWebhookDispatcher(repo, &worker.WebhookDispatcherConfig{
+		PollInterval:   20 * time.Millisecond,
+		InitialBackoff: 1 * time.Minute,
+		MaxAttempts:    5,
+	})
+	before := time.Now()
+	require.NoError(t, dispatcher.Start(ctx))
+
+	select {
+	case attempt := <-recorded:
+		assert.Equal(t, model.WebhookDeliveryStatusPending, attempt.Status)
+		require.NotNil(t, attempt.StatusCode)
+		assert.Equal(t, http.StatusInternalServerError, *attempt.StatusCode)
+		require.NotNil(t, attempt.Error)
+		assert.WithinDuration(t, before.Add(4*time.Minute), attempt.NextAttemptAt, 5*time.Second)
+	case <-time.After(2 * time.Second):
+		t.Fatal("delivery attempt was not recorded")
+	}
+}
+
+func TestWebhookDispatcher_MarksFailedAfterMaxAttempts(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var hits atomic.Int32
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer receiver.Close()
+
+	repo := repoMocks.NewMockWebhookRepository(t)
+	task := newTestDeliveryTask(receiver.URL, 4)
+	claimOnce(repo, task)
+
+	recorded := make(chan model.WebhookDeliveryAttempt, 1)
+	repo.EXPECT().RecordDeliveryAttempt(mock.Anything, int64(1), mock.Anything).
+		Run(func(_ context.Context, _ int64, attempt model.WebhookDeliveryAttempt) { recorded <- attempt }).
+		Return(nil).Once()
+
+	dispatcher := worker.NewWebhookDispatcher(repo, &worker.WebhookDispatcherConfig{
+		PollInterval: 20 * time.Millisecond,
+		MaxAttempts:  5,
+	})
+	require.NoError(t, dispatcher.Start(ctx))
+
+	select {
+	case attempt := <-recorded:
+		assert.Equal(t, model.WebhookDeliveryStatusFailed, attempt.Status)
+		assert.Equal(t, int32(1), hits.Load())
+	case <-time.After(2 * time.Second):
+		t.Fatal("delivery attempt was not recorded")
+	}
+}
+
+func TestWebhookDispatcher_UnreachableReceiver(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// 關閉的 server：連線失敗、沒有狀態碼
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	url := receiver.URL
+	receiver.Close()
+
+	repo := repoMocks.NewMockWebhookRepository(t)
+	claimOnce(repo, newTestDeliveryTask(url, 0))
+
+	recorded := make(chan model.WebhookDeliveryAttempt, 1)
+	repo.EXPECT().RecordDeliveryAttempt(mock.Anything, int64(1), mock.Anything).
+		Run(func(_ context.Context, _ int64, attempt model.WebhookDeliveryAttempt) { recorded <- attempt }).
+		Return(nil).Once()
+
+	dispatcher := worker.NewWebhookDispatcher(repo, &worker.WebhookDispatcherConfig{PollInterval: 20 * time.Millisecond})
+	require.NoError(t, dispatcher.Start(ctx))
+
+	select {
+	case attempt := <-recorded:
+		assert.Equal(t, model.WebhookDeliveryStatusPending, attempt.Status)
+		assert.Nil(t, attempt.StatusCode)
+		assert.NotNil(t, attempt.Error)
+	case <-time.After(2 * time.Second):
+		t.Fatal("delivery attempt was not recorded")
+	}
+}
+
+func TestWebhookDispatcher_UpdateConfigRaisesMaxAttempts(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer receiver.Close()
+
+	repo := repoMocks.NewMockWebhookRepository(t)
+	task := newTestDeliveryTask(receiver.URL, 4)
+	claimOnce(repo, task)
+
+	recorded := make(chan model.WebhookDeliveryAttempt, 1)
+	repo.EXPECT().RecordDeliveryAttempt(mock.Anything, int64(1), mock.Anything).
+		Run(func(_ context.Context, _ int64, attempt model.WebhookDeliveryAttempt) { recorded <- attempt }).
+		Return(nil).Once()
+
+	dispatcher := worker.NewWebhookDispatcher(repo, &worker.WebhookDispatcherConfig{
+		PollInterval: 20 * time.Millisecond,
+		MaxAttempts:  5,
+	})
+	// 執行中調高重試次數：第 5 次失敗仍排入重試
+	dispatcher.UpdateConfig(worker.WebhookDispatcherConfig{MaxAttempts: 10})
+	require.NoError(t, dispatcher.Start(ctx))
+
+	select {
+	case attempt := <-recorded:
+		assert.Equal(t, model.WebhookDeliveryStatusPending, attempt.Status)
+		assert.False(t, attempt.NextAttemptAt.IsZero())
+	case <-time.After(2 * time.Second):
+		t.Fatal("delivery attempt was not recorded")
+	}
+}